@@ -261,14 +261,11 @@ func (a *Service) updateAttestation(beaconState *pb.BeaconState, attestation *et
 		// validator's actual index.
 		pubkey := bytesutil.ToBytes48(beaconState.Validators[committee[i]].PublicKey)
 		attTargetBoundarySlot := attestation.Data.Target.Epoch * params.BeaconConfig().SlotsPerEpoch
-		currentAttestationSlot := uint64(0)
 		a.store.Lock()
-		defer a.store.Unlock()
-		if _, exists := a.store.m[pubkey]; exists {
-			currentAttestationSlot = attTargetBoundarySlot
-		}
-		// If the attestation is newer than this attester's one in pool.
-		if attTargetBoundarySlot > currentAttestationSlot {
+		current := a.store.m[pubkey]
+		// If the attester has no attestation in pool or the attestation is newer
+		// than this attester's one in pool.
+		if current == nil || attTargetBoundarySlot > current.Data.Target.Epoch*params.BeaconConfig().SlotsPerEpoch {
 			a.store.m[pubkey] = attestation
 
 			log.WithFields(
@@ -278,6 +275,7 @@ func (a *Service) updateAttestation(beaconState *pb.BeaconState, attestation *et
 				},
 			).Debug("Attestation store updated")
 		}
+		a.store.Unlock()
 	}
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -97,6 +98,133 @@ func TestUpdateLatestAttestation_UpdatesLatest(t *testing.T) {
 	}
 }
 
+func TestUpdateLatestAttestation_KeepsNewestTarget(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	var validators []*ethpb.Validator
+	for i := 0; i < 64; i++ {
+		validators = append(validators, &ethpb.Validator{
+			PublicKey:       []byte{byte(i)},
+			ActivationEpoch: 0,
+			ExitEpoch:       10,
+		})
+	}
+	beaconState := &pb.BeaconState{
+		Validators:       validators,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+	block := &ethpb.BeaconBlock{
+		Slot: 1,
+	}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(ctx, block, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	pubkey := bytesutil.ToBytes48(beaconState.Validators[10].PublicKey)
+
+	newAttestation := func(targetEpoch uint64, blockRoot byte) *ethpb.Attestation {
+		return &ethpb.Attestation{
+			AggregationBits: bitfield.Bitlist{0x03},
+			Data: &ethpb.AttestationData{
+				BeaconBlockRoot: []byte{blockRoot},
+				Crosslink: &ethpb.Crosslink{
+					Shard: 1,
+				},
+				Target: &ethpb.Checkpoint{Epoch: targetEpoch},
+				Source: &ethpb.Checkpoint{},
+			},
+		}
+	}
+	tests := []struct {
+		attestation *ethpb.Attestation
+		wantRoot    byte
+	}{
+		// The first attestation of the attester is stored, even in epoch 0.
+		{attestation: newAttestation(0, 'a'), wantRoot: 'a'},
+		{attestation: newAttestation(1, 'b'), wantRoot: 'b'},
+		{attestation: newAttestation(1, 'c'), wantRoot: 'b'},
+		{attestation: newAttestation(0, 'd'), wantRoot: 'b'},
+		{attestation: newAttestation(2, 'e'), wantRoot: 'e'},
+	}
+	for _, tt := range tests {
+		if err := service.UpdateLatestAttestation(ctx, tt.attestation); err != nil {
+			t.Fatalf("could not update latest attestation: %v", err)
+		}
+		if root := service.store.m[pubkey].Data.BeaconBlockRoot; !bytes.Equal(root, []byte{tt.wantRoot}) {
+			t.Errorf("Expected the attestation for block %q after target epoch %d, received %q",
+				tt.wantRoot, tt.attestation.Data.Target.Epoch, root)
+		}
+	}
+}
+
+func TestUpdateLatestAttestation_StoresEveryAttester(t *testing.T) {
+	helpers.ClearAllCaches()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	var validators []*ethpb.Validator
+	for i := 0; i < 256; i++ {
+		validators = append(validators, &ethpb.Validator{
+			PublicKey: []byte{byte(i), byte(i >> 8)},
+			ExitEpoch: 10,
+		})
+	}
+	beaconState := &pb.BeaconState{
+		Validators:       validators,
+		RandaoMixes:      make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots: make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+	block := &ethpb.BeaconBlock{
+		Slot: 1,
+	}
+	if err := beaconDB.SaveBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.UpdateChainHead(ctx, block, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	committee, err := helpers.CrosslinkCommittee(beaconState, 0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(committee) < 2 {
+		t.Fatalf("Expected a committee of several attesters, received %d", len(committee))
+	}
+	aggregationBits := bitfield.NewBitlist(uint64(len(committee)))
+	for i := range committee {
+		aggregationBits.SetBitAt(uint64(i), true)
+	}
+	attestation := &ethpb.Attestation{
+		AggregationBits: aggregationBits,
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: []byte{'A'},
+			Crosslink: &ethpb.Crosslink{
+				Shard: 1,
+			},
+			Target: &ethpb.Checkpoint{},
+			Source: &ethpb.Checkpoint{},
+		},
+	}
+
+	service := NewAttestationService(context.Background(), &Config{BeaconDB: beaconDB})
+	if err := service.UpdateLatestAttestation(ctx, attestation); err != nil {
+		t.Fatalf("could not update latest attestation: %v", err)
+	}
+	for _, index := range committee {
+		pubkey := bytesutil.ToBytes48(validators[index].PublicKey)
+		if service.store.m[pubkey] != attestation {
+			t.Errorf("Expected the attestation of validator %d to be stored", index)
+		}
+	}
+}
+
 func TestAttestationPool_UpdatesAttestationPool(t *testing.T) {
 	beaconDB := internal.SetupDB(t)
 	defer internal.TeardownDB(t, beaconDB)
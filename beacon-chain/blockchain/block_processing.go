@@ -9,6 +9,7 @@ import (
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -128,7 +129,8 @@ func (c *ChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBloc
 // Ethereum 2.0, namely:
 //   The parent block with root block.parent_root has been processed and accepted.
 //   The node has processed its state up to slot, block.slot - 1.
-//   The Ethereum 1.0 block pointed to by the state.processed_pow_receipt_root has been processed and accepted.
+//   The Ethereum 1.0 block pointed to by the state.processed_pow_receipt_root has been processed and accepted,
+//   unless the service skips the ETH1.0 block check.
 //   The node's local clock time is greater than or equal to state.genesis_time + block.slot * SECONDS_PER_SLOT.
 func (c *ChainService) VerifyBlockValidity(
 	ctx context.Context,
@@ -139,9 +141,16 @@ func (c *ChainService) VerifyBlockValidity(
 		return fmt.Errorf("cannot process a genesis block: received block with slot %d",
 			block.Slot)
 	}
-	powBlockFetcher := c.web3Service.Client().BlockByHash
-	if err := b.IsValidBlock(ctx, beaconState, block,
-		c.beaconDB.HasBlock, powBlockFetcher, c.genesisTime); err != nil {
+	var err error
+	if c.skipETH1BlockCheck {
+		// Without a web3 service there is no ETH1.0 block fetcher to give.
+		err = b.IsValidBlock(ctx, beaconState, block,
+			c.beaconDB.HasBlock, nil, true, c.genesisTime, c.clock.Now())
+	} else {
+		err = b.IsValidBlock(ctx, beaconState, block,
+			c.beaconDB.HasBlock, c.web3Service.Client().BlockByHash, false, c.genesisTime, c.clock.Now())
+	}
+	if err != nil {
 		return errors.Wrap(err, "block does not fulfill pre-processing conditions")
 	}
 	return nil
@@ -203,18 +212,29 @@ func (c *ChainService) AdvanceState(
 	block *ethpb.BeaconBlock,
 ) (*pb.BeaconState, error) {
 	finalizedEpoch := beaconState.FinalizedCheckpoint.Epoch
-	newState, err := state.ExecuteStateTransition(
+	justifiedEpoch := beaconState.CurrentJustifiedCheckpoint.Epoch
+	postState, err := state.ExecuteStateTransition(
 		ctx,
-		beaconState,
+		statetrie.New(beaconState),
 		block,
 	)
 	if err != nil {
 		return beaconState, &BlockFailedProcessingErr{err}
 	}
+	newState := postState.InnerStateUnsafe()
 	// Prune the block cache and helper caches on every new finalized epoch.
 	if newState.FinalizedCheckpoint.Epoch > finalizedEpoch {
 		helpers.ClearAllCaches()
 		c.beaconDB.ClearBlockCache()
+		c.checkpointFeed.Send(&CheckpointEvent{
+			Finalized:  true,
+			Checkpoint: newState.FinalizedCheckpoint,
+		})
+	}
+	if newState.CurrentJustifiedCheckpoint.Epoch > justifiedEpoch {
+		c.checkpointFeed.Send(&CheckpointEvent{
+			Checkpoint: newState.CurrentJustifiedCheckpoint,
+		})
 	}
 
 	log.WithField(
@@ -231,7 +251,7 @@ func (c *ChainService) AdvanceState(
 			return nil, err
 		}
 		// Save Historical States.
-		if err := c.beaconDB.SaveHistoricalState(ctx, beaconState, blockRoot); err != nil {
+		if err := c.beaconDB.SaveHistoricalState(ctx, newState, blockRoot); err != nil {
 			return nil, errors.Wrap(err, "could not save historical state")
 		}
 	}
@@ -1,6 +1,7 @@
 package blockchain
 
 import (
+	"bytes"
 	"context"
 	"encoding/binary"
 	"math/big"
@@ -14,11 +15,13 @@ import (
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	v "github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -45,12 +48,12 @@ func initBlockStateRoot(t *testing.T, block *ethpb.BeaconBlock, chainService *Ch
 		return nil, err
 	}
 
-	computedState, err := state.ExecuteStateTransitionNoVerify(context.Background(), beaconState, block)
+	computedState, err := state.ExecuteStateTransitionNoVerify(context.Background(), statetrie.New(beaconState), block)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	stateRoot, err := ssz.HashTreeRoot(computedState)
+	stateRoot, err := computedState.HashTreeRoot()
 	if err != nil {
 		return nil, err
 	}
@@ -168,12 +171,12 @@ func TestReceiveBlock_ProcessCorrectly(t *testing.T) {
 		},
 	}
 
-	stateRootCandidate, err := state.ExecuteStateTransitionNoVerify(context.Background(), beaconState, block)
+	stateRootCandidate, err := state.ExecuteStateTransitionNoVerify(context.Background(), statetrie.New(beaconState), block)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	stateRoot, err := ssz.HashTreeRoot(stateRootCandidate)
+	stateRoot, err := stateRootCandidate.HashTreeRoot()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -197,6 +200,26 @@ func TestReceiveBlock_ProcessCorrectly(t *testing.T) {
 		t.Errorf("Block failed processing: %v", err)
 	}
 	testutil.AssertLogsContain(t, hook, "Finished processing beacon block")
+
+	// The historical state of the block is its post state.
+	blockRoot, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	historicalState, err := db.HistoricalStateAtBlock(ctx, block.Slot, blockRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if historicalState == nil {
+		t.Fatal("Expected a historical state saved for the block")
+	}
+	historicalStateRoot, err := ssz.HashTreeRoot(historicalState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(historicalStateRoot[:], block.StateRoot) {
+		t.Errorf("Expected the post state %#x of the block, received %#x", block.StateRoot, historicalStateRoot)
+	}
 }
 
 func TestReceiveBlock_UsesParentBlockState(t *testing.T) {
@@ -237,7 +260,11 @@ func TestReceiveBlock_UsesParentBlockState(t *testing.T) {
 	}
 
 	// We ensure the block uses the right state parent if its ancestor is not block.Slot-1.
-	beaconState, err = state.ProcessSlots(ctx, beaconState, beaconState.Slot+3)
+	slotState, err := state.ProcessSlots(ctx, statetrie.New(beaconState), beaconState.Slot+3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState = slotState.InnerStateUnsafe()
 	beaconState.Slot++
 	epoch := helpers.SlotToEpoch(beaconState.Slot)
 	randaoReveal, err := testutil.CreateRandaoReveal(beaconState, epoch, privKeys)
@@ -259,12 +286,12 @@ func TestReceiveBlock_UsesParentBlockState(t *testing.T) {
 		},
 	}
 
-	stateRootCandidate, err := state.ExecuteStateTransitionNoVerify(context.Background(), beaconState, block)
+	stateRootCandidate, err := state.ExecuteStateTransitionNoVerify(context.Background(), statetrie.New(beaconState), block)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	stateRoot, err := ssz.HashTreeRoot(stateRootCandidate)
+	stateRoot, err := stateRootCandidate.HashTreeRoot()
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1011,6 +1038,76 @@ func TestIsBlockReadyForProcessing_ValidBlock(t *testing.T) {
 	}
 }
 
+func TestVerifyBlockValidity_SlotFollowsClock(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	chainService := setupBeaconChain(t, db, nil)
+	chainService.genesisTime = time.Unix(1000, 0)
+	clock := clockutil.NewManual(chainService.genesisTime)
+	chainService.clock = clock
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("cannot save block: %v", err)
+	}
+	parentRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState := &pb.BeaconState{
+		Eth1Data: &ethpb.Eth1Data{BlockHash: []byte{3}},
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       2,
+		ParentRoot: parentRoot[:],
+	}
+
+	clock.Advance(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	if err := chainService.VerifyBlockValidity(ctx, block, beaconState); err == nil {
+		t.Fatal("block is valid despite its slot not having started")
+	}
+	clock.Advance(time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second + time.Second)
+	if err := chainService.VerifyBlockValidity(ctx, block, beaconState); err != nil {
+		t.Fatalf("block is invalid despite its slot having started: %v", err)
+	}
+}
+
+func TestVerifyBlockValidity_SkipsETH1BlockCheck(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	// Without a web3 service the check of the ETH1.0 block cannot run.
+	chainService, err := NewChainService(ctx, &Config{
+		BeaconDB:           db,
+		SkipETH1BlockCheck: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	genesis := b.NewGenesisBlock([]byte{})
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatalf("cannot save block: %v", err)
+	}
+	parentRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState := &pb.BeaconState{
+		Eth1Data: &ethpb.Eth1Data{BlockHash: []byte{3}},
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       1,
+		ParentRoot: parentRoot[:],
+	}
+	if err := chainService.VerifyBlockValidity(ctx, block, beaconState); err != nil {
+		t.Fatalf("block is invalid despite skipping the ETH1.0 block check: %v", err)
+	}
+}
+
 func TestDeleteValidatorIdx_DeleteWorks(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
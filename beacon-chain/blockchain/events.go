@@ -0,0 +1,44 @@
+package blockchain
+
+import (
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+)
+
+// EventFeeds interface defines the methods of the ChainService which notify
+// subscribers of changes to the canonical chain.
+type EventFeeds interface {
+	HeadFeed() *event.Feed
+	CheckpointFeed() *event.Feed
+}
+
+// HeadEvent is sent on the head feed whenever the fork choice rule selects a
+// new head block. Reorg is set if the new head does not descend from the
+// previous one.
+type HeadEvent struct {
+	Slot         uint64
+	BlockRoot    [32]byte
+	StateRoot    [32]byte
+	PreviousSlot uint64
+	PreviousRoot [32]byte
+	Reorg        bool
+}
+
+// CheckpointEvent is sent on the checkpoint feed whenever a state transition
+// justifies or finalizes a newer checkpoint.
+type CheckpointEvent struct {
+	Finalized  bool
+	Checkpoint *ethpb.Checkpoint
+}
+
+// HeadFeed returns a feed that is written to whenever the head
+// of the canonical chain changes.
+func (c *ChainService) HeadFeed() *event.Feed {
+	return c.headFeed
+}
+
+// CheckpointFeed returns a feed that is written to whenever a new
+// checkpoint is justified or finalized.
+func (c *ChainService) CheckpointFeed() *event.Feed {
+	return c.checkpointFeed
+}
@@ -184,7 +184,8 @@ func (c *ChainService) ApplyForkChoiceRule(
 	}
 
 	newState := postState
-	if !isDescendant && !proto.Equal(currentHead, newHead) {
+	reorged := !isDescendant && !proto.Equal(currentHead, newHead)
+	if reorged {
 		log.WithFields(logrus.Fields{
 			"currentSlot": currentHead.Slot,
 			"currentRoot": fmt.Sprintf("%#x", bytesutil.Trunc(currentHeadRoot[:])),
@@ -231,6 +232,16 @@ func (c *ChainService) ApplyForkChoiceRule(
 		"stateSlot": newState.Slot,
 	}).Info("Chain head block and state updated")
 
+	if !proto.Equal(currentHead, newHead) {
+		c.headFeed.Send(&HeadEvent{
+			Slot:         newHead.Slot,
+			BlockRoot:    newHeadRoot,
+			StateRoot:    bytesutil.ToBytes32(newHead.StateRoot),
+			PreviousSlot: currentHead.Slot,
+			PreviousRoot: currentHeadRoot,
+			Reorg:        reorged,
+		})
+	}
 	return nil
 }
 
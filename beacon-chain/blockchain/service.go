@@ -11,6 +11,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
@@ -20,7 +21,9 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -48,11 +51,26 @@ type ChainService struct {
 	genesisTime          time.Time
 	finalizedEpoch       uint64
 	stateInitializedFeed *event.Feed
+	headFeed             *event.Feed
+	checkpointFeed       *event.Feed
 	p2p                  p2p.Broadcaster
 	canonicalBlocks      map[uint64][]byte
 	canonicalBlocksLock  sync.RWMutex
 	receiveBlockLock     sync.Mutex
 	maxRoutines          int64
+	clock                clockutil.Clock
+	skipETH1BlockCheck   bool
+	// finalizedStatusRoots caches the finalized state root of the chain
+	// status for the last finalized checkpoint, as hashing the state for
+	// every peer handshake is expensive.
+	finalizedStatusRoots finalizedStatusRoots
+}
+
+// finalizedStatusRoots is the finalized state root of a finalized checkpoint.
+type finalizedStatusRoots struct {
+	lock       sync.Mutex
+	checkpoint *ethpb.Checkpoint
+	stateRoot  [32]byte
 }
 
 // Config options for the service.
@@ -65,11 +83,23 @@ type Config struct {
 	DevMode        bool
 	P2p            p2p.Broadcaster
 	MaxRoutines    int64
+	// Clock is the local clock blocks are checked against, the system
+	// clock if it is not set.
+	Clock clockutil.Clock
+	// SkipETH1BlockCheck disables the check that the ETH1.0 block referenced
+	// by the parent state exists, Web3Service may then be nil once the chain
+	// is initialized. It is meant for blocks verified by another node, such
+	// as imported chain data.
+	SkipETH1BlockCheck bool
 }
 
 // NewChainService instantiates a new service instance that will
 // be registered into a running beacon node.
 func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
+	clock := cfg.Clock
+	if clock == nil {
+		clock = clockutil.System{}
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &ChainService{
 		ctx:                  ctx,
@@ -81,9 +111,13 @@ func NewChainService(ctx context.Context, cfg *Config) (*ChainService, error) {
 		canonicalBlockFeed:   new(event.Feed),
 		chainStartChan:       make(chan time.Time),
 		stateInitializedFeed: new(event.Feed),
+		headFeed:             new(event.Feed),
+		checkpointFeed:       new(event.Feed),
 		p2p:                  cfg.P2p,
 		canonicalBlocks:      make(map[uint64][]byte),
 		maxRoutines:          cfg.MaxRoutines,
+		clock:                clock,
+		skipETH1BlockCheck:   cfg.SkipETH1BlockCheck,
 	}, nil
 }
 
@@ -225,6 +259,72 @@ func (c *ChainService) ChainHeadRoot() ([32]byte, error) {
 	return root, nil
 }
 
+// ChainStatus returns the finalized checkpoint and the head of the local
+// chain, which are exchanged with peers in the p2p handshake. It returns nil
+// if the chain has not started yet.
+func (c *ChainService) ChainStatus() (*pb.Handshake, error) {
+	head, err := c.beaconDB.ChainHead()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve chain head")
+	}
+	if head == nil {
+		return nil, nil
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not tree hash chain head")
+	}
+	headState, err := c.beaconDB.HeadState(c.ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve head state")
+	}
+	if headState == nil {
+		return nil, nil
+	}
+	finalizedStateRoot, err := c.finalizedStatusRoot(headState.FinalizedCheckpoint)
+	if err != nil {
+		return nil, err
+	}
+	headStateRoot := c.beaconDB.HeadStateRoot()
+
+	return &pb.Handshake{
+		FinalizedRoot:      headState.FinalizedCheckpoint.Root,
+		FinalizedEpoch:     headState.FinalizedCheckpoint.Epoch,
+		FinalizedStateRoot: finalizedStateRoot[:],
+		HeadRoot:           headRoot[:],
+		HeadSlot:           head.Slot,
+		HeadStateRoot:      headStateRoot[:],
+	}, nil
+}
+
+// finalizedStatusRoot returns the root of the finalized state of the given
+// finalized checkpoint, hashing the finalized state only when the checkpoint
+// changes.
+func (c *ChainService) finalizedStatusRoot(checkpoint *ethpb.Checkpoint) ([32]byte, error) {
+	c.finalizedStatusRoots.lock.Lock()
+	defer c.finalizedStatusRoots.lock.Unlock()
+	if cached := c.finalizedStatusRoots.checkpoint; cached != nil && proto.Equal(cached, checkpoint) {
+		return c.finalizedStatusRoots.stateRoot, nil
+	}
+	finalizedState, err := c.beaconDB.FinalizedState()
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not retrieve finalized state")
+	}
+	root, err := hashutil.HashProto(finalizedState)
+	if err != nil {
+		return [32]byte{}, errors.Wrap(err, "could not tree hash finalized state")
+	}
+	c.finalizedStatusRoots.checkpoint = proto.Clone(checkpoint).(*ethpb.Checkpoint)
+	c.finalizedStatusRoots.stateRoot = root
+	return root, nil
+}
+
+// HasBlock returns true if the block with the given root exists in the
+// database.
+func (c *ChainService) HasBlock(root [32]byte) bool {
+	return c.beaconDB.HasBlock(root)
+}
+
 // UpdateCanonicalRoots sets a new head into the canonical block roots map.
 func (c *ChainService) UpdateCanonicalRoots(newHead *ethpb.BeaconBlock, newHeadRoot [32]byte) {
 	c.canonicalBlocksLock.Lock()
@@ -18,8 +18,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -28,6 +30,7 @@ import (
 
 // Ensure ChainService implements interfaces.
 var _ = ChainFeeds(&ChainService{})
+var _ = EventFeeds(&ChainService{})
 
 func init() {
 	logrus.SetLevel(logrus.DebugLevel)
@@ -268,3 +271,49 @@ func TestChainStartStop_Initialized(t *testing.T) {
 	}
 	testutil.AssertLogsContain(t, hook, "Beacon chain data already exists, starting service")
 }
+
+func TestFinalizedStatusRoot_CachedPerCheckpoint(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	chainService := setupBeaconChain(t, db, nil)
+
+	if err := db.SaveFinalizedState(&pb.BeaconState{Slot: 1}); err != nil {
+		t.Fatal(err)
+	}
+	checkpoint := &ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}}
+	root, err := chainService.finalizedStatusRoot(checkpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := hashutil.HashProto(&pb.BeaconState{Slot: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Errorf("Expected finalized state root %#x, received %#x", want, root)
+	}
+
+	// The state is not hashed again while the checkpoint is the same.
+	if err := db.SaveFinalizedState(&pb.BeaconState{Slot: 2}); err != nil {
+		t.Fatal(err)
+	}
+	root, err = chainService.finalizedStatusRoot(&ethpb.Checkpoint{Epoch: 1, Root: []byte{'a'}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Errorf("Expected cached finalized state root %#x, received %#x", want, root)
+	}
+
+	root, err = chainService.finalizedStatusRoot(&ethpb.Checkpoint{Epoch: 2, Root: []byte{'b'}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err = hashutil.HashProto(&pb.BeaconState{Slot: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Errorf("Expected finalized state root %#x of the new checkpoint, received %#x", want, root)
+	}
+}
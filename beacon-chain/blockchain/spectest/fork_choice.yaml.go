@@ -0,0 +1,47 @@
+package spectest
+
+import (
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// ForkChoiceTest is the format of the fork choice test vectors.
+type ForkChoiceTest struct {
+	Title         string                `json:"title"`
+	Summary       string                `json:"summary"`
+	ForksTimeline string                `json:"forks_timeline"`
+	Forks         []string              `json:"forks"`
+	Config        string                `json:"config"`
+	Runner        string                `json:"runner"`
+	Handler       string                `json:"handler"`
+	TestCases     []*ForkChoiceTestCase `json:"test_cases"`
+}
+
+// ForkChoiceTestCase starts a chain from the anchor block and state, and
+// applies its steps in order.
+type ForkChoiceTestCase struct {
+	Description string             `json:"description"`
+	AnchorState *pb.BeaconState    `json:"anchor_state"`
+	AnchorBlock *ethpb.BeaconBlock `json:"anchor_block"`
+	Steps       []*ForkChoiceStep  `json:"steps"`
+}
+
+// ForkChoiceStep holds exactly one of a tick to a unix time, a block, an
+// attestation or the checks of the fork choice store after the previous steps.
+// Valid is false for blocks and attestations which must be rejected.
+type ForkChoiceStep struct {
+	Tick        *uint64            `json:"tick"`
+	Block       *ethpb.BeaconBlock `json:"block"`
+	Attestation *ethpb.Attestation `json:"attestation"`
+	Valid       *bool              `json:"valid"`
+	Checks      *ForkChoiceChecks  `json:"checks"`
+}
+
+// ForkChoiceChecks holds the expected head and checkpoints, empty fields are
+// not checked.
+type ForkChoiceChecks struct {
+	HeadRoot            []byte            `json:"head_root"`
+	HeadSlot            *uint64           `json:"head_slot"`
+	JustifiedCheckpoint *ethpb.Checkpoint `json:"justified_checkpoint"`
+	FinalizedCheckpoint *ethpb.Checkpoint `json:"finalized_checkpoint"`
+}
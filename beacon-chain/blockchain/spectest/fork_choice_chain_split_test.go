@@ -0,0 +1,148 @@
+package spectest
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// TestForkChoiceChainSplit runs a test case built from a genesis state, until
+// the spec tests release includes fork choice vectors. Two blocks at slots 1
+// and 2 build on the genesis block, the block with the higher root is the head
+// until an attestation votes for the other block.
+func TestForkChoiceChainSplit(t *testing.T) {
+	helpers.ClearAllCaches()
+	deposits, privKeys := testutil.SetupInitialDeposits(t, 64)
+	genesisState, err := state.GenesisBeaconState(deposits, 1567000000, &ethpb.Eth1Data{
+		BlockHash: bytes.Repeat([]byte{0x42}, 32),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := blocks.NewGenesisBlock(stateRoot[:])
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	blockA := buildBlock(t, genesisState, genesisRoot, 1, privKeys)
+	blockB := buildBlock(t, genesisState, genesisRoot, 2, privKeys)
+	rootA, err := ssz.SigningRoot(blockA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootB, err := ssz.SigningRoot(blockB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Without votes the fork choice picks the child with the higher root.
+	higherRoot, lowerRoot := rootA, rootB
+	if bytes.Compare(rootA[:], rootB[:]) < 0 {
+		higherRoot, lowerRoot = rootB, rootA
+	}
+
+	shard, err := helpers.StartShard(genesisState, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vote := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: lowerRoot[:],
+			Source:          &ethpb.Checkpoint{},
+			Target:          &ethpb.Checkpoint{Root: genesisRoot[:]},
+			Crosslink:       &ethpb.Crosslink{Shard: shard},
+		},
+		AggregationBits: bitfield.Bitlist{0x03},
+	}
+
+	genesisCheckpoint := &ethpb.Checkpoint{Root: genesisRoot[:]}
+	invalid := false
+	runForkChoiceTestCase(t, &ForkChoiceTestCase{
+		Description: "chain_split",
+		AnchorState: genesisState,
+		AnchorBlock: genesis,
+		Steps: []*ForkChoiceStep{
+			{Checks: &ForkChoiceChecks{HeadRoot: genesisRoot[:], HeadSlot: uint64Ptr(0)}},
+			{Tick: uint64Ptr(slotTime(genesisState, 1))},
+			{Block: blockA},
+			{Checks: &ForkChoiceChecks{HeadRoot: rootA[:], HeadSlot: uint64Ptr(1)}},
+			// Block B is from a slot the clock has not reached yet.
+			{Block: blockB, Valid: &invalid},
+			{Tick: uint64Ptr(slotTime(genesisState, 2))},
+			{Block: blockB},
+			{Checks: &ForkChoiceChecks{HeadRoot: higherRoot[:]}},
+			{Attestation: vote},
+			{Checks: &ForkChoiceChecks{
+				HeadRoot:            lowerRoot[:],
+				JustifiedCheckpoint: genesisCheckpoint,
+				FinalizedCheckpoint: genesisCheckpoint,
+			}},
+		},
+	})
+}
+
+// buildBlock returns a signed block at the given slot on top of the parent,
+// with the state root of its post state.
+func buildBlock(t *testing.T, parentState *pb.BeaconState, parentRoot [32]byte, slot uint64, privKeys []*bls.SecretKey) *ethpb.BeaconBlock {
+	ctx := context.Background()
+	preState := statetrie.New(parentState)
+	s, err := state.ProcessSlots(ctx, preState.Copy(), slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slotState := s.InnerStateUnsafe()
+	randaoReveal, err := testutil.CreateRandaoReveal(slotState, helpers.CurrentEpoch(slotState), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data:     parentState.Eth1Data,
+			RandaoReveal: randaoReveal,
+		},
+	}
+	block, err = testutil.SignBlock(slotState, block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	postState, err := state.ExecuteStateTransitionNoVerify(ctx, preState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := postState.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.StateRoot = stateRoot[:]
+	block, err = testutil.SignBlock(slotState, block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
+
+func slotTime(genesisState *pb.BeaconState, slot uint64) uint64 {
+	return genesisState.GenesisTime + slot*params.BeaconConfig().SecondsPerSlot
+}
+
+func uint64Ptr(v uint64) *uint64 {
+	return &v
+}
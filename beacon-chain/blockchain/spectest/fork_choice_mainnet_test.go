@@ -0,0 +1,10 @@
+package spectest
+
+import (
+	"testing"
+)
+
+func TestForkChoiceMainnet(t *testing.T) {
+	t.Skip("The spec tests release pinned in WORKSPACE does not include fork choice vectors")
+	runForkChoiceTests(t, "fork_choice_mainnet.yaml")
+}
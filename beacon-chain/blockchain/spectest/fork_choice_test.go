@@ -0,0 +1,209 @@
+package spectest
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/chaindata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/params/spectest"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+const forkChoicePrefix = "tests/fork_choice/"
+
+type mockBroadcaster struct{}
+
+func (mb *mockBroadcaster) Broadcast(_ context.Context, _ proto.Message) {}
+
+func runForkChoiceTests(t *testing.T, filename string) {
+	filepath, err := bazel.Runfile(forkChoicePrefix + filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		t.Fatalf("Could not load file %v", err)
+	}
+
+	s := &ForkChoiceTest{}
+	if err := testutil.UnmarshalYaml(file, s); err != nil {
+		t.Fatalf("Failed to Unmarshal: %v", err)
+	}
+
+	if err := spectest.SetConfig(s.Config); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.TestCases) == 0 {
+		t.Fatal("No tests!")
+	}
+
+	for _, tt := range s.TestCases {
+		t.Run(tt.Description, func(t *testing.T) {
+			runForkChoiceTestCase(t, tt)
+		})
+	}
+}
+
+// runForkChoiceTestCase saves the anchor of the test case to an empty database
+// and applies the steps to a chain service reading a manual clock, which
+// starts at the slot of the anchor and moves forward with the ticks.
+func runForkChoiceTestCase(t *testing.T, tt *ForkChoiceTestCase) {
+	helpers.ClearAllCaches()
+	ctx := context.Background()
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up database: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+
+	if _, err := chaindata.SaveAnchor(ctx, beaconDB, tt.AnchorBlock, tt.AnchorState); err != nil {
+		t.Fatalf("Could not save anchor: %v", err)
+	}
+	// The interop service knows the eth1 block of the anchor state, which is
+	// all the chain service asks for.
+	web3Service, err := powchain.NewInteropService(ctx, beaconDB, tt.AnchorState, nil /*deposits*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attsService := attestation.NewAttestationService(ctx, &attestation.Config{BeaconDB: beaconDB})
+	anchorTime := tt.AnchorState.GenesisTime + tt.AnchorState.Slot*params.BeaconConfig().SecondsPerSlot
+	clock := clockutil.NewManual(time.Unix(int64(anchorTime), 0))
+	chainService, err := blockchain.NewChainService(ctx, &blockchain.Config{
+		BeaconDB:       beaconDB,
+		Web3Service:    web3Service,
+		AttsService:    attsService,
+		OpsPoolService: operations.NewOpsPoolService(ctx, &operations.Config{BeaconDB: beaconDB}),
+		P2p:            &mockBroadcaster{},
+		Clock:          clock,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chainService.Start()
+	defer chainService.Stop()
+
+	for i, step := range tt.Steps {
+		switch {
+		case step.Tick != nil:
+			clock.Set(time.Unix(int64(*step.Tick), 0))
+		case step.Block != nil:
+			checkValidity(t, i, step.Valid, processBlock(ctx, chainService, step.Block))
+		case step.Attestation != nil:
+			checkValidity(t, i, step.Valid, processAttestation(ctx, beaconDB, attsService, chainService, step.Attestation))
+		case step.Checks != nil:
+			checkForkChoice(ctx, t, i, beaconDB, step.Checks)
+		default:
+			t.Fatalf("Step %d holds nothing to apply", i)
+		}
+	}
+}
+
+// processBlock applies the block the way the sync service applies blocks
+// received from peers.
+func processBlock(ctx context.Context, chainService *blockchain.ChainService, block *ethpb.BeaconBlock) error {
+	postState, err := chainService.ReceiveBlock(ctx, block)
+	if err != nil {
+		return err
+	}
+	return chainService.ApplyForkChoiceRule(ctx, block, postState)
+}
+
+// processAttestation updates the latest attestations with the attestation and
+// runs the fork choice again from the current head.
+func processAttestation(
+	ctx context.Context,
+	beaconDB *db.BeaconDB,
+	attsService *attestation.Service,
+	chainService *blockchain.ChainService,
+	att *ethpb.Attestation,
+) error {
+	if err := attsService.BatchUpdateLatestAttestation(ctx, []*ethpb.Attestation{att}); err != nil {
+		return err
+	}
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return err
+	}
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return err
+	}
+	return chainService.ApplyForkChoiceRule(ctx, head, headState)
+}
+
+func checkValidity(t *testing.T, step int, valid *bool, err error) {
+	if valid == nil || *valid {
+		if err != nil {
+			t.Fatalf("Step %d: %v", step, err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("Step %d: expected to be rejected", step)
+	}
+}
+
+// checkForkChoice compares the checks with the chain head, and the checkpoint
+// epochs of the head state together with the roots of the justified and
+// finalized blocks the chain service runs the fork choice from.
+func checkForkChoice(ctx context.Context, t *testing.T, step int, beaconDB *db.BeaconDB, checks *ForkChoiceChecks) {
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checks.HeadRoot != nil && !bytes.Equal(headRoot[:], checks.HeadRoot) {
+		t.Errorf("Step %d: expected head %#x, received %#x at slot %d", step, checks.HeadRoot, headRoot, head.Slot)
+	}
+	if checks.HeadSlot != nil && head.Slot != *checks.HeadSlot {
+		t.Errorf("Step %d: expected head at slot %d, received slot %d", step, *checks.HeadSlot, head.Slot)
+	}
+
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	justifiedBlock, err := beaconDB.JustifiedBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCheckpoint(t, step, "justified", checks.JustifiedCheckpoint, headState.CurrentJustifiedCheckpoint.Epoch, justifiedBlock)
+	finalizedBlock, err := beaconDB.FinalizedBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkCheckpoint(t, step, "finalized", checks.FinalizedCheckpoint, headState.FinalizedCheckpoint.Epoch, finalizedBlock)
+}
+
+func checkCheckpoint(t *testing.T, step int, name string, want *ethpb.Checkpoint, epoch uint64, block *ethpb.BeaconBlock) {
+	if want == nil {
+		return
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want.Epoch != epoch || !bytes.Equal(want.Root, root[:]) {
+		t.Errorf("Step %d: expected %s checkpoint %#x at epoch %d, received %#x at epoch %d",
+			step, name, want.Root, want.Epoch, root, epoch)
+	}
+}
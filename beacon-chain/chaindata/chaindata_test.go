@@ -0,0 +1,271 @@
+package chaindata
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// fakeImporter saves the received blocks as the new head, in place of the
+// blockchain service.
+type fakeImporter struct {
+	db         *db.BeaconDB
+	states     map[uint64]*pb.BeaconState
+	forkChoice int
+}
+
+func (f *fakeImporter) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
+	if err := f.db.SaveBlock(block); err != nil {
+		return nil, err
+	}
+	return f.states[block.Slot], f.db.UpdateChainHead(ctx, block, f.states[block.Slot])
+}
+
+func (f *fakeImporter) ApplyForkChoiceRule(_ context.Context, _ *ethpb.BeaconBlock, _ *pb.BeaconState) error {
+	f.forkChoice++
+	return nil
+}
+
+func testBlock(t *testing.T, slot uint64, parentRoot []byte, s *pb.BeaconState) *ethpb.BeaconBlock {
+	stateRoot := make([]byte, 32)
+	if s != nil {
+		root, err := ssz.HashTreeRoot(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		stateRoot = root[:]
+	}
+	return &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot,
+		StateRoot:  stateRoot,
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: make([]byte, 96),
+			Eth1Data: &ethpb.Eth1Data{
+				DepositRoot: make([]byte, 32),
+				BlockHash:   make([]byte, 32),
+			},
+			Graffiti: make([]byte, 32),
+		},
+		Signature: make([]byte, 96),
+	}
+}
+
+// setupChain saves a chain with blocks at the given slots on top of a genesis
+// anchor to the database, and returns the post states of the blocks by slot.
+func setupChain(t *testing.T, beaconDB *db.BeaconDB, slots []uint64) map[uint64]*pb.BeaconState {
+	ctx := context.Background()
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{
+		DepositRoot: make([]byte, 32),
+		BlockHash:   make([]byte, 32),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := testBlock(t, 0, make([]byte, 32), genesisState)
+	if _, err := SaveAnchor(ctx, beaconDB, genesis, genesisState); err != nil {
+		t.Fatal(err)
+	}
+
+	states := map[uint64]*pb.BeaconState{0: genesisState}
+	parentRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, slot := range slots {
+		s := proto.Clone(genesisState).(*pb.BeaconState)
+		s.Slot = slot
+		block := testBlock(t, slot, parentRoot[:], s)
+		if err := beaconDB.SaveBlock(block); err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.UpdateChainHead(ctx, block, s); err != nil {
+			t.Fatal(err)
+		}
+		parentRoot, err = ssz.SigningRoot(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := beaconDB.SaveHistoricalState(ctx, s, parentRoot); err != nil {
+			t.Fatal(err)
+		}
+		states[slot] = s
+	}
+	return states
+}
+
+func TestExportImport_FromGenesis(t *testing.T) {
+	ctx := context.Background()
+	sourceDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, sourceDB)
+	states := setupChain(t, sourceDB, []uint64{1, 3, 4})
+
+	buf := new(bytes.Buffer)
+	exported, err := Export(ctx, sourceDB, buf, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exported != 3 {
+		t.Errorf("Expected 3 exported blocks, received %d", exported)
+	}
+
+	targetDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, targetDB)
+	data := buf.Bytes()
+	importer := &fakeImporter{db: targetDB, states: states}
+	for i, wantImported := range []uint64{3, 0} {
+		cr, err := NewReader(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		anchor, anchorState, err := cr.ReadAnchor()
+		if err != nil {
+			t.Fatal(err)
+		}
+		saved, err := SaveAnchor(ctx, targetDB, anchor, anchorState)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if saved != (i == 0) {
+			t.Errorf("Expected the anchor to be saved only on the first import, saved: %v", saved)
+		}
+		imported, err := ImportBlocks(ctx, targetDB, cr, importer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if imported != wantImported {
+			t.Errorf("Expected %d imported blocks, received %d", wantImported, imported)
+		}
+	}
+	if importer.forkChoice != 3 {
+		t.Errorf("Expected fork choice to run for 3 blocks, ran %d times", importer.forkChoice)
+	}
+
+	sourceHead, err := sourceDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	targetHead, err := targetDB.ChainHead()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(sourceHead, targetHead) {
+		t.Errorf("Expected head %v, received %v", sourceHead, targetHead)
+	}
+}
+
+func TestExport_FromCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	setupChain(t, beaconDB, []uint64{1, 2, 5})
+
+	finalized, err := beaconDB.CanonicalBlockBySlot(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := beaconDB.SaveFinalizedBlock(finalized); err != nil {
+		t.Fatal(err)
+	}
+
+	buf := new(bytes.Buffer)
+	exported, err := Export(ctx, beaconDB, buf, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exported != 1 {
+		t.Errorf("Expected 1 exported block, received %d", exported)
+	}
+	cr, err := NewReader(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	anchor, anchorState, err := cr.ReadAnchor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(anchor, finalized) {
+		t.Errorf("Expected finalized block as anchor, received %v", anchor)
+	}
+	if err := anchorStateMatches(anchor, anchorState); err != nil {
+		t.Error(err)
+	}
+	block, err := cr.ReadBlock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block.Slot != 5 {
+		t.Errorf("Expected block at slot 5, received slot %d", block.Slot)
+	}
+	if _, err := cr.ReadBlock(); err != io.EOF {
+		t.Errorf("Expected end of file, received %v", err)
+	}
+}
+
+func TestSaveAnchor_RejectsUnknownAnchor(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	states := setupChain(t, beaconDB, []uint64{1})
+
+	s := proto.Clone(states[1]).(*pb.BeaconState)
+	s.Slot = 7
+	block := testBlock(t, 7, make([]byte, 32), s)
+	if _, err := SaveAnchor(ctx, beaconDB, block, s); err == nil || !strings.Contains(err.Error(), "not part of the chain") {
+		t.Errorf("Expected unknown anchor to be rejected, received %v", err)
+	}
+	s.Slot = 8
+	if _, err := SaveAnchor(ctx, beaconDB, block, s); err == nil || !strings.Contains(err.Error(), "does not match") {
+		t.Errorf("Expected mismatched anchor state to be rejected, received %v", err)
+	}
+}
+
+func TestReader_RejectsInvalidFiles(t *testing.T) {
+	if _, err := NewReader(strings.NewReader("not chain data file")); err == nil {
+		t.Error("Expected a file without header to be rejected")
+	}
+	versioned := append(append([]byte{}, magic...), formatVersion+1)
+	if _, err := NewReader(bytes.NewReader(versioned)); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("Expected an unsupported version to be rejected, received %v", err)
+	}
+
+	buf := new(bytes.Buffer)
+	cw, err := NewWriter(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.WriteBlock(testBlock(t, 1, make([]byte, 32), nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	cr, err := NewReader(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := cr.ReadAnchor(); err == nil || !strings.Contains(err.Error(), "expected anchor block") {
+		t.Errorf("Expected a file without anchor to be rejected, received %v", err)
+	}
+
+	truncated := buf.Bytes()[:buf.Len()-1]
+	cr, err = NewReader(bytes.NewReader(truncated))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cr.ReadBlock(); err == nil || err == io.EOF {
+		t.Errorf("Expected a truncated block to be rejected, received %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package chaindata
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "chaindata")
+
+// Export writes the canonical chain of the database to w. The chain starts
+// from the genesis block, or from the last finalized block if fromCheckpoint
+// is set, and ends at the current head. It returns the number of blocks
+// written after the anchor.
+func Export(ctx context.Context, beaconDB *db.BeaconDB, w io.Writer, fromCheckpoint bool) (uint64, error) {
+	anchor, err := anchorBlock(ctx, beaconDB, fromCheckpoint)
+	if err != nil {
+		return 0, err
+	}
+	anchorRoot, err := ssz.SigningRoot(anchor)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not hash anchor block")
+	}
+	anchorState, err := beaconDB.HistoricalStateFromSlot(ctx, anchor.Slot, anchorRoot)
+	if err == nil && anchorState.Slot != anchor.Slot {
+		err = errors.New("no state saved at the slot of the anchor block")
+	}
+	if err != nil {
+		if !fromCheckpoint {
+			return 0, errors.Wrap(err, "could not retrieve genesis state, it may have been pruned: "+
+				"export from the finalized checkpoint instead")
+		}
+		return 0, errors.Wrap(err, "could not retrieve the state of the finalized block")
+	}
+	head, err := beaconDB.ChainHead()
+	if err != nil {
+		return 0, errors.Wrap(err, "could not retrieve chain head")
+	}
+
+	cw, err := NewWriter(w)
+	if err != nil {
+		return 0, err
+	}
+	if err := cw.WriteAnchor(anchor, anchorState); err != nil {
+		return 0, err
+	}
+	log.WithFields(logrus.Fields{
+		"slot":      anchor.Slot,
+		"blockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(anchorRoot[:])),
+	}).Info("Exporting chain from anchor block")
+
+	var count uint64
+	for slot := anchor.Slot + 1; slot <= head.Slot; slot++ {
+		if ctx.Err() != nil {
+			return count, ctx.Err()
+		}
+		block, err := beaconDB.CanonicalBlockBySlot(ctx, slot)
+		if err != nil {
+			return count, errors.Wrapf(err, "could not retrieve canonical block at slot %d", slot)
+		}
+		if block == nil {
+			continue
+		}
+		if err := cw.WriteBlock(block); err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, cw.Flush()
+}
+
+// anchorBlock returns the canonical genesis block or the last finalized block.
+func anchorBlock(ctx context.Context, beaconDB *db.BeaconDB, fromCheckpoint bool) (*ethpb.BeaconBlock, error) {
+	if fromCheckpoint {
+		block, err := beaconDB.FinalizedBlock()
+		if err != nil {
+			return nil, errors.Wrap(err, "could not retrieve finalized block")
+		}
+		return block, nil
+	}
+	block, err := beaconDB.CanonicalBlockBySlot(ctx, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve genesis block")
+	}
+	if block == nil {
+		return nil, errors.New("no genesis block saved, the chain has not started")
+	}
+	return block, nil
+}
@@ -0,0 +1,174 @@
+// Package chaindata exports the canonical beacon chain of a node to a file and
+// imports it back into another node. Files start with an anchor block and its
+// post state, the genesis or the last finalized block, followed by the SSZ
+// encoded canonical blocks built on top of the anchor in increasing slot order.
+package chaindata
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+// magic identifies chain data files, it is followed by the format version.
+var magic = []byte("prysm-chaindata")
+
+const (
+	formatVersion = 1
+	// maxRecordSize bounds the size of a record read from a file, so that a
+	// corrupt length prefix does not allocate unbounded memory.
+	maxRecordSize = 1 << 28
+)
+
+// recordType tags each SSZ encoded record of a file.
+type recordType byte
+
+const (
+	anchorBlockRecord recordType = iota + 1
+	anchorStateRecord
+	blockRecord
+)
+
+func (t recordType) String() string {
+	switch t {
+	case anchorBlockRecord:
+		return "anchor block"
+	case anchorStateRecord:
+		return "anchor state"
+	case blockRecord:
+		return "block"
+	default:
+		return fmt.Sprintf("unknown record %d", byte(t))
+	}
+}
+
+// Writer writes chain data records. Each record is a type byte and a little
+// endian uint32 length followed by the SSZ encoding of the record.
+type Writer struct {
+	w *bufio.Writer
+}
+
+// NewWriter writes the file header to w and returns a writer of records.
+func NewWriter(w io.Writer) (*Writer, error) {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(magic); err != nil {
+		return nil, errors.Wrap(err, "could not write header")
+	}
+	if err := bw.WriteByte(formatVersion); err != nil {
+		return nil, errors.Wrap(err, "could not write header")
+	}
+	return &Writer{w: bw}, nil
+}
+
+// WriteAnchor writes the anchor block and its post state, it must be called
+// before any block is written.
+func (cw *Writer) WriteAnchor(block *ethpb.BeaconBlock, state *pb.BeaconState) error {
+	if err := cw.write(anchorBlockRecord, block); err != nil {
+		return err
+	}
+	return cw.write(anchorStateRecord, state)
+}
+
+// WriteBlock writes a block built on top of the previously written blocks.
+func (cw *Writer) WriteBlock(block *ethpb.BeaconBlock) error {
+	return cw.write(blockRecord, block)
+}
+
+// Flush writes the buffered records to the underlying writer.
+func (cw *Writer) Flush() error {
+	return cw.w.Flush()
+}
+
+func (cw *Writer) write(typ recordType, val interface{}) error {
+	enc, err := ssz.Marshal(val)
+	if err != nil {
+		return errors.Wrapf(err, "could not encode %v", typ)
+	}
+	if len(enc) > maxRecordSize {
+		return fmt.Errorf("encoded %v of %d bytes exceeds the maximum record size", typ, len(enc))
+	}
+	header := make([]byte, 5)
+	header[0] = byte(typ)
+	binary.LittleEndian.PutUint32(header[1:], uint32(len(enc)))
+	if _, err := cw.w.Write(header); err != nil {
+		return err
+	}
+	_, err = cw.w.Write(enc)
+	return err
+}
+
+// Reader reads the records of a chain data file.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader checks the file header of r and returns a reader of records.
+func NewReader(r io.Reader) (*Reader, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Wrap(err, "could not read header")
+	}
+	if !bytes.Equal(header[:len(magic)], magic) {
+		return nil, errors.New("not a chain data file")
+	}
+	if header[len(magic)] != formatVersion {
+		return nil, fmt.Errorf("unsupported chain data format version %d", header[len(magic)])
+	}
+	return &Reader{r: br}, nil
+}
+
+// ReadAnchor reads the anchor block and state, it must be called before any
+// block is read.
+func (cr *Reader) ReadAnchor() (*ethpb.BeaconBlock, *pb.BeaconState, error) {
+	block := &ethpb.BeaconBlock{}
+	if err := cr.read(anchorBlockRecord, block); err != nil {
+		return nil, nil, err
+	}
+	state := &pb.BeaconState{}
+	if err := cr.read(anchorStateRecord, state); err != nil {
+		return nil, nil, err
+	}
+	return block, state, nil
+}
+
+// ReadBlock reads the next block, io.EOF is returned at the end of the file.
+func (cr *Reader) ReadBlock() (*ethpb.BeaconBlock, error) {
+	block := &ethpb.BeaconBlock{}
+	if err := cr.read(blockRecord, block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (cr *Reader) read(want recordType, val interface{}) error {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(cr.r, header); err != nil {
+		if err == io.EOF {
+			return err
+		}
+		return errors.Wrapf(err, "could not read %v", want)
+	}
+	if typ := recordType(header[0]); typ != want {
+		return fmt.Errorf("expected %v, read %v", want, typ)
+	}
+	size := binary.LittleEndian.Uint32(header[1:])
+	if size > maxRecordSize {
+		return fmt.Errorf("%v of %d bytes exceeds the maximum record size", want, size)
+	}
+	enc := make([]byte, size)
+	if _, err := io.ReadFull(cr.r, enc); err != nil {
+		return errors.Wrapf(err, "could not read %v", want)
+	}
+	if err := ssz.Unmarshal(enc, val); err != nil {
+		return errors.Wrapf(err, "could not decode %v", want)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package chaindata
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/validators"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+)
+
+// BlockImporter runs imported blocks through the full block processing
+// pipeline, it is implemented by the blockchain service.
+type BlockImporter interface {
+	ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error)
+	ApplyForkChoiceRule(ctx context.Context, block *ethpb.BeaconBlock, computedState *pb.BeaconState) error
+}
+
+// SaveAnchor checks that the anchor state is the post state of the anchor
+// block and, if the database holds no chain yet, saves them as its head,
+// justified and finalized checkpoint. A database which already holds a chain
+// must contain the anchor block. It returns true if the anchor was saved.
+func SaveAnchor(ctx context.Context, beaconDB *db.BeaconDB, block *ethpb.BeaconBlock, state *pb.BeaconState) (bool, error) {
+	if err := anchorStateMatches(block, state); err != nil {
+		return false, err
+	}
+	root, err := ssz.SigningRoot(block)
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash anchor block")
+	}
+	headState, err := beaconDB.HeadState(ctx)
+	if err != nil {
+		return false, errors.Wrap(err, "could not retrieve head state")
+	}
+	if headState != nil {
+		if !beaconDB.HasBlock(root) {
+			return false, fmt.Errorf("anchor block %#x at slot %d is not part of the chain in the database",
+				bytesutil.Trunc(root[:]), block.Slot)
+		}
+		return false, nil
+	}
+
+	if err := beaconDB.SaveBlock(block); err != nil {
+		return false, errors.Wrap(err, "could not save anchor block")
+	}
+	if err := beaconDB.SaveAttestationTarget(ctx, &pb.AttestationTarget{
+		Slot:            block.Slot,
+		BeaconBlockRoot: root[:],
+		ParentRoot:      block.ParentRoot,
+	}); err != nil {
+		return false, errors.Wrap(err, "could not save attestation target")
+	}
+	if err := beaconDB.SaveHistoricalState(ctx, state, root); err != nil {
+		return false, errors.Wrap(err, "could not save anchor state")
+	}
+	if err := beaconDB.UpdateChainHead(ctx, block, state); err != nil {
+		return false, errors.Wrap(err, "could not set chain head")
+	}
+	if err := beaconDB.SaveJustifiedBlock(block); err != nil {
+		return false, errors.Wrap(err, "could not save anchor block as justified block")
+	}
+	if err := beaconDB.SaveFinalizedBlock(block); err != nil {
+		return false, errors.Wrap(err, "could not save anchor block as finalized block")
+	}
+	if err := beaconDB.SaveJustifiedState(state); err != nil {
+		return false, errors.Wrap(err, "could not save anchor state as justified state")
+	}
+	if err := beaconDB.SaveFinalizedState(state); err != nil {
+		return false, errors.Wrap(err, "could not save anchor state as finalized state")
+	}
+	for i, v := range state.Validators {
+		if err := beaconDB.SaveValidatorIndex(v.PublicKey, i); err != nil {
+			return false, errors.Wrap(err, "could not save validator index")
+		}
+	}
+	if err := validators.InitializeValidatorStore(state); err != nil {
+		return false, errors.Wrap(err, "could not initialize validator store")
+	}
+	return true, nil
+}
+
+// ImportBlocks reads the blocks following the anchor and processes them with
+// the importer, skipping the blocks already in the database. It returns the
+// number of processed blocks.
+func ImportBlocks(ctx context.Context, beaconDB *db.BeaconDB, cr *Reader, importer BlockImporter) (uint64, error) {
+	var count uint64
+	for {
+		if ctx.Err() != nil {
+			return count, ctx.Err()
+		}
+		block, err := cr.ReadBlock()
+		if err == io.EOF {
+			return count, nil
+		}
+		if err != nil {
+			return count, err
+		}
+		root, err := ssz.SigningRoot(block)
+		if err != nil {
+			return count, errors.Wrap(err, "could not hash block")
+		}
+		if beaconDB.HasBlock(root) {
+			log.WithField("slot", block.Slot).Debug("Block already in database, skipping it")
+			continue
+		}
+		postState, err := importer.ReceiveBlock(ctx, block)
+		if err != nil {
+			return count, errors.Wrapf(err, "could not process block at slot %d", block.Slot)
+		}
+		if err := importer.ApplyForkChoiceRule(ctx, block, postState); err != nil {
+			return count, errors.Wrapf(err, "could not apply fork choice rule to block at slot %d", block.Slot)
+		}
+		count++
+		log.WithFields(logrus.Fields{
+			"slot":      block.Slot,
+			"blockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(root[:])),
+		}).Debug("Imported block")
+	}
+}
+
+// anchorStateMatches checks that the anchor state is the post state of the
+// anchor block.
+func anchorStateMatches(block *ethpb.BeaconBlock, state *pb.BeaconState) error {
+	if state.Slot != block.Slot {
+		return fmt.Errorf("anchor state at slot %d does not match anchor block at slot %d", state.Slot, block.Slot)
+	}
+	root, err := ssz.HashTreeRoot(state)
+	if err != nil {
+		return errors.Wrap(err, "could not hash anchor state")
+	}
+	if !bytes.Equal(root[:], block.StateRoot) {
+		return fmt.Errorf("anchor state root %#x does not match the state root %#x of the anchor block",
+			root, block.StateRoot)
+	}
+	return nil
+}
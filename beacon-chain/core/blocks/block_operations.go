@@ -551,16 +551,40 @@ func ProcessAttestationsNoVerify(
 //    assert data.crosslink.data_root == Bytes32()  # [to be removed in phase 1]
 //    validate_indexed_attestation(state, convert_to_indexed(state, attestation))
 func ProcessAttestation(beaconState *pb.BeaconState, att *ethpb.Attestation) (*pb.BeaconState, error) {
-	beaconState, err := ProcessAttestationNoVerify(beaconState, att)
+	pendingAtt, err := checkAttestation(beaconState, att)
 	if err != nil {
 		return nil, err
 	}
-	return beaconState, VerifyAttestation(beaconState, att)
+	if err := VerifyAttestation(beaconState, att); err != nil {
+		return nil, err
+	}
+	return appendPendingAttestation(beaconState, pendingAtt), nil
 }
 
 // ProcessAttestationNoVerify processes the attestation without verifying the attestation signature. This
 // method is used to validate attestations whose signatures have already been verified.
 func ProcessAttestationNoVerify(beaconState *pb.BeaconState, att *ethpb.Attestation) (*pb.BeaconState, error) {
+	pendingAtt, err := checkAttestation(beaconState, att)
+	if err != nil {
+		return nil, err
+	}
+	return appendPendingAttestation(beaconState, pendingAtt), nil
+}
+
+// appendPendingAttestation records a checked attestation in the state, the
+// state is only modified once every check of the attestation passed.
+func appendPendingAttestation(beaconState *pb.BeaconState, pendingAtt *pb.PendingAttestation) *pb.BeaconState {
+	if pendingAtt.Data.Target.Epoch == helpers.CurrentEpoch(beaconState) {
+		beaconState.CurrentEpochAttestations = append(beaconState.CurrentEpochAttestations, pendingAtt)
+	} else {
+		beaconState.PreviousEpochAttestations = append(beaconState.PreviousEpochAttestations, pendingAtt)
+	}
+	return beaconState
+}
+
+// checkAttestation runs the checks of the attestation against the state other
+// than the signature, and returns the pending attestation to record.
+func checkAttestation(beaconState *pb.BeaconState, att *ethpb.Attestation) (*pb.PendingAttestation, error) {
 	data := att.Data
 	attestationSlot, err := helpers.AttestationDataSlot(beaconState, data)
 	if err != nil {
@@ -618,7 +642,6 @@ func ProcessAttestationNoVerify(beaconState *pb.BeaconState, att *ethpb.Attestat
 		}
 
 		parentCrosslink = beaconState.CurrentCrosslinks[crosslinkShard]
-		beaconState.CurrentEpochAttestations = append(beaconState.CurrentEpochAttestations, pendingAtt)
 	} else {
 		ffgSourceEpoch = beaconState.PreviousJustifiedCheckpoint.Epoch
 		ffgSourceRoot = beaconState.PreviousJustifiedCheckpoint.Root
@@ -628,7 +651,6 @@ func ProcessAttestationNoVerify(beaconState *pb.BeaconState, att *ethpb.Attestat
 			return nil, fmt.Errorf("invalid shard given in attestation: %d", crosslinkShard)
 		}
 		parentCrosslink = beaconState.PreviousCrosslinks[crosslinkShard]
-		beaconState.PreviousEpochAttestations = append(beaconState.PreviousEpochAttestations, pendingAtt)
 	}
 	if data.Source.Epoch != ffgSourceEpoch {
 		return nil, fmt.Errorf("expected source epoch %d, received %d", ffgSourceEpoch, data.Source.Epoch)
@@ -666,7 +688,7 @@ func ProcessAttestationNoVerify(beaconState *pb.BeaconState, att *ethpb.Attestat
 	if !bytes.Equal(data.Crosslink.DataRoot, params.BeaconConfig().ZeroHash[:]) {
 		return nil, fmt.Errorf("expected data root %#x == ZERO_HASH", data.Crosslink.DataRoot)
 	}
-	return beaconState, nil
+	return pendingAtt, nil
 }
 
 // ConvertToIndexed converts attestation to (almost) indexed-verifiable form.
@@ -1177,6 +1177,59 @@ func TestProcessAttestations_OK(t *testing.T) {
 	}
 }
 
+func TestProcessAttestations_InvalidSignatureKeepsState(t *testing.T) {
+	helpers.ClearAllCaches()
+	deposits, _ := testutil.SetupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	beaconState.Slot += params.BeaconConfig().MinAttestationInclusionDelay
+	beaconState.CurrentCrosslinks = []*ethpb.Crosslink{
+		{
+			Shard:      0,
+			StartEpoch: 0,
+		},
+	}
+	beaconState.CurrentJustifiedCheckpoint.Root = []byte("hello-world")
+	beaconState.CurrentEpochAttestations = []*pb.PendingAttestation{}
+	encoded, err := ssz.HashTreeRoot(beaconState.CurrentCrosslinks[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The attestation passes every check but the signature one.
+	att := &ethpb.Attestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: 0, Root: []byte("hello-world")},
+			Target: &ethpb.Checkpoint{Epoch: 0},
+			Crosslink: &ethpb.Crosslink{
+				Shard:      0,
+				StartEpoch: 0,
+				ParentRoot: encoded[:],
+				DataRoot:   params.BeaconConfig().ZeroHash[:],
+			},
+		},
+		AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+		CustodyBits:     bitfield.Bitlist{0x00, 0x00, 0x00, 0x00, 0x01},
+		Signature:       make([]byte, 96),
+	}
+	if _, err := blocks.ProcessAttestationsNoVerify(proto.Clone(beaconState).(*pb.BeaconState), &ethpb.BeaconBlockBody{
+		Attestations: []*ethpb.Attestation{att},
+	}); err != nil {
+		t.Fatalf("Expected the attestation to pass the checks other than the signature: %v", err)
+	}
+
+	if _, err := blocks.ProcessAttestations(beaconState, &ethpb.BeaconBlockBody{
+		Attestations: []*ethpb.Attestation{att},
+	}); err == nil {
+		t.Fatal("Expected the attestation signature to fail verification")
+	}
+	if len(beaconState.CurrentEpochAttestations) != 0 {
+		t.Errorf("Expected no pending attestation recorded, received %d", len(beaconState.CurrentEpochAttestations))
+	}
+}
+
 func TestProcessAttestationsNoVerify_OK(t *testing.T) {
 	// Attestation passes with an empty signature
 	helpers.ClearAllCaches()
@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	"github.com/prysmaticlabs/prysm/shared/params/spectest"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"gopkg.in/d4l3k/messagediff.v1"
@@ -44,9 +45,9 @@ func runBlockProcessingTest(t *testing.T, filename string) {
 			helpers.ClearAllCaches()
 			blocks.ClearEth1DataVoteCache()
 
-			s := tt.Pre
+			s := statetrie.New(tt.Pre)
 			for _, b := range tt.Blocks {
-				tt.Pre, err = state.ExecuteStateTransition(ctx, tt.Pre, b)
+				post, err := state.ExecuteStateTransition(ctx, s, b)
 				if tt.Post == nil {
 					if err == nil {
 						t.Fatal("Transition did not fail despite being invalid")
@@ -56,10 +57,11 @@ func runBlockProcessingTest(t *testing.T, filename string) {
 				if err != nil {
 					t.Fatalf("Transition failed with block at slot %d: %v", b.Slot, err)
 				}
+				s = post
 			}
 			if tt.Post != nil {
-				if !proto.Equal(s, tt.Post) {
-					diff, _ := messagediff.PrettyDiff(s, tt.Post)
+				if !proto.Equal(s.InnerStateUnsafe(), tt.Post) {
+					diff, _ := messagediff.PrettyDiff(s.InnerStateUnsafe(), tt.Post)
 					t.Log(diff)
 					t.Fatal("Post state does not match expected")
 				}
@@ -0,0 +1,9 @@
+package spectest
+
+import (
+	"testing"
+)
+
+func TestStateRootMainnetYaml(t *testing.T) {
+	runStateRootTest(t, "sanity_blocks_mainnet.yaml")
+}
@@ -0,0 +1,93 @@
+package spectest
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	"github.com/prysmaticlabs/prysm/shared/params/spectest"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+// runStateRootTest applies the blocks of the valid sanity blocks test cases,
+// and compares the root of the state computed from the cached field roots
+// with the hash tree root of the whole state, after every epoch transition
+// and every block.
+func runStateRootTest(t *testing.T, filename string) {
+	filepath, err := bazel.Runfile("tests/sanity/blocks/" + filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := ioutil.ReadFile(filepath)
+	if err != nil {
+		t.Fatalf("Could not load file %v", err)
+	}
+
+	s := &BlocksMainnet{}
+	if err := testutil.UnmarshalYaml(file, s); err != nil {
+		t.Fatalf("Failed to Unmarshal: %v", err)
+	}
+
+	if err := spectest.SetConfig(s.Config); err != nil {
+		t.Fatalf("Could not set config: %v", err)
+	}
+
+	if len(s.TestCases) == 0 {
+		t.Fatal("No tests!")
+	}
+
+	for _, tt := range s.TestCases {
+		if tt.Post == nil {
+			continue
+		}
+		t.Run(tt.Description, func(t *testing.T) {
+			ctx := context.Background()
+			helpers.ClearAllCaches()
+			blocks.ClearEth1DataVoteCache()
+
+			s := statetrie.New(tt.Pre)
+			checkStateRoot(t, s, "the pre state")
+			for _, b := range tt.Blocks {
+				// Process the slots up to the block one epoch at a time, the
+				// epoch transition runs on the last slot of each epoch.
+				for s.Slot() < b.Slot {
+					slot := helpers.StartSlot(helpers.SlotToEpoch(s.Slot()) + 1)
+					if slot > b.Slot {
+						slot = b.Slot
+					}
+					s, err = state.ProcessSlots(ctx, s, slot)
+					if err != nil {
+						t.Fatalf("Could not process slots up to %d: %v", slot, err)
+					}
+					checkStateRoot(t, s, fmt.Sprintf("processing the slots up to %d", slot))
+				}
+				s, err = state.ExecuteStateTransition(ctx, s, b)
+				if err != nil {
+					t.Fatalf("Transition failed with block at slot %d: %v", b.Slot, err)
+				}
+				checkStateRoot(t, s, fmt.Sprintf("the block at slot %d", b.Slot))
+			}
+		})
+	}
+}
+
+func checkStateRoot(t *testing.T, s *statetrie.BeaconState, after string) {
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ssz.HashTreeRoot(s.InnerStateUnsafe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Fatalf("Expected state root %#x after %s, received %#x", want, after, root)
+	}
+}
@@ -15,13 +15,17 @@ import (
 )
 
 // IsValidBlock ensures that the block is compliant with the block processing validity conditions.
+// The check of the ETH1.0 block referenced by the state is skipped with skipPOWBlockCheck,
+// GetPOWBlock is then not used.
 func IsValidBlock(
 	ctx context.Context,
 	state *pb.BeaconState,
 	block *ethpb.BeaconBlock,
 	HasBlock func(hash [32]byte) bool,
 	GetPOWBlock func(ctx context.Context, hash common.Hash) (*gethTypes.Block, error),
-	genesisTime time.Time) error {
+	skipPOWBlockCheck bool,
+	genesisTime time.Time,
+	now time.Time) error {
 
 	// Pre-Processing Condition 1:
 	// Check that the parent Block has been processed and saved.
@@ -31,35 +35,35 @@ func IsValidBlock(
 		return fmt.Errorf("unprocessed parent block as it is not saved in the db: %#x", parentRoot)
 	}
 
-	h := common.BytesToHash(state.Eth1Data.BlockHash)
-	powBlock, err := GetPOWBlock(ctx, h)
-	if err != nil {
-		return errors.Wrap(err, "unable to retrieve POW chain reference block")
-	}
-
 	// Pre-Processing Condition 2:
 	// The block pointed to by the state in state.processed_pow_receipt_root has
 	// been processed in the ETH 1.0 chain.
-	if powBlock == nil {
-		return fmt.Errorf("proof-of-Work chain reference in state does not exist: %#x", state.Eth1Data.BlockHash)
+	if !skipPOWBlockCheck {
+		h := common.BytesToHash(state.Eth1Data.BlockHash)
+		powBlock, err := GetPOWBlock(ctx, h)
+		if err != nil {
+			return errors.Wrap(err, "unable to retrieve POW chain reference block")
+		}
+		if powBlock == nil {
+			return fmt.Errorf("proof-of-Work chain reference in state does not exist: %#x", state.Eth1Data.BlockHash)
+		}
 	}
 
 	// Pre-Processing Condition 4:
 	// The node's local time is greater than or equal to
 	// state.genesis_time + (block.slot-GENESIS_SLOT)* SECONDS_PER_SLOT.
-	if !IsSlotValid(block.Slot, genesisTime) {
+	if !IsSlotValid(block.Slot, genesisTime, now) {
 		return fmt.Errorf("slot of block is too high: %d", block.Slot)
 	}
 
 	return nil
 }
 
-// IsSlotValid compares the slot to the system clock to determine if the block is valid.
-func IsSlotValid(slot uint64, genesisTime time.Time) bool {
+// IsSlotValid compares the slot to the given time of the local clock to determine if the block is valid.
+func IsSlotValid(slot uint64, genesisTime time.Time, now time.Time) bool {
 	secondsPerSlot := time.Duration((slot)*params.BeaconConfig().SecondsPerSlot) * time.Second
 	validTimeThreshold := genesisTime.Add(secondsPerSlot)
-	now := time.Now()
-	isValid := now.After(validTimeThreshold)
+	isValid := !now.Before(validTimeThreshold)
 
 	return isValid
 }
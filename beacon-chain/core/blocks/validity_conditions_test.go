@@ -9,6 +9,7 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
@@ -54,7 +55,7 @@ func TestIsValidBlock_NoParent(t *testing.T) {
 	db.hasBlock = false
 
 	if err := IsValidBlock(ctx, beaconState, block,
-		db.HasBlock, powClient.BlockByHash, genesisTime); err == nil {
+		db.HasBlock, powClient.BlockByHash, false, genesisTime, time.Now()); err == nil {
 		t.Fatal("block is valid despite not having a parent")
 	}
 }
@@ -83,7 +84,7 @@ func TestIsValidBlock_InvalidSlot(t *testing.T) {
 		BlockHash:   []byte{3},
 	}
 	if err := IsValidBlock(ctx, beaconState, block,
-		db.HasBlock, powClient.BlockByHash, genesisTime); err == nil {
+		db.HasBlock, powClient.BlockByHash, false, genesisTime, time.Now()); err == nil {
 		t.Fatalf("block is valid despite having an invalid slot %d", block.Slot)
 	}
 }
@@ -113,7 +114,7 @@ func TestIsValidBlock_InvalidPoWReference(t *testing.T) {
 	}
 
 	if err := IsValidBlock(ctx, beaconState, block,
-		db.HasBlock, powClient.BlockByHash, genesisTime); err == nil {
+		db.HasBlock, powClient.BlockByHash, false, genesisTime, time.Now()); err == nil {
 		t.Fatalf("block is valid despite having an invalid pow reference block")
 	}
 
@@ -143,7 +144,7 @@ func TestIsValidBlock_InvalidGenesis(t *testing.T) {
 	invalidTime := time.Now().AddDate(1, 2, 3)
 
 	if err := IsValidBlock(ctx, beaconState, block,
-		db.HasBlock, powClient.BlockByHash, genesisTime); err == nil {
+		db.HasBlock, powClient.BlockByHash, false, genesisTime, time.Now()); err == nil {
 		t.Fatalf("block is valid despite having an invalid genesis time %v", invalidTime)
 	}
 
@@ -172,7 +173,40 @@ func TestIsValidBlock_GoodBlock(t *testing.T) {
 	}
 
 	if err := IsValidBlock(ctx, beaconState, block,
-		db.HasBlock, powClient.BlockByHash, genesisTime); err != nil {
+		db.HasBlock, powClient.BlockByHash, false, genesisTime, time.Now()); err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestIsValidBlock_SkipsPoWReferenceCheck(t *testing.T) {
+	beaconState := &pb.BeaconState{
+		Slot: 3,
+		Eth1Data: &ethpb.Eth1Data{
+			BlockHash: []byte{3},
+		},
+	}
+	db := &mockDB{hasBlock: true}
+	block := &ethpb.BeaconBlock{
+		Slot: 4,
+	}
+
+	if err := IsValidBlock(context.Background(), beaconState, block,
+		db.HasBlock, nil, true, time.Unix(0, 0), time.Now()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIsSlotValid_ComparesToGivenTime(t *testing.T) {
+	genesisTime := time.Unix(1000, 0)
+	slotStart := genesisTime.Add(time.Duration(4*params.BeaconConfig().SecondsPerSlot) * time.Second)
+
+	if IsSlotValid(4, genesisTime, slotStart.Add(-time.Second)) {
+		t.Error("Expected slot 4 to be invalid before it starts")
+	}
+	if !IsSlotValid(4, genesisTime, slotStart) {
+		t.Error("Expected slot 4 to be valid when it starts")
+	}
+	if !IsSlotValid(4, genesisTime, slotStart.Add(time.Second)) {
+		t.Error("Expected slot 4 to be valid after it starts")
+	}
+}
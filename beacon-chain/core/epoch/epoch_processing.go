@@ -239,6 +239,16 @@ func ProcessJustificationAndFinalization(state *pb.BeaconState, prevAttestedBal
 //            if 3 * get_total_balance(state, attesting_indices) >= 2 * get_total_balance(state, crosslink_committee):
 //                state.current_crosslinks[shard] = winning_crosslink
 func ProcessCrosslinks(state *pb.BeaconState) (*pb.BeaconState, error) {
+	p, err := NewPrecompute(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not precompute attestations")
+	}
+	return ProcessCrosslinksWithPrecompute(state, p)
+}
+
+// ProcessCrosslinksWithPrecompute processes the crosslinks with the attestations
+// of the precompute pass of the epoch.
+func ProcessCrosslinksWithPrecompute(state *pb.BeaconState, p *Precompute) (*pb.BeaconState, error) {
 	copy(state.PreviousCrosslinks, state.CurrentCrosslinks)
 	epochs := []uint64{helpers.PrevEpoch(state), helpers.CurrentEpoch(state)}
 	for _, e := range epochs {
@@ -256,7 +266,7 @@ func ProcessCrosslinks(state *pb.BeaconState) (*pb.BeaconState, error) {
 			if err != nil {
 				return nil, errors.Wrap(err, "could not get crosslink committee")
 			}
-			crosslink, indices, err := winningCrosslink(state, shard, e)
+			crosslink, indices, err := winningCrosslink(state, p, shard, e)
 			if err != nil {
 				return nil, errors.Wrap(err, "could not get winning crosslink")
 			}
@@ -290,17 +300,24 @@ func ProcessRewardsAndPenalties(state *pb.BeaconState) (*pb.BeaconState, error)
 	if helpers.CurrentEpoch(state) == 0 {
 		return state, nil
 	}
-	attsRewards, attsPenalties, err := attestationDelta(state)
+	p, err := NewPrecompute(state)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get attestation delta")
+		return nil, errors.Wrap(err, "could not precompute attestations")
 	}
-	clRewards, clPenalties, err := crosslinkDelta(state)
+	return ProcessRewardsAndPenaltiesWithPrecompute(state, p)
+}
+
+// ProcessRewardsAndPenaltiesWithPrecompute processes the rewards and penalties
+// with the attesters of the precompute pass of the epoch.
+func ProcessRewardsAndPenaltiesWithPrecompute(state *pb.BeaconState, p *Precompute) (*pb.BeaconState, error) {
+	breakdown, err := RewardsAndPenaltiesBreakdownWithPrecompute(state, p)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not get crosslink delta")
+		return nil, err
 	}
+	rewards, penalties := breakdown.Total()
 	for i := 0; i < len(state.Validators); i++ {
-		state = helpers.IncreaseBalance(state, uint64(i), attsRewards[i]+clRewards[i])
-		state = helpers.DecreaseBalance(state, uint64(i), attsPenalties[i]+clPenalties[i])
+		state = helpers.IncreaseBalance(state, uint64(i), rewards[i])
+		state = helpers.DecreaseBalance(state, uint64(i), penalties[i])
 	}
 	return state, nil
 }
@@ -594,73 +611,53 @@ func unslashedAttestingIndices(state *pb.BeaconState, atts []*pb.PendingAttestat
 //    ), default=Crosslink())
 //    winning_attestations = [a for a in attestations if a.data.crosslink == winning_crosslink]
 //    return winning_crosslink, get_unslashed_attesting_indices(state, winning_attestations)
-func winningCrosslink(state *pb.BeaconState, shard uint64, epoch uint64) (*ethpb.Crosslink, []uint64, error) {
-	var shardAtts []*pb.PendingAttestation
-	matchedAtts, err := MatchAttestations(state, epoch)
+func winningCrosslink(state *pb.BeaconState, p *Precompute, shard uint64, epoch uint64) (*ethpb.Crosslink, []uint64, error) {
+	shardAtts, err := p.shardAtts(epoch, shard)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not get matching attestations")
 	}
+	if len(shardAtts) == 0 {
+		return genesisCrosslink(), nil, nil
+	}
 
-	// Filter out source attestations by shard.
-	for _, att := range matchedAtts.source {
-		if att.Data.Crosslink.Shard == shard {
-			shardAtts = append(shardAtts, att)
-		}
+	stateCrosslinkRoot, err := ssz.HashTreeRoot(state.CurrentCrosslinks[shard])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not hash tree root crosslink from state")
 	}
-	var candidateCrosslinks []*ethpb.Crosslink
-	// Filter out shard crosslinks with correct current or previous crosslink data.
+	var winnerCrosslink *ethpb.Crosslink
+	var winnerIndices []uint64
+	var winnerBalance uint64
+	// Out of the shard crosslinks with correct current or previous crosslink data,
+	// pick the one that has the most balance staked.
 	for _, a := range shardAtts {
-		stateCrosslink := state.CurrentCrosslinks[shard]
-		stateCrosslinkRoot, err := ssz.HashTreeRoot(stateCrosslink)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "could not hash tree root crosslink from state")
-		}
-		attCrosslinkRoot, err := ssz.HashTreeRoot(a.Data.Crosslink)
+		c := a.Data.Crosslink
+		attCrosslinkRoot, err := ssz.HashTreeRoot(c)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not hash tree root crosslink from attestation")
 		}
 		currCrosslinkMatches := bytes.Equal(stateCrosslinkRoot[:], attCrosslinkRoot[:])
-		prevCrosslinkMatches := bytes.Equal(stateCrosslinkRoot[:], a.Data.Crosslink.ParentRoot)
-		if currCrosslinkMatches || prevCrosslinkMatches {
-			candidateCrosslinks = append(candidateCrosslinks, a.Data.Crosslink)
-		}
-	}
-
-	if len(candidateCrosslinks) == 0 {
-		return &ethpb.Crosslink{
-			DataRoot:   params.BeaconConfig().ZeroHash[:],
-			ParentRoot: params.BeaconConfig().ZeroHash[:],
-		}, nil, nil
-	}
-	var crosslinkAtts []*pb.PendingAttestation
-	var winnerBalance uint64
-	var winnerCrosslink *ethpb.Crosslink
-	// Out of the existing shard crosslinks, pick the one that has the
-	// most balance staked.
-	crosslinkAtts = attsForCrosslink(candidateCrosslinks[0], shardAtts)
-	winnerBalance, err = AttestingBalance(state, crosslinkAtts)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	winnerCrosslink = candidateCrosslinks[0]
-	for _, c := range candidateCrosslinks {
-		crosslinkAtts = attsForCrosslink(c, shardAtts)
-		attestingBalance, err := AttestingBalance(state, crosslinkAtts)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "could not get crosslink's attesting balance")
+		prevCrosslinkMatches := bytes.Equal(stateCrosslinkRoot[:], c.ParentRoot)
+		if !currCrosslinkMatches && !prevCrosslinkMatches {
+			continue
 		}
-		if attestingBalance > winnerBalance {
-			winnerCrosslink = c
+		indices, attestingBalance := p.unslashedAttesters(attsForCrosslink(c, shardAtts))
+		// Ties are broken by the greater crosslink data root.
+		if winnerCrosslink == nil || attestingBalance > winnerBalance ||
+			(attestingBalance == winnerBalance && bytes.Compare(c.DataRoot, winnerCrosslink.DataRoot) > 0) {
+			winnerCrosslink, winnerIndices, winnerBalance = c, indices, attestingBalance
 		}
 	}
-
-	crosslinkIndices, err := unslashedAttestingIndices(state, attsForCrosslink(winnerCrosslink, shardAtts))
-	if err != nil {
-		return nil, nil, errors.New("could not get crosslink indices")
+	if winnerCrosslink == nil {
+		return genesisCrosslink(), nil, nil
 	}
+	return winnerCrosslink, winnerIndices, nil
+}
 
-	return winnerCrosslink, crosslinkIndices, nil
+func genesisCrosslink() *ethpb.Crosslink {
+	return &ethpb.Crosslink{
+		DataRoot:   params.BeaconConfig().ZeroHash[:],
+		ParentRoot: params.BeaconConfig().ZeroHash[:],
+	}
 }
 
 // baseReward takes state and validator index and calculate
@@ -679,16 +676,29 @@ func baseReward(state *pb.BeaconState, index uint64) (uint64, error) {
 	if err != nil {
 		return 0, errors.Wrap(err, "could not calculate active balance")
 	}
-	effectiveBalance := state.Validators[index].EffectiveBalance
-	baseReward := effectiveBalance * params.BeaconConfig().BaseRewardFactor /
+	return baseRewardForBalance(state.Validators[index].EffectiveBalance, totalBalance), nil
+}
+
+// baseRewardForBalance returns the base reward of a validator of the given
+// effective balance and total active balance.
+func baseRewardForBalance(effectiveBalance uint64, totalBalance uint64) uint64 {
+	return effectiveBalance * params.BeaconConfig().BaseRewardFactor /
 		mathutil.IntegerSquareRoot(totalBalance) / params.BeaconConfig().BaseRewardsPerEpoch
-	return baseReward, nil
 }
 
 // attestationDelta calculates the rewards and penalties of individual
 // validator for voting the correct FFG source, FFG target, and head. It
 // also calculates proposer delay inclusion and inactivity rewards
 // and penalties. Individual rewards and penalties are returned in list.
+func attestationDelta(state *pb.BeaconState, p *Precompute) ([]uint64, []uint64) {
+	breakdown := newRewardsBreakdown(len(state.Validators))
+	attestationDeltas(state, p, breakdown)
+	return breakdown.Total()
+}
+
+// attestationDeltas adds the rewards and penalties of individual validator for
+// voting the correct FFG source, FFG target, and head, the inclusion delay and
+// proposer rewards and the inactivity penalties to the breakdown.
 //
 // Note: we calculated adjusted quotient outside of base reward because it's too inefficient
 // to repeat the same calculation for every validator versus just doing it once.
@@ -746,129 +756,59 @@ func baseReward(state *pb.BeaconState, index uint64) (uint64, error) {
 //                )
 //
 //    return rewards, penalties
-func attestationDelta(state *pb.BeaconState) ([]uint64, []uint64, error) {
+func attestationDeltas(state *pb.BeaconState, p *Precompute, breakdown *RewardsBreakdown) {
 	prevEpoch := helpers.PrevEpoch(state)
-	totalBalance, err := helpers.TotalActiveBalance(state)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "could not get total active balance")
-	}
-
-	rewards := make([]uint64, len(state.Validators))
-	penalties := make([]uint64, len(state.Validators))
-
-	// Filter out the list of eligible validator indices. The eligible validator
-	// has to be active or slashed but before withdrawn.
-	var eligible []uint64
-	for i, v := range state.Validators {
-		isActive := helpers.IsActiveValidator(v, prevEpoch)
-		isSlashed := v.Slashed && (prevEpoch+1 < v.WithdrawableEpoch)
-		if isActive || isSlashed {
-			eligible = append(eligible, uint64(i))
-		}
-	}
-
-	// Apply rewards and penalties for voting correct source target and head.
-	// Construct a attestations list contains source, target and head attestations.
-	atts, err := MatchAttestations(state, prevEpoch)
-	if err != nil {
-		return nil, nil, errors.Wrap(err, "could not get source, target and head attestations")
-	}
-	var attsPackage [][]*pb.PendingAttestation
-	attsPackage = append(attsPackage, atts.source)
-	attsPackage = append(attsPackage, atts.Target)
-	attsPackage = append(attsPackage, atts.head)
-
-	// Cache the validators who voted correctly for source in a map
-	// to calculate earliest attestation rewards later.
-	attestersVotedSoruce := make(map[uint64]*pb.PendingAttestation)
-	// Compute rewards / penalties for each attestation in the list and update
-	// the rewards and penalties lists.
-	for i, matchAtt := range attsPackage {
-		indices, err := unslashedAttestingIndices(state, matchAtt)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "could not get attestation indices")
-		}
-
-		attested := make(map[uint64]bool)
-		// Construct a map to look up validators that voted for source, target or head.
-		for _, index := range indices {
-			if i == 0 {
-				attestersVotedSoruce[index] = &pb.PendingAttestation{InclusionDelay: params.BeaconConfig().FarFutureEpoch}
-			}
-			attested[index] = true
-		}
-		attestedBalance := helpers.TotalBalance(state, indices)
-
-		// Update rewards and penalties to each eligible validator index.
-		for _, index := range eligible {
-			base, err := baseReward(state, index)
-			if err != nil {
-				return nil, nil, errors.Wrap(err, "could not get base reward")
+	totalBalance := p.Balances.ActiveCurrentEpoch
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	finalityDelay := prevEpoch - state.FinalizedCheckpoint.Epoch
+	isInactivityLeak := finalityDelay > params.BeaconConfig().MinEpochsToInactivityPenalty
+
+	for i, v := range p.Validators {
+		index := uint64(i)
+		base := baseRewardForBalance(v.EffectiveBalance, totalBalance)
+
+		// The eligible validator has to be active or slashed but before withdrawn.
+		if v.IsEligible {
+			// Apply rewards and penalties for voting correct source target and head.
+			votes := []struct {
+				attested        bool
+				attestedBalance uint64
+				deltas          *Deltas
+			}{
+				{v.IsPrevEpochAttester, p.Balances.PrevEpochAttesters, breakdown.Source},
+				{v.IsPrevEpochTargetAttester, p.Balances.PrevEpochTargetAttesters, breakdown.Target},
+				{v.IsPrevEpochHeadAttester, p.Balances.PrevEpochHeadAttesters, breakdown.Head},
 			}
-			if _, ok := attested[index]; ok {
-				rewards[index] += base * attestedBalance / totalBalance
-			} else {
-				penalties[index] += base
+			for _, vote := range votes {
+				if vote.attested && !v.IsSlashed {
+					vote.deltas.Rewards[index] += base * vote.attestedBalance / totalBalance
+				} else {
+					vote.deltas.Penalties[index] += base
+				}
 			}
-		}
-	}
 
-	// For every index, filter the matching source attestation that correspond to the index,
-	// sort by inclusion delay and get the one that was included on chain first.
-	for _, att := range atts.source {
-		indices, err := helpers.AttestingIndices(state, att.Data, att.AggregationBits)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "could not get attester indices")
-		}
-		for _, i := range indices {
-			if _, ok := attestersVotedSoruce[i]; ok {
-				if attestersVotedSoruce[i].InclusionDelay > att.InclusionDelay {
-					attestersVotedSoruce[i] = att
+			// Apply penalties for quadratic leaks.
+			// When epoch since finality exceeds inactivity penalty constant, the penalty gets increased
+			// based on the finality delay.
+			if isInactivityLeak {
+				breakdown.Inactivity.Penalties[index] += params.BeaconConfig().BaseRewardsPerEpoch * base
+				if !v.IsPrevEpochTargetAttester || v.IsSlashed {
+					breakdown.Inactivity.Penalties[index] += v.EffectiveBalance * finalityDelay /
+						params.BeaconConfig().InactivityPenaltyQuotient
 				}
 			}
 		}
-	}
-
-	for i, a := range attestersVotedSoruce {
-		slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
 
-		baseReward, err := baseReward(state, i)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "could not get proposer reward")
+		// Reward the proposer and the inclusion delay of the earliest included
+		// attestation of the validator.
+		if v.IsPrevEpochAttester && !v.IsSlashed {
+			proposerReward := base / params.BeaconConfig().ProposerRewardQuotient
+			breakdown.Proposer.Rewards[v.ProposerIndex] += proposerReward
+			attesterReward := base - proposerReward
+			breakdown.InclusionDelay.Rewards[index] += attesterReward *
+				(slotsPerEpoch + params.BeaconConfig().MinAttestationInclusionDelay - v.InclusionDelay) / slotsPerEpoch
 		}
-		proposerReward := baseReward / params.BeaconConfig().ProposerRewardQuotient
-		rewards[a.ProposerIndex] += proposerReward
-		attesterReward := baseReward - proposerReward
-		attesterRewardFactor := (slotsPerEpoch + params.BeaconConfig().MinAttestationInclusionDelay - a.InclusionDelay) / slotsPerEpoch
-		rewards[i] += attesterReward * attesterRewardFactor
 	}
-
-	// Apply penalties for quadratic leaks.
-	// When epoch since finality exceeds inactivity penalty constant, the penalty gets increased
-	// based on the finality delay.
-	finalityDelay := prevEpoch - state.FinalizedCheckpoint.Epoch
-	if finalityDelay > params.BeaconConfig().MinEpochsToInactivityPenalty {
-		targetIndices, err := unslashedAttestingIndices(state, atts.Target)
-		if err != nil {
-			return nil, nil, errors.Wrap(err, "could not get attestation indices")
-		}
-		attestedTarget := make(map[uint64]bool)
-		for _, index := range targetIndices {
-			attestedTarget[index] = true
-		}
-		for _, index := range eligible {
-			base, err := baseReward(state, index)
-			if err != nil {
-				return nil, nil, errors.Wrap(err, "could not get base reward")
-			}
-			penalties[index] += params.BeaconConfig().BaseRewardsPerEpoch * base
-			if _, ok := attestedTarget[index]; !ok {
-				penalties[index] += state.Validators[index].EffectiveBalance * finalityDelay /
-					params.BeaconConfig().InactivityPenaltyQuotient
-			}
-		}
-	}
-	return rewards, penalties, nil
 }
 
 // crosslinkDelta calculates the rewards and penalties of individual
@@ -896,7 +836,7 @@ func attestationDelta(state *pb.BeaconState) ([]uint64, []uint64, error) {
 //            else:
 //                penalties[index] += base_reward
 //    return rewards, penalties
-func crosslinkDelta(state *pb.BeaconState) ([]uint64, []uint64, error) {
+func crosslinkDelta(state *pb.BeaconState, p *Precompute) ([]uint64, []uint64, error) {
 	rewards := make([]uint64, len(state.Validators))
 	penalties := make([]uint64, len(state.Validators))
 	epoch := helpers.PrevEpoch(state)
@@ -914,7 +854,7 @@ func crosslinkDelta(state *pb.BeaconState) ([]uint64, []uint64, error) {
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not get crosslink's committee")
 		}
-		_, attestingIndices, err := winningCrosslink(state, shard, epoch)
+		_, attestingIndices, err := winningCrosslink(state, p, shard, epoch)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "could not get winning crosslink")
 		}
@@ -928,10 +868,7 @@ func crosslinkDelta(state *pb.BeaconState) ([]uint64, []uint64, error) {
 		attestingBalance := helpers.TotalBalance(state, attestingIndices)
 
 		for _, index := range committee {
-			base, err := baseReward(state, index)
-			if err != nil {
-				return nil, nil, errors.Wrap(err, "could not get base reward")
-			}
+			base := baseRewardForBalance(p.Validators[index].EffectiveBalance, p.Balances.ActiveCurrentEpoch)
 			if _, ok := attested[index]; ok {
 				rewards[index] += base * attestingBalance / committeeBalance
 			} else {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -294,7 +295,12 @@ func TestAttsForCrosslink_CanGetAttestations(t *testing.T) {
 func TestWinningCrosslink_CantGetMatchingAtts(t *testing.T) {
 	wanted := fmt.Sprintf("could not get matching attestations: input epoch: %d != current epoch: %d or previous epoch: %d",
 		100, 0, 0)
-	_, _, err := winningCrosslink(&pb.BeaconState{Slot: 0}, 0, 100)
+	state := &pb.BeaconState{Slot: 0}
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, err = winningCrosslink(state, p, 0, 100)
 	if err.Error() != wanted {
 		t.Fatal(err)
 	}
@@ -318,7 +324,11 @@ func TestWinningCrosslink_ReturnGenesisCrosslink(t *testing.T) {
 		ParentRoot: params.BeaconConfig().ZeroHash[:],
 	}
 
-	crosslink, indices, err := winningCrosslink(state, 0, ge)
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crosslink, indices, err := winningCrosslink(state, p, 0, ge)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -391,7 +401,11 @@ func TestWinningCrosslink_CanGetWinningRoot(t *testing.T) {
 		ActiveIndexRoots:          make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 	}
 
-	winner, indices, err := winningCrosslink(state, 1, ge)
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	winner, indices, err := winningCrosslink(state, p, 1, ge)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -404,6 +418,55 @@ func TestWinningCrosslink_CanGetWinningRoot(t *testing.T) {
 	}
 }
 
+func TestWinningCrosslink_BreaksTiesByDataRoot(t *testing.T) {
+	helpers.ClearAllCaches()
+	e := params.BeaconConfig().SlotsPerEpoch
+
+	crosslinks := make([]*ethpb.Crosslink, params.BeaconConfig().ShardCount)
+	for i := uint64(0); i < params.BeaconConfig().ShardCount; i++ {
+		crosslinks[i] = &ethpb.Crosslink{Shard: 1, DataRoot: []byte{'B'}}
+	}
+	parentRoot, err := ssz.HashTreeRoot(crosslinks[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var atts []*pb.PendingAttestation
+	for _, dataRoot := range []byte{'A', 'C'} {
+		atts = append(atts, &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard:      1,
+					ParentRoot: parentRoot[:],
+					DataRoot:   []byte{dataRoot},
+				},
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+		})
+	}
+	state := &pb.BeaconState{
+		Slot:                      e + 2,
+		PreviousEpochAttestations: atts,
+		BlockRoots:                make([][]byte, 128),
+		CurrentCrosslinks:         crosslinks,
+		RandaoMixes:               make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+		ActiveIndexRoots:          make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
+	}
+
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	winner, _, err := winningCrosslink(state, p, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Both crosslinks have the same attesting balance, the greater data root wins.
+	if !reflect.DeepEqual(winner, atts[1].Data.Crosslink) {
+		t.Errorf("Did not get wanted crosslink, got: %v", winner)
+	}
+}
+
 func TestProcessCrosslinks_NoUpdate(t *testing.T) {
 	helpers.ClearAllCaches()
 
@@ -912,7 +975,11 @@ func TestCrosslinkDelta_NoOneAttested(t *testing.T) {
 	validatorCount := uint64(128)
 	state := buildState(e+2, validatorCount)
 
-	rewards, penalties, err := crosslinkDelta(state)
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewards, penalties, err := crosslinkDelta(state, p)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -987,7 +1054,11 @@ func TestCrosslinkDelta_SomeAttested(t *testing.T) {
 		DataRoot: []byte{'A'}, Shard: startShard + 1,
 	}
 
-	rewards, penalties, err := crosslinkDelta(state)
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewards, penalties, err := crosslinkDelta(state, p)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -1023,66 +1094,6 @@ func TestCrosslinkDelta_SomeAttested(t *testing.T) {
 	}
 }
 
-func TestCrosslinkDelta_CantGetWinningCrosslink(t *testing.T) {
-	state := buildState(0, 1)
-
-	_, _, err := crosslinkDelta(state)
-	wanted := "could not get winning crosslink: could not get matching attestations"
-	if !strings.Contains(err.Error(), wanted) {
-		t.Fatalf("Got: %v, want: %v", err.Error(), wanted)
-	}
-}
-
-func TestAttestationDelta_CantGetBlockRoot(t *testing.T) {
-	e := params.BeaconConfig().SlotsPerEpoch
-
-	state := buildState(2*e, 1)
-	state.Slot = 0
-
-	_, _, err := attestationDelta(state)
-	wanted := "could not get block root for epoch"
-	if !strings.Contains(err.Error(), wanted) {
-		t.Fatalf("Got: %v, want: %v", err.Error(), wanted)
-	}
-}
-
-func TestAttestationDelta_CantGetAttestation(t *testing.T) {
-	state := buildState(0, 1)
-
-	_, _, err := attestationDelta(state)
-	wanted := "could not get source, target and head attestations"
-	if !strings.Contains(err.Error(), wanted) {
-		t.Fatalf("Got: %v, want: %v", err.Error(), wanted)
-	}
-}
-
-func TestAttestationDelta_CantGetAttestationIndices(t *testing.T) {
-	e := params.BeaconConfig().SlotsPerEpoch
-
-	state := buildState(e+2, 1)
-	atts := make([]*pb.PendingAttestation, 2)
-	for i := 0; i < len(atts); i++ {
-		atts[i] = &pb.PendingAttestation{
-			Data: &ethpb.AttestationData{
-				Crosslink: &ethpb.Crosslink{
-					Shard: uint64(i),
-				},
-				Target: &ethpb.Checkpoint{},
-				Source: &ethpb.Checkpoint{},
-			},
-			InclusionDelay:  uint64(i + 100),
-			AggregationBits: bitfield.Bitlist{0xFF, 0x01},
-		}
-	}
-	state.PreviousEpochAttestations = atts
-
-	_, _, err := attestationDelta(state)
-	wanted := "could not get attestation indices"
-	if !strings.Contains(err.Error(), wanted) {
-		t.Fatalf("Got: %v, want: %v", err.Error(), wanted)
-	}
-}
-
 func TestAttestationDelta_NoOneAttested(t *testing.T) {
 	e := params.BeaconConfig().SlotsPerEpoch
 	validatorCount := params.BeaconConfig().MinGenesisActiveValidatorCount / 32
@@ -1104,10 +1115,11 @@ func TestAttestationDelta_NoOneAttested(t *testing.T) {
 		}
 	}
 
-	rewards, penalties, err := attestationDelta(state)
+	p, err := NewPrecompute(state)
 	if err != nil {
 		t.Fatal(err)
 	}
+	rewards, penalties := attestationDelta(state, p)
 	for i := uint64(0); i < validatorCount; i++ {
 		// Since no one attested, all the validators should gain 0 reward
 		if rewards[i] != 0 {
@@ -1156,10 +1168,11 @@ func TestAttestationDelta_SomeAttested(t *testing.T) {
 		DataRoot: []byte{'A'},
 	}
 
-	rewards, penalties, err := attestationDelta(state)
+	p, err := NewPrecompute(state)
 	if err != nil {
 		t.Fatal(err)
 	}
+	rewards, penalties := attestationDelta(state, p)
 
 	attestedBalance, err := AttestingBalance(state, atts)
 	if err != nil {
@@ -1209,6 +1222,66 @@ func TestAttestationDelta_SomeAttested(t *testing.T) {
 	}
 }
 
+func TestAttestationDelta_InclusionDelayReward(t *testing.T) {
+	helpers.ClearAllCaches()
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := params.BeaconConfig().MinGenesisActiveValidatorCount / 8
+	state := buildState(e+2, validatorCount)
+	startShard := uint64(960)
+	inclusionDelay := uint64(2)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard:    startShard + uint64(i),
+					DataRoot: []byte{'A'},
+				},
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  inclusionDelay,
+		}
+	}
+	state.PreviousEpochAttestations = atts
+
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewards, _ := attestationDelta(state, p)
+
+	attestedBalance, err := AttestingBalance(state, atts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totalBalance, err := helpers.TotalActiveBalance(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attestedIndices := []uint64{5, 754, 797, 1637, 1770, 1862, 1192}
+	for _, i := range attestedIndices {
+		base, err := baseReward(state, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		votesReward := 3 * (base * attestedBalance / totalBalance)
+		attesterReward := base - base/params.BeaconConfig().ProposerRewardQuotient
+		// The spec multiplies before dividing by the slots per epoch. Dividing
+		// first rounds the reward of any attestation included after the
+		// minimum delay down to nothing.
+		wanted := votesReward + attesterReward*(e+params.BeaconConfig().MinAttestationInclusionDelay-inclusionDelay)/e
+		if rewards[i] != wanted {
+			t.Errorf("Wanted reward balance %d of validator %d, got %d", wanted, i, rewards[i])
+		}
+		if rewards[i] == votesReward {
+			t.Errorf("Wanted an inclusion delay reward for validator %d", i)
+		}
+	}
+}
+
 func TestAttestationDelta_SomeAttestedFinalityDelay(t *testing.T) {
 	helpers.ClearAllCaches()
 	e := params.BeaconConfig().SlotsPerEpoch
@@ -1239,10 +1312,11 @@ func TestAttestationDelta_SomeAttestedFinalityDelay(t *testing.T) {
 		DataRoot: []byte{'A'},
 	}
 
-	rewards, penalties, err := attestationDelta(state)
+	p, err := NewPrecompute(state)
 	if err != nil {
 		t.Fatal(err)
 	}
+	rewards, penalties := attestationDelta(state, p)
 
 	attestedBalance, err := AttestingBalance(state, atts)
 	if err != nil {
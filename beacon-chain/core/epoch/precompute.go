@@ -0,0 +1,219 @@
+package epoch
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// ValidatorStatus holds what the epoch processing needs to know of a
+// validator and its attestations in the previous and current epochs.
+type ValidatorStatus struct {
+	IsSlashed            bool
+	IsActivePrevEpoch    bool
+	IsActiveCurrentEpoch bool
+	// IsEligible is whether the validator gets the attestation rewards and
+	// penalties of the previous epoch: it was active, or it is slashed and not
+	// yet withdrawable.
+	IsEligible       bool
+	EffectiveBalance uint64
+
+	IsCurrentEpochAttester       bool
+	IsCurrentEpochTargetAttester bool
+	IsPrevEpochAttester          bool
+	IsPrevEpochTargetAttester    bool
+	IsPrevEpochHeadAttester      bool
+	// InclusionDelay and ProposerIndex are those of the earliest included
+	// attestation of the validator in the previous epoch.
+	InclusionDelay uint64
+	ProposerIndex  uint64
+}
+
+// Balances holds the total effective balances of the active validators and
+// of the unslashed attesters. Like helpers.TotalBalance, they are at least 1
+// gwei to avoid divisions by zero.
+type Balances struct {
+	ActiveCurrentEpoch          uint64
+	CurrentEpochTargetAttesters uint64
+	PrevEpochAttesters          uint64
+	PrevEpochTargetAttesters    uint64
+	PrevEpochHeadAttesters      uint64
+}
+
+// Precompute is built in a single pass over the validators and the pending
+// attestations at the start of the epoch processing, so the processing steps
+// do not each derive the committees and attesting indices again.
+type Precompute struct {
+	Validators []*ValidatorStatus
+	Balances   *Balances
+
+	prevEpoch    uint64
+	currentEpoch uint64
+	// attestingIndices holds the attesting indices of the pending attestations.
+	attestingIndices map[*pb.PendingAttestation][]uint64
+	// shardAttestations holds the pending attestations by epoch and shard.
+	shardAttestations map[uint64]map[uint64][]*pb.PendingAttestation
+}
+
+// NewPrecompute matches the pending attestations of the previous and current
+// epochs of the state against the block roots, and flags the attesters.
+func NewPrecompute(state *pb.BeaconState) (*Precompute, error) {
+	p := &Precompute{
+		Validators:        make([]*ValidatorStatus, len(state.Validators)),
+		prevEpoch:         helpers.PrevEpoch(state),
+		currentEpoch:      helpers.CurrentEpoch(state),
+		attestingIndices:  make(map[*pb.PendingAttestation][]uint64),
+		shardAttestations: make(map[uint64]map[uint64][]*pb.PendingAttestation),
+	}
+	for i, v := range state.Validators {
+		isActivePrev := helpers.IsActiveValidator(v, p.prevEpoch)
+		p.Validators[i] = &ValidatorStatus{
+			IsSlashed:            v.Slashed,
+			IsActivePrevEpoch:    isActivePrev,
+			IsActiveCurrentEpoch: helpers.IsActiveValidator(v, p.currentEpoch),
+			IsEligible:           isActivePrev || (v.Slashed && p.prevEpoch+1 < v.WithdrawableEpoch),
+			EffectiveBalance:     v.EffectiveBalance,
+			InclusionDelay:       params.BeaconConfig().FarFutureEpoch,
+		}
+	}
+
+	// The previous epoch is the current epoch in the genesis epoch.
+	epochs := []uint64{p.currentEpoch}
+	if p.prevEpoch != p.currentEpoch {
+		epochs = append(epochs, p.prevEpoch)
+	}
+	for _, epoch := range epochs {
+		if err := p.processAttestations(state, epoch); err != nil {
+			return nil, err
+		}
+	}
+
+	totalActive, err := helpers.TotalActiveBalance(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get total active balance")
+	}
+	p.Balances = &Balances{ActiveCurrentEpoch: totalActive}
+	for _, v := range p.Validators {
+		if v.IsSlashed {
+			continue
+		}
+		if v.IsCurrentEpochTargetAttester {
+			p.Balances.CurrentEpochTargetAttesters += v.EffectiveBalance
+		}
+		if v.IsPrevEpochAttester {
+			p.Balances.PrevEpochAttesters += v.EffectiveBalance
+		}
+		if v.IsPrevEpochTargetAttester {
+			p.Balances.PrevEpochTargetAttesters += v.EffectiveBalance
+		}
+		if v.IsPrevEpochHeadAttester {
+			p.Balances.PrevEpochHeadAttesters += v.EffectiveBalance
+		}
+	}
+	for _, b := range []*uint64{
+		&p.Balances.ActiveCurrentEpoch,
+		&p.Balances.CurrentEpochTargetAttesters,
+		&p.Balances.PrevEpochAttesters,
+		&p.Balances.PrevEpochTargetAttesters,
+		&p.Balances.PrevEpochHeadAttesters,
+	} {
+		if *b == 0 {
+			*b = 1
+		}
+	}
+	return p, nil
+}
+
+// processAttestations flags the attesters of the pending attestations of the
+// epoch, which are its matching source attestations.
+func (p *Precompute) processAttestations(state *pb.BeaconState, epoch uint64) error {
+	atts := state.PreviousEpochAttestations
+	if epoch == p.currentEpoch {
+		atts = state.CurrentEpochAttestations
+	}
+	p.shardAttestations[epoch] = make(map[uint64][]*pb.PendingAttestation)
+	if len(atts) == 0 {
+		return nil
+	}
+	targetRoot, err := helpers.BlockRoot(state, epoch)
+	if err != nil {
+		return errors.Wrapf(err, "could not get block root for epoch %d", epoch)
+	}
+
+	for _, att := range atts {
+		indices, err := helpers.AttestingIndices(state, att.Data, att.AggregationBits)
+		if err != nil {
+			return errors.Wrap(err, "could not get attesting indices")
+		}
+		slot, err := helpers.AttestationDataSlot(state, att.Data)
+		if err != nil {
+			return errors.Wrap(err, "could not get attestation slot")
+		}
+		headRoot, err := helpers.BlockRootAtSlot(state, slot)
+		if err != nil {
+			return errors.Wrapf(err, "could not get block root for slot %d", slot)
+		}
+		votedTarget := bytes.Equal(att.Data.Target.Root, targetRoot)
+		votedHead := bytes.Equal(att.Data.BeaconBlockRoot, headRoot)
+
+		for _, index := range indices {
+			v := p.Validators[index]
+			if epoch == p.currentEpoch {
+				v.IsCurrentEpochAttester = true
+				v.IsCurrentEpochTargetAttester = v.IsCurrentEpochTargetAttester || votedTarget
+			}
+			if epoch == p.prevEpoch {
+				v.IsPrevEpochAttester = true
+				v.IsPrevEpochTargetAttester = v.IsPrevEpochTargetAttester || votedTarget
+				v.IsPrevEpochHeadAttester = v.IsPrevEpochHeadAttester || votedHead
+				if att.InclusionDelay < v.InclusionDelay {
+					v.InclusionDelay = att.InclusionDelay
+					v.ProposerIndex = att.ProposerIndex
+				}
+			}
+		}
+		p.attestingIndices[att] = indices
+		shard := att.Data.Crosslink.Shard
+		p.shardAttestations[epoch][shard] = append(p.shardAttestations[epoch][shard], att)
+	}
+	return nil
+}
+
+// shardAtts returns the pending attestations of the epoch for the shard.
+func (p *Precompute) shardAtts(epoch uint64, shard uint64) ([]*pb.PendingAttestation, error) {
+	byShard, ok := p.shardAttestations[epoch]
+	if !ok {
+		return nil, fmt.Errorf("input epoch: %d != current epoch: %d or previous epoch: %d",
+			epoch, p.currentEpoch, p.prevEpoch)
+	}
+	return byShard[shard], nil
+}
+
+// unslashedAttesters returns the sorted unslashed attesting indices of the
+// pending attestations and their total effective balance, at least 1 gwei.
+func (p *Precompute) unslashedAttesters(atts []*pb.PendingAttestation) ([]uint64, uint64) {
+	attested := make(map[uint64]bool)
+	for _, att := range atts {
+		for _, index := range p.attestingIndices[att] {
+			if !p.Validators[index].IsSlashed {
+				attested[index] = true
+			}
+		}
+	}
+	indices := make([]uint64, 0, len(attested))
+	balance := uint64(0)
+	for index := range attested {
+		indices = append(indices, index)
+		balance += p.Validators[index].EffectiveBalance
+	}
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+	if balance == 0 {
+		balance = 1
+	}
+	return indices, balance
+}
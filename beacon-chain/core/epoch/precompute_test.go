@@ -0,0 +1,214 @@
+package epoch
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestNewPrecompute_CantGetBlockRoot(t *testing.T) {
+	state := buildState(0, 1)
+	state.CurrentEpochAttestations = []*pb.PendingAttestation{
+		{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{},
+				Target:    &ethpb.Checkpoint{},
+				Source:    &ethpb.Checkpoint{},
+			},
+		},
+	}
+
+	_, err := NewPrecompute(state)
+	wanted := "could not get block root for epoch 0"
+	if err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Fatalf("Got: %v, want: %v", err, wanted)
+	}
+}
+
+func TestNewPrecompute_CantGetAttestingIndices(t *testing.T) {
+	e := params.BeaconConfig().SlotsPerEpoch
+
+	state := buildState(e+2, 1)
+	atts := make([]*pb.PendingAttestation, 2)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard: uint64(i),
+				},
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			InclusionDelay:  uint64(i + 100),
+			AggregationBits: bitfield.Bitlist{0xFF, 0x01},
+		}
+	}
+	state.PreviousEpochAttestations = atts
+
+	_, err := NewPrecompute(state)
+	wanted := "could not get attesting indices"
+	if err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Fatalf("Got: %v, want: %v", err, wanted)
+	}
+}
+
+func TestNewPrecompute_NoAttestations(t *testing.T) {
+	helpers.ClearAllCaches()
+	validatorCount := uint64(128)
+	state := buildState(params.BeaconConfig().SlotsPerEpoch+2, validatorCount)
+
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range p.Validators {
+		if !v.IsActivePrevEpoch || !v.IsActiveCurrentEpoch || !v.IsEligible {
+			t.Errorf("Wanted validator %d active and eligible, got %+v", i, v)
+		}
+		if v.IsPrevEpochAttester || v.IsCurrentEpochAttester {
+			t.Errorf("Wanted validator %d not flagged as attester, got %+v", i, v)
+		}
+	}
+	want := &Balances{
+		ActiveCurrentEpoch:          validatorCount * params.BeaconConfig().MaxEffectiveBalance,
+		CurrentEpochTargetAttesters: 1,
+		PrevEpochAttesters:          1,
+		PrevEpochTargetAttesters:    1,
+		PrevEpochHeadAttesters:      1,
+	}
+	if !reflect.DeepEqual(p.Balances, want) {
+		t.Errorf("Wanted balances %+v, got %+v", want, p.Balances)
+	}
+}
+
+func TestNewPrecompute_FlagsAttesters(t *testing.T) {
+	helpers.ClearAllCaches()
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := params.BeaconConfig().MinGenesisActiveValidatorCount / 8
+	state := buildState(e+2, validatorCount)
+	startShard := uint64(960)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard:    startShard + uint64(i),
+					DataRoot: []byte{'A'},
+				},
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  2,
+			ProposerIndex:   uint64(i),
+		}
+	}
+	// The same attestation included later by another proposer.
+	late := *atts[0]
+	late.InclusionDelay = 5
+	late.ProposerIndex = 10
+	state.PreviousEpochAttestations = append(atts, &late)
+
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attested := make(map[uint64]*pb.PendingAttestation)
+	for _, att := range atts {
+		indices, err := helpers.AttestingIndices(state, att.Data, att.AggregationBits)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, index := range indices {
+			attested[index] = att
+		}
+	}
+	if len(attested) == 0 {
+		t.Fatal("Wanted attesters in the test state")
+	}
+	for i, v := range p.Validators {
+		att, ok := attested[uint64(i)]
+		if !ok {
+			if v.IsPrevEpochAttester || v.IsPrevEpochTargetAttester || v.IsPrevEpochHeadAttester {
+				t.Errorf("Wanted validator %d not flagged as attester, got %+v", i, v)
+			}
+			continue
+		}
+		if !v.IsPrevEpochAttester || !v.IsPrevEpochTargetAttester || !v.IsPrevEpochHeadAttester {
+			t.Errorf("Wanted validator %d flagged as source, target and head attester, got %+v", i, v)
+		}
+		if v.InclusionDelay != att.InclusionDelay || v.ProposerIndex != att.ProposerIndex {
+			t.Errorf("Wanted the earliest inclusion of validator %d, got delay %d by proposer %d",
+				i, v.InclusionDelay, v.ProposerIndex)
+		}
+	}
+
+	attestedBalance := uint64(len(attested)) * params.BeaconConfig().MaxEffectiveBalance
+	if p.Balances.PrevEpochAttesters != attestedBalance {
+		t.Errorf("Wanted attesting balance %d, got %d", attestedBalance, p.Balances.PrevEpochAttesters)
+	}
+	if p.Balances.PrevEpochTargetAttesters != attestedBalance || p.Balances.PrevEpochHeadAttesters != attestedBalance {
+		t.Errorf("Wanted target and head attesting balance %d, got %d and %d",
+			attestedBalance, p.Balances.PrevEpochTargetAttesters, p.Balances.PrevEpochHeadAttesters)
+	}
+}
+
+func TestNewPrecompute_ExcludesSlashedBalances(t *testing.T) {
+	helpers.ClearAllCaches()
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := params.BeaconConfig().MinGenesisActiveValidatorCount / 8
+	state := buildState(e+2, validatorCount)
+	att := &pb.PendingAttestation{
+		Data: &ethpb.AttestationData{
+			Crosslink: &ethpb.Crosslink{
+				Shard:    960,
+				DataRoot: []byte{'A'},
+			},
+			Target: &ethpb.Checkpoint{},
+			Source: &ethpb.Checkpoint{},
+		},
+		AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+		InclusionDelay:  1,
+	}
+	state.PreviousEpochAttestations = []*pb.PendingAttestation{att}
+	indices, err := helpers.AttestingIndices(state, att.Data, att.AggregationBits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(indices) < 2 {
+		t.Fatalf("Wanted at least 2 attesters, got %d", len(indices))
+	}
+	slashed := indices[0]
+	state.Validators[slashed].Slashed = true
+
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Validators[slashed].IsPrevEpochAttester {
+		t.Error("Wanted the slashed validator flagged as attester")
+	}
+	want := uint64(len(indices)-1) * params.BeaconConfig().MaxEffectiveBalance
+	if p.Balances.PrevEpochAttesters != want {
+		t.Errorf("Wanted attesting balance %d, got %d", want, p.Balances.PrevEpochAttesters)
+	}
+	unslashed, balance := p.unslashedAttesters(state.PreviousEpochAttestations)
+	if len(unslashed) != len(indices)-1 {
+		t.Errorf("Wanted %d unslashed attesters, got %d", len(indices)-1, len(unslashed))
+	}
+	for _, index := range unslashed {
+		if index == slashed {
+			t.Errorf("Wanted slashed validator %d filtered out", slashed)
+		}
+	}
+	if balance != want {
+		t.Errorf("Wanted unslashed attesting balance %d, got %d", want, balance)
+	}
+}
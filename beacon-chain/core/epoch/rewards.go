@@ -0,0 +1,100 @@
+package epoch
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Deltas holds the rewards and penalties in gwei of the validators, indexed
+// by validator index.
+type Deltas struct {
+	Rewards   []uint64
+	Penalties []uint64
+}
+
+func newDeltas(numValidators int) *Deltas {
+	return &Deltas{
+		Rewards:   make([]uint64, numValidators),
+		Penalties: make([]uint64, numValidators),
+	}
+}
+
+// RewardsBreakdown holds the deltas the rewards and penalties processing of an
+// epoch applies to the balances, by the duty they reward or penalize.
+type RewardsBreakdown struct {
+	// Source, Target and Head are the deltas for voting the correct FFG
+	// source, FFG target and head in the previous epoch.
+	Source *Deltas
+	Target *Deltas
+	Head   *Deltas
+	// InclusionDelay rewards the attesters for the inclusion delay of their
+	// earliest included attestation.
+	InclusionDelay *Deltas
+	// Proposer rewards the proposers for including attestations.
+	Proposer *Deltas
+	// Inactivity penalizes the validators while finality is delayed.
+	Inactivity *Deltas
+	// Crosslink holds the deltas for voting the winning crosslinks.
+	Crosslink *Deltas
+}
+
+func newRewardsBreakdown(numValidators int) *RewardsBreakdown {
+	return &RewardsBreakdown{
+		Source:         newDeltas(numValidators),
+		Target:         newDeltas(numValidators),
+		Head:           newDeltas(numValidators),
+		InclusionDelay: newDeltas(numValidators),
+		Proposer:       newDeltas(numValidators),
+		Inactivity:     newDeltas(numValidators),
+		Crosslink:      newDeltas(numValidators),
+	}
+}
+
+// Total returns the sums of the rewards and penalties of each validator.
+func (b *RewardsBreakdown) Total() ([]uint64, []uint64) {
+	components := []*Deltas{b.Source, b.Target, b.Head, b.InclusionDelay, b.Proposer, b.Inactivity, b.Crosslink}
+	rewards := make([]uint64, len(b.Source.Rewards))
+	penalties := make([]uint64, len(b.Source.Penalties))
+	for _, d := range components {
+		for i := range rewards {
+			rewards[i] += d.Rewards[i]
+			penalties[i] += d.Penalties[i]
+		}
+	}
+	return rewards, penalties
+}
+
+// RewardsAndPenaltiesBreakdown returns the deltas ProcessRewardsAndPenalties
+// applies to the state, by component. The deltas are all zero in the genesis
+// epoch.
+func RewardsAndPenaltiesBreakdown(state *pb.BeaconState) (*RewardsBreakdown, error) {
+	breakdown := newRewardsBreakdown(len(state.Validators))
+	// Can't process rewards and penalties in genesis epoch.
+	if helpers.CurrentEpoch(state) == 0 {
+		return breakdown, nil
+	}
+	p, err := NewPrecompute(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not precompute attestations")
+	}
+	return RewardsAndPenaltiesBreakdownWithPrecompute(state, p)
+}
+
+// RewardsAndPenaltiesBreakdownWithPrecompute returns the deltas of the rewards
+// and penalties processing with the attesters of the precompute pass of the
+// epoch. The deltas are all zero in the genesis epoch.
+func RewardsAndPenaltiesBreakdownWithPrecompute(state *pb.BeaconState, p *Precompute) (*RewardsBreakdown, error) {
+	breakdown := newRewardsBreakdown(len(state.Validators))
+	// Can't process rewards and penalties in genesis epoch.
+	if helpers.CurrentEpoch(state) == 0 {
+		return breakdown, nil
+	}
+	attestationDeltas(state, p, breakdown)
+	rewards, penalties, err := crosslinkDelta(state, p)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get crosslink delta")
+	}
+	breakdown.Crosslink = &Deltas{Rewards: rewards, Penalties: penalties}
+	return breakdown, nil
+}
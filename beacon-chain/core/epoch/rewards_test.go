@@ -0,0 +1,126 @@
+package epoch
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestRewardsAndPenaltiesBreakdown_SplitsDeltas(t *testing.T) {
+	helpers.ClearAllCaches()
+	e := params.BeaconConfig().SlotsPerEpoch
+	validatorCount := params.BeaconConfig().MinGenesisActiveValidatorCount / 8
+	state := buildState(e+2, validatorCount)
+	startShard := uint64(960)
+	inclusionDelay := uint64(3)
+	proposerIndex := uint64(7)
+	atts := make([]*pb.PendingAttestation, 3)
+	for i := 0; i < len(atts); i++ {
+		atts[i] = &pb.PendingAttestation{
+			Data: &ethpb.AttestationData{
+				Crosslink: &ethpb.Crosslink{
+					Shard:    startShard + uint64(i),
+					DataRoot: []byte{'A'},
+				},
+				Target: &ethpb.Checkpoint{},
+				Source: &ethpb.Checkpoint{},
+			},
+			AggregationBits: bitfield.Bitlist{0xC0, 0xC0, 0xC0, 0xC0, 0x01},
+			InclusionDelay:  inclusionDelay,
+			ProposerIndex:   proposerIndex,
+		}
+	}
+	state.PreviousEpochAttestations = atts
+	state.CurrentCrosslinks[startShard] = &ethpb.Crosslink{
+		DataRoot: []byte{'A'},
+	}
+
+	breakdown, err := RewardsAndPenaltiesBreakdown(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attestedBalance, err := AttestingBalance(state, atts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totalBalance, err := helpers.TotalActiveBalance(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	attestedIndices := []uint64{5, 754, 797, 1637, 1770, 1862, 1192}
+	var proposerReward uint64
+	for _, i := range attestedIndices {
+		base, err := baseReward(state, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wanted := base * attestedBalance / totalBalance
+		for name, d := range map[string]*Deltas{
+			"source": breakdown.Source,
+			"target": breakdown.Target,
+			"head":   breakdown.Head,
+		} {
+			if d.Rewards[i] != wanted || d.Penalties[i] != 0 {
+				t.Errorf("Wanted %s reward %d of validator %d, got reward %d and penalty %d",
+					name, wanted, i, d.Rewards[i], d.Penalties[i])
+			}
+		}
+		// The attester reward shrinks with each slot of inclusion delay.
+		proposerReward += base / params.BeaconConfig().ProposerRewardQuotient
+		maxAttesterReward := base - base/params.BeaconConfig().ProposerRewardQuotient
+		wanted = maxAttesterReward * (e + params.BeaconConfig().MinAttestationInclusionDelay - inclusionDelay) / e
+		if breakdown.InclusionDelay.Rewards[i] != wanted {
+			t.Errorf("Wanted inclusion delay reward %d of validator %d, got %d",
+				wanted, i, breakdown.InclusionDelay.Rewards[i])
+		}
+	}
+	if breakdown.Proposer.Rewards[proposerIndex] != proposerReward {
+		t.Errorf("Wanted proposer reward %d, got %d", proposerReward, breakdown.Proposer.Rewards[proposerIndex])
+	}
+	for i, penalty := range breakdown.Inactivity.Penalties {
+		if penalty != 0 {
+			t.Fatalf("Wanted no inactivity penalty without finality delay, got %d for validator %d", penalty, i)
+		}
+	}
+
+	p, err := NewPrecompute(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	attsRewards, attsPenalties := attestationDelta(state, p)
+	clRewards, clPenalties, err := crosslinkDelta(state, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(breakdown.Crosslink, &Deltas{Rewards: clRewards, Penalties: clPenalties}) {
+		t.Error("Wanted the crosslink deltas in the breakdown")
+	}
+	rewards, penalties := breakdown.Total()
+	for i := range rewards {
+		if rewards[i] != attsRewards[i]+clRewards[i] || penalties[i] != attsPenalties[i]+clPenalties[i] {
+			t.Fatalf("Wanted total reward %d and penalty %d of validator %d, got %d and %d",
+				attsRewards[i]+clRewards[i], attsPenalties[i]+clPenalties[i], i, rewards[i], penalties[i])
+		}
+	}
+}
+
+func TestRewardsAndPenaltiesBreakdown_GenesisEpoch(t *testing.T) {
+	state := buildState(1, 64)
+	breakdown, err := RewardsAndPenaltiesBreakdown(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rewards, penalties := breakdown.Total()
+	for i := range rewards {
+		if rewards[i] != 0 || penalties[i] != 0 {
+			t.Fatalf("Wanted no deltas in the genesis epoch, got reward %d and penalty %d of validator %d",
+				rewards[i], penalties[i], i)
+		}
+	}
+}
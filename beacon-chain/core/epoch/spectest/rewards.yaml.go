@@ -0,0 +1,32 @@
+package spectest
+
+import pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+
+// RewardsTest is the format of the rewards and penalties test vectors. The
+// inclusion delay deltas include the proposer rewards.
+type RewardsTest struct {
+	Title         string   `json:"title"`
+	Summary       string   `json:"summary"`
+	ForksTimeline string   `json:"forks_timeline"`
+	Forks         []string `json:"forks"`
+	Config        string   `json:"config"`
+	Runner        string   `json:"runner"`
+	Handler       string   `json:"handler"`
+	TestCases     []struct {
+		Description             string          `json:"description"`
+		Pre                     *pb.BeaconState `json:"pre"`
+		SourceDeltas            *RewardsDeltas  `json:"source_deltas"`
+		TargetDeltas            *RewardsDeltas  `json:"target_deltas"`
+		HeadDeltas              *RewardsDeltas  `json:"head_deltas"`
+		InclusionDelayDeltas    *RewardsDeltas  `json:"inclusion_delay_deltas"`
+		InactivityPenaltyDeltas *RewardsDeltas  `json:"inactivity_penalty_deltas"`
+		CrosslinkDeltas         *RewardsDeltas  `json:"crosslink_deltas"`
+	} `json:"test_cases"`
+}
+
+// RewardsDeltas holds the rewards and penalties of the validators, indexed by
+// validator index.
+type RewardsDeltas struct {
+	Rewards   []uint64 `json:"rewards"`
+	Penalties []uint64 `json:"penalties"`
+}
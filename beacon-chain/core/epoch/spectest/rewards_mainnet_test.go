@@ -0,0 +1,18 @@
+package spectest
+
+import (
+	"testing"
+
+	"github.com/bazelbuild/rules_go/go/tools/bazel"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+func TestRewardsMainnet(t *testing.T) {
+	t.Skip("The spec tests release pinned in WORKSPACE does not include rewards vectors")
+	helpers.ClearAllCaches()
+	filepath, err := bazel.Runfile(rewardsPrefix + "rewards_mainnet.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	runRewardsTests(t, filepath)
+}
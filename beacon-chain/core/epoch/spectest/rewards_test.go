@@ -0,0 +1,77 @@
+package spectest
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
+	"github.com/prysmaticlabs/prysm/shared/params/spectest"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"gopkg.in/d4l3k/messagediff.v1"
+)
+
+const rewardsPrefix = "tests/rewards/core/"
+
+func runRewardsTests(t *testing.T, filename string) {
+	file, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("Could not load file %v", err)
+	}
+
+	s := &RewardsTest{}
+	if err := testutil.UnmarshalYaml(file, s); err != nil {
+		t.Fatalf("Failed to Unmarshal: %v", err)
+	}
+
+	if err := spectest.SetConfig(s.Config); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(s.TestCases) == 0 {
+		t.Fatal("No tests!")
+	}
+
+	for _, tt := range s.TestCases {
+		t.Run(tt.Description, func(t *testing.T) {
+			breakdown, err := epoch.RewardsAndPenaltiesBreakdown(tt.Pre)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// The vectors count the proposer rewards as inclusion delay rewards.
+			inclusionDelay := &RewardsDeltas{
+				Rewards:   make([]uint64, len(tt.Pre.Validators)),
+				Penalties: breakdown.InclusionDelay.Penalties,
+			}
+			for i := range inclusionDelay.Rewards {
+				inclusionDelay.Rewards[i] = breakdown.InclusionDelay.Rewards[i] + breakdown.Proposer.Rewards[i]
+			}
+			for _, c := range []struct {
+				name string
+				got  *RewardsDeltas
+				want *RewardsDeltas
+			}{
+				{"source", toRewardsDeltas(breakdown.Source), tt.SourceDeltas},
+				{"target", toRewardsDeltas(breakdown.Target), tt.TargetDeltas},
+				{"head", toRewardsDeltas(breakdown.Head), tt.HeadDeltas},
+				{"inclusion delay", inclusionDelay, tt.InclusionDelayDeltas},
+				{"inactivity penalty", toRewardsDeltas(breakdown.Inactivity), tt.InactivityPenaltyDeltas},
+				{"crosslink", toRewardsDeltas(breakdown.Crosslink), tt.CrosslinkDeltas},
+			} {
+				if c.want == nil {
+					continue
+				}
+				if diff, equal := messagediff.PrettyDiff(c.got, c.want); !equal {
+					t.Errorf("Did not get expected %s deltas: %s", c.name, diff)
+				}
+			}
+		})
+	}
+}
+
+func toRewardsDeltas(d *epoch.Deltas) *RewardsDeltas {
+	return &RewardsDeltas{
+		Rewards:   d.Rewards,
+		Penalties: d.Penalties,
+	}
+}
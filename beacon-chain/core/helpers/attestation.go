@@ -1,10 +1,12 @@
 package helpers
 
 import (
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
-
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -15,6 +17,12 @@ var (
 	// ErrAttestationDataSlotNilData is returned when a nil attestation data
 	// argument is provided to AttestationDataSlot.
 	ErrAttestationDataSlotNilData = errors.New("nil data provided for AttestationDataSlot")
+	// ErrAttestationAggregationBitsOverlap is returned when two attestations
+	// being aggregated have attesters in common.
+	ErrAttestationAggregationBitsOverlap = errors.New("overlapping aggregation bits")
+	// ErrAttestationAggregationBitsDifferentLen is returned when two attestations
+	// being aggregated are not for committees of the same size.
+	ErrAttestationAggregationBitsDifferentLen = errors.New("different bitlist lengths")
 )
 
 // AttestationDataSlot returns current slot of AttestationData for given state
@@ -49,3 +57,51 @@ func AttestationDataSlot(state *pb.BeaconState, data *ethpb.AttestationData) (ui
 
 	return StartSlot(data.Target.Epoch) + (offset / (committeeCount / params.BeaconConfig().SlotsPerEpoch)), nil
 }
+
+// AggregateAttestation combines two attestations of the same attestation data
+// from disjoint sets of committee members into a single attestation, with the
+// union of their aggregation and custody bits and the aggregate of their
+// signatures.
+func AggregateAttestation(a1 *ethpb.Attestation, a2 *ethpb.Attestation) (*ethpb.Attestation, error) {
+	if !proto.Equal(a1.Data, a2.Data) {
+		return nil, errors.New("can not aggregate attestations with different data")
+	}
+	if a1.AggregationBits.Len() != a2.AggregationBits.Len() {
+		return nil, ErrAttestationAggregationBitsDifferentLen
+	}
+	aggregationBits := bitfield.Bitlist(append([]byte{}, a1.AggregationBits...))
+	for i := uint64(0); i < a2.AggregationBits.Len(); i++ {
+		if !a2.AggregationBits.BitAt(i) {
+			continue
+		}
+		if aggregationBits.BitAt(i) {
+			return nil, ErrAttestationAggregationBitsOverlap
+		}
+		aggregationBits.SetBitAt(i, true)
+	}
+	custodyBits := bitfield.Bitlist(append([]byte{}, a1.CustodyBits...))
+	if len(a2.CustodyBits) > 0 && a2.CustodyBits.Len() == custodyBits.Len() {
+		for i := uint64(0); i < a2.CustodyBits.Len(); i++ {
+			if a2.CustodyBits.BitAt(i) {
+				custodyBits.SetBitAt(i, true)
+			}
+		}
+	}
+
+	sig1, err := bls.SignatureFromBytes(a1.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert signature from bytes")
+	}
+	sig2, err := bls.SignatureFromBytes(a2.Signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert signature from bytes")
+	}
+	aggregateSig := bls.AggregateSignatures([]*bls.Signature{sig1, sig2})
+
+	return &ethpb.Attestation{
+		AggregationBits: aggregationBits,
+		Data:            proto.Clone(a1.Data).(*ethpb.AttestationData),
+		CustodyBits:     custodyBits,
+		Signature:       aggregateSig.Marshal(),
+	}, nil
+}
@@ -2,12 +2,15 @@ package helpers_test
 
 import (
 	"context"
+	"crypto/rand"
 	"testing"
 
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
@@ -100,3 +103,49 @@ func TestAttestationDataSlot_ReturnsErrorWhenTargetEpochLessThanCurrentEpoch(t *
 		t.Logf("attestation slot=%v", s)
 	}
 }
+
+func TestAggregateAttestation(t *testing.T) {
+	data := &ethpb.AttestationData{
+		Crosslink: &ethpb.Crosslink{Shard: 3},
+		Source:    &ethpb.Checkpoint{},
+		Target:    &ethpb.Checkpoint{Epoch: 1},
+	}
+	newAtt := func(bit uint64) *ethpb.Attestation {
+		key, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bits := bitfield.NewBitlist(4)
+		bits.SetBitAt(bit, true)
+		return &ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            data,
+			CustodyBits:     bitfield.NewBitlist(4),
+			Signature:       key.Sign([]byte{'a'}, 0).Marshal(),
+		}
+	}
+	a1 := newAtt(0)
+	a2 := newAtt(2)
+
+	aggregate, err := helpers.AggregateAttestation(a1, a2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []bool{true, false, true, false} {
+		if aggregate.AggregationBits.BitAt(uint64(i)) != want {
+			t.Errorf("Expected aggregation bit %d to be %v", i, want)
+		}
+	}
+	if !a1.AggregationBits.BitAt(0) || a1.AggregationBits.BitAt(2) {
+		t.Error("Aggregation modified the bits of the first attestation")
+	}
+
+	if _, err := helpers.AggregateAttestation(aggregate, a1); err != helpers.ErrAttestationAggregationBitsOverlap {
+		t.Errorf("Expected overlapping bits error, received %v", err)
+	}
+	other := newAtt(1)
+	other.AggregationBits = bitfield.NewBitlist(8)
+	if _, err := helpers.AggregateAttestation(a1, other); err != helpers.ErrAttestationAggregationBitsDifferentLen {
+		t.Errorf("Expected different length error, received %v", err)
+	}
+}
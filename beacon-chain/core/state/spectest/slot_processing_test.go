@@ -8,6 +8,7 @@ import (
 	"github.com/bazelbuild/rules_go/go/tools/bazel"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	"github.com/prysmaticlabs/prysm/shared/params/spectest"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"gopkg.in/d4l3k/messagediff.v1"
@@ -40,10 +41,11 @@ func runSlotProcessingTests(t *testing.T, filename string) {
 
 	for _, tt := range s.TestCases {
 		t.Run(tt.Description, func(t *testing.T) {
-			postState, err := state.ProcessSlots(context.Background(), tt.Pre, tt.Pre.Slot+tt.Slots)
+			s, err := state.ProcessSlots(context.Background(), statetrie.New(tt.Pre), tt.Pre.Slot+tt.Slots)
 			if err != nil {
 				t.Fatal(err)
 			}
+			postState := s.InnerStateUnsafe()
 
 			if !proto.Equal(postState, tt.Post) {
 				diff, _ := messagediff.PrettyDiff(postState, tt.Post)
@@ -0,0 +1,224 @@
+package statetrie
+
+import (
+	"encoding/binary"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// FieldIndex identifies a field of the beacon state, by its position in the
+// SSZ container.
+type FieldIndex int
+
+// The fields of the beacon state.
+const (
+	GenesisTime FieldIndex = iota
+	Slot
+	Fork
+	LatestBlockHeader
+	BlockRoots
+	StateRoots
+	HistoricalRoots
+	Eth1Data
+	Eth1DataVotes
+	Eth1DepositIndex
+	Validators
+	Balances
+	StartShard
+	RandaoMixes
+	ActiveIndexRoots
+	CompactCommitteesRoots
+	Slashings
+	PreviousEpochAttestations
+	CurrentEpochAttestations
+	PreviousCrosslinks
+	CurrentCrosslinks
+	JustificationBits
+	PreviousJustifiedCheckpoint
+	CurrentJustifiedCheckpoint
+	FinalizedCheckpoint
+)
+
+type fieldKind int
+
+const (
+	// basicField is hashed as a whole when it changes.
+	basicField fieldKind = iota
+	// rootsField is a vector or list of 32 byte roots, hashed per root.
+	rootsField
+	// uint64sField is a vector or list of uint64 packed in chunks, hashed per
+	// chunk.
+	uint64sField
+	// containersField is a vector or list of containers, hashed per container.
+	containersField
+)
+
+// field describes an SSZ field of the beacon state.
+type field struct {
+	name  string
+	index []int
+	// wrapper is a struct type holding only the field, with its SSZ tags. The
+	// root of a container of one field is the root of the field, so go-ssz
+	// hashes the field with the sizes of the compiled config.
+	wrapper reflect.Type
+	kind    fieldKind
+	// length is the length of a vector or the limit of a list, in elements.
+	length uint64
+	isList bool
+}
+
+var stateFields = describeFields(reflect.TypeOf(pb.BeaconState{}))
+
+func describeFields(typ reflect.Type) []*field {
+	var fields []*field
+	for i := 0; i < typ.NumField(); i++ {
+		sf := typ.Field(i)
+		if strings.HasPrefix(sf.Name, "XXX_") {
+			continue
+		}
+		f := &field{
+			name:    sf.Name,
+			index:   sf.Index,
+			wrapper: reflect.StructOf([]reflect.StructField{{Name: sf.Name, Type: sf.Type, Tag: sf.Tag}}),
+		}
+		if max, ok := sf.Tag.Lookup("ssz-max"); ok {
+			f.length, f.isList = parseLength(max), true
+		} else if size, ok := sf.Tag.Lookup("ssz-size"); ok {
+			f.length = parseLength(strings.Split(size, ",")[0])
+		}
+		if f.length > 0 {
+			switch {
+			case sf.Type == reflect.TypeOf([][]byte{}) && strings.HasSuffix(sf.Tag.Get("ssz-size"), ",32"):
+				f.kind = rootsField
+			case sf.Type == reflect.TypeOf([]uint64{}):
+				f.kind = uint64sField
+			case sf.Type.Kind() == reflect.Slice && sf.Type.Elem().Implements(reflect.TypeOf((*proto.Message)(nil)).Elem()):
+				f.kind = containersField
+			}
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+func parseLength(s string) uint64 {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// hashWhole returns the root of the field, hashed by go-ssz.
+func (f *field) hashWhole(v reflect.Value) ([32]byte, error) {
+	w := reflect.New(f.wrapper)
+	w.Elem().Field(0).Set(v)
+	return ssz.HashTreeRoot(w.Interface())
+}
+
+// fitsLength returns whether a vector or list field of n elements has the
+// length of its type.
+func (f *field) fitsLength(n int) bool {
+	if f.isList {
+		return uint64(n) <= f.length
+	}
+	return uint64(n) == f.length
+}
+
+// fitsElement returns whether the element at the given index of a vector or
+// list field has the size of its type.
+func (f *field) fitsElement(v reflect.Value, i int) bool {
+	e := v.Index(i)
+	switch f.kind {
+	case rootsField:
+		return e.Len() == 32
+	case containersField:
+		return !e.IsNil()
+	}
+	return true
+}
+
+// chunkOf returns the index of the chunk holding the element at the given
+// index. Four uint64 are packed in a chunk.
+func (f *field) chunkOf(i int) int {
+	if f.kind == uint64sField {
+		return i / 4
+	}
+	return i
+}
+
+// chunkCount returns the number of chunks of n elements.
+func (f *field) chunkCount(n int) int {
+	if f.kind == uint64sField {
+		return (n + 3) / 4
+	}
+	return n
+}
+
+// depth returns the depth of the tree of the chunks of the field.
+func (f *field) depth() int {
+	if f.kind == uint64sField {
+		return depthOf((f.length*8 + 31) / 32)
+	}
+	return depthOf(f.length)
+}
+
+// chunk returns the chunk at the given index of a vector or list field.
+func (f *field) chunk(v reflect.Value, i int) ([32]byte, error) {
+	var chunk [32]byte
+	switch f.kind {
+	case rootsField:
+		copy(chunk[:], v.Index(i).Bytes())
+	case uint64sField:
+		for j := 0; j < 4 && 4*i+j < v.Len(); j++ {
+			binary.LittleEndian.PutUint64(chunk[8*j:], v.Index(4*i+j).Uint())
+		}
+	case containersField:
+		return ssz.HashTreeRoot(v.Index(i).Interface())
+	}
+	return chunk, nil
+}
+
+// root returns the root of a vector or list field of n elements from the
+// tree of its chunks.
+func (f *field) root(t *trie, n int) [32]byte {
+	root := t.root()
+	if f.isList {
+		root = mixInLength(root, n)
+	}
+	return root
+}
+
+// copyBasic returns a copy of a basic field.
+func copyBasic(v reflect.Value) reflect.Value {
+	switch {
+	case v.Kind() == reflect.Ptr && !v.IsNil():
+		return reflect.ValueOf(proto.Clone(v.Interface().(proto.Message)))
+	case v.Kind() == reflect.Slice && !v.IsNil():
+		return reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, v.Len()), v)
+	}
+	return v
+}
+
+// copyElements returns a copy of a vector or list field which shares its
+// elements.
+func copyElements(v reflect.Value) reflect.Value {
+	if v.IsNil() {
+		return v
+	}
+	return reflect.AppendSlice(reflect.MakeSlice(v.Type(), 0, v.Len()), v)
+}
+
+// copyElement replaces the element at the given index of a vector or list
+// field with a copy.
+func copyElement(v reflect.Value, i int) {
+	e := v.Index(i)
+	if e.Kind() == reflect.Ptr || e.Kind() == reflect.Slice {
+		e.Set(copyBasic(e))
+	}
+}
@@ -0,0 +1,352 @@
+// Package statetrie wraps the beacon state to compute its hash tree root
+// incrementally. A wrapped state caches the root of every field, and the
+// trees of the elements of its vectors and lists, such as the validator
+// registry, the balances and the historical block and state roots.
+//
+// The state is changed through Modify, which declares the fields and elements
+// a change modifies. Hashing the state again only rehashes those. Copies of a
+// state share the values of its vectors and lists and their trees, until one
+// of the copies changes them.
+package statetrie
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+// Change declares a field which a modification of the state changes. Only
+// the elements at the given indices of a vector or list changed, or the whole
+// field when no indices are given. Indices past the end of a list declare
+// appended elements.
+type Change struct {
+	Field   FieldIndex
+	Indices []uint64
+}
+
+// reference counts the states sharing a value.
+type reference struct {
+	lock sync.Mutex
+	refs uint
+}
+
+func newReference() *reference {
+	return &reference{refs: 1}
+}
+
+func (r *reference) add() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.refs++
+}
+
+// unshare calls copy when the value is shared with other states, before
+// releasing it. It returns whether the value was copied.
+func (r *reference) unshare(copy func()) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.refs <= 1 {
+		return false
+	}
+	copy()
+	r.refs--
+	return true
+}
+
+// fieldTrie is the tree of the chunks of a vector or list field.
+type fieldTrie struct {
+	trie *trie
+	// length is the number of elements of the field the tree was built from.
+	length int
+	ref    *reference
+}
+
+// BeaconState wraps a beacon state with the roots of its fields.
+type BeaconState struct {
+	state *pb.BeaconState
+	lock  sync.RWMutex
+	// sharedFields counts the states sharing the value of each vector and
+	// list field. Copies of the state share the values of these fields, and
+	// copy them before changing them.
+	sharedFields map[FieldIndex]*reference
+	// roots holds the root of every field which did not change since the
+	// state was last hashed. It is nil until the state is first hashed.
+	roots [][32]byte
+	tries map[FieldIndex]*fieldTrie
+	// dirtyFields holds the fields which changed as a whole since the state
+	// was last hashed, and dirtyIndices the changed elements of the others.
+	dirtyFields  map[FieldIndex]bool
+	dirtyIndices map[FieldIndex][]uint64
+}
+
+// New wraps the beacon state, which it takes ownership of. The state must not
+// share values with the states of other wrappers, and must only be changed
+// through the wrapper from then on. Use Copy to share the values of a state.
+func New(state *pb.BeaconState) *BeaconState {
+	b := &BeaconState{
+		state:        state,
+		sharedFields: make(map[FieldIndex]*reference),
+		tries:        make(map[FieldIndex]*fieldTrie),
+		dirtyFields:  make(map[FieldIndex]bool),
+		dirtyIndices: make(map[FieldIndex][]uint64),
+	}
+	for i, f := range stateFields {
+		if f.kind != basicField {
+			b.sharedFields[FieldIndex(i)] = newReference()
+		}
+	}
+	return b
+}
+
+// InnerStateUnsafe returns the wrapped beacon state without copying it. It
+// must not be modified, as it may share values with copies of the state.
+func (b *BeaconState) InnerStateUnsafe() *pb.BeaconState {
+	return b.state
+}
+
+// CloneInnerState returns a deep copy of the wrapped beacon state.
+func (b *BeaconState) CloneInnerState() *pb.BeaconState {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if b.state == nil {
+		return nil
+	}
+	return proto.Clone(b.state).(*pb.BeaconState)
+}
+
+// Slot returns the slot of the beacon state.
+func (b *BeaconState) Slot() uint64 {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	return b.state.GetSlot()
+}
+
+// Copy returns a copy of the beacon state. The copy shares the values of the
+// vector and list fields of the state, and the roots of its fields, until
+// either state changes them.
+//
+// Copies which are dropped keep counting as sharing the values, so the other
+// states copy a shared value once more on their next change of it.
+func (b *BeaconState) Copy() *BeaconState {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	c := New(nil)
+	if b.state == nil {
+		return c
+	}
+
+	c.state = &pb.BeaconState{}
+	src, dst := reflect.ValueOf(b.state).Elem(), reflect.ValueOf(c.state).Elem()
+	for i, f := range stateFields {
+		idx := FieldIndex(i)
+		v := src.FieldByIndex(f.index)
+		if f.kind == basicField {
+			dst.FieldByIndex(f.index).Set(copyBasic(v))
+			continue
+		}
+		dst.FieldByIndex(f.index).Set(v)
+		b.sharedFields[idx].add()
+		c.sharedFields[idx] = b.sharedFields[idx]
+	}
+
+	if b.roots != nil {
+		c.roots = append([][32]byte{}, b.roots...)
+	}
+	for idx, t := range b.tries {
+		t.ref.add()
+		c.tries[idx] = t
+	}
+	for idx := range b.dirtyFields {
+		c.dirtyFields[idx] = true
+	}
+	for idx, indices := range b.dirtyIndices {
+		c.dirtyIndices[idx] = append([]uint64{}, indices...)
+	}
+	return c
+}
+
+// Modify runs fn to modify the wrapped beacon state in place. fn must only
+// change the given fields and elements, which are copied first when other
+// states share them, and rehashed when the state is hashed again.
+func (b *BeaconState) Modify(fn func(*pb.BeaconState) error, changes ...Change) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == nil {
+		return errors.New("nil beacon state")
+	}
+
+	v := reflect.ValueOf(b.state).Elem()
+	for _, c := range changes {
+		f := stateFields[c.Field]
+		if f.kind == basicField || len(c.Indices) == 0 {
+			b.dirtyFields[c.Field] = true
+		} else {
+			b.dirtyIndices[c.Field] = append(b.dirtyIndices[c.Field], c.Indices...)
+		}
+		if f.kind != basicField {
+			b.unshareField(c, v.FieldByIndex(f.index))
+		}
+	}
+	return fn(b.state)
+}
+
+// unshareField copies the value of a vector or list field before it changes,
+// when other states share it. A change of some elements copies the list of
+// elements and only the changed ones. The changed elements are copied even
+// when the field is not shared, as the state transition changes elements in
+// place, which other elements of the field may alias.
+func (b *BeaconState) unshareField(c Change, v reflect.Value) {
+	whole := len(c.Indices) == 0
+	if b.sharedFields[c.Field].unshare(func() {
+		v.Set(copyElements(v))
+		if whole {
+			for i := 0; i < v.Len(); i++ {
+				copyElement(v, i)
+			}
+		}
+	}) {
+		b.sharedFields[c.Field] = newReference()
+	}
+	for _, i := range c.Indices {
+		if i < uint64(v.Len()) {
+			copyElement(v, int(i))
+		}
+	}
+}
+
+// HashTreeRoot returns the hash tree root of the beacon state, rehashing the
+// fields and elements which changed since it was last hashed.
+func (b *BeaconState) HashTreeRoot() ([32]byte, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.state == nil {
+		return [32]byte{}, errors.New("nil beacon state")
+	}
+
+	if b.roots == nil {
+		b.roots = make([][32]byte, len(stateFields))
+		for i := range stateFields {
+			b.dirtyFields[FieldIndex(i)] = true
+		}
+	}
+	v := reflect.ValueOf(b.state).Elem()
+	for i, f := range stateFields {
+		idx := FieldIndex(i)
+		if _, ok := b.dirtyIndices[idx]; !ok && !b.dirtyFields[idx] {
+			continue
+		}
+		root, err := b.hashField(idx, v.FieldByIndex(f.index))
+		if err != nil {
+			return [32]byte{}, errors.Wrapf(err, "could not hash field %s", f.name)
+		}
+		b.roots[i] = root
+		delete(b.dirtyFields, idx)
+		delete(b.dirtyIndices, idx)
+	}
+	return newTrie(append([][32]byte{}, b.roots...), depthOf(uint64(len(b.roots)))).root(), nil
+}
+
+// hashField returns the root of a changed field. The tree of a vector or list
+// field is updated at its changed elements, or rebuilt when the field changed
+// as a whole. Fields which do not have the size of their type are hashed as
+// a whole and not cached.
+func (b *BeaconState) hashField(idx FieldIndex, v reflect.Value) ([32]byte, error) {
+	f := stateFields[idx]
+	if f.kind == basicField {
+		return f.hashWhole(v)
+	}
+	if t, ok := b.tries[idx]; ok && !b.dirtyFields[idx] && b.canUpdate(f, v, t.length, b.dirtyIndices[idx]) {
+		return b.updateTrie(idx, v, b.dirtyIndices[idx])
+	}
+
+	b.releaseTrie(idx)
+	n := v.Len()
+	if !f.fitsLength(n) {
+		return f.hashWhole(v)
+	}
+	for i := 0; i < n; i++ {
+		if !f.fitsElement(v, i) {
+			return f.hashWhole(v)
+		}
+	}
+	chunks := make([][32]byte, f.chunkCount(n))
+	for i := range chunks {
+		chunk, err := f.chunk(v, i)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		chunks[i] = chunk
+	}
+	t := newTrie(chunks, f.depth())
+	b.tries[idx] = &fieldTrie{trie: t, length: n, ref: newReference()}
+	return f.root(t, n), nil
+}
+
+// canUpdate returns whether the tree of a field of the given length can be
+// updated at the changed elements. The field must not have shrunk, and the
+// appended elements must be declared.
+func (b *BeaconState) canUpdate(f *field, v reflect.Value, length int, indices []uint64) bool {
+	n := v.Len()
+	if n < length || !f.fitsLength(n) {
+		return false
+	}
+	appended := make(map[uint64]bool)
+	for _, i := range indices {
+		if i >= uint64(n) {
+			continue
+		}
+		if !f.fitsElement(v, int(i)) {
+			return false
+		}
+		if i >= uint64(length) {
+			appended[i] = true
+		}
+	}
+	return len(appended) == n-length
+}
+
+// updateTrie rehashes the chunks of the changed elements of a field. The tree
+// is copied first when other states share it.
+func (b *BeaconState) updateTrie(idx FieldIndex, v reflect.Value, indices []uint64) ([32]byte, error) {
+	f := stateFields[idx]
+	n := v.Len()
+	chunks := make(map[int][32]byte)
+	for _, i := range indices {
+		if i >= uint64(n) {
+			continue
+		}
+		c := f.chunkOf(int(i))
+		if _, ok := chunks[c]; ok {
+			continue
+		}
+		chunk, err := f.chunk(v, c)
+		if err != nil {
+			return [32]byte{}, err
+		}
+		chunks[c] = chunk
+	}
+
+	t := b.tries[idx]
+	if t.ref.unshare(func() {
+		t = &fieldTrie{trie: t.trie.copy(), length: t.length, ref: newReference()}
+	}) {
+		b.tries[idx] = t
+	}
+	t.trie.grow(f.chunkCount(n))
+	t.trie.update(chunks)
+	t.length = n
+	return f.root(t.trie, n), nil
+}
+
+// releaseTrie drops the tree of a field.
+func (b *BeaconState) releaseTrie(idx FieldIndex) {
+	t, ok := b.tries[idx]
+	if !ok {
+		return
+	}
+	t.ref.unshare(func() {})
+	delete(b.tries, idx)
+}
@@ -0,0 +1,380 @@
+package statetrie
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestFieldIndex_MatchesState(t *testing.T) {
+	fields := map[FieldIndex]string{
+		GenesisTime:                 "GenesisTime",
+		LatestBlockHeader:           "LatestBlockHeader",
+		Eth1DepositIndex:            "Eth1DepositIndex",
+		Validators:                  "Validators",
+		Balances:                    "Balances",
+		RandaoMixes:                 "RandaoMixes",
+		CurrentCrosslinks:           "CurrentCrosslinks",
+		FinalizedCheckpoint:         "FinalizedCheckpoint",
+		PreviousJustifiedCheckpoint: "PreviousJustifiedCheckpoint",
+	}
+	if len(stateFields) != int(FinalizedCheckpoint)+1 {
+		t.Fatalf("Expected %d state fields, received %d", FinalizedCheckpoint+1, len(stateFields))
+	}
+	for idx, name := range fields {
+		if stateFields[idx].name != name {
+			t.Errorf("Expected field %d to be %s, received %s", idx, name, stateFields[idx].name)
+		}
+	}
+}
+
+func TestHashTreeRoot_MatchesSSZ(t *testing.T) {
+	assertRoot(t, New(testState(100)))
+}
+
+func TestModify_RehashesChanges(t *testing.T) {
+	b := New(testState(100))
+	assertRoot(t, b)
+
+	changes := []struct {
+		name    string
+		change  func(*pb.BeaconState)
+		changes []Change
+	}{
+		{
+			name:    "slot",
+			change:  func(s *pb.BeaconState) { s.Slot++ },
+			changes: []Change{{Field: Slot}},
+		},
+		{
+			name:    "block root",
+			change:  func(s *pb.BeaconState) { s.BlockRoots[3] = root(1) },
+			changes: []Change{{Field: BlockRoots, Indices: []uint64{3}}},
+		},
+		{
+			name:    "latest block header",
+			change:  func(s *pb.BeaconState) { s.LatestBlockHeader.StateRoot = root(2) },
+			changes: []Change{{Field: LatestBlockHeader}},
+		},
+		{
+			name:    "historical root",
+			change:  func(s *pb.BeaconState) { s.HistoricalRoots = append(s.HistoricalRoots, root(3)) },
+			changes: []Change{{Field: HistoricalRoots, Indices: []uint64{0}}},
+		},
+		{
+			name:    "validator",
+			change:  func(s *pb.BeaconState) { s.Validators[7].Slashed = true },
+			changes: []Change{{Field: Validators, Indices: []uint64{7}}},
+		},
+		{
+			name:    "validator public key",
+			change:  func(s *pb.BeaconState) { s.Validators[8].PublicKey[0]++ },
+			changes: []Change{{Field: Validators, Indices: []uint64{8}}},
+		},
+		{
+			name:    "balance",
+			change:  func(s *pb.BeaconState) { s.Balances[9] += 1e9 },
+			changes: []Change{{Field: Balances, Indices: []uint64{9}}},
+		},
+		{
+			name: "new validator",
+			change: func(s *pb.BeaconState) {
+				s.Validators = append(s.Validators, &ethpb.Validator{
+					PublicKey:             bytes.Repeat([]byte{1}, 48),
+					WithdrawalCredentials: make([]byte, 32),
+					ExitEpoch:             params.BeaconConfig().FarFutureEpoch,
+				})
+				s.Balances = append(s.Balances, 1e9)
+			},
+			changes: []Change{{Field: Validators, Indices: []uint64{100}}, {Field: Balances, Indices: []uint64{100}}},
+		},
+		{
+			name:    "randao mix",
+			change:  func(s *pb.BeaconState) { s.RandaoMixes[5][0]++ },
+			changes: []Change{{Field: RandaoMixes, Indices: []uint64{5}}},
+		},
+		{
+			name:    "slashings",
+			change:  func(s *pb.BeaconState) { s.Slashings[6] = 1e9 },
+			changes: []Change{{Field: Slashings, Indices: []uint64{6}}},
+		},
+		{
+			name: "pending attestation",
+			change: func(s *pb.BeaconState) {
+				s.CurrentEpochAttestations = append(s.CurrentEpochAttestations, &pb.PendingAttestation{
+					AggregationBits: bitfield.Bitlist{0x03},
+					Data: &ethpb.AttestationData{
+						BeaconBlockRoot: root(4),
+						Source:          &ethpb.Checkpoint{Root: root(5)},
+						Target:          &ethpb.Checkpoint{Root: root(6)},
+						Crosslink:       &ethpb.Crosslink{ParentRoot: root(7), DataRoot: root(8)},
+					},
+				})
+			},
+			changes: []Change{{Field: CurrentEpochAttestations, Indices: []uint64{0}}},
+		},
+		{
+			name: "rotated attestations",
+			change: func(s *pb.BeaconState) {
+				s.PreviousEpochAttestations = s.CurrentEpochAttestations
+				s.CurrentEpochAttestations = nil
+			},
+			changes: []Change{{Field: PreviousEpochAttestations}, {Field: CurrentEpochAttestations}},
+		},
+		{
+			name:    "crosslink",
+			change:  func(s *pb.BeaconState) { s.CurrentCrosslinks[10].DataRoot = root(9) },
+			changes: []Change{{Field: CurrentCrosslinks, Indices: []uint64{10}}},
+		},
+		{
+			name:    "justification bits",
+			change:  func(s *pb.BeaconState) { s.JustificationBits.SetBitAt(0, true) },
+			changes: []Change{{Field: JustificationBits}},
+		},
+		{
+			name:    "checkpoint",
+			change:  func(s *pb.BeaconState) { s.FinalizedCheckpoint = &ethpb.Checkpoint{Epoch: 1, Root: root(10)} },
+			changes: []Change{{Field: FinalizedCheckpoint}},
+		},
+		{
+			name: "removed balances",
+			change: func(s *pb.BeaconState) {
+				s.Validators = s.Validators[:50]
+				s.Balances = s.Balances[:50]
+			},
+			changes: []Change{{Field: Validators}, {Field: Balances}},
+		},
+	}
+	for _, tt := range changes {
+		if err := b.Modify(func(s *pb.BeaconState) error {
+			tt.change(s)
+			return nil
+		}, tt.changes...); err != nil {
+			t.Fatal(err)
+		}
+		if !assertRoot(t, b) {
+			t.Errorf("Wrong root after changing the %s", tt.name)
+		}
+	}
+}
+
+func TestModify_UndeclaredAppendRebuildsTrie(t *testing.T) {
+	b := New(testState(10))
+	assertRoot(t, b)
+	if err := b.Modify(func(s *pb.BeaconState) error {
+		s.Balances[0]++
+		s.Balances = append(s.Balances, 1, 2)
+		return nil
+	}, Change{Field: Balances, Indices: []uint64{0, 10}}); err != nil {
+		t.Fatal(err)
+	}
+	assertRoot(t, b)
+}
+
+func TestModify_CopiesChangedElements(t *testing.T) {
+	state := testState(10)
+	// The state transition aliases randao mixes of consecutive epochs.
+	state.RandaoMixes[1] = state.RandaoMixes[0]
+	b := New(state)
+	assertRoot(t, b)
+	if err := b.Modify(func(s *pb.BeaconState) error {
+		s.RandaoMixes[1][0]++
+		return nil
+	}, Change{Field: RandaoMixes, Indices: []uint64{1}}); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b.InnerStateUnsafe().RandaoMixes[0], root(0)) {
+		t.Error("Expected changing a randao mix to leave the mix it aliased as it was")
+	}
+	assertRoot(t, b)
+}
+
+func TestHashTreeRoot_WrongVectorLength(t *testing.T) {
+	state := testState(10)
+	state.BlockRoots = state.BlockRoots[:10]
+	want, wantErr := ssz.HashTreeRoot(state)
+	got, err := New(state).HashTreeRoot()
+	if (err != nil) != (wantErr != nil) {
+		t.Fatalf("Expected error %v, received %v", wantErr, err)
+	}
+	if err == nil && got != want {
+		t.Errorf("Expected root %#x, received %#x", want, got)
+	}
+}
+
+func TestCopy_SharesValuesUntilChanged(t *testing.T) {
+	b := New(testState(100))
+	assertRoot(t, b)
+
+	c := b.Copy()
+	if &c.InnerStateUnsafe().Validators[0] != &b.InnerStateUnsafe().Validators[0] {
+		t.Error("Expected the copy to share the validators")
+	}
+	if c.tries[Validators] != b.tries[Validators] {
+		t.Error("Expected the copy to share the tree of the validators")
+	}
+	if err := c.Modify(func(s *pb.BeaconState) error {
+		s.Balances[0] = 0
+		s.Validators[1].Slashed = true
+		s.RandaoMixes[2][0]++
+		return nil
+	},
+		Change{Field: Balances, Indices: []uint64{0}},
+		Change{Field: Validators, Indices: []uint64{1}},
+		Change{Field: RandaoMixes, Indices: []uint64{2}},
+	); err != nil {
+		t.Fatal(err)
+	}
+	assertRoot(t, c)
+
+	s := b.InnerStateUnsafe()
+	if s.Balances[0] == 0 || s.Validators[1].Slashed || !bytes.Equal(s.RandaoMixes[2], root(2)) {
+		t.Error("Expected the changes of the copy to leave the state as it was")
+	}
+	if &c.InnerStateUnsafe().Validators[2] == &s.Validators[2] || c.InnerStateUnsafe().Validators[2] != s.Validators[2] {
+		t.Error("Expected the copy to copy the list of validators and share the unchanged ones")
+	}
+	assertRoot(t, b)
+
+	// The state is the only one left sharing its values, and changes them in
+	// place.
+	balances := s.Balances
+	if err := b.Modify(func(s *pb.BeaconState) error {
+		s.Balances[3] = 0
+		return nil
+	}, Change{Field: Balances, Indices: []uint64{3}}); err != nil {
+		t.Fatal(err)
+	}
+	if &b.InnerStateUnsafe().Balances[0] != &balances[0] {
+		t.Error("Expected the state to change its unshared balances in place")
+	}
+	assertRoot(t, b)
+	assertRoot(t, c)
+}
+
+func TestCopy_Concurrent(t *testing.T) {
+	b := New(testState(100))
+	assertRoot(t, b)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			c := b.Copy()
+			if err := c.Modify(func(s *pb.BeaconState) error {
+				s.Slot += uint64(i)
+				s.Validators[i].Slashed = true
+				s.Balances = append(s.Balances, uint64(i))
+				return nil
+			},
+				Change{Field: Slot},
+				Change{Field: Validators, Indices: []uint64{uint64(i)}},
+				Change{Field: Balances, Indices: []uint64{100}},
+			); err != nil {
+				t.Error(err)
+				return
+			}
+			assertRoot(t, c)
+			assertRoot(t, c.Copy())
+		}(i)
+	}
+	wg.Wait()
+	assertRoot(t, b)
+}
+
+func TestHashTreeRoot_NilState(t *testing.T) {
+	if _, err := New(nil).HashTreeRoot(); err == nil {
+		t.Error("Expected an error hashing a nil state")
+	}
+}
+
+// assertRoot checks the root of the wrapped state against the root of the
+// whole state.
+func assertRoot(t *testing.T, b *BeaconState) bool {
+	want, err := ssz.HashTreeRoot(b.InnerStateUnsafe())
+	if err != nil {
+		t.Error(err)
+		return false
+	}
+	got, err := b.HashTreeRoot()
+	if err != nil {
+		t.Error(err)
+		return false
+	}
+	if got != want {
+		t.Errorf("Expected root %#x, received %#x", want, got)
+		return false
+	}
+	return true
+}
+
+func root(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func roots(n uint64) [][]byte {
+	r := make([][]byte, n)
+	for i := range r {
+		r[i] = root(byte(i))
+	}
+	return r
+}
+
+func crosslinks(n uint64) []*ethpb.Crosslink {
+	c := make([]*ethpb.Crosslink, n)
+	for i := range c {
+		c[i] = &ethpb.Crosslink{Shard: uint64(i), ParentRoot: root(0), DataRoot: root(0)}
+	}
+	return c
+}
+
+func testState(validatorCount int) *pb.BeaconState {
+	cfg := params.BeaconConfig()
+	validators := make([]*ethpb.Validator, validatorCount)
+	balances := make([]uint64, validatorCount)
+	for i := range validators {
+		validators[i] = &ethpb.Validator{
+			PublicKey:             bytes.Repeat([]byte{byte(i)}, 48),
+			WithdrawalCredentials: root(byte(i)),
+			EffectiveBalance:      cfg.MaxEffectiveBalance,
+			ExitEpoch:             cfg.FarFutureEpoch,
+			WithdrawableEpoch:     cfg.FarFutureEpoch,
+		}
+		balances[i] = cfg.MaxEffectiveBalance + uint64(i)
+	}
+	return &pb.BeaconState{
+		GenesisTime: 1,
+		Slot:        2,
+		Fork: &pb.Fork{
+			PreviousVersion: []byte{0, 0, 0, 0},
+			CurrentVersion:  []byte{0, 0, 0, 0},
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			ParentRoot: root(0),
+			StateRoot:  root(0),
+			BodyRoot:   root(0),
+		},
+		BlockRoots:                  roots(cfg.SlotsPerHistoricalRoot),
+		StateRoots:                  roots(cfg.SlotsPerHistoricalRoot),
+		Eth1Data:                    &ethpb.Eth1Data{DepositRoot: root(0), BlockHash: root(0)},
+		Validators:                  validators,
+		Balances:                    balances,
+		RandaoMixes:                 roots(cfg.EpochsPerHistoricalVector),
+		ActiveIndexRoots:            roots(cfg.EpochsPerHistoricalVector),
+		CompactCommitteesRoots:      roots(cfg.EpochsPerHistoricalVector),
+		Slashings:                   make([]uint64, cfg.EpochsPerSlashingsVector),
+		PreviousCrosslinks:          crosslinks(cfg.ShardCount),
+		CurrentCrosslinks:           crosslinks(cfg.ShardCount),
+		JustificationBits:           bitfield.Bitvector4{0},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Root: root(0)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Root: root(0)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Root: root(0)},
+	}
+}
@@ -0,0 +1,129 @@
+package statetrie
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// zeroHashes holds the roots of the trees of zero chunks, by tree depth.
+var zeroHashes = make([][32]byte, 64)
+
+func init() {
+	for i := 1; i < len(zeroHashes); i++ {
+		zeroHashes[i] = hashPair(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+// trie holds the layers of the merkle tree of a list of chunks, from the
+// leaves up to the root of the smallest subtree holding all the leaves. The
+// missing nodes of a layer are zero hashes, and the tree is extended with
+// zero hashes up to its depth.
+type trie struct {
+	layers [][][32]byte
+	depth  int
+}
+
+// newTrie builds the tree of the given leaves, which it takes ownership of.
+func newTrie(leaves [][32]byte, depth int) *trie {
+	layers := [][][32]byte{leaves}
+	for d := 0; len(layers[d]) > 1; d++ {
+		next := make([][32]byte, (len(layers[d])+1)/2)
+		for i := range next {
+			next[i] = parent(layers[d], i, d)
+		}
+		layers = append(layers, next)
+	}
+	return &trie{layers: layers, depth: depth}
+}
+
+// root returns the root of the tree.
+func (t *trie) root() [32]byte {
+	if len(t.layers[0]) == 0 {
+		return zeroHashes[t.depth]
+	}
+	top := len(t.layers) - 1
+	root := t.layers[top][0]
+	for d := top; d < t.depth; d++ {
+		root = hashPair(root, zeroHashes[d])
+	}
+	return root
+}
+
+// copy returns a deep copy of the tree, to update without changing the
+// tree shared with other states.
+func (t *trie) copy() *trie {
+	layers := make([][][32]byte, len(t.layers))
+	for i, layer := range t.layers {
+		layers[i] = make([][32]byte, len(layer))
+		copy(layers[i], layer)
+	}
+	return &trie{layers: layers, depth: t.depth}
+}
+
+// grow extends the tree to n leaves. The new leaves must be set with update.
+func (t *trie) grow(n int) {
+	if n <= len(t.layers[0]) {
+		return
+	}
+	t.layers[0] = append(t.layers[0], make([][32]byte, n-len(t.layers[0]))...)
+	for d := 0; len(t.layers[d]) > 1; d++ {
+		size := (len(t.layers[d]) + 1) / 2
+		if d+1 == len(t.layers) {
+			t.layers = append(t.layers, make([][32]byte, size))
+			continue
+		}
+		if size > len(t.layers[d+1]) {
+			t.layers[d+1] = append(t.layers[d+1], make([][32]byte, size-len(t.layers[d+1]))...)
+		}
+	}
+}
+
+// update sets the given leaves, by index, and rehashes the nodes above them.
+func (t *trie) update(leaves map[int][32]byte) {
+	dirty := make(map[int]bool, len(leaves))
+	for i, leaf := range leaves {
+		t.layers[0][i] = leaf
+		dirty[i] = true
+	}
+	for d := 1; d < len(t.layers); d++ {
+		parents := make(map[int]bool, len(dirty))
+		for i := range dirty {
+			parents[i/2] = true
+		}
+		for i := range parents {
+			t.layers[d][i] = parent(t.layers[d-1], i, d-1)
+		}
+		dirty = parents
+	}
+}
+
+// parent returns the parent of the nodes 2*index and 2*index+1 of a layer at
+// the given depth from the leaves.
+func parent(layer [][32]byte, index int, depth int) [32]byte {
+	right := zeroHashes[depth]
+	if 2*index+1 < len(layer) {
+		right = layer[2*index+1]
+	}
+	return hashPair(layer[2*index], right)
+}
+
+func hashPair(left [32]byte, right [32]byte) [32]byte {
+	return hashutil.Hash(append(left[:], right[:]...))
+}
+
+// mixInLength mixes the length of a list into the root of its chunks.
+func mixInLength(root [32]byte, length int) [32]byte {
+	var l [32]byte
+	binary.LittleEndian.PutUint64(l[:8], uint64(length))
+	return hashPair(root, l)
+}
+
+// depthOf returns the depth of the tree holding the given number of chunks.
+func depthOf(chunks uint64) int {
+	if chunks <= 1 {
+		return 0
+	}
+	return bits.Len64(chunks - 1)
+}
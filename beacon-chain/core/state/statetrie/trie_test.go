@@ -0,0 +1,74 @@
+package statetrie
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTrie_RootMatchesMerkleize(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		for depth := depthOf(uint64(n)); depth < depthOf(uint64(n))+3; depth++ {
+			leaves := make([][32]byte, n)
+			for i := range leaves {
+				r.Read(leaves[i][:])
+			}
+			tr := newTrie(append([][32]byte{}, leaves...), depth)
+			if tr.root() != merkleize(leaves, depth) {
+				t.Errorf("Wrong root of %d leaves at depth %d", n, depth)
+			}
+
+			if n == 0 {
+				continue
+			}
+			updated := tr.copy()
+			i := r.Intn(n)
+			old := leaves[i]
+			r.Read(leaves[i][:])
+			updated.update(map[int][32]byte{i: leaves[i]})
+			if updated.root() != merkleize(leaves, depth) {
+				t.Errorf("Wrong root of %d leaves at depth %d after updating leaf %d", n, depth, i)
+			}
+			leaves[i] = old
+			if tr.root() != merkleize(leaves, depth) {
+				t.Errorf("Expected updating the copy to leave the tree of %d leaves as it was", n)
+			}
+		}
+	}
+}
+
+func TestTrie_Grow(t *testing.T) {
+	for n := 0; n < 10; n++ {
+		for added := 1; added < 10; added++ {
+			leaves := make([][32]byte, n+added)
+			for i := range leaves {
+				leaves[i] = [32]byte{byte(i + 1)}
+			}
+			tr := newTrie(append([][32]byte{}, leaves[:n]...), 5)
+			tr.grow(n + added)
+			// Appending leaves rehashes the nodes at the old end of each layer.
+			updated := map[int][32]byte{}
+			for i := n; i < n+added; i++ {
+				updated[i] = leaves[i]
+			}
+			tr.update(updated)
+			if tr.root() != merkleize(leaves, 5) {
+				t.Errorf("Wrong root after growing %d leaves by %d", n, added)
+			}
+		}
+	}
+}
+
+// merkleize hashes the leaves padded with zero chunks to the given depth.
+func merkleize(leaves [][32]byte, depth int) [32]byte {
+	layer := make([][32]byte, 1<<uint(depth))
+	copy(layer, leaves)
+	for len(layer) > 1 {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+	return layer[0]
+}
@@ -14,6 +14,7 @@ import (
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	e "github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
@@ -23,6 +24,7 @@ import (
 )
 
 // ExecuteStateTransition defines the procedure for a state transition function.
+// It runs on a copy of the state, the given state is not modified.
 //
 // Spec pseudocode definition:
 //  def state_transition(state: BeaconState, block: BeaconBlock, validate_state_root: bool=False) -> BeaconState:
@@ -37,12 +39,15 @@ import (
 //    return state
 func ExecuteStateTransition(
 	ctx context.Context,
-	state *pb.BeaconState,
+	state *statetrie.BeaconState,
 	block *ethpb.BeaconBlock,
-) (*pb.BeaconState, error) {
+) (*statetrie.BeaconState, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
+	// Blocks come from peers, a block failing the transition must leave the
+	// state of the caller as it was.
+	state = state.Copy()
 	helpers.ClearStartShardCache()
 	b.ClearEth1DataVoteCache()
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.ExecuteStateTransition")
@@ -56,13 +61,15 @@ func ExecuteStateTransition(
 
 	// Execute per block transition.
 	if block != nil {
-		state, err = ProcessBlock(ctx, state, block)
-		if err != nil {
+		if err := state.Modify(func(s *pb.BeaconState) error {
+			_, err := ProcessBlock(ctx, s, block)
+			return err
+		}, blockChanges(state.InnerStateUnsafe(), block.Body)...); err != nil {
 			return nil, errors.Wrap(err, "could not process block")
 		}
 	}
 
-	postStateRoot, err := ssz.HashTreeRoot(state)
+	postStateRoot, err := state.HashTreeRoot()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not tree hash processed state")
 	}
@@ -92,14 +99,14 @@ func ExecuteStateTransition(
 //    return state
 func ExecuteStateTransitionNoVerify(
 	ctx context.Context,
-	state *pb.BeaconState,
+	state *statetrie.BeaconState,
 	block *ethpb.BeaconBlock,
-) (*pb.BeaconState, error) {
+) (*statetrie.BeaconState, error) {
 	if ctx.Err() != nil {
 		return nil, ctx.Err()
 	}
 
-	stateCopy := proto.Clone(state).(*pb.BeaconState)
+	stateCopy := state.Copy()
 	helpers.ClearStartShardCache()
 	b.ClearEth1DataVoteCache()
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.ExecuteStateTransition")
@@ -114,8 +121,10 @@ func ExecuteStateTransitionNoVerify(
 
 	// Execute per block transition.
 	if block != nil {
-		stateCopy, err = processBlockNoVerify(ctx, stateCopy, block)
-		if err != nil {
+		if err := stateCopy.Modify(func(s *pb.BeaconState) error {
+			_, err := processBlockNoVerify(ctx, s, block)
+			return err
+		}, blockChanges(stateCopy.InnerStateUnsafe(), block.Body)...); err != nil {
 			return nil, errors.Wrap(err, "could not process block")
 		}
 	}
@@ -139,26 +148,36 @@ func ExecuteStateTransitionNoVerify(
 //    # Cache block root
 //    previous_block_root = signing_root(state.latest_block_header)
 //    state.block_roots[state.slot % SLOTS_PER_HISTORICAL_ROOT] = previous_block_root
-func ProcessSlot(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState, error) {
+func ProcessSlot(ctx context.Context, state *statetrie.BeaconState) (*statetrie.BeaconState, error) {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessSlot")
 	defer span.End()
-	prevStateRoot, err := ssz.HashTreeRoot(state)
+	prevStateRoot, err := state.HashTreeRoot()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not tree hash prev state root")
 	}
-	state.StateRoots[state.Slot%params.BeaconConfig().SlotsPerHistoricalRoot] = prevStateRoot[:]
+	idx := state.Slot() % params.BeaconConfig().SlotsPerHistoricalRoot
+	if err := state.Modify(func(s *pb.BeaconState) error {
+		s.StateRoots[idx] = prevStateRoot[:]
 
-	zeroHash := params.BeaconConfig().ZeroHash
-	// Cache latest block header state root.
-	if bytes.Equal(state.LatestBlockHeader.StateRoot, zeroHash[:]) {
-		state.LatestBlockHeader.StateRoot = prevStateRoot[:]
-	}
-	prevBlockRoot, err := ssz.SigningRoot(state.LatestBlockHeader)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not determine prev block root")
+		zeroHash := params.BeaconConfig().ZeroHash
+		// Cache latest block header state root.
+		if bytes.Equal(s.LatestBlockHeader.StateRoot, zeroHash[:]) {
+			s.LatestBlockHeader.StateRoot = prevStateRoot[:]
+		}
+		prevBlockRoot, err := ssz.SigningRoot(s.LatestBlockHeader)
+		if err != nil {
+			return errors.Wrap(err, "could not determine prev block root")
+		}
+		// Cache the block root.
+		s.BlockRoots[idx] = prevBlockRoot[:]
+		return nil
+	},
+		statetrie.Change{Field: statetrie.StateRoots, Indices: []uint64{idx}},
+		statetrie.Change{Field: statetrie.LatestBlockHeader},
+		statetrie.Change{Field: statetrie.BlockRoots, Indices: []uint64{idx}},
+	); err != nil {
+		return nil, err
 	}
-	// Cache the block root.
-	state.BlockRoots[state.Slot%params.BeaconConfig().SlotsPerHistoricalRoot] = prevBlockRoot[:]
 	return state, nil
 }
 
@@ -174,11 +193,11 @@ func ProcessSlot(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState, e
 //            process_epoch(state)
 //        state.slot += 1
 //    ]
-func ProcessSlots(ctx context.Context, state *pb.BeaconState, slot uint64) (*pb.BeaconState, error) {
-	if state.Slot > slot {
-		return nil, fmt.Errorf("expected state.slot %d < slot %d", state.Slot, slot)
+func ProcessSlots(ctx context.Context, state *statetrie.BeaconState, slot uint64) (*statetrie.BeaconState, error) {
+	if state.Slot() > slot {
+		return nil, fmt.Errorf("expected state.slot %d < slot %d", state.Slot(), slot)
 	}
-	for state.Slot < slot {
+	for state.Slot() < slot {
 		if ctx.Err() != nil {
 			return nil, ctx.Err()
 		}
@@ -186,13 +205,20 @@ func ProcessSlots(ctx context.Context, state *pb.BeaconState, slot uint64) (*pb.
 		if err != nil {
 			return nil, errors.Wrap(err, "could not process slot")
 		}
-		if CanProcessEpoch(state) {
-			state, err = ProcessEpoch(ctx, state)
-			if err != nil {
+		if CanProcessEpoch(state.InnerStateUnsafe()) {
+			if err := state.Modify(func(s *pb.BeaconState) error {
+				_, err := ProcessEpoch(ctx, s)
+				return err
+			}, epochChanges(state.InnerStateUnsafe())...); err != nil {
 				return nil, errors.Wrap(err, "could not process epoch")
 			}
 		}
-		state.Slot++
+		if err := state.Modify(func(s *pb.BeaconState) error {
+			s.Slot++
+			return nil
+		}, statetrie.Change{Field: statetrie.Slot}); err != nil {
+			return nil, err
+		}
 	}
 	return state, nil
 }
@@ -285,6 +311,78 @@ func processBlockNoVerify(
 	return state, nil
 }
 
+// blockChanges returns the fields and elements of the state which processing
+// a block with the given body changes.
+func blockChanges(state *pb.BeaconState, body *ethpb.BeaconBlockBody) []statetrie.Change {
+	cfg := params.BeaconConfig()
+	currentEpoch := helpers.CurrentEpoch(state)
+	changes := []statetrie.Change{
+		{Field: statetrie.LatestBlockHeader},
+		{Field: statetrie.RandaoMixes, Indices: []uint64{currentEpoch % cfg.EpochsPerHistoricalVector}},
+		{Field: statetrie.Eth1Data},
+		{Field: statetrie.Eth1DataVotes, Indices: []uint64{uint64(len(state.Eth1DataVotes))}},
+	}
+	if body == nil {
+		return changes
+	}
+
+	// Attestations are appended to the pending attestations of their target
+	// epoch.
+	var current, previous []uint64
+	for _, att := range body.Attestations {
+		if att.GetData().GetTarget().GetEpoch() == currentEpoch {
+			current = append(current, uint64(len(state.CurrentEpochAttestations)+len(current)))
+		} else {
+			previous = append(previous, uint64(len(state.PreviousEpochAttestations)+len(previous)))
+		}
+	}
+	changes = append(changes,
+		statetrie.Change{Field: statetrie.CurrentEpochAttestations, Indices: current},
+		statetrie.Change{Field: statetrie.PreviousEpochAttestations, Indices: previous},
+	)
+
+	// Slashings, deposits and transfers change the balances of validators
+	// which are not known before processing them.
+	var validators []uint64
+	var changesBalances bool
+	for _, slashing := range body.ProposerSlashings {
+		validators = append(validators, slashing.ProposerIndex)
+		changesBalances = true
+	}
+	for _, slashing := range body.AttesterSlashings {
+		att := slashing.GetAttestation_1()
+		validators = append(validators, att.GetCustodyBit_0Indices()...)
+		validators = append(validators, att.GetCustodyBit_1Indices()...)
+		changesBalances = true
+	}
+	if len(validators) > 0 {
+		changes = append(changes, statetrie.Change{
+			Field:   statetrie.Slashings,
+			Indices: []uint64{currentEpoch % cfg.EpochsPerSlashingsVector},
+		})
+	}
+	for i := range body.Deposits {
+		validators = append(validators, uint64(len(state.Validators)+i))
+		changesBalances = true
+	}
+	if len(body.Deposits) > 0 {
+		changes = append(changes, statetrie.Change{Field: statetrie.Eth1DepositIndex})
+	}
+	for _, exit := range body.VoluntaryExits {
+		validators = append(validators, exit.ValidatorIndex)
+	}
+	if len(body.Transfers) > 0 {
+		changesBalances = true
+	}
+	if len(validators) > 0 {
+		changes = append(changes, statetrie.Change{Field: statetrie.Validators, Indices: validators})
+	}
+	if changesBalances {
+		changes = append(changes, statetrie.Change{Field: statetrie.Balances})
+	}
+	return changes
+}
+
 // ProcessOperations processes the operations in the beacon block and updates beacon state
 // with the operations in block.
 //
@@ -518,36 +616,19 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.ProcessEpoch")
 	defer span.End()
 
-	prevEpochAtts, err := e.MatchAttestations(state, helpers.PrevEpoch(state))
-	if err != nil {
-		return nil, fmt.Errorf("could not get target atts prev epoch %d: %v",
-			helpers.PrevEpoch(state), err)
-	}
-	currentEpochAtts, err := e.MatchAttestations(state, helpers.CurrentEpoch(state))
-	if err != nil {
-		return nil, fmt.Errorf("could not get target atts current epoch %d: %v",
-			helpers.CurrentEpoch(state), err)
-	}
-	prevEpochAttestedBalance, err := e.AttestingBalance(state, prevEpochAtts.Target)
+	// Match the attestations of the epoch and flag the attesters once, for all
+	// the processing steps.
+	p, err := e.NewPrecompute(state)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not get attesting balance prev epoch")
-	}
-	currentEpochAttestedBalance, err := e.AttestingBalance(state, currentEpochAtts.Target)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not get attesting balance current epoch")
-	}
-
-	state, err = e.ProcessJustificationAndFinalization(state, prevEpochAttestedBalance, currentEpochAttestedBalance)
-	if err != nil {
-		return nil, errors.Wrap(err, "could not process justification")
+		return nil, errors.Wrap(err, "could not precompute epoch attestations")
 	}
 
-	state, err = e.ProcessCrosslinks(state)
+	state, err = processJustificationAndCrosslinks(state, p)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not process crosslink")
+		return nil, err
 	}
 
-	state, err = e.ProcessRewardsAndPenalties(state)
+	state, err = e.ProcessRewardsAndPenaltiesWithPrecompute(state, p)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not process rewards and penalties")
 	}
@@ -568,3 +649,70 @@ func ProcessEpoch(ctx context.Context, state *pb.BeaconState) (*pb.BeaconState,
 	}
 	return state, nil
 }
+
+// epochChanges returns the fields and elements of the state which the epoch
+// processing changes.
+func epochChanges(state *pb.BeaconState) []statetrie.Change {
+	cfg := params.BeaconConfig()
+	nextEpoch := helpers.CurrentEpoch(state) + 1
+	return []statetrie.Change{
+		{Field: statetrie.JustificationBits},
+		{Field: statetrie.PreviousJustifiedCheckpoint},
+		{Field: statetrie.CurrentJustifiedCheckpoint},
+		{Field: statetrie.FinalizedCheckpoint},
+		{Field: statetrie.PreviousCrosslinks},
+		{Field: statetrie.CurrentCrosslinks},
+		{Field: statetrie.Validators},
+		{Field: statetrie.Balances},
+		{Field: statetrie.Eth1DataVotes},
+		{Field: statetrie.StartShard},
+		{Field: statetrie.ActiveIndexRoots, Indices: []uint64{(nextEpoch + cfg.ActivationExitDelay) % cfg.EpochsPerHistoricalVector}},
+		{Field: statetrie.CompactCommitteesRoots, Indices: []uint64{nextEpoch % cfg.EpochsPerHistoricalVector}},
+		{Field: statetrie.Slashings, Indices: []uint64{nextEpoch % cfg.EpochsPerSlashingsVector}},
+		{Field: statetrie.RandaoMixes, Indices: []uint64{nextEpoch % cfg.EpochsPerHistoricalVector}},
+		{Field: statetrie.HistoricalRoots, Indices: []uint64{uint64(len(state.HistoricalRoots))}},
+		{Field: statetrie.PreviousEpochAttestations},
+		{Field: statetrie.CurrentEpochAttestations},
+	}
+}
+
+// processJustificationAndCrosslinks runs the epoch processing steps which come
+// before the rewards and penalties.
+func processJustificationAndCrosslinks(state *pb.BeaconState, p *e.Precompute) (*pb.BeaconState, error) {
+	state, err := e.ProcessJustificationAndFinalization(
+		state,
+		p.Balances.PrevEpochTargetAttesters,
+		p.Balances.CurrentEpochTargetAttesters,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process justification")
+	}
+
+	state, err = e.ProcessCrosslinksWithPrecompute(state, p)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not process crosslink")
+	}
+	return state, nil
+}
+
+// EpochRewardsBreakdown returns the per validator rewards and penalties the
+// epoch processing of the state applies, without modifying the state. The
+// state must be at the last slot of an epoch.
+func EpochRewardsBreakdown(ctx context.Context, state *pb.BeaconState) (*e.RewardsBreakdown, error) {
+	ctx, span := trace.StartSpan(ctx, "beacon-chain.ChainService.state.EpochRewardsBreakdown")
+	defer span.End()
+
+	if !CanProcessEpoch(state) {
+		return nil, fmt.Errorf("state at slot %d is not at the end of an epoch", state.Slot)
+	}
+	state = proto.Clone(state).(*pb.BeaconState)
+	p, err := e.NewPrecompute(state)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not precompute epoch attestations")
+	}
+	state, err = processJustificationAndCrosslinks(state, p)
+	if err != nil {
+		return nil, err
+	}
+	return e.RewardsAndPenaltiesBreakdownWithPrecompute(state, p)
+}
@@ -9,11 +9,13 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
@@ -32,11 +34,33 @@ func TestExecuteStateTransition_IncorrectSlot(t *testing.T) {
 		Slot: 4,
 	}
 	want := "expected state.slot"
-	if _, err := state.ExecuteStateTransition(context.Background(), beaconState, block); !strings.Contains(err.Error(), want) {
+	if _, err := state.ExecuteStateTransition(context.Background(), statetrie.New(beaconState), block); !strings.Contains(err.Error(), want) {
 		t.Errorf("Expected %s, received %v", want, err)
 	}
 }
 
+func TestExecuteStateTransition_FailedBlockKeepsState(t *testing.T) {
+	helpers.ClearAllCaches()
+	deposits, _ := testutil.SetupInitialDeposits(t, 100)
+	beaconState, err := state.GenesisBeaconState(deposits, uint64(0), &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	preState := proto.Clone(beaconState).(*pb.BeaconState)
+	// The slots are processed before the block fails its header checks.
+	block := &ethpb.BeaconBlock{
+		Slot:       1,
+		ParentRoot: []byte("unknown parent"),
+		Body:       &ethpb.BeaconBlockBody{},
+	}
+	if _, err := state.ExecuteStateTransition(context.Background(), statetrie.New(beaconState), block); err == nil {
+		t.Fatal("Expected the block to fail processing")
+	}
+	if !proto.Equal(beaconState, preState) {
+		t.Error("Expected the failed state transition to leave the state unchanged")
+	}
+}
+
 func TestExecuteStateTransition_FullProcess(t *testing.T) {
 	helpers.ClearAllCaches()
 	deposits, privKeys := testutil.SetupInitialDeposits(t, 100)
@@ -76,15 +100,25 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 		},
 	}
 
-	stateRootCandidate, err := state.ExecuteStateTransitionNoVerify(context.Background(), beaconState, block)
+	preState := statetrie.New(beaconState)
+	stateRootCandidate, err := state.ExecuteStateTransitionNoVerify(context.Background(), preState, block)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	stateRoot, err := ssz.HashTreeRoot(stateRootCandidate)
+	stateRoot, err := stateRootCandidate.HashTreeRoot()
 	if err != nil {
 		t.Fatal(err)
 	}
+	// The root of the changed fields must match the root of the whole state,
+	// across the epoch transition and the block.
+	wantRoot, err := ssz.HashTreeRoot(stateRootCandidate.InnerStateUnsafe())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stateRoot != wantRoot {
+		t.Fatalf("Expected state root %#x, received %#x", wantRoot, stateRoot)
+	}
 	block.StateRoot = stateRoot[:]
 
 	block, err = testutil.SignBlock(beaconState, block, privKeys)
@@ -92,10 +126,11 @@ func TestExecuteStateTransition_FullProcess(t *testing.T) {
 		t.Error(err)
 	}
 
-	beaconState, err = state.ExecuteStateTransition(context.Background(), beaconState, block)
+	postState, err := state.ExecuteStateTransition(context.Background(), preState, block)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
 	}
+	beaconState = postState.InnerStateUnsafe()
 
 	if beaconState.Slot != 64 {
 		t.Errorf("Unexpected Slot number, expected: 64, received: %d", beaconState.Slot)
@@ -667,15 +702,16 @@ func TestProcessBlock_PassesProcessingConditions(t *testing.T) {
 	}
 }
 
-func TestProcessEpoch_CantGetTgtAttsPrevEpoch(t *testing.T) {
+func TestProcessEpoch_CantPrecomputeWithoutBlockRootPrevEpoch(t *testing.T) {
 	atts := []*pb.PendingAttestation{{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{Epoch: 1}}}}
 	_, err := state.ProcessEpoch(context.Background(), &pb.BeaconState{CurrentEpochAttestations: atts})
-	if !strings.Contains(err.Error(), "could not get target atts prev epoch") {
-		t.Fatal("Did not receive wanted error")
+	want := "could not precompute epoch attestations: could not get block root for epoch 0"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("Expected %s, received %v", want, err)
 	}
 }
 
-func TestProcessEpoch_CantGetTgtAttsCurrEpoch(t *testing.T) {
+func TestProcessEpoch_CantPrecomputeWithoutBlockRootCurrEpoch(t *testing.T) {
 	epoch := uint64(1)
 
 	atts := []*pb.PendingAttestation{{Data: &ethpb.AttestationData{Crosslink: &ethpb.Crosslink{Shard: 100}}}}
@@ -685,8 +721,9 @@ func TestProcessEpoch_CantGetTgtAttsCurrEpoch(t *testing.T) {
 		RandaoMixes:              make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 		ActiveIndexRoots:         make([][]byte, params.BeaconConfig().EpochsPerHistoricalVector),
 		CurrentEpochAttestations: atts})
-	if !strings.Contains(err.Error(), "could not get target atts current epoch") {
-		t.Fatal("Did not receive wanted error")
+	want := "could not precompute epoch attestations: could not get block root for epoch 1"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("Expected %s, received %v", want, err)
 	}
 }
 
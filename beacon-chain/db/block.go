@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"go.opencensus.io/trace"
 )
 
@@ -163,6 +164,11 @@ func (db *BeaconDB) SaveBlock(block *ethpb.BeaconBlock) error {
 		if err := bucket.Put(slotRootBinary, enc); err != nil {
 			return errors.Wrap(err, "failed to include the block in the main chain bucket")
 		}
+		if len(block.StateRoot) == 32 {
+			if err := tx.Bucket(stateRootIndexBucket).Put(block.StateRoot, signingRoot[:]); err != nil {
+				return errors.Wrap(err, "failed to index the block by its state root")
+			}
+		}
 		return bucket.Put(signingRoot[:], enc)
 	})
 }
@@ -188,10 +194,36 @@ func (db *BeaconDB) DeleteBlock(block *ethpb.BeaconBlock) error {
 		if err := bucket.Delete(slotRootBinary); err != nil {
 			return errors.Wrap(err, "failed to include the block in the main chain bucket")
 		}
+		stateRoots := tx.Bucket(stateRootIndexBucket)
+		if len(block.StateRoot) == 32 && bytes.Equal(stateRoots.Get(block.StateRoot), signingRoot[:]) {
+			if err := stateRoots.Delete(block.StateRoot); err != nil {
+				return errors.Wrap(err, "failed to remove the block from the state root index")
+			}
+		}
 		return bucket.Delete(signingRoot[:])
 	})
 }
 
+// BlockByStateRoot returns the saved block whose post state has the given
+// root. Returns nil if there is no such block.
+func (db *BeaconDB) BlockByStateRoot(stateRoot [32]byte) (*ethpb.BeaconBlock, error) {
+	var blockRoot [32]byte
+	var indexed bool
+	if err := db.view(func(tx *bolt.Tx) error {
+		if root := tx.Bucket(stateRootIndexBucket).Get(stateRoot[:]); root != nil {
+			blockRoot = bytesutil.ToBytes32(root)
+			indexed = true
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if !indexed {
+		return nil, nil
+	}
+	return db.Block(blockRoot)
+}
+
 // SaveJustifiedBlock saves the last justified block from canonical chain to DB.
 func (db *BeaconDB) SaveJustifiedBlock(block *ethpb.BeaconBlock) error {
 	return db.update(func(tx *bolt.Tx) error {
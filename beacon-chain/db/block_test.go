@@ -9,6 +9,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-ssz"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
@@ -181,6 +182,36 @@ func TestDeleteBlockInCache_OK(t *testing.T) {
 	}
 }
 
+func TestBlockByStateRoot_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	stateRoot := bytesutil.ToBytes32([]byte{'a'})
+	block := &ethpb.BeaconBlock{Slot: 3, StateRoot: stateRoot[:]}
+	if err := db.SaveBlock(block); err != nil {
+		t.Fatalf("save block failed: %v", err)
+	}
+
+	savedBlock, err := db.BlockByStateRoot(stateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(block, savedBlock) {
+		t.Errorf("Wanted %v, received %v", block, savedBlock)
+	}
+
+	if err := db.DeleteBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	savedBlock, err = db.BlockByStateRoot(stateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if savedBlock != nil {
+		t.Errorf("Expected block to have been removed from the index, received: %v", savedBlock)
+	}
+}
+
 func TestBlocksBySlotEmptyChain_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
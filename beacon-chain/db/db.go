@@ -8,6 +8,7 @@ import (
 
 	"github.com/boltdb/bolt"
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/sirupsen/logrus"
 )
@@ -21,13 +22,11 @@ var log = logrus.WithField("prefix", "beacondb")
 // This defines methods such as getBlock, saveBlocksAndAttestations, etc.
 type BeaconDB struct {
 	// state objects and caches
-	stateLock         sync.RWMutex
-	serializedState   []byte
-	stateHash         [32]byte
-	validatorRegistry []*ethpb.Validator
-	validatorBalances []uint64
-	db                *bolt.DB
-	DatabasePath      string
+	stateLock    sync.RWMutex
+	headState    *statetrie.BeaconState
+	stateHash    [32]byte
+	db           *bolt.DB
+	DatabasePath string
 
 	// Beacon block info in memory.
 	highestBlockSlot uint64
@@ -89,7 +88,8 @@ func NewDB(dirPath string) (*BeaconDB, error) {
 
 	if err := db.update(func(tx *bolt.Tx) error {
 		return createBuckets(tx, blockBucket, attestationBucket, attestationTargetBucket, mainChainBucket,
-			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket)
+			histStateBucket, chainInfoBucket, cleanupHistoryBucket, blockOperationsBucket, validatorBucket,
+			depositsBucket, powchainBucket, stateRootIndexBucket)
 	}); err != nil {
 		return nil, err
 	}
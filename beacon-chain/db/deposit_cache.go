@@ -0,0 +1,121 @@
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/boltdb/bolt"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+var lastProcessedEth1BlockKey = []byte("last-processed-eth1-block")
+
+// SaveDepositCache persists the deposits which are not stored yet, including
+// the deposits which replaced a stored deposit of the same index, along with
+// the last eth1 block whose deposit logs were processed. This allows the
+// powchain service to resume from that block after a restart instead of
+// fetching every deposit log again.
+func (db *BeaconDB) SaveDepositCache(ctx context.Context, lastProcessedBlock *big.Int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveDepositCache")
+	defer span.End()
+	if lastProcessedBlock == nil {
+		return errors.New("nil last processed eth1 block")
+	}
+
+	db.depositsLock.RLock()
+	var unsaved []*DepositContainer
+	for _, ctnr := range db.deposits {
+		if !ctnr.saved {
+			unsaved = append(unsaved, ctnr)
+		}
+	}
+	db.depositsLock.RUnlock()
+
+	err := db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositsBucket)
+		for _, ctnr := range unsaved {
+			enc, err := proto.Marshal(&pb.DepositContainer{
+				Deposit:         ctnr.Deposit,
+				Eth1BlockHeight: ctnr.Block.Uint64(),
+				Index:           uint64(ctnr.Index),
+				DepositRoot:     ctnr.depositRoot[:],
+			})
+			if err != nil {
+				return errors.Wrap(err, "could not encode deposit")
+			}
+			if err := bkt.Put(encodeDepositIndex(uint64(ctnr.Index)), enc); err != nil {
+				return err
+			}
+		}
+		return tx.Bucket(powchainBucket).Put(lastProcessedEth1BlockKey, lastProcessedBlock.Bytes())
+	})
+	if err != nil {
+		return err
+	}
+
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+	for _, ctnr := range unsaved {
+		ctnr.saved = true
+	}
+	return nil
+}
+
+// LoadDepositCache loads the persisted deposits into memory, replacing the
+// deposits already held, and returns the last eth1 block whose deposit logs
+// were processed. A nil block is returned if no deposit cache was persisted.
+func (db *BeaconDB) LoadDepositCache(ctx context.Context) (*big.Int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LoadDepositCache")
+	defer span.End()
+
+	var lastProcessedBlock *big.Int
+	var deposits []*DepositContainer
+	err := db.view(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(powchainBucket).Get(lastProcessedEth1BlockKey)
+		if enc == nil {
+			return nil
+		}
+		lastProcessedBlock = new(big.Int).SetBytes(enc)
+
+		return tx.Bucket(depositsBucket).ForEach(func(k, v []byte) error {
+			ctnr := &pb.DepositContainer{}
+			if err := proto.Unmarshal(v, ctnr); err != nil {
+				return errors.Wrap(err, "could not decode deposit")
+			}
+			if int(ctnr.Index) != len(deposits) {
+				return fmt.Errorf("missing deposit with index %d", len(deposits))
+			}
+			deposits = append(deposits, &DepositContainer{
+				Deposit:     ctnr.Deposit,
+				Block:       new(big.Int).SetUint64(ctnr.Eth1BlockHeight),
+				Index:       int(ctnr.Index),
+				depositRoot: bytesutil.ToBytes32(ctnr.DepositRoot),
+				saved:       true,
+			})
+			return nil
+		})
+	})
+	if err != nil || lastProcessedBlock == nil {
+		return nil, err
+	}
+
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+	db.deposits = deposits
+	historicalDepositsCount.Add(float64(len(deposits)))
+	return lastProcessedBlock, nil
+}
+
+// encodeDepositIndex encodes a deposit index as big-endian, so deposits are
+// iterated in order of their index.
+func encodeDepositIndex(index uint64) []byte {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, index)
+	return enc
+}
@@ -0,0 +1,111 @@
+package db
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+)
+
+func TestDepositCache_SaveAndLoad(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	lastBlock, err := db.LoadDepositCache(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastBlock != nil {
+		t.Fatal("Expected no deposit cache in a new database")
+	}
+
+	deposits := []*ethpb.Deposit{
+		{Data: &ethpb.Deposit_Data{PublicKey: []byte("A"), Amount: 1}},
+		{Data: &ethpb.Deposit_Data{PublicKey: []byte("B"), Amount: 2}},
+		{Data: &ethpb.Deposit_Data{PublicKey: []byte("C"), Amount: 3}},
+	}
+	db.InsertDeposit(ctx, deposits[0], big.NewInt(10), 0, [32]byte{'a'})
+	db.InsertDeposit(ctx, deposits[1], big.NewInt(12), 1, [32]byte{'b'})
+	if err := db.SaveDepositCache(ctx, big.NewInt(20)); err != nil {
+		t.Fatal(err)
+	}
+	// The new deposit and the deposit replacing a stored one are written
+	// when the cache is saved again.
+	db.InsertDeposit(ctx, deposits[2], big.NewInt(25), 2, [32]byte{'c'})
+	deposits[1] = &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte("D"), Amount: 4}}
+	db.InsertDeposit(ctx, deposits[1], big.NewInt(12), 1, [32]byte{'d'})
+	if err := db.SaveDepositCache(ctx, big.NewInt(30)); err != nil {
+		t.Fatal(err)
+	}
+
+	db.deposits = nil
+	lastBlock, err = db.LoadDepositCache(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastBlock.Cmp(big.NewInt(30)) != 0 {
+		t.Errorf("Expected last processed block 30, received %v", lastBlock)
+	}
+	loaded := db.AllDeposits(ctx, nil)
+	if len(loaded) != len(deposits) {
+		t.Fatalf("Expected %d deposits, received %d", len(deposits), len(loaded))
+	}
+	for i := range deposits {
+		if !proto.Equal(loaded[i], deposits[i]) {
+			t.Errorf("Expected deposit %v at index %d, received %v", deposits[i], i, loaded[i])
+		}
+	}
+	count, root := db.DepositsNumberAndRootAtHeight(ctx, big.NewInt(12))
+	if count != 2 || root != [32]byte{'d'} {
+		t.Errorf("Expected 2 deposits with root of the replaced second deposit at height 12, received %d and %#x", count, root)
+	}
+}
+
+func TestRemoveDepositsAfterBlock_RemovesPersistedDeposits(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	deposits := []*ethpb.Deposit{
+		{Data: &ethpb.Deposit_Data{PublicKey: []byte("A"), Amount: 1}},
+		{Data: &ethpb.Deposit_Data{PublicKey: []byte("B"), Amount: 2}},
+		{Data: &ethpb.Deposit_Data{PublicKey: []byte("C"), Amount: 3}},
+	}
+	for i, blockNum := range []int64{10, 20, 30} {
+		db.InsertDeposit(ctx, deposits[i], big.NewInt(blockNum), i, [32]byte{byte(i)})
+		db.InsertPendingDeposit(ctx, deposits[i], big.NewInt(blockNum), i, [32]byte{byte(i)})
+	}
+	if err := db.SaveDepositCache(ctx, big.NewInt(30)); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := db.RemoveDepositsAfterBlock(ctx, big.NewInt(15))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 2 {
+		t.Errorf("Expected 2 removed deposits, received %d", removed)
+	}
+	if len(db.AllDeposits(ctx, nil)) != 1 || len(db.PendingDeposits(ctx, nil)) != 1 {
+		t.Errorf("Expected a single deposit to remain, received %d deposits and %d pending deposits",
+			len(db.AllDeposits(ctx, nil)), len(db.PendingDeposits(ctx, nil)))
+	}
+
+	// A deposit reusing a removed index on the new chain replaces the persisted one.
+	replacement := &ethpb.Deposit{Data: &ethpb.Deposit_Data{PublicKey: []byte("D"), Amount: 4}}
+	db.InsertDeposit(ctx, replacement, big.NewInt(18), 1, [32]byte{'d'})
+	if err := db.SaveDepositCache(ctx, big.NewInt(30)); err != nil {
+		t.Fatal(err)
+	}
+	db.deposits = nil
+	if _, err := db.LoadDepositCache(ctx); err != nil {
+		t.Fatal(err)
+	}
+	loaded := db.AllDeposits(ctx, nil)
+	if len(loaded) != 2 || !proto.Equal(loaded[0], deposits[0]) || !proto.Equal(loaded[1], replacement) {
+		t.Errorf("Expected the first and replacement deposits to be persisted, received %v", loaded)
+	}
+}
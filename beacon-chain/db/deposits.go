@@ -7,6 +7,7 @@ import (
 	"math/big"
 	"sort"
 
+	"github.com/boltdb/bolt"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -40,6 +41,12 @@ func (db *BeaconDB) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blockNu
 
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	insertionIndex := sort.Search(len(db.deposits), func(i int) bool { return db.deposits[i].Index >= index })
+	// A deposit inserted again, such as when deposit logs are processed again,
+	// replaces the deposit with the same index.
+	if insertionIndex < len(db.deposits) && db.deposits[insertionIndex].Index == index {
+		db.deposits[insertionIndex] = &DepositContainer{Deposit: d, Block: blockNum, depositRoot: depositRoot, Index: index}
+		return
+	}
 	newDeposits := append([]*DepositContainer{{Deposit: d, Block: blockNum, depositRoot: depositRoot, Index: index}}, db.deposits[insertionIndex:]...)
 	db.deposits = append(db.deposits[:insertionIndex], newDeposits...)
 	historicalDepositsCount.Inc()
@@ -118,3 +125,46 @@ func (db *BeaconDB) DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.
 	}
 	return deposit, blockNum
 }
+
+// RemoveDepositsAfterBlock removes the deposits, pending or not, which were included in
+// the eth1 chain after the given block number, along with their persisted copies. This is
+// used to revert the deposits of eth1 blocks which are no longer canonical after a reorg.
+// It returns the number of removed deposits.
+func (db *BeaconDB) RemoveDepositsAfterBlock(ctx context.Context, blockNum *big.Int) (int, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RemoveDepositsAfterBlock")
+	defer span.End()
+
+	db.depositsLock.Lock()
+	// Deposits are sorted by index, and therefore by block number as well.
+	cutoff := sort.Search(len(db.deposits), func(i int) bool { return db.deposits[i].Block.Cmp(blockNum) > 0 })
+	removed := len(db.deposits) - cutoff
+	db.deposits = db.deposits[:cutoff]
+	var pending []*DepositContainer
+	for _, dp := range db.pendingDeposits {
+		if dp.Block.Cmp(blockNum) <= 0 {
+			pending = append(pending, dp)
+		}
+	}
+	db.pendingDeposits = pending
+	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
+	db.depositsLock.Unlock()
+
+	if removed == 0 {
+		return 0, nil
+	}
+	err := db.update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(depositsBucket)
+		var keys [][]byte
+		c := bkt.Cursor()
+		for k, _ := c.Seek(encodeDepositIndex(uint64(cutoff))); k != nil; k, _ = c.Next() {
+			keys = append(keys, k)
+		}
+		for _, k := range keys {
+			if err := bkt.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return removed, err
+}
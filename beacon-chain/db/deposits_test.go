@@ -86,6 +86,22 @@ func TestBeaconDB_InsertDeposit_MaintainsSortedOrderByIndex(t *testing.T) {
 	}
 }
 
+func TestBeaconDB_InsertDeposit_ReplacesDepositWithSameIndex(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+
+	replacement := &ethpb.Deposit{Proof: [][]byte{[]byte("B")}}
+	db.InsertDeposit(context.Background(), &ethpb.Deposit{Proof: [][]byte{[]byte("A")}}, big.NewInt(1), 0, [32]byte{})
+	db.InsertDeposit(context.Background(), replacement, big.NewInt(1), 0, [32]byte{})
+
+	if len(db.deposits) != 1 {
+		t.Fatalf("Expected 1 deposit, received %d", len(db.deposits))
+	}
+	if db.deposits[0].Deposit != replacement {
+		t.Error("Expected the deposit to be replaced")
+	}
+}
+
 func TestBeaconDB_AllDeposits_ReturnsAllDeposits(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -27,6 +27,8 @@ type DepositContainer struct {
 	Block       *big.Int
 	Index       int
 	depositRoot [32]byte
+	// saved is set once the deposit is persisted by SaveDepositCache.
+	saved bool
 }
 
 // InsertPendingDeposit into the database. If deposit or block number are nil
@@ -159,3 +161,24 @@ func (db *BeaconDB) PrunePendingDeposits(ctx context.Context, merkleTreeIndex in
 	db.pendingDeposits = cleanDeposits
 	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
 }
+
+// ResetPendingDeposits replaces the pending deposits with the historical
+// deposits from the given deposit merkle tree index onwards. It is used to
+// rebuild the pending deposits after the deposits are loaded from disk.
+func (db *BeaconDB) ResetPendingDeposits(ctx context.Context, merkleTreeIndex int) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ResetPendingDeposits")
+	defer span.End()
+
+	db.depositsLock.Lock()
+	defer db.depositsLock.Unlock()
+
+	var pending []*DepositContainer
+	for _, dp := range db.deposits {
+		if dp.Index >= merkleTreeIndex {
+			pending = append(pending, dp)
+		}
+	}
+
+	db.pendingDeposits = pending
+	pendingDepositsCount.Set(float64(len(db.pendingDeposits)))
+}
@@ -158,3 +158,19 @@ func TestPrunePendingDeposits_OK(t *testing.T) {
 	}
 
 }
+
+func TestResetPendingDeposits_OK(t *testing.T) {
+	db := BeaconDB{}
+	db.deposits = []*DepositContainer{
+		{Deposit: &ethpb.Deposit{Proof: [][]byte{[]byte("A")}}, Index: 0},
+		{Deposit: &ethpb.Deposit{Proof: [][]byte{[]byte("B")}}, Index: 1},
+		{Deposit: &ethpb.Deposit{Proof: [][]byte{[]byte("C")}}, Index: 2},
+	}
+	db.pendingDeposits = []*DepositContainer{{Deposit: &ethpb.Deposit{}, Index: 7}}
+
+	db.ResetPendingDeposits(context.Background(), 1)
+
+	if !reflect.DeepEqual(db.pendingDeposits, db.deposits[1:]) {
+		t.Errorf("Expected deposits from index 1 to be pending, received %v", db.pendingDeposits)
+	}
+}
@@ -23,6 +23,9 @@ var (
 	histStateBucket         = []byte("historical-state-bucket")
 	chainInfoBucket         = []byte("chain-info")
 	validatorBucket         = []byte("validator")
+	depositsBucket          = []byte("deposits-bucket")
+	powchainBucket          = []byte("powchain-bucket")
+	stateRootIndexBucket    = []byte("state-root-index-bucket")
 
 	mainChainHeightKey      = []byte("chain-height")
 	canonicalHeadKey        = []byte("canonical-head")
@@ -14,6 +14,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -49,7 +50,6 @@ func (db *BeaconDB) InitializeState(ctx context.Context, genesisTime uint64, dep
 	blockEnc, _ := proto.Marshal(genesisBlock)
 	zeroBinary := encodeSlotNumberRoot(0, blockRoot)
 
-	db.serializedState = stateEnc
 	db.stateHash = stateHash
 
 	if err := db.SaveState(ctx, beaconState); err != nil {
@@ -111,15 +111,10 @@ func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 	lockSpan.End()
 
 	// Return in-memory cached state, if available.
-	if db.serializedState != nil {
-		_, span := trace.StartSpan(ctx, "proto.Marshal")
+	if db.headState != nil {
+		_, span := trace.StartSpan(ctx, "proto.Clone")
 		defer span.End()
-		newState := &pb.BeaconState{}
-		// For each READ we unmarshal the serialized state into a new state struct and return that.
-		if err := proto.Unmarshal(db.serializedState, newState); err != nil {
-			return nil, err
-		}
-		return newState, nil
+		return db.headState.CloneInnerState(), nil
 	}
 
 	var beaconState *pb.BeaconState
@@ -136,15 +131,41 @@ func (db *BeaconDB) HeadState(ctx context.Context) (*pb.BeaconState, error) {
 		if beaconState != nil && beaconState.Slot > db.highestBlockSlot {
 			db.highestBlockSlot = beaconState.Slot
 		}
-		db.serializedState = enc
-		db.stateHash = hashutil.Hash(enc)
-
 		return err
 	})
 
 	return beaconState, err
 }
 
+// HeadStateTrie returns a copy of the canonical beacon chain's head state,
+// which shares the unchanged fields of the head state and their roots. The
+// copy should be used to run the state transition from the head state.
+func (db *BeaconDB) HeadStateTrie(ctx context.Context) (*statetrie.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HeadStateTrie")
+	defer span.End()
+
+	db.stateLock.RLock()
+	headState := db.headState
+	db.stateLock.RUnlock()
+
+	if headState == nil {
+		beaconState, err := db.HeadState(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if beaconState == nil {
+			return nil, errors.New("no head state saved")
+		}
+		return statetrie.New(beaconState), nil
+	}
+
+	// Hash the head state once, so its copies share the roots of its fields.
+	if _, err := headState.HashTreeRoot(); err != nil {
+		return nil, errors.Wrap(err, "could not hash head state")
+	}
+	return headState.Copy(), nil
+}
+
 // HeadStateRoot returns the root of the current state from the db.
 func (db *BeaconDB) HeadStateRoot() [32]byte {
 	return db.stateHash
@@ -167,12 +188,11 @@ func (db *BeaconDB) SaveState(ctx context.Context, beaconState *pb.BeaconState)
 		return err
 	}
 	stateHash := hashutil.Hash(enc)
-	tempState := &pb.BeaconState{}
-	tempState.Validators = beaconState.Validators
-
-	copy(db.validatorBalances, beaconState.Balances)
-	db.validatorRegistry = proto.Clone(tempState).(*pb.BeaconState).Validators
-	db.serializedState = enc
+	headState, err := createState(enc)
+	if err != nil {
+		return err
+	}
+	db.headState = statetrie.New(headState)
 	db.stateHash = stateHash
 
 	if beaconState.LatestBlockHeader != nil {
@@ -345,6 +365,30 @@ func (db *BeaconDB) HistoricalStateFromSlot(ctx context.Context, slot uint64, bl
 	return beaconState, err
 }
 
+// HistoricalStateAtBlock retrieves the historical state saved for the block
+// with the given slot and root. Returns nil if no state was saved for it.
+func (db *BeaconDB) HistoricalStateAtBlock(ctx context.Context, slot uint64, blockRoot [32]byte) (*pb.BeaconState, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.HistoricalStateAtBlock")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
+
+	var beaconState *pb.BeaconState
+	err := db.view(func(tx *bolt.Tx) error {
+		stateHash := tx.Bucket(histStateBucket).Get(encodeSlotNumberRoot(slot, blockRoot))
+		if stateHash == nil {
+			return nil
+		}
+		encState := tx.Bucket(chainInfoBucket).Get(stateHash)
+		if encState == nil {
+			return nil
+		}
+		var err error
+		beaconState, err = createState(encState)
+		return err
+	})
+	return beaconState, err
+}
+
 // Validators fetches the current validator registry stored in state.
 func (db *BeaconDB) Validators(ctx context.Context) ([]*ethpb.Validator, error) {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Validators")
@@ -354,11 +398,11 @@ func (db *BeaconDB) Validators(ctx context.Context) ([]*ethpb.Validator, error)
 	defer db.stateLock.RUnlock()
 
 	// Return in-memory cached state, if available.
-	if db.validatorRegistry != nil {
+	if db.headState != nil {
 		_, span := trace.StartSpan(ctx, "proto.Clone.Validators")
 		defer span.End()
 		tempState := &pb.BeaconState{
-			Validators: db.validatorRegistry,
+			Validators: db.headState.InnerStateUnsafe().Validators,
 		}
 		newState := proto.Clone(tempState).(*pb.BeaconState)
 		return newState.Validators, nil
@@ -391,12 +435,13 @@ func (db *BeaconDB) ValidatorFromState(ctx context.Context, index uint64) (*ethp
 	db.stateLock.RLock()
 	defer db.stateLock.RUnlock()
 
-	if db.validatorRegistry != nil {
+	if db.headState != nil {
+		validators := db.headState.InnerStateUnsafe().Validators
 		// return error if it's an invalid validator index.
-		if index >= uint64(len(db.validatorRegistry)) {
+		if index >= uint64(len(validators)) {
 			return nil, fmt.Errorf("invalid validator index %d", index)
 		}
-		validator := proto.Clone(db.validatorRegistry[index]).(*ethpb.Validator)
+		validator := proto.Clone(validators[index]).(*ethpb.Validator)
 		return validator, nil
 	}
 
@@ -417,7 +462,7 @@ func (db *BeaconDB) ValidatorFromState(ctx context.Context, index uint64) (*ethp
 	})
 
 	// return error if it's an invalid validator index.
-	if index >= uint64(len(db.validatorRegistry)) {
+	if beaconState == nil || index >= uint64(len(beaconState.Validators)) {
 		return nil, fmt.Errorf("invalid validator index %d", index)
 	}
 
@@ -433,11 +478,12 @@ func (db *BeaconDB) Balances(ctx context.Context) ([]uint64, error) {
 	defer db.stateLock.RUnlock()
 
 	// Return in-memory cached state, if available.
-	if db.validatorBalances != nil {
+	if db.headState != nil {
 		_, span := trace.StartSpan(ctx, "BeaconDB.Copy.Balances")
 		defer span.End()
-		newBalances := make([]uint64, len(db.validatorBalances))
-		copy(newBalances, db.validatorBalances)
+		balances := db.headState.InnerStateUnsafe().Balances
+		newBalances := make([]uint64, len(balances))
+		copy(newBalances, balances)
 		return newBalances, nil
 	}
 
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -65,6 +66,44 @@ func TestInitializeState_OK(t *testing.T) {
 	}
 }
 
+func TestHeadStateTrie_CopiesHeadState(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	genesisTime := uint64(time.Now().Unix())
+	deposits, _ := testutil.SetupInitialDeposits(t, 10)
+	if err := db.InitializeState(ctx, genesisTime, deposits, &ethpb.Eth1Data{}); err != nil {
+		t.Fatalf("Failed to initialize state: %v", err)
+	}
+
+	s, err := db.HeadStateTrie(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+	if err := s.Modify(func(state *pb.BeaconState) error {
+		state.Slot++
+		state.Balances[0]++
+		return nil
+	},
+		statetrie.Change{Field: statetrie.Slot},
+		statetrie.Change{Field: statetrie.Balances, Indices: []uint64{0}},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	headState, err := db.HeadState(ctx)
+	if err != nil {
+		t.Fatalf("Failed to get state: %v", err)
+	}
+	if headState.Slot != 0 {
+		t.Errorf("Expected head state slot 0, received %d", headState.Slot)
+	}
+	if headState.Balances[0] == s.InnerStateUnsafe().Balances[0] {
+		t.Error("Expected the change of the copy to leave the head state unchanged")
+	}
+}
+
 func TestFinalizeState_OK(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
@@ -115,8 +154,7 @@ func BenchmarkState_ReadingFromCache(b *testing.B) {
 		b.Fatalf("Could not save beacon state to cache from DB: %v", err)
 	}
 
-	savedState := &pb.BeaconState{}
-	savedState.Unmarshal(db.serializedState)
+	savedState := db.headState.InnerStateUnsafe()
 
 	if savedState.Slot != 1 {
 		b.Fatal("cache should be prepared on state after saving to DB")
@@ -258,6 +296,35 @@ func TestHistoricalState_CanSaveRetrieve(t *testing.T) {
 	}
 }
 
+func TestHistoricalStateAtBlock_OK(t *testing.T) {
+	db := setupDB(t)
+	defer teardownDB(t, db)
+	ctx := context.Background()
+
+	beaconState := &pb.BeaconState{Slot: 5}
+	blockRoot := [32]byte{'a'}
+	if err := db.SaveHistoricalState(ctx, beaconState, blockRoot); err != nil {
+		t.Fatalf("could not save historical state: %v", err)
+	}
+
+	retState, err := db.HistoricalStateAtBlock(ctx, 5, blockRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(beaconState, retState) {
+		t.Errorf("Saved and retrieved states are not equal got\n %v but wanted\n %v", retState, beaconState)
+	}
+
+	// A state saved for another block at an earlier slot is not returned.
+	retState, err = db.HistoricalStateAtBlock(ctx, 6, [32]byte{'b'})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retState != nil {
+		t.Errorf("Expected no state for an unknown block, received %v", retState)
+	}
+}
+
 func TestHistoricalState_Pruning(t *testing.T) {
 	db := setupDB(t)
 	defer teardownDB(t, db)
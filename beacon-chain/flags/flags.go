@@ -1,6 +1,8 @@
 package flags
 
 import (
+	"time"
+
 	"github.com/urfave/cli"
 )
 
@@ -19,9 +21,32 @@ var (
 	// Web3ProviderFlag defines a flag for a mainchain RPC endpoint.
 	Web3ProviderFlag = cli.StringFlag{
 		Name:  "web3provider",
-		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string or a WebSocket endpoint. Cannot be an HTTP endpoint.",
+		Usage: "A mainchain web3 provider string endpoint. Can either be an IPC file string, a WebSocket endpoint or an HTTP endpoint. New blocks are polled for when using an HTTP endpoint.",
 		Value: "wss://goerli.prylabs.net/websocket",
 	}
+	// Eth1LogChunkSizeFlag defines the block range of requests for past deposit logs.
+	Eth1LogChunkSizeFlag = cli.Uint64Flag{
+		Name:  "eth1-log-chunk-size",
+		Usage: "Maximum number of ETH1.0 blocks covered by a single request for past deposit logs. The range shrinks automatically if the provider rejects a request.",
+		Value: 1000,
+	}
+	// Eth1HeaderPollIntervalFlag defines the interval at which new ETH1.0 headers are polled for.
+	Eth1HeaderPollIntervalFlag = cli.DurationFlag{
+		Name:  "eth1-header-poll-interval",
+		Usage: "Interval at which the latest ETH1.0 header is requested when the web3 provider is an HTTP endpoint, which does not support subscriptions.",
+		Value: 5 * time.Second,
+	}
+	// FallbackWeb3ProviderFlag defines additional mainchain RPC endpoints to fail over to.
+	FallbackWeb3ProviderFlag = cli.StringSliceFlag{
+		Name:  "fallback-web3provider",
+		Usage: "A mainchain web3 provider string endpoint to switch to when the endpoint in use is unhealthy. Can be given multiple times, endpoints listed first are preferred.",
+	}
+	// Eth1HealthCheckIntervalFlag defines the interval at which the health of the ETH1.0 endpoints is checked.
+	Eth1HealthCheckIntervalFlag = cli.DurationFlag{
+		Name:  "eth1-health-check-interval",
+		Usage: "Interval at which the head freshness, error rate and latency of the ETH1.0 endpoints are checked when fallback endpoints are given.",
+		Value: 10 * time.Second,
+	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = cli.StringFlag{
 		Name:  "deposit-contract",
@@ -53,4 +78,34 @@ var (
 		Name:  "grpc-gateway-port",
 		Usage: "Enable gRPC gateway for JSON requests",
 	}
+	// ChainDataFileFlag defines the file written by the export command and read by the import command.
+	ChainDataFileFlag = cli.StringFlag{
+		Name:  "file",
+		Usage: "Path of the SSZ chain data file to export the canonical chain to, or to import it from",
+	}
+	// ExportFromCheckpointFlag exports the chain from the last finalized block instead of genesis.
+	ExportFromCheckpointFlag = cli.BoolFlag{
+		Name:  "checkpoint",
+		Usage: "Export the chain from the last finalized block and its state instead of from genesis",
+	}
+	// ImportSkipETH1CheckFlag skips the check of imported blocks against the ETH1.0 chain.
+	ImportSkipETH1CheckFlag = cli.BoolFlag{
+		Name:  "skip-eth1-check",
+		Usage: "Skip the check that the ETH1.0 blocks referenced by the imported chain exist, which otherwise requires the web3 provider flags",
+	}
+	// InteropGenesisStateFlag defines a genesis state file to start the chain from without an ETH1.0 chain.
+	InteropGenesisStateFlag = cli.StringFlag{
+		Name:  "interop-genesis-state",
+		Usage: "Path of an SSZ encoded genesis state to start the beacon chain from, instead of waiting for the chain start of the deposit contract. No ETH1.0 chain is followed.",
+	}
+	// InteropNumValidatorsFlag defines the number of validators of a generated interop genesis state.
+	InteropNumValidatorsFlag = cli.Uint64Flag{
+		Name:  "interop-num-validators",
+		Usage: "Start the beacon chain from a genesis state of this many validators holding the deterministic interop keys, instead of waiting for the chain start of the deposit contract. No ETH1.0 chain is followed.",
+	}
+	// InteropGenesisTimeFlag defines the genesis time of a generated interop genesis state.
+	InteropGenesisTimeFlag = cli.Uint64Flag{
+		Name:  "interop-genesis-time",
+		Usage: "Unix timestamp of the genesis of the generated interop genesis state, the genesis time of the chain in the database or, for a new database, the current time if not set. All nodes of the network need the same genesis time.",
+	}
 )
@@ -0,0 +1,126 @@
+// Corpus writes the seed corpora of the fuzz targets from the objects of the
+// mainnet spec tests vectors. Each object is written once for every seed state,
+// in the corpus directory of its target under the output directory, in the
+// format of the Go fuzzing engine:
+//
+//	corpus -spec-tests /path/to/eth2.0-spec-tests -out beacon-chain/fuzz/testdata/fuzz
+//	go test -run NONE -fuzz FuzzBlock ./beacon-chain/fuzz
+package main
+
+import (
+	"crypto/sha256"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks/spectest"
+	"github.com/prysmaticlabs/prysm/beacon-chain/fuzz"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+var (
+	specTestsDir = flag.String("spec-tests", "", "Directory of the spec tests release, holding the tests directory")
+	outDir       = flag.String("out", "", "Directory to write the corpus of each target in")
+)
+
+// fuzzTargets maps the targets to the name of their fuzz test.
+var fuzzTargets = map[string]string{
+	"block":             "FuzzBlock",
+	"attestation":       "FuzzAttestation",
+	"deposit":           "FuzzDeposit",
+	"proposer_slashing": "FuzzProposerSlashing",
+	"attester_slashing": "FuzzAttesterSlashing",
+}
+
+// operationVectors maps the targets of block operations to the file of their
+// vectors.
+var operationVectors = map[string]string{
+	"attestation":       "tests/operations/attestation/attestation_mainnet.yaml",
+	"deposit":           "tests/operations/deposit/deposit_mainnet.yaml",
+	"proposer_slashing": "tests/operations/proposer_slashing/proposer_slashing_mainnet.yaml",
+	"attester_slashing": "tests/operations/attester_slashing/attester_slashing_mainnet.yaml",
+}
+
+func main() {
+	flag.Parse()
+	if *specTestsDir == "" || *outDir == "" {
+		log.Fatal("Both -spec-tests and -out are required")
+	}
+
+	var blocks []interface{}
+	sanity := &spectest.BlocksMainnet{}
+	load("tests/sanity/blocks/sanity_blocks_mainnet.yaml", sanity)
+	for _, tt := range sanity.TestCases {
+		for _, b := range tt.Blocks {
+			blocks = append(blocks, b)
+		}
+	}
+	headers := &spectest.BlockOperationTest{}
+	load("tests/operations/block_header/block_header_mainnet.yaml", headers)
+	for _, tt := range headers.TestCases {
+		blocks = append(blocks, tt.Block)
+	}
+	write("block", blocks)
+
+	attestations := &spectest.AttestationTest{}
+	load(operationVectors["attestation"], attestations)
+	var atts []interface{}
+	for _, tt := range attestations.TestCases {
+		atts = append(atts, tt.Attestation)
+	}
+	write("attestation", atts)
+
+	for _, target := range []string{"deposit", "proposer_slashing", "attester_slashing"} {
+		test := &spectest.BlockOperationTest{}
+		load(operationVectors[target], test)
+		var objs []interface{}
+		for _, tt := range test.TestCases {
+			switch target {
+			case "deposit":
+				objs = append(objs, tt.Deposit)
+			case "proposer_slashing":
+				objs = append(objs, tt.ProposerSlashing)
+			case "attester_slashing":
+				objs = append(objs, tt.AttesterSlashing)
+			}
+		}
+		write(target, objs)
+	}
+}
+
+func load(path string, dest interface{}) {
+	file, err := ioutil.ReadFile(filepath.Join(*specTestsDir, path))
+	if err != nil {
+		log.Fatalf("Could not read vectors: %v", err)
+	}
+	if err := testutil.UnmarshalYaml(file, dest); err != nil {
+		log.Fatalf("Could not unmarshal %s: %v", path, err)
+	}
+}
+
+func write(target string, objs []interface{}) {
+	dir := filepath.Join(*outDir, fuzzTargets[target])
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		log.Fatalf("Could not create corpus directory: %v", err)
+	}
+	var count int
+	for _, obj := range objs {
+		for i := range fuzz.SeedStates() {
+			data, err := fuzz.Input(byte(i), obj)
+			if err != nil {
+				log.Fatalf("Could not encode %s input: %v", target, err)
+			}
+			// Name the inputs by their hash the way the fuzzing engine does.
+			name := fmt.Sprintf("%x", sha256.Sum256(data))
+			entry := fmt.Sprintf("go test fuzz v1\n[]byte(%q)\n", data)
+			if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(entry), 0644); err != nil {
+				log.Fatalf("Could not write input: %v", err)
+			}
+			count++
+		}
+	}
+	log.Printf("Wrote %d inputs to %s", count, dir)
+}
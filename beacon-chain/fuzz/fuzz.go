@@ -0,0 +1,70 @@
+// Package fuzz holds the Go fuzz targets of the state transition and the
+// processing of block operations, which accept attacker controlled input.
+//
+// Each input is a byte selecting one of the seed states followed by the SSZ
+// encoding of the fuzzed object. The object is applied to a copy of the seed
+// state, as the block operations modify the state they are given. A target
+// fails when processing panics, when two runs of the same input give different
+// results, or when a failed state transition modified the state it was given.
+//
+// Run a target with go test:
+//
+//	go test -run NONE -fuzz FuzzBlock ./beacon-chain/fuzz
+//
+// The seed corpora under testdata/fuzz are written from the spec tests vectors
+// by the corpus tool in the corpus directory.
+package fuzz
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// seedValidatorCount is the number of validators of the seed states, their keys
+// are the deterministic interop keys.
+const seedValidatorCount = 64
+
+var (
+	seedStates     []*pb.BeaconState
+	seedStatesOnce sync.Once
+)
+
+// SeedStates returns the states the fuzzed objects are applied to: the interop
+// genesis state, the state at the first slot, and the state at the second
+// slot of the next epoch, past an epoch transition.
+func SeedStates() []*pb.BeaconState {
+	seedStatesOnce.Do(func() {
+		genesis, _, err := interop.GenerateGenesisState(0 /*genesisTime*/, seedValidatorCount)
+		if err != nil {
+			panic(fmt.Sprintf("could not generate genesis state: %v", err))
+		}
+		seedStates = []*pb.BeaconState{genesis}
+		genesisState := statetrie.New(genesis)
+		for _, slot := range []uint64{1, params.BeaconConfig().SlotsPerEpoch + 1} {
+			s, err := state.ProcessSlots(context.Background(), genesisState.Copy(), slot)
+			if err != nil {
+				panic(fmt.Sprintf("could not process slots up to %d: %v", slot, err))
+			}
+			seedStates = append(seedStates, s.InnerStateUnsafe())
+		}
+	})
+	return seedStates
+}
+
+// Input returns the fuzz input applying the SSZ encoding of the object to the
+// seed state with the given index.
+func Input(seedIndex byte, obj interface{}) ([]byte, error) {
+	enc, err := ssz.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{seedIndex}, enc...), nil
+}
@@ -0,0 +1,177 @@
+package fuzz
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+var targets = map[string]*target{
+	"block":             blockTarget,
+	"attestation":       attestationTarget,
+	"deposit":           depositTarget,
+	"proposer_slashing": proposerSlashingTarget,
+	"attester_slashing": attesterSlashingTarget,
+}
+
+// run decodes the input with the target and applies it to copies of the
+// selected seed state twice. It returns an error when the two runs disagree,
+// or when failed processing modified the state of a target keeping it, and
+// reports whether the input was processed successfully.
+func run(data []byte, tgt *target) (bool, error) {
+	if len(data) == 0 {
+		return false, nil
+	}
+	seeds := SeedStates()
+	seed := seeds[int(data[0])%len(seeds)]
+	enc := data[1:]
+
+	// The object is decoded again for each run, in case processing modifies
+	// it.
+	decode := func() proto.Message {
+		obj := tgt.newObj()
+		if err := ssz.Unmarshal(enc, obj); err != nil {
+			return nil
+		}
+		return obj
+	}
+	obj := decode()
+	if obj == nil {
+		return false, nil
+	}
+	pre := proto.Clone(seed).(*pb.BeaconState)
+	post, err := tgt.apply(pre, obj)
+	if err != nil && tgt.keepsStateOnError && !proto.Equal(pre, seed) {
+		return false, fmt.Errorf("failed processing modified the state: %v", err)
+	}
+	again, errAgain := tgt.apply(proto.Clone(seed).(*pb.BeaconState), decode())
+	switch {
+	case (err == nil) != (errAgain == nil), err != nil && err.Error() != errAgain.Error():
+		return false, fmt.Errorf("non deterministic processing: first error %v, second error %v", err, errAgain)
+	case err == nil && !proto.Equal(post, again):
+		return false, errors.New("non deterministic processing: post states differ")
+	}
+	return err == nil, nil
+}
+
+func TestRun_ValidBlock(t *testing.T) {
+	helpers.ClearAllCaches()
+	data, err := Input(0, validBlock(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := proto.Clone(SeedStates()[0])
+	processed, err := run(data, blockTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !processed {
+		t.Error("Expected the valid block to process")
+	}
+	// The harness gives the target a copy of the seed state.
+	if !proto.Equal(SeedStates()[0], genesis) {
+		t.Error("Expected the seed state to be left unchanged")
+	}
+}
+
+func TestRun_MalformedInputs(t *testing.T) {
+	helpers.ClearAllCaches()
+	valid, err := Input(0, validBlock(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputs := [][]byte{nil, {0}, {1, 0, 0, 0}}
+	// Truncated and bit flipped copies of a valid block.
+	for i := 1; i < len(valid); i += len(valid) / 16 {
+		inputs = append(inputs, valid[:i])
+		flipped := append([]byte{}, valid...)
+		flipped[i] ^= 0xff
+		inputs = append(inputs, flipped)
+	}
+
+	for name, tgt := range targets {
+		for _, data := range inputs {
+			processed, err := run(data, tgt)
+			if err != nil {
+				t.Errorf("Target %s failed on input %#x: %v", name, data, err)
+			}
+			if processed {
+				t.Errorf("Target %s processed malformed input %#x", name, data)
+			}
+		}
+	}
+}
+
+func TestRun_BlockTooFarAhead(t *testing.T) {
+	helpers.ClearAllCaches()
+	block := validBlock(t)
+	block.Slot = maxSlotsAhead + 1
+	data, err := Input(0, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	processed, err := run(data, blockTarget)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if processed {
+		t.Error("Expected the block to be rejected")
+	}
+}
+
+// validBlock returns a signed block at slot 1 on top of the genesis seed state.
+func validBlock(t testing.TB) *ethpb.BeaconBlock {
+	ctx := context.Background()
+	genesis := SeedStates()[0]
+	privKeys, _, err := interop.DeterministicallyGenerateKeys(0 /*startIndex*/, seedValidatorCount)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisState := statetrie.New(proto.Clone(genesis).(*pb.BeaconState))
+	s, err := state.ProcessSlots(ctx, genesisState.Copy(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slotState := s.InnerStateUnsafe()
+	parentRoot, err := ssz.SigningRoot(slotState.LatestBlockHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	randaoReveal, err := testutil.CreateRandaoReveal(slotState, helpers.CurrentEpoch(slotState), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       1,
+		ParentRoot: parentRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data:     genesis.Eth1Data,
+			RandaoReveal: randaoReveal,
+		},
+	}
+	postState, err := state.ExecuteStateTransitionNoVerify(ctx, genesisState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := postState.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.StateRoot = stateRoot[:]
+	block, err = testutil.SignBlock(slotState, block, privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return block
+}
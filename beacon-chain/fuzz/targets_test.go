@@ -0,0 +1,136 @@
+package fuzz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// maxSlotsAhead bounds the slot of fuzzed blocks ahead of the seed state. The
+// chain service rejects blocks from future slots before running the state
+// transition, which otherwise processes every empty slot up to the block.
+var maxSlotsAhead = 2 * params.BeaconConfig().SlotsPerEpoch
+
+var errBlockTooFar = errors.New("block slot is too far ahead of the seed state")
+
+// target decodes the fuzzed objects of a kind and applies them to a state.
+// keepsStateOnError is set for the targets which must leave the state they
+// are given unchanged when processing fails.
+type target struct {
+	newObj            func() proto.Message
+	apply             func(*pb.BeaconState, proto.Message) (*pb.BeaconState, error)
+	keepsStateOnError bool
+}
+
+// blockTarget runs the state transition of a block, which runs on a copy of
+// the state.
+var blockTarget = &target{
+	newObj: func() proto.Message {
+		return &ethpb.BeaconBlock{}
+	},
+	apply: func(s *pb.BeaconState, m proto.Message) (*pb.BeaconState, error) {
+		block := m.(*ethpb.BeaconBlock)
+		if block.Slot > s.Slot+maxSlotsAhead {
+			return nil, errBlockTooFar
+		}
+		post, err := state.ExecuteStateTransition(context.Background(), statetrie.New(s), block)
+		if err != nil {
+			return nil, err
+		}
+		return post.InnerStateUnsafe(), nil
+	},
+	keepsStateOnError: true,
+}
+
+// attestationTarget processes a block body with a single attestation.
+var attestationTarget = &target{
+	newObj: func() proto.Message {
+		return &ethpb.Attestation{}
+	},
+	apply: func(s *pb.BeaconState, m proto.Message) (*pb.BeaconState, error) {
+		return blocks.ProcessAttestations(s, &ethpb.BeaconBlockBody{
+			Attestations: []*ethpb.Attestation{m.(*ethpb.Attestation)},
+		})
+	},
+}
+
+// depositTarget processes a block body with a single deposit.
+var depositTarget = &target{
+	newObj: func() proto.Message {
+		return &ethpb.Deposit{}
+	},
+	apply: func(s *pb.BeaconState, m proto.Message) (*pb.BeaconState, error) {
+		return blocks.ProcessDeposits(s, &ethpb.BeaconBlockBody{
+			Deposits: []*ethpb.Deposit{m.(*ethpb.Deposit)},
+		})
+	},
+}
+
+// proposerSlashingTarget processes a block body with a single proposer
+// slashing.
+var proposerSlashingTarget = &target{
+	newObj: func() proto.Message {
+		return &ethpb.ProposerSlashing{}
+	},
+	apply: func(s *pb.BeaconState, m proto.Message) (*pb.BeaconState, error) {
+		return blocks.ProcessProposerSlashings(s, &ethpb.BeaconBlockBody{
+			ProposerSlashings: []*ethpb.ProposerSlashing{m.(*ethpb.ProposerSlashing)},
+		})
+	},
+}
+
+// attesterSlashingTarget processes a block body with a single attester
+// slashing.
+var attesterSlashingTarget = &target{
+	newObj: func() proto.Message {
+		return &ethpb.AttesterSlashing{}
+	},
+	apply: func(s *pb.BeaconState, m proto.Message) (*pb.BeaconState, error) {
+		return blocks.ProcessAttesterSlashings(s, &ethpb.BeaconBlockBody{
+			AttesterSlashings: []*ethpb.AttesterSlashing{m.(*ethpb.AttesterSlashing)},
+		})
+	},
+}
+
+func FuzzBlock(f *testing.F) {
+	data, err := Input(0, validBlock(f))
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data)
+	fuzz(f, blockTarget)
+}
+
+func FuzzAttestation(f *testing.F) {
+	fuzz(f, attestationTarget)
+}
+
+func FuzzDeposit(f *testing.F) {
+	fuzz(f, depositTarget)
+}
+
+func FuzzProposerSlashing(f *testing.F) {
+	fuzz(f, proposerSlashingTarget)
+}
+
+func FuzzAttesterSlashing(f *testing.F) {
+	fuzz(f, attesterSlashingTarget)
+}
+
+// fuzz runs the target on the seed corpus under testdata and, with the -fuzz
+// flag, on the inputs generated from it.
+func fuzz(f *testing.F, tgt *target) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if _, err := run(data, tgt); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
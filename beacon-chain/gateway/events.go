@@ -0,0 +1,86 @@
+package gateway
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1_gateway"
+)
+
+// eventsPath is the path of the Server-Sent Events stream of the node events.
+const eventsPath = "/v1/beacon/events"
+
+var eventMarshaler = &gwruntime.JSONPb{OrigName: true}
+
+// parseTopics converts a comma separated list of event topics, such as
+// "head,chain_reorg", to the topics of the StreamEvents request.
+func parseTopics(query string) ([]pb.EventTopic, error) {
+	var topics []pb.EventTopic
+	for _, name := range strings.Split(query, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		topic, ok := pb.EventTopic_value[strings.ToUpper(name)]
+		if !ok || topic == int32(pb.EventTopic_UNKNOWN_TOPIC) {
+			return nil, fmt.Errorf("unknown event topic %q", name)
+		}
+		topics = append(topics, pb.EventTopic(topic))
+	}
+	return topics, nil
+}
+
+// streamEvents serves the StreamEvents RPC as Server-Sent Events. The topics
+// are selected with the "topics" query parameter, all topics are streamed if
+// it is empty. Each event is named after its topic and carries the JSON
+// encoded event, whose missed_events field reports the events dropped because
+// the client did not keep up.
+func (g *Gateway) streamEvents(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	topics, err := parseTopics(r.URL.Query().Get("topics"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	stream, err := pb.NewBeaconServiceClient(g.conn).StreamEvents(r.Context(), &pb.StreamEventsRequest{Topics: topics})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		e, err := stream.Recv()
+		if err != nil {
+			if r.Context().Err() == nil {
+				log.WithError(err).Debug("Event stream closed")
+			}
+			return
+		}
+		data, err := eventMarshaler.Marshal(e)
+		if err != nil {
+			log.WithError(err).Error("Could not marshal event")
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", strings.ToLower(e.Topic.String()), data); err != nil {
+			log.WithError(err).Debug("Could not write event")
+			return
+		}
+		flusher.Flush()
+	}
+}
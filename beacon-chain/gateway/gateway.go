@@ -58,6 +58,7 @@ func (g *Gateway) Start() {
 		}
 	}
 
+	g.mux.HandleFunc(eventsPath, g.streamEvents)
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
@@ -83,6 +83,35 @@ func (mr *MockBeaconServiceServerMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceServer)(nil).CanonicalHead), arg0, arg1)
 }
 
+// Eth1ConnectionStatus mocks base method
+func (m *MockBeaconServiceServer) Eth1ConnectionStatus(arg0 context.Context, arg1 *types.Empty) (*v1.Eth1ConnectionStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Eth1ConnectionStatus", arg0, arg1)
+	ret0, _ := ret[0].(*v1.Eth1ConnectionStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1ConnectionStatus indicates an expected call of Eth1ConnectionStatus
+func (mr *MockBeaconServiceServerMockRecorder) Eth1ConnectionStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1ConnectionStatus", reflect.TypeOf((*MockBeaconServiceServer)(nil).Eth1ConnectionStatus), arg0, arg1)
+}
+
+// StreamEvents mocks base method
+func (m *MockBeaconServiceServer) StreamEvents(arg0 *v1.StreamEventsRequest, arg1 v1.BeaconService_StreamEventsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamEvents", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StreamEvents indicates an expected call of StreamEvents
+func (mr *MockBeaconServiceServerMockRecorder) StreamEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEvents", reflect.TypeOf((*MockBeaconServiceServer)(nil).StreamEvents), arg0, arg1)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceServer) WaitForChainStart(arg0 *types.Empty, arg1 v1.BeaconService_WaitForChainStartServer) error {
 	m.ctrl.T.Helper()
@@ -25,6 +25,13 @@ var appFlags = []cli.Flag{
 	flags.DepositContractFlag,
 	flags.Web3ProviderFlag,
 	flags.HTTPWeb3ProviderFlag,
+	flags.Eth1LogChunkSizeFlag,
+	flags.Eth1HeaderPollIntervalFlag,
+	flags.FallbackWeb3ProviderFlag,
+	flags.Eth1HealthCheckIntervalFlag,
+	flags.InteropGenesisStateFlag,
+	flags.InteropNumValidatorsFlag,
+	flags.InteropGenesisTimeFlag,
 	flags.RPCPort,
 	flags.CertFlag,
 	flags.KeyFlag,
@@ -32,14 +39,21 @@ var appFlags = []cli.Flag{
 	flags.GRPCGatewayPort,
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
+	cmd.EnableDiscv5,
+	cmd.Discv5BootstrapNode,
 	cmd.StaticPeers,
 	cmd.RelayNode,
 	cmd.P2PPort,
+	cmd.P2PUDPPort,
 	cmd.P2PHost,
+	cmd.P2PLocalIP,
 	cmd.P2PMaxPeers,
 	cmd.P2PPrivKey,
 	cmd.P2PWhitelist,
+	cmd.AttestationSubnetCount,
+	cmd.PersistentSubnets,
 	cmd.DataDirFlag,
+	cmd.ChainConfigFileFlag,
 	cmd.VerbosityFlag,
 	cmd.EnableTracingFlag,
 	cmd.TracingProcessNameFlag,
@@ -71,6 +85,34 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []cli.Command{
+		{
+			Name:     "export",
+			Category: "chaindata",
+			Usage:    "exports the canonical chain of the node database to an SSZ chain data file",
+			Description: `streams the canonical blocks of the database, from genesis or from the last finalized
+block and its state, into a file which can be imported by another node`,
+			Flags: []cli.Flag{
+				flags.ChainDataFileFlag,
+				flags.ExportFromCheckpointFlag,
+			},
+			Action: node.ExportChainData,
+		},
+		{
+			Name:     "import",
+			Category: "chaindata",
+			Usage:    "imports an SSZ chain data file into the node database",
+			Description: `processes the blocks of a chain data file through the full block processing pipeline.
+The ETH1.0 blocks referenced by the chain are checked through the web3 provider flags, unless
+--skip-eth1-check is given. An empty database is initialized from the first block
+and state of the file`,
+			Flags: []cli.Flag{
+				flags.ChainDataFileFlag,
+				flags.ImportSkipETH1CheckFlag,
+			},
+			Action: node.ImportChainData,
+		},
+	}
 
 	app.Flags = appFlags
 
@@ -0,0 +1,171 @@
+package node
+
+import (
+	"context"
+	"os"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/attestation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/chaindata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// ExportChainData writes the canonical chain of the node database to the
+// chain data file given by the --file flag, starting from genesis or from the
+// last finalized block if --checkpoint is set.
+func ExportChainData(ctx *cli.Context) error {
+	filePath := ctx.String(flags.ChainDataFileFlag.Name)
+	if filePath == "" {
+		return errors.New("no chain data file given, use the --file flag")
+	}
+	if err := configureChain(ctx); err != nil {
+		return err
+	}
+	b := &BeaconNode{ctx: ctx}
+	if err := b.startDB(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.db.Close(); err != nil {
+			log.Errorf("Failed to close database: %v", err)
+		}
+	}()
+
+	// The chain is written to a temporary file first, so a failed export
+	// never leaves a truncated file at the given path.
+	tmpPath := filePath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+	count, err := chaindata.Export(context.Background(), b.db, f, ctx.Bool(flags.ExportFromCheckpointFlag.Name))
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpPath, filePath)
+	}
+	if err != nil {
+		if removeErr := os.Remove(tmpPath); removeErr != nil && !os.IsNotExist(removeErr) {
+			log.Errorf("Failed to remove temporary chain data file: %v", removeErr)
+		}
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"blocks": count,
+		"file":   filePath,
+	}).Info("Exported canonical chain")
+	return nil
+}
+
+// ImportChainData imports the chain data file given by the --file flag into
+// the node database. Blocks go through the full block processing pipeline of
+// the blockchain service. The ETH1.0 blocks they reference are checked
+// against the ETH1.0 chain given by the web3 provider flags, unless the
+// --skip-eth1-check flag is set. An empty database is initialized from the
+// anchor of the file, otherwise the anchor must already be part of the stored
+// chain.
+func ImportChainData(ctx *cli.Context) error {
+	filePath := ctx.String(flags.ChainDataFileFlag.Name)
+	if filePath == "" {
+		return errors.New("no chain data file given, use the --file flag")
+	}
+	if err := configureChain(ctx); err != nil {
+		return err
+	}
+	b := &BeaconNode{
+		ctx:      ctx,
+		services: shared.NewServiceRegistry(),
+	}
+	if err := b.startDB(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := b.db.Close(); err != nil {
+			log.Errorf("Failed to close database: %v", err)
+		}
+	}()
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	cr, err := chaindata.NewReader(f)
+	if err != nil {
+		return err
+	}
+	anchor, anchorState, err := cr.ReadAnchor()
+	if err != nil {
+		return err
+	}
+	saved, err := chaindata.SaveAnchor(context.Background(), b.db, anchor, anchorState)
+	if err != nil {
+		return err
+	}
+	if saved {
+		log.WithField("slot", anchor.Slot).Info("Initialized database from the anchor block of the chain data")
+	}
+
+	verifyETH1 := !ctx.Bool(flags.ImportSkipETH1CheckFlag.Name)
+	var web3Service *powchain.Web3Service
+	if verifyETH1 {
+		if err := b.registerPOWChainService(ctx); err != nil {
+			return err
+		}
+		if err := b.services.FetchService(&web3Service); err != nil {
+			return err
+		}
+		if web3Service.Client() == nil {
+			return errors.New("verifying the ETH1.0 blocks of chain data requires an ETH1.0 endpoint")
+		}
+	}
+	chainService, err := blockchain.NewChainService(context.Background(), &blockchain.Config{
+		BeaconDB:    b.db,
+		Web3Service: web3Service,
+		AttsService: attestation.NewAttestationService(context.Background(), &attestation.Config{
+			BeaconDB: b.db,
+		}),
+		OpsPoolService: operations.NewOpsPoolService(context.Background(), &operations.Config{
+			BeaconDB: b.db,
+			P2P:      noopBroadcaster{},
+		}),
+		P2p:                noopBroadcaster{},
+		MaxRoutines:        ctx.GlobalInt64(cmd.MaxGoroutines.Name),
+		SkipETH1BlockCheck: !verifyETH1,
+	})
+	if err != nil {
+		return err
+	}
+	// The head state is saved, so starting the service only loads the genesis
+	// time and finalized epoch the block processing relies on.
+	chainService.Start()
+	defer chainService.Stop()
+
+	count, err := chaindata.ImportBlocks(context.Background(), b.db, cr, chainService)
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"blocks": count,
+		"file":   filePath,
+	}).Info("Imported chain data")
+	return nil
+}
+
+// noopBroadcaster drops the announcements of imported blocks, which are not
+// gossiped to peers.
+type noopBroadcaster struct{}
+
+func (noopBroadcaster) Broadcast(context.Context, proto.Message) {}
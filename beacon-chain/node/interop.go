@@ -0,0 +1,99 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/chaindata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// interopEnabled returns true if the chain starts from an interop genesis
+// state instead of the chain start of the deposit contract.
+func interopEnabled(cliCtx *cli.Context) bool {
+	return cliCtx.GlobalString(flags.InteropGenesisStateFlag.Name) != "" ||
+		cliCtx.GlobalUint64(flags.InteropNumValidatorsFlag.Name) > 0
+}
+
+// registerInteropPOWChainService saves the interop genesis state given by the
+// flags to an empty database, and registers a powchain service standing in for
+// the ETH1.0 chain.
+func (b *BeaconNode) registerInteropPOWChainService(cliCtx *cli.Context) error {
+	ctx := context.Background()
+	genesisState, deposits, err := interopGenesisState(ctx, cliCtx, b.db, b.clock)
+	if err != nil {
+		return err
+	}
+	stateRoot, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		return errors.Wrap(err, "could not hash genesis state")
+	}
+	saved, err := chaindata.SaveAnchor(ctx, b.db, blocks.NewGenesisBlock(stateRoot[:]), genesisState)
+	if err != nil {
+		return errors.Wrap(err, "could not save interop genesis state, the database may hold another chain")
+	}
+	if saved {
+		log.WithFields(logrus.Fields{
+			"genesisTime":   time.Unix(int64(genesisState.GenesisTime), 0),
+			"numValidators": len(genesisState.Validators),
+			"stateRoot":     fmt.Sprintf("%#x", stateRoot),
+		}).Info("Initialized beacon chain from interop genesis state")
+	}
+
+	web3Service, err := powchain.NewInteropService(ctx, b.db, genesisState, deposits)
+	if err != nil {
+		return errors.Wrap(err, "could not register interop proof-of-work chain service")
+	}
+	return b.services.RegisterService(web3Service)
+}
+
+// interopGenesisState loads the genesis state file given by the flags or, if
+// there is none, generates the genesis state of the deterministic interop keys.
+// The deposits of a loaded state are not known. Without a genesis time flag,
+// the genesis time of the chain in the database is reused so that restarts
+// generate the same state, a new chain starts at the current time of the clock.
+func interopGenesisState(
+	ctx context.Context, cliCtx *cli.Context, beaconDB *db.BeaconDB, clock clockutil.Clock,
+) (*pb.BeaconState, []*ethpb.Deposit, error) {
+	if path := cliCtx.GlobalString(flags.InteropGenesisStateFlag.Name); path != "" {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not read genesis state file")
+		}
+		genesisState := &pb.BeaconState{}
+		if err := ssz.Unmarshal(data, genesisState); err != nil {
+			return nil, nil, errors.Wrap(err, "could not decode genesis state file")
+		}
+		if genesisState.Slot != 0 {
+			return nil, nil, fmt.Errorf("genesis state file holds a state at slot %d", genesisState.Slot)
+		}
+		return genesisState, nil, nil
+	}
+
+	genesisTime := cliCtx.GlobalUint64(flags.InteropGenesisTimeFlag.Name)
+	if genesisTime == 0 {
+		headState, err := beaconDB.HeadState(ctx)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not retrieve head state")
+		}
+		if headState != nil {
+			genesisTime = headState.GenesisTime
+		} else {
+			genesisTime = uint64(clock.Now().Unix())
+		}
+	}
+	return interop.GenerateGenesisState(genesisTime, cliCtx.GlobalUint64(flags.InteropNumValidatorsFlag.Name))
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	rbcsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -50,11 +51,18 @@ type BeaconNode struct {
 	lock     sync.RWMutex
 	stop     chan struct{} // Channel to wait for termination notifications.
 	db       *db.BeaconDB
+	clock    clockutil.Clock
 }
 
 // NewBeaconNode creates a new node instance, sets up configuration options, and registers
 // every required service to the node.
 func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
+	return NewBeaconNodeWithClock(ctx, clockutil.System{})
+}
+
+// NewBeaconNodeWithClock creates a new node instance like NewBeaconNode, with
+// the slots and the ETH1.0 block ages of its services timed by the given clock.
+func NewBeaconNodeWithClock(ctx *cli.Context, clock clockutil.Clock) (*BeaconNode, error) {
 	if err := tracing.Setup(
 		"beacon-chain", // service name
 		ctx.GlobalString(cmd.TracingProcessNameFlag.Name),
@@ -70,16 +78,13 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 		ctx:      ctx,
 		services: registry,
 		stop:     make(chan struct{}),
+		clock:    clock,
 	}
 
-	// Use custom config values if the --no-custom-config flag is set.
-	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
-		log.Info("Using custom parameter configuration")
-		params.UseDemoBeaconConfig()
+	if err := configureChain(ctx); err != nil {
+		return nil, err
 	}
 
-	featureconfig.ConfigureBeaconFeatures(ctx)
-
 	if err := beacon.startDB(ctx); err != nil {
 		return nil, err
 	}
@@ -125,6 +130,21 @@ func NewBeaconNode(ctx *cli.Context) (*BeaconNode, error) {
 	return beacon, nil
 }
 
+// configureChain sets the chain parameters and the features given by the flags.
+func configureChain(ctx *cli.Context) error {
+	// Use custom config values if the --no-custom-config flag is set.
+	if !ctx.GlobalBool(flags.NoCustomConfigFlag.Name) {
+		log.Info("Using custom parameter configuration")
+		params.UseDemoBeaconConfig()
+	}
+	if err := cmd.ConfigureChainConfig(ctx); err != nil {
+		return err
+	}
+
+	featureconfig.ConfigureBeaconFeatures(ctx)
+	return nil
+}
+
 // Start the BeaconNode and kicks off every registered service.
 func (b *BeaconNode) Start() {
 	b.lock.Lock()
@@ -226,10 +246,12 @@ func (b *BeaconNode) registerBlockchainService(ctx *cli.Context) error {
 		AttsService:    attsService,
 		P2p:            p2pService,
 		MaxRoutines:    maxRoutines,
+		Clock:          b.clock,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not register blockchain service")
 	}
+	p2pService.SetChainStatusProvider(blockchainService)
 	return b.services.RegisterService(blockchainService)
 }
 
@@ -251,6 +273,9 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	if cliCtx.GlobalBool(testSkipPowFlag) {
 		return b.services.RegisterService(&powchain.Web3Service{})
 	}
+	if interopEnabled(cliCtx) {
+		return b.registerInteropPOWChainService(cliCtx)
+	}
 
 	depAddress := cliCtx.GlobalString(flags.DepositContractFlag.Name)
 
@@ -278,17 +303,35 @@ func (b *BeaconNode) registerPOWChainService(cliCtx *cli.Context) error {
 	}
 	powClient := ethclient.NewClient(rpcClient)
 
+	var fallbacks []*powchain.Eth1Endpoint
+	for _, endpoint := range cliCtx.GlobalStringSlice(flags.FallbackWeb3ProviderFlag.Name) {
+		fallbackRPCClient, err := gethRPC.Dial(endpoint)
+		if err != nil {
+			log.WithError(err).Warn("Unable to connect to fallback ETH1.0 endpoint, skipping it")
+			continue
+		}
+		fallbacks = append(fallbacks, &powchain.Eth1Endpoint{
+			URL:    endpoint,
+			Client: ethclient.NewClient(fallbackRPCClient),
+		})
+	}
+
 	ctx := context.Background()
 	cfg := &powchain.Web3ServiceConfig{
-		Endpoint:        cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
-		DepositContract: common.HexToAddress(depAddress),
-		Client:          httpClient,
-		Reader:          powClient,
-		Logger:          powClient,
-		HTTPLogger:      httpClient,
-		BlockFetcher:    httpClient,
-		ContractBackend: httpClient,
-		BeaconDB:        b.db,
+		Endpoint:            cliCtx.GlobalString(flags.Web3ProviderFlag.Name),
+		DepositContract:     common.HexToAddress(depAddress),
+		Client:              httpClient,
+		Reader:              powClient,
+		Logger:              powClient,
+		HTTPLogger:          httpClient,
+		BlockFetcher:        httpClient,
+		ContractBackend:     httpClient,
+		BeaconDB:            b.db,
+		LogChunkSize:        cliCtx.GlobalUint64(flags.Eth1LogChunkSizeFlag.Name),
+		HeadPollInterval:    cliCtx.GlobalDuration(flags.Eth1HeaderPollIntervalFlag.Name),
+		FallbackEndpoints:   fallbacks,
+		HealthCheckInterval: cliCtx.GlobalDuration(flags.Eth1HealthCheckIntervalFlag.Name),
+		Clock:               b.clock,
 	}
 	web3Service, err := powchain.NewWeb3Service(ctx, cfg)
 	if err != nil {
@@ -376,10 +419,12 @@ func (b *BeaconNode) registerRPCService(ctx *cli.Context) error {
 		KeyFlag:          key,
 		BeaconDB:         b.db,
 		Broadcaster:      p2pService,
+		SubnetManager:    p2pService,
 		ChainService:     chainService,
 		OperationService: operationService,
 		POWChainService:  web3Service,
 		SyncService:      syncService,
+		Clock:            b.clock,
 	})
 
 	return b.services.RegisterService(rpcService)
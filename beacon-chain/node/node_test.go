@@ -1,11 +1,17 @@
 package node
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/urfave/cli"
@@ -39,3 +45,35 @@ func TestNodeClose_OK(t *testing.T) {
 
 	os.RemoveAll(tmp)
 }
+
+func TestInteropGenesisState_ReusesStoredGenesisTime(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	app := cli.NewApp()
+	set := flag.NewFlagSet("test", 0)
+	set.Uint64(flags.InteropNumValidatorsFlag.Name, 8, "")
+	cliCtx := cli.NewContext(app, set, nil)
+	clock := clockutil.NewManual(time.Unix(1565000000, 0))
+
+	first, _, err := interopGenesisState(ctx, cliCtx, beaconDB, clock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.GenesisTime != uint64(clock.Now().Unix()) {
+		t.Errorf("Expected a new chain to start at %d, received %d", clock.Now().Unix(), first.GenesisTime)
+	}
+	// Store a chain started long ago, as after a restart.
+	genesisTime := first.GenesisTime - 3600
+	if err := beaconDB.SaveState(ctx, &pb.BeaconState{GenesisTime: genesisTime}); err != nil {
+		t.Fatal(err)
+	}
+	restarted, _, err := interopGenesisState(ctx, cliCtx, beaconDB, clock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restarted.GenesisTime != genesisTime {
+		t.Errorf("Expected the stored genesis time %d, received %d", genesisTime, restarted.GenesisTime)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/p2p/adapter/metric"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/urfave/cli"
 )
 
@@ -27,11 +28,14 @@ var topicMappings = map[pb.Topic]proto.Message{
 	pb.Topic_ATTESTATION_ANNOUNCE:                &pb.AttestationAnnounce{},
 	pb.Topic_ATTESTATION_REQUEST:                 &pb.AttestationRequest{},
 	pb.Topic_ATTESTATION_RESPONSE:                &pb.AttestationResponse{},
+	pb.Topic_AGGREGATE_ATTESTATION:               &pb.AggregateAttestation{},
 }
 
 func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 	contractAddress := ctx.GlobalString(flags.DepositContractFlag.Name)
-	if contractAddress == "" {
+	// There is no deposit contract to fetch for a chain started from an
+	// interop genesis state.
+	if contractAddress == "" && !interopEnabled(ctx) {
 		var err error
 		contractAddress, err = fetchDepositContract()
 		if err != nil {
@@ -44,18 +48,30 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 		staticPeers = append(staticPeers, peers...)
 	}
 
+	discv5Bootnodes := []string{}
+	for _, entry := range ctx.GlobalStringSlice(cmd.Discv5BootstrapNode.Name) {
+		discv5Bootnodes = append(discv5Bootnodes, strings.Split(entry, ",")...)
+	}
+
 	s, err := p2p.NewServer(&p2p.ServerConfig{
 		NoDiscovery:            ctx.GlobalBool(cmd.NoDiscovery.Name),
 		StaticPeers:            staticPeers,
 		BootstrapNodeAddr:      ctx.GlobalString(cmd.BootstrapNode.Name),
 		RelayNodeAddr:          ctx.GlobalString(cmd.RelayNode.Name),
 		HostAddress:            ctx.GlobalString(cmd.P2PHost.Name),
+		LocalIP:                ctx.GlobalString(cmd.P2PLocalIP.Name),
 		Port:                   ctx.GlobalInt(cmd.P2PPort.Name),
 		MaxPeers:               ctx.GlobalInt(cmd.P2PMaxPeers.Name),
 		PrvKey:                 ctx.GlobalString(cmd.P2PPrivKey.Name),
 		DepositContractAddress: contractAddress,
 		WhitelistCIDR:          ctx.GlobalString(cmd.P2PWhitelist.Name),
 		EnableUPnP:             ctx.GlobalBool(cmd.EnableUPnPFlag.Name),
+		EnableDiscv5:           ctx.GlobalBool(cmd.EnableDiscv5.Name),
+		UDPPort:                ctx.GlobalInt(cmd.P2PUDPPort.Name),
+		Discv5BootstrapAddrs:   discv5Bootnodes,
+		ForkVersion:            params.BeaconConfig().GenesisForkVersion,
+		AttestationSubnetCount: ctx.GlobalUint64(cmd.AttestationSubnetCount.Name),
+		PersistentSubnetCount:  ctx.GlobalUint64(cmd.PersistentSubnets.Name),
 	})
 	if err != nil {
 		return nil, err
@@ -69,6 +85,7 @@ func configureP2P(ctx *cli.Context) (*p2p.Server, error) {
 	for k, v := range topicMappings {
 		s.RegisterTopic(k.String(), v, adapters...)
 	}
+	s.RegisterAttestationSubnets(&pb.UnaggregatedAttestation{}, adapters...)
 
 	return s, nil
 }
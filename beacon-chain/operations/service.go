@@ -133,15 +133,16 @@ func (s *Service) AttestationPool(ctx context.Context, requestedSlot uint64) ([]
 	if err != nil {
 		return nil, errors.New("could not retrieve attestations from DB")
 	}
-	bState, err := s.beaconDB.HeadState(ctx)
+	headState, err := s.beaconDB.HeadStateTrie(ctx)
 	if err != nil {
 		return nil, errors.New("could not retrieve attestations from DB")
 	}
 
-	bState, err = state.ProcessSlots(ctx, bState, requestedSlot)
+	headState, err = state.ProcessSlots(ctx, headState, requestedSlot)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not process slots up to %d", requestedSlot)
 	}
+	bState := headState.InnerStateUnsafe()
 
 	sort.Slice(attestationsFromDB, func(i, j int) bool {
 		return attestationsFromDB[i].Data.Crosslink.Shard < attestationsFromDB[j].Data.Crosslink.Shard
@@ -168,6 +168,29 @@ func (b *blockCache) AddBlock(blk *gethTypes.Block) error {
 	return nil
 }
 
+// RemoveBlockByHeight removes the blockInfo at the given height from the
+// cache, if present. This is used to evict blocks which are no longer
+// canonical after a reorg.
+func (b *blockCache) RemoveBlockByHeight(height *big.Int) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	obj, exists, err := b.heightCache.GetByKey(height.String())
+	if err != nil || !exists {
+		return err
+	}
+	if err := b.heightCache.Delete(obj); err != nil {
+		return err
+	}
+	if err := b.hashCache.Delete(obj); err != nil {
+		return err
+	}
+
+	blockCacheSize.Set(float64(len(b.hashCache.ListKeys())))
+
+	return nil
+}
+
 // trim the FIFO queue to the maxSize.
 func trim(queue *cache.FIFO, maxSize int) {
 	for s := len(queue.ListKeys()); s > maxSize; s-- {
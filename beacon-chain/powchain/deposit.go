@@ -21,6 +21,13 @@ func (w *Web3Service) processDeposit(
 	if err := verifyDeposit(eth1Data, deposit); err != nil {
 		return errors.Wrapf(err, "could not verify deposit from #%x", bytesutil.Trunc(deposit.Data.PublicKey))
 	}
+	return w.trackDepositedBalance(deposit)
+}
+
+// trackDepositedBalance adds the amount of a deposit to the balance of its
+// public key, and counts the validators reaching the maximum effective balance
+// towards the number of genesis validators.
+func (w *Web3Service) trackDepositedBalance(deposit *ethpb.Deposit) error {
 	pubKey := bytesutil.ToBytes48(deposit.Data.PublicKey)
 	amount := deposit.Data.Amount
 	currBal, ok := w.depositedPubkeys[pubKey]
@@ -0,0 +1,400 @@
+package powchain
+
+import (
+	"context"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultHealthCheckInterval is the interval at which the health of the
+	// eth1 endpoints is checked.
+	defaultHealthCheckInterval = 10 * time.Second
+	// endpointRequestTimeout bounds the requests made to check the health of
+	// an endpoint.
+	endpointRequestTimeout = 5 * time.Second
+	// staleHeadThreshold is the age past which the head of an endpoint is
+	// considered stale, as for the service status.
+	staleHeadThreshold = 5 * time.Minute
+	// maxHeadLag is the number of blocks an endpoint may be behind the most
+	// recent head among all endpoints before it is considered unhealthy.
+	maxHeadLag = 6
+	// switchMargin is the score by which another endpoint must beat the
+	// active healthy endpoint for the service to switch to it.
+	switchMargin = 10
+	// statsWeight is the weight of the latest request in the moving averages
+	// of the error rate and latency of an endpoint.
+	statsWeight = 0.2
+)
+
+var endpointSwitchCount = promauto.NewCounter(prometheus.CounterOpts{
+	Name: "powchain_endpoint_switches",
+	Help: "The number of times the ETH1.0 endpoint in use was switched",
+})
+
+// Eth1Endpoint is an additional eth1 node the service fails over to when the
+// endpoint in use is unhealthy.
+type Eth1Endpoint struct {
+	URL    string
+	Client Client
+}
+
+// EndpointStatus describes the health of an eth1 endpoint.
+type EndpointStatus struct {
+	URL        string
+	Active     bool
+	Healthy    bool
+	Score      float64
+	HeadNumber uint64
+	HeadTime   uint64
+	ErrorRate  float64
+	Latency    time.Duration
+	LastError  error
+}
+
+// endpointHealth is an eth1 endpoint along with the statistics its health score is
+// computed from.
+type endpointHealth struct {
+	url             string
+	reader          Reader
+	logger          bind.ContractFilterer
+	httpLogger      bind.ContractFilterer
+	blockFetcher    POWBlockFetcher
+	client          Client
+	contractBackend bind.ContractCaller
+	headNumber      uint64
+	headTime        uint64
+	errorRate       float64
+	latency         time.Duration
+	lastError       error
+}
+
+func newFallbackEndpoint(e *Eth1Endpoint) *endpointHealth {
+	return &endpointHealth{
+		url:             e.URL,
+		reader:          e.Client,
+		logger:          e.Client,
+		httpLogger:      e.Client,
+		blockFetcher:    e.Client,
+		client:          e.Client,
+		contractBackend: e.Client,
+	}
+}
+
+// record updates the statistics of the endpoint with the outcome of a request.
+func (e *endpointHealth) record(err error, latency time.Duration) {
+	e.lastError = err
+	if err != nil {
+		e.errorRate = e.errorRate*(1-statsWeight) + statsWeight
+		return
+	}
+	e.errorRate = e.errorRate * (1 - statsWeight)
+	if e.latency == 0 {
+		e.latency = latency
+	} else {
+		e.latency = time.Duration(float64(e.latency)*(1-statsWeight) + float64(latency)*statsWeight)
+	}
+}
+
+// healthy returns true if the last request to the endpoint succeeded and its
+// head is recent, both in time and compared to the other endpoints.
+func (e *endpointHealth) healthy(bestHead uint64, now time.Time) bool {
+	if e.lastError != nil {
+		return false
+	}
+	if e.headTime > 0 && now.Sub(time.Unix(int64(e.headTime), 0)) > staleHeadThreshold {
+		return false
+	}
+	return e.headNumber+maxHeadLag >= bestHead
+}
+
+// score rates the endpoint from 0 to 100 on its error rate, how far its head
+// is behind the most recent head among all endpoints and its latency.
+func (e *endpointHealth) score(bestHead uint64) float64 {
+	score := 100 - 50*e.errorRate
+	if bestHead > e.headNumber {
+		lag := bestHead - e.headNumber
+		if lag > maxHeadLag {
+			lag = maxHeadLag
+		}
+		score -= 5 * float64(lag)
+	}
+	latencyPenalty := 10 * e.latency.Seconds()
+	if latencyPenalty > 20 {
+		latencyPenalty = 20
+	}
+	score -= latencyPenalty
+	if score < 0 {
+		return 0
+	}
+	return score
+}
+
+// redactEndpoint removes the path and credentials of an endpoint URL, which
+// hosted providers use for API keys, before it is logged or reported.
+func redactEndpoint(endpoint string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return endpoint
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+// checkEndpointHealth requests the latest header of every endpoint in
+// parallel and records the head, latency and outcome of each request.
+func (w *Web3Service) checkEndpointHealth() {
+	type result struct {
+		number  uint64
+		time    uint64
+		latency time.Duration
+		err     error
+	}
+	results := make([]result, len(w.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range w.endpoints {
+		wg.Add(1)
+		go func(i int, fetcher POWBlockFetcher) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(w.ctx, endpointRequestTimeout)
+			defer cancel()
+			start := time.Now()
+			header, err := fetcher.HeaderByNumber(ctx, nil)
+			if err == nil && header == nil {
+				err = errors.New("no header returned")
+			}
+			results[i] = result{latency: time.Since(start), err: err}
+			if err == nil {
+				results[i].number = header.Number.Uint64()
+				results[i].time = header.Time
+			}
+		}(i, e.blockFetcher)
+	}
+	wg.Wait()
+
+	w.endpointsLock.Lock()
+	defer w.endpointsLock.Unlock()
+	for i, e := range w.endpoints {
+		e.record(results[i].err, results[i].latency)
+		if results[i].err == nil {
+			e.headNumber = results[i].number
+			e.headTime = results[i].time
+		}
+	}
+}
+
+// recordEndpointError counts a failed request towards the error rate of the
+// endpoint in use.
+func (w *Web3Service) recordEndpointError(err error) {
+	w.endpointsLock.Lock()
+	defer w.endpointsLock.Unlock()
+	if len(w.endpoints) == 0 {
+		return
+	}
+	w.endpoints[w.activeEndpoint].record(err, 0)
+}
+
+// selectEndpoint switches to the healthiest endpoint if the endpoint in use
+// is unhealthy, or if another endpoint scores better by more than the switch
+// margin. Endpoints listed first are preferred on equal scores. It returns
+// true if the endpoint in use was switched.
+func (w *Web3Service) selectEndpoint() bool {
+	w.endpointsLock.Lock()
+	defer w.endpointsLock.Unlock()
+	if len(w.endpoints) < 2 {
+		return false
+	}
+
+	now := w.wallClock().Now()
+	bestHead := w.bestEndpointHead()
+	best := -1
+	bestScore := 0.0
+	for i, e := range w.endpoints {
+		if !e.healthy(bestHead, now) {
+			continue
+		}
+		if score := e.score(bestHead); best == -1 || score > bestScore {
+			best = i
+			bestScore = score
+		}
+	}
+	if best == -1 || best == w.activeEndpoint {
+		return false
+	}
+	active := w.endpoints[w.activeEndpoint]
+	if active.healthy(bestHead, now) && active.score(bestHead)+switchMargin >= bestScore {
+		return false
+	}
+	w.useEndpoint(best)
+	return true
+}
+
+// failOver switches to the next endpoint whose last request did not fail,
+// after the endpoint in use failed with the given error. It returns false if
+// there is no such endpoint.
+func (w *Web3Service) failOver(err error) bool {
+	w.endpointsLock.Lock()
+	defer w.endpointsLock.Unlock()
+	if len(w.endpoints) == 0 {
+		return false
+	}
+	w.endpoints[w.activeEndpoint].record(err, 0)
+	for i := 1; i < len(w.endpoints); i++ {
+		next := (w.activeEndpoint + i) % len(w.endpoints)
+		if w.endpoints[next].lastError == nil {
+			w.useEndpoint(next)
+			return true
+		}
+	}
+	return false
+}
+
+// useEndpoint makes the service use the endpoint at the given index for all
+// its requests. The caller must hold the endpoints lock, and requests read the
+// endpoint in use through the active* accessors, which hold it as well.
+func (w *Web3Service) useEndpoint(index int) {
+	e := w.endpoints[index]
+	depositContractCaller, err := contracts.NewDepositContractCaller(w.depositContractAddress, e.contractBackend)
+	if err != nil {
+		log.WithError(err).WithField("endpoint", redactEndpoint(e.url)).Error("Could not switch ETH1.0 endpoint")
+		return
+	}
+	previous := w.endpoints[w.activeEndpoint]
+	w.activeEndpoint = index
+	w.endpoint = e.url
+	w.reader = e.reader
+	w.logger = e.logger
+	w.httpLogger = e.httpLogger
+	w.blockFetcher = e.blockFetcher
+	w.client = e.client
+	w.depositContractCaller = depositContractCaller
+	endpointSwitchCount.Inc()
+	log.WithFields(logrus.Fields{
+		"from": redactEndpoint(previous.url),
+		"to":   redactEndpoint(e.url),
+	}).Warn("Switched ETH1.0 endpoint")
+}
+
+// activeEndpointURL returns the URL of the endpoint in use.
+func (w *Web3Service) activeEndpointURL() string {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
+	return w.endpoint
+}
+
+// activeReader returns the reader of the endpoint in use.
+func (w *Web3Service) activeReader() Reader {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
+	return w.reader
+}
+
+// activeHTTPLogger returns the log filterer of the endpoint in use.
+func (w *Web3Service) activeHTTPLogger() bind.ContractFilterer {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
+	return w.httpLogger
+}
+
+// activeBlockFetcher returns the block fetcher of the endpoint in use.
+func (w *Web3Service) activeBlockFetcher() POWBlockFetcher {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
+	return w.blockFetcher
+}
+
+// activeDepositContractCaller returns the deposit contract caller bound to
+// the endpoint in use.
+func (w *Web3Service) activeDepositContractCaller() *contracts.DepositContractCaller {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
+	return w.depositContractCaller
+}
+
+// bestEndpointHead returns the most recent head among all endpoints. The
+// caller must hold the endpoints lock.
+func (w *Web3Service) bestEndpointHead() uint64 {
+	var best uint64
+	for _, e := range w.endpoints {
+		if e.lastError == nil && e.headNumber > best {
+			best = e.headNumber
+		}
+	}
+	return best
+}
+
+// EndpointStatuses returns the health of the eth1 endpoints of the service,
+// in the order they were configured.
+func (w *Web3Service) EndpointStatuses() []*EndpointStatus {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
+	now := w.wallClock().Now()
+	bestHead := w.bestEndpointHead()
+	statuses := make([]*EndpointStatus, len(w.endpoints))
+	for i, e := range w.endpoints {
+		statuses[i] = &EndpointStatus{
+			URL:        redactEndpoint(e.url),
+			Active:     i == w.activeEndpoint,
+			Healthy:    e.healthy(bestHead, now),
+			Score:      e.score(bestHead),
+			HeadNumber: e.headNumber,
+			HeadTime:   e.headTime,
+			ErrorRate:  e.errorRate,
+			Latency:    e.latency,
+			LastError:  e.lastError,
+		}
+	}
+	return statuses
+}
+
+// headTracker delivers the new heads of the endpoint in use, either through
+// a subscription or by polling for the latest header.
+type headTracker struct {
+	sub    ethereum.Subscription
+	ticker *time.Ticker
+	err    <-chan error
+	poll   <-chan time.Time
+	failed bool
+}
+
+func (t *headTracker) stop() {
+	if t.sub != nil {
+		t.sub.Unsubscribe()
+	}
+	if t.ticker != nil {
+		t.ticker.Stop()
+	}
+}
+
+// trackHead starts tracking the head of the endpoint in use. HTTP endpoints
+// do not support subscriptions, so new headers are polled for instead.
+func (w *Web3Service) trackHead() (*headTracker, error) {
+	if isHTTPEndpoint(w.activeEndpointURL()) {
+		ticker := time.NewTicker(w.headPollInterval)
+		return &headTracker{ticker: ticker, poll: ticker.C}, nil
+	}
+	sub, err := w.activeReader().SubscribeNewHead(w.ctx, w.headerChan)
+	if err != nil {
+		return nil, err
+	}
+	return &headTracker{sub: sub, err: sub.Err()}, nil
+}
+
+// trackHeadWithFailover tracks the head of the endpoint in use, failing over
+// to the other endpoints until one of them can be tracked.
+func (w *Web3Service) trackHeadWithFailover() (*headTracker, error) {
+	tracker, err := w.trackHead()
+	for err != nil && w.failOver(err) {
+		log.WithError(err).Warn("Unable to track head of ETH1.0 endpoint, failing over")
+		tracker, err = w.trackHead()
+	}
+	return tracker, err
+}
@@ -0,0 +1,28 @@
+package powchain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// defaultHeadPollInterval is the interval at which the latest eth1 header is
+// requested when the endpoint does not support subscriptions.
+const defaultHeadPollInterval = 5 * time.Second
+
+// isHTTPEndpoint returns true if the endpoint only supports plain JSON-RPC
+// requests, in which case new headers are polled rather than subscribed to.
+func isHTTPEndpoint(endpoint string) bool {
+	return strings.HasPrefix(endpoint, "http")
+}
+
+// pollLatestHeader requests the latest header of the eth1 chain and processes
+// it as the header subscription would have.
+func (w *Web3Service) pollLatestHeader() error {
+	head, err := w.activeBlockFetcher().HeaderByNumber(w.ctx, nil)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve latest ETH1.0 chain header")
+	}
+	return w.processNewHead(head)
+}
@@ -0,0 +1,205 @@
+package powchain
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// pollingFetcher serves headers of a simulated eth1 chain whose head can be
+// moved and forked between polls.
+type pollingFetcher struct {
+	headers map[uint64]*gethTypes.Header
+	head    uint64
+}
+
+func newPollingFetcher(height uint64) *pollingFetcher {
+	f := &pollingFetcher{
+		headers: map[uint64]*gethTypes.Header{0: {Number: big.NewInt(0)}},
+	}
+	f.extend(0, height, 0)
+	return f
+}
+
+// extend builds the chain from the block at the given height up to the new
+// head. A different fork byte produces different blocks at the same heights.
+func (f *pollingFetcher) extend(from uint64, to uint64, fork byte) {
+	for i := from + 1; i <= to; i++ {
+		f.headers[i] = &gethTypes.Header{
+			Number:     new(big.Int).SetUint64(i),
+			ParentHash: f.headers[i-1].Hash(),
+			Time:       i * 10,
+			Extra:      []byte{fork},
+		}
+	}
+	for i := to + 1; f.headers[i] != nil; i++ {
+		delete(f.headers, i)
+	}
+	f.head = to
+}
+
+func (f *pollingFetcher) BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *pollingFetcher) BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (f *pollingFetcher) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	if number == nil {
+		return f.headers[f.head], nil
+	}
+	header, ok := f.headers[number.Uint64()]
+	if !ok {
+		return nil, errors.New("header not found")
+	}
+	return header, nil
+}
+
+// newHeadTrackingService creates a service tracking the head of the given
+// simulated chain, without any deposits.
+func newHeadTrackingService(t *testing.T, fetcher POWBlockFetcher) *Web3Service {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Web3Service{
+		ctx:                     context.Background(),
+		blockCache:              newBlockCache(),
+		blockFetcher:            fetcher,
+		beaconDB:                beaconDB,
+		depositTrie:             depositTrie,
+		lastReceivedMerkleIndex: -1,
+		lastRequestedBlock:      big.NewInt(0),
+		depositedPubkeys:        make(map[[48]byte]uint64),
+	}
+}
+
+func assertCachedHeader(t *testing.T, w *Web3Service, header *gethTypes.Header) {
+	exists, info, err := w.blockCache.BlockInfoByHeight(header.Number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists || info.Hash != header.Hash() {
+		t.Errorf("Expected block %d with hash %#x to be cached", header.Number, header.Hash())
+	}
+}
+
+func TestIsHTTPEndpoint(t *testing.T) {
+	if !isHTTPEndpoint("http://127.0.0.1:8545") || !isHTTPEndpoint("https://goerli.prylabs.net") {
+		t.Error("Expected HTTP endpoints to be polled")
+	}
+	if isHTTPEndpoint("ws://127.0.0.1:8546") || isHTTPEndpoint("ipc://geth.ipc") {
+		t.Error("Expected WebSocket and IPC endpoints to be subscribed to")
+	}
+}
+
+func TestPollLatestHeader_FillsGapBetweenPolls(t *testing.T) {
+	fetcher := newPollingFetcher(10)
+	web3Service := newHeadTrackingService(t, fetcher)
+	defer db.TeardownDB(web3Service.beaconDB)
+
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	if web3Service.blockHeight.Uint64() != 10 {
+		t.Fatalf("Expected block height 10, received %v", web3Service.blockHeight)
+	}
+
+	// Polling again without a new block is a no-op.
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	fetcher.extend(10, 14, 0)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	if web3Service.blockHeight.Uint64() != 14 || web3Service.blockHash != fetcher.headers[14].Hash() {
+		t.Errorf("Expected head to be block 14, received block %v", web3Service.blockHeight)
+	}
+	for i := uint64(10); i <= 14; i++ {
+		assertCachedHeader(t, web3Service, fetcher.headers[i])
+	}
+}
+
+func TestPollLatestHeader_DetectsReorg(t *testing.T) {
+	hook := logTest.NewGlobal()
+	fetcher := newPollingFetcher(7)
+	web3Service := newHeadTrackingService(t, fetcher)
+	defer db.TeardownDB(web3Service.beaconDB)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	fetcher.extend(7, 10, 0)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	replaced := fetcher.headers[10].Hash()
+
+	// Blocks 9 and 10 are replaced by a fork which is one block longer.
+	fetcher.extend(8, 11, 1)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertLogsContain(t, hook, "Detected ETH1.0 chain reorg")
+	if web3Service.blockHeight.Uint64() != 11 || web3Service.blockHash != fetcher.headers[11].Hash() {
+		t.Errorf("Expected head to be block 11 of the new fork, received block %v", web3Service.blockHeight)
+	}
+	for i := uint64(8); i <= 11; i++ {
+		assertCachedHeader(t, web3Service, fetcher.headers[i])
+	}
+	exists, _, err := web3Service.blockCache.BlockInfoByHash(replaced)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("Expected the replaced block to be evicted from the cache")
+	}
+}
+
+func TestPollLatestHeader_DetectsReorgToShorterChain(t *testing.T) {
+	hook := logTest.NewGlobal()
+	fetcher := newPollingFetcher(5)
+	web3Service := newHeadTrackingService(t, fetcher)
+	defer db.TeardownDB(web3Service.beaconDB)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	fetcher.extend(5, 8, 0)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	fetcher.extend(6, 7, 1)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertLogsContain(t, hook, "Detected ETH1.0 chain reorg")
+	if web3Service.blockHeight.Uint64() != 7 || web3Service.blockHash != fetcher.headers[7].Hash() {
+		t.Errorf("Expected head to be block 7 of the new fork, received block %v", web3Service.blockHeight)
+	}
+	exists, _, err := web3Service.blockCache.BlockInfoByHeight(big.NewInt(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("Expected block 8 of the replaced fork to be evicted from the cache")
+	}
+}
@@ -0,0 +1,156 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+var errNoInteropChain = errors.New("there is no ETH1.0 chain in interop mode")
+
+// NewInteropService returns a service which stands in for the ETH1.0 chain
+// when the beacon chain starts from an interop genesis state. The chain is
+// reported as started at the genesis time of the state, with the genesis
+// deposits as chain start deposits. If the deposits are not known, deposits
+// carrying the keys and balances of the genesis validators stand in for them.
+// The only ETH1.0 block known to the service is the one referenced by the eth1
+// data of the state, so proposers keep voting for that eth1 data.
+func NewInteropService(ctx context.Context, beaconDB *db.BeaconDB, genesisState *pb.BeaconState, deposits []*ethpb.Deposit) (*Web3Service, error) {
+	if deposits == nil {
+		deposits = depositsFromValidators(genesisState)
+	}
+	leaves := make([][]byte, len(deposits))
+	for i, d := range deposits {
+		leaf, err := ssz.HashTreeRoot(d.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		leaves[i] = leaf[:]
+	}
+	depositTrie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate deposit trie")
+	}
+	for i, d := range deposits {
+		if d.Proof == nil {
+			if d.Proof, err = depositTrie.MerkleProof(i); err != nil {
+				return nil, errors.Wrapf(err, "could not generate proof of deposit %d", i)
+			}
+		}
+		beaconDB.InsertDeposit(ctx, d, big.NewInt(0), i, depositTrie.Root())
+	}
+
+	chain := newInteropChain(genesisState.Eth1Data.BlockHash)
+	ctx, cancel := context.WithCancel(ctx)
+	return &Web3Service{
+		ctx:                     ctx,
+		cancel:                  cancel,
+		client:                  chain,
+		reader:                  chain,
+		logger:                  chain,
+		blockFetcher:            chain,
+		blockHeight:             big.NewInt(0),
+		blockHash:               chain.hash,
+		blockCache:              newBlockCache(),
+		chainStartFeed:          new(event.Feed),
+		depositRoot:             genesisState.Eth1Data.DepositRoot,
+		depositTrie:             depositTrie,
+		chainStartDeposits:      deposits,
+		chainStarted:            true,
+		beaconDB:                beaconDB,
+		lastReceivedMerkleIndex: int64(len(deposits)) - 1,
+		lastRequestedBlock:      big.NewInt(0),
+		chainStartETH1Data:      genesisState.Eth1Data,
+		depositedPubkeys:        make(map[[48]byte]uint64),
+		eth2GenesisTime:         genesisState.GenesisTime,
+		interop:                 true,
+	}, nil
+}
+
+// depositsFromValidators returns deposits of the keys, withdrawal credentials
+// and balances of the validators of the state.
+func depositsFromValidators(beaconState *pb.BeaconState) []*ethpb.Deposit {
+	deposits := make([]*ethpb.Deposit, len(beaconState.Validators))
+	for i, v := range beaconState.Validators {
+		deposits[i] = &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             v.PublicKey,
+				WithdrawalCredentials: v.WithdrawalCredentials,
+				Amount:                beaconState.Balances[i],
+				Signature:             params.BeaconConfig().EmptySignature[:],
+			},
+		}
+	}
+	return deposits
+}
+
+// interopChain is the ETH1.0 client of the interop service. Its chain has a
+// single block at height zero, standing in for the block of the given hash,
+// which is also returned for the latest block and any lower height.
+type interopChain struct {
+	hash  common.Hash
+	block *gethTypes.Block
+}
+
+func newInteropChain(blockHash []byte) *interopChain {
+	return &interopChain{
+		hash: common.BytesToHash(blockHash),
+		block: gethTypes.NewBlockWithHeader(&gethTypes.Header{
+			Number:     big.NewInt(0),
+			Difficulty: big.NewInt(0),
+		}),
+	}
+}
+
+func (c *interopChain) SubscribeNewHead(_ context.Context, _ chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return nil, errNoInteropChain
+}
+
+func (c *interopChain) BlockByHash(_ context.Context, hash common.Hash) (*gethTypes.Block, error) {
+	if hash != c.hash {
+		return nil, ethereum.NotFound
+	}
+	return c.block, nil
+}
+
+func (c *interopChain) BlockByNumber(_ context.Context, number *big.Int) (*gethTypes.Block, error) {
+	if number != nil && number.Sign() > 0 {
+		return nil, ethereum.NotFound
+	}
+	return c.block, nil
+}
+
+func (c *interopChain) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	block, err := c.BlockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	return block.Header(), nil
+}
+
+func (c *interopChain) FilterLogs(_ context.Context, _ ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return nil, nil
+}
+
+func (c *interopChain) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, _ chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return nil, errNoInteropChain
+}
+
+func (c *interopChain) CodeAt(_ context.Context, _ common.Address, _ *big.Int) ([]byte, error) {
+	return nil, errNoInteropChain
+}
+
+func (c *interopChain) CallContract(_ context.Context, _ ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	return nil, errNoInteropChain
+}
@@ -0,0 +1,70 @@
+package powchain
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestNewInteropService_StandsInForChainStart(t *testing.T) {
+	ctx := context.Background()
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("unable to set up simulated db instance: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 8)
+	eth1BlockHash := bytes.Repeat([]byte{0x42}, 32)
+	genesisState, err := state.GenesisBeaconState(deposits, 1567000000, &ethpb.Eth1Data{BlockHash: eth1BlockHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := NewInteropService(ctx, beaconDB, genesisState, nil /*deposits*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !w.HasChainStarted() {
+		t.Error("Expected the chain to be started")
+	}
+	if w.ETH2GenesisTime() != genesisState.GenesisTime {
+		t.Errorf("Expected genesis time %d, received %d", genesisState.GenesisTime, w.ETH2GenesisTime())
+	}
+	if len(w.ChainStartDeposits()) != 8 {
+		t.Errorf("Expected 8 chain start deposits, received %d", len(w.ChainStartDeposits()))
+	}
+	for _, d := range deposits {
+		if _, height := beaconDB.DepositByPubkey(ctx, d.Data.PublicKey); height == nil {
+			t.Errorf("Expected deposit of %#x to be saved", d.Data.PublicKey)
+		}
+	}
+	if processed, err := w.AreAllDepositsProcessed(); err != nil || !processed {
+		t.Errorf("Expected all deposits to be processed, received %v, %v", processed, err)
+	}
+
+	exists, height, err := w.BlockExists(ctx, common.BytesToHash(eth1BlockHash))
+	if err != nil || !exists || height.Sign() != 0 {
+		t.Errorf("Expected the eth1 block of the genesis state at height 0, received %v, %v, %v", exists, height, err)
+	}
+	if exists, _, _ := w.BlockExists(ctx, common.BytesToHash([]byte{0x01})); exists {
+		t.Error("Expected an unknown eth1 block not to exist")
+	}
+	number, err := w.BlockNumberByTimestamp(ctx, genesisState.GenesisTime+1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if number.Sign() != 0 {
+		t.Errorf("Expected block number 0, received %v", number)
+	}
+	if _, err := w.BlockHashByHeight(ctx, big.NewInt(-1024)); err != nil {
+		t.Errorf("Expected heights before the eth1 block to resolve to it, received %v", err)
+	}
+}
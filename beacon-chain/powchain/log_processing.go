@@ -1,9 +1,11 @@
 package powchain
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -13,6 +15,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -26,6 +29,14 @@ var (
 	depositEventSignature = []byte("DepositEvent(bytes,bytes,bytes,bytes,bytes)")
 )
 
+// defaultLogChunkSize is the maximum number of blocks covered by a single
+// request for past deposit logs, unless configured otherwise.
+const defaultLogChunkSize = 1000
+
+// logRequestTimeout is the time after which a request for past deposit logs
+// is retried over a smaller block range.
+var logRequestTimeout = 30 * time.Second
+
 // ETH2GenesisTime retrieves the genesis time of the beacon chain
 // from the deposit contract.
 func (w *Web3Service) ETH2GenesisTime() uint64 {
@@ -45,7 +56,7 @@ func (w *Web3Service) ProcessLog(depositLog gethTypes.Log) {
 				log.Error("Got empty blockhash from powchain service")
 				return
 			}
-			blk, err := w.blockFetcher.BlockByHash(w.ctx, depositLog.BlockHash)
+			blk, err := w.activeBlockFetcher().BlockByHash(w.ctx, depositLog.BlockHash)
 			if err != nil {
 				log.Errorf("Could not get eth1 block %v", err)
 				return
@@ -214,33 +225,186 @@ func (w *Web3Service) setGenesisTime(timeStamp uint64) {
 }
 
 // processPastLogs processes all the past logs from the deposit contract and
-// updates the deposit trie with the data from each individual log.
+// updates the deposit trie with the data from each individual log. If the
+// deposits were persisted before a restart, only the logs after the last
+// processed block are requested.
 func (w *Web3Service) processPastLogs() error {
+	currentState, err := w.beaconDB.HeadState(w.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head state")
+	}
+	fromBlock, err := w.restoreDepositCache(currentState)
+	if err != nil {
+		return errors.Wrap(err, "could not restore deposit cache")
+	}
+	if fromBlock > 0 {
+		w.lastRequestedBlock.SetUint64(fromBlock - 1)
+	}
+
+	if err := w.fetchPastLogs(fromBlock, w.blockHeight.Uint64()); err != nil {
+		return err
+	}
+
+	if currentState != nil && currentState.Eth1DepositIndex > 0 {
+		w.beaconDB.PrunePendingDeposits(w.ctx, int(currentState.Eth1DepositIndex))
+	}
+
+	return nil
+}
+
+// fetchPastLogs requests the deposit logs from fromBlock up to toBlock in
+// ranges of at most logChunkSize blocks. The logs of each range are processed
+// and the deposit cache is saved after it, so an interrupted node resumes
+// from the last range it completed. The range is halved whenever the provider
+// rejects a request for returning too many results or timing out, and grows
+// back after successful requests.
+func (w *Web3Service) fetchPastLogs(fromBlock uint64, toBlock uint64) error {
+	chunkSize := w.logChunkSize
+	for start := fromBlock; start <= toBlock; {
+		end := start + chunkSize - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		logs, err := w.filterLogs(start, end)
+		if err != nil {
+			if w.ctx.Err() != nil || !isLogRangeError(err) || chunkSize == 1 {
+				return errors.Wrapf(err, "could not fetch deposit logs from block %d to %d", start, end)
+			}
+			chunkSize /= 2
+			log.WithError(err).WithField("chunkSize", chunkSize).Debug("Reducing block range of deposit log requests")
+			continue
+		}
+
+		for _, log := range logs {
+			w.ProcessLog(log)
+		}
+		w.lastRequestedBlock.SetUint64(end)
+		if err := w.saveDepositCache(); err != nil {
+			return err
+		}
+		log.WithFields(logrus.Fields{
+			"fromBlock": start,
+			"toBlock":   end,
+			"logs":      len(logs),
+		}).Debug("Processed past deposit logs")
+
+		if chunkSize < w.logChunkSize {
+			chunkSize *= 2
+			if chunkSize > w.logChunkSize {
+				chunkSize = w.logChunkSize
+			}
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// filterLogs requests the deposit logs between two blocks, inclusive.
+func (w *Web3Service) filterLogs(fromBlock uint64, toBlock uint64) ([]gethTypes.Log, error) {
+	ctx, cancel := context.WithTimeout(w.ctx, logRequestTimeout)
+	defer cancel()
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{
 			w.depositContractAddress,
 		},
+		FromBlock: new(big.Int).SetUint64(fromBlock),
+		ToBlock:   new(big.Int).SetUint64(toBlock),
 	}
+	return w.activeHTTPLogger().FilterLogs(ctx, query)
+}
 
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
-	if err != nil {
-		return err
+// isLogRangeError returns true if a log request failed because the block
+// range it covered was too large for the provider.
+func isLogRangeError(err error) bool {
+	if errors.Cause(err) == context.DeadlineExceeded {
+		return true
 	}
-
-	for _, log := range logs {
-		w.ProcessLog(log)
+	msg := strings.ToLower(err.Error())
+	for _, reason := range []string{"too many results", "query returned more than", "response size exceeded", "timeout", "timed out"} {
+		if strings.Contains(msg, reason) {
+			return true
+		}
 	}
-	w.lastRequestedBlock.Set(w.blockHeight)
+	return false
+}
 
-	currentState, err := w.beaconDB.HeadState(w.ctx)
+// restoreDepositCache loads the deposits persisted in the database, rebuilds
+// the deposit trie from them and returns the block from which deposit logs
+// should be requested. The trie is not persisted: its leaves are the hashes
+// of the deposit data, so a snapshot would only duplicate the deposits, and
+// rebuilding it takes a fraction of the time needed to fetch the logs again.
+// The rebuilt trie is checked against the deposit root stored with the last
+// deposit, all logs are processed again if they differ.
+func (w *Web3Service) restoreDepositCache(currentState *pb.BeaconState) (uint64, error) {
+	lastProcessedBlock, err := w.beaconDB.LoadDepositCache(w.ctx)
 	if err != nil {
-		return errors.Wrap(err, "could not get head state")
+		return 0, err
+	}
+	if lastProcessedBlock == nil {
+		return 0, nil
 	}
 
-	if currentState != nil && currentState.Eth1DepositIndex > 0 {
-		w.beaconDB.PrunePendingDeposits(w.ctx, int(currentState.Eth1DepositIndex))
+	w.processingLock.Lock()
+	defer w.processingLock.Unlock()
+	deposits := w.beaconDB.AllDeposits(w.ctx, nil)
+	depositTrie, err := depositTrieFromDeposits(deposits)
+	if err != nil {
+		return 0, err
+	}
+	if len(deposits) > 0 {
+		_, root := w.beaconDB.DepositsNumberAndRootAtHeight(w.ctx, lastProcessedBlock)
+		if root != depositTrie.Root() {
+			log.WithFields(logrus.Fields{
+				"storedRoot":  fmt.Sprintf("%#x", root),
+				"rebuiltRoot": fmt.Sprintf("%#x", depositTrie.Root()),
+			}).Warn("Deposit trie rebuilt from the database does not match the stored deposit root, processing all deposit logs again")
+			return 0, nil
+		}
 	}
+	if currentState == nil {
+		// Before chain start, the deposits counting towards the genesis
+		// validators are rebuilt from the stored deposits.
+		for _, deposit := range deposits {
+			if err := w.trackDepositedBalance(deposit); err != nil {
+				log.WithError(err).Debug("Invalid deposit restored from the database")
+			}
+		}
+		// If genesis may already have been triggered by one of the stored
+		// deposits, the logs are processed again from the start to find the
+		// chain start. The stored deposits are replaced as they are processed.
+		if w.activeValidatorCount >= params.BeaconConfig().MinGenesisActiveValidatorCount {
+			w.activeValidatorCount = 0
+			w.depositedPubkeys = make(map[[48]byte]uint64)
+			return 0, nil
+		}
+		for _, deposit := range deposits {
+			w.beaconDB.MarkPubkeyForChainstart(w.ctx, fmt.Sprintf("#%x", deposit.Data.PublicKey))
+		}
+		w.chainStartDeposits = deposits
+	} else {
+		w.chainStarted = true
+		w.eth2GenesisTime = currentState.GenesisTime
+		w.beaconDB.ResetPendingDeposits(w.ctx, int(currentState.Eth1DepositIndex))
+	}
+	w.depositTrie = depositTrie
+	w.lastReceivedMerkleIndex = int64(len(deposits)) - 1
 
+	log.WithFields(logrus.Fields{
+		"deposits":           len(deposits),
+		"lastProcessedBlock": lastProcessedBlock,
+	}).Info("Restored deposits from the database")
+	return lastProcessedBlock.Uint64() + 1, nil
+}
+
+// saveDepositCache persists the new and replaced deposits along with the last
+// block whose deposit logs were processed. The deposit trie is rebuilt from the
+// deposits on restart, see restoreDepositCache.
+func (w *Web3Service) saveDepositCache() error {
+	w.processingLock.RLock()
+	defer w.processingLock.RUnlock()
+	if err := w.beaconDB.SaveDepositCache(w.ctx, w.lastRequestedBlock); err != nil {
+		return errors.Wrap(err, "could not save deposit cache")
+	}
 	return nil
 }
 
@@ -257,7 +421,7 @@ func (w *Web3Service) requestBatchedLogs() error {
 		FromBlock: w.lastRequestedBlock.Add(w.lastRequestedBlock, big.NewInt(1)),
 		ToBlock:   requestedBlock,
 	}
-	logs, err := w.httpLogger.FilterLogs(w.ctx, query)
+	logs, err := w.activeHTTPLogger().FilterLogs(w.ctx, query)
 	if err != nil {
 		return err
 	}
@@ -270,7 +434,7 @@ func (w *Web3Service) requestBatchedLogs() error {
 	}
 
 	w.lastRequestedBlock.Set(requestedBlock)
-	return nil
+	return w.saveDepositCache()
 }
 
 // ChainStartDepositHashes returns the hashes of all the chainstart deposits
@@ -4,15 +4,21 @@ import (
 	"bytes"
 	"context"
 	"encoding/binary"
+	"errors"
 	"io/ioutil"
+	"math/big"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/sirupsen/logrus"
@@ -378,3 +384,235 @@ func TestProcessETH2GenesisLog(t *testing.T) {
 
 	hook.Reset()
 }
+
+type recordingLogger struct {
+	backend bind.ContractFilterer
+	queries []ethereum.FilterQuery
+}
+
+func (r *recordingLogger) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return r.backend.SubscribeFilterLogs(ctx, q, ch)
+}
+
+func (r *recordingLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	r.queries = append(r.queries, q)
+	return r.backend.FilterLogs(ctx, q)
+}
+
+func TestProcessPastLogs_ResumesFromDepositCache(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	newService := func(beaconDB *db.BeaconDB, logger *recordingLogger) *Web3Service {
+		web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+			Endpoint:        endpoint,
+			DepositContract: testAcc.ContractAddr,
+			Reader:          &goodReader{},
+			Logger:          logger,
+			HTTPLogger:      logger,
+			ContractBackend: testAcc.Backend,
+			BeaconDB:        beaconDB,
+		})
+		if err != nil {
+			t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+		}
+		return web3Service
+	}
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 2)
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	for _, deposit := range deposits {
+		data := deposit.Data
+		if _, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature); err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+	}
+	testAcc.Backend.Commit()
+
+	web3Service := newService(beaconDB, &recordingLogger{backend: testAcc.Backend})
+	web3Service.chainStarted = true
+	web3Service.blockHeight = big.NewInt(5)
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatal(err)
+	}
+	depositRoot := web3Service.DepositRoot()
+	if err := beaconDB.SaveState(context.Background(), &pb.BeaconState{GenesisTime: 100, Eth1DepositIndex: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen the database, as on a restart of the node.
+	if err := beaconDB.Close(); err != nil {
+		t.Fatal(err)
+	}
+	beaconDB, err = db.NewDB(beaconDB.DatabasePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.TeardownDB(beaconDB)
+
+	logger := &recordingLogger{backend: testAcc.Backend}
+	web3Service = newService(beaconDB, logger)
+	web3Service.blockHeight = big.NewInt(8)
+	if err := web3Service.processPastLogs(); err != nil {
+		t.Fatal(err)
+	}
+	if len(logger.queries) != 1 || logger.queries[0].FromBlock.Cmp(big.NewInt(6)) != 0 {
+		t.Errorf("Expected logs to be requested from the block after the stored height, received %v", logger.queries)
+	}
+	if !web3Service.HasChainStarted() || web3Service.ETH2GenesisTime() != 100 {
+		t.Error("Expected chain start to be restored from the head state")
+	}
+	if web3Service.DepositRoot() != depositRoot {
+		t.Errorf("Expected restored deposit root %#x, received %#x", depositRoot, web3Service.DepositRoot())
+	}
+	if len(beaconDB.AllDeposits(context.Background(), nil)) != 2 {
+		t.Errorf("Expected 2 restored deposits, received %d", len(beaconDB.AllDeposits(context.Background(), nil)))
+	}
+	if len(beaconDB.PendingDeposits(context.Background(), nil)) != 1 {
+		t.Errorf("Expected 1 pending deposit, received %d", len(beaconDB.PendingDeposits(context.Background(), nil)))
+	}
+}
+
+func TestRestoreDepositCache_ProcessesLogsAgainOnRootMismatch(t *testing.T) {
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 1)
+	beaconDB.InsertDeposit(ctx, deposits[0], big.NewInt(5), 0, [32]byte{'x'})
+	if err := beaconDB.SaveDepositCache(ctx, big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+
+	web3Service := &Web3Service{ctx: ctx, beaconDB: beaconDB}
+	fromBlock, err := web3Service.restoreDepositCache(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fromBlock != 0 {
+		t.Errorf("Expected logs to be processed again from block 0, received %d", fromBlock)
+	}
+	if web3Service.depositTrie != nil {
+		t.Error("Expected the mismatching deposit trie not to be used")
+	}
+}
+
+type rangeLimitedLogger struct {
+	maxRange uint64
+	queries  []ethereum.FilterQuery
+}
+
+func (r *rangeLimitedLogger) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return new(event.Feed).Subscribe(ch), nil
+}
+
+func (r *rangeLimitedLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	if q.ToBlock.Uint64()-q.FromBlock.Uint64()+1 > r.maxRange {
+		return nil, errors.New("query returned more than 10000 results")
+	}
+	r.queries = append(r.queries, q)
+	return nil, nil
+}
+
+func TestFetchPastLogs_ShrinksChunkOnRangeError(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	logger := &rangeLimitedLogger{maxRange: 30}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.ContractAddr,
+		Reader:          &goodReader{},
+		Logger:          logger,
+		HTTPLogger:      logger,
+		ContractBackend: testAcc.Backend,
+		BeaconDB:        beaconDB,
+		LogChunkSize:    100,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+
+	if err := web3Service.fetchPastLogs(0, 249); err != nil {
+		t.Fatal(err)
+	}
+	next := uint64(0)
+	for _, q := range logger.queries {
+		if q.FromBlock.Uint64() != next {
+			t.Fatalf("Expected request from block %d, received %d", next, q.FromBlock.Uint64())
+		}
+		if q.ToBlock.Uint64()-q.FromBlock.Uint64()+1 > logger.maxRange {
+			t.Errorf("Request from %d to %d exceeds the provider limit", q.FromBlock.Uint64(), q.ToBlock.Uint64())
+		}
+		next = q.ToBlock.Uint64() + 1
+	}
+	if next != 250 {
+		t.Errorf("Expected logs to be requested up to block 249, requested up to %d", next-1)
+	}
+
+	// Progress is checkpointed in the database.
+	lastProcessedBlock, err := beaconDB.LoadDepositCache(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lastProcessedBlock.Uint64() != 249 {
+		t.Errorf("Expected last processed block 249, received %v", lastProcessedBlock)
+	}
+}
+
+func TestFetchPastLogs_FailsWhenRangeCannotShrink(t *testing.T) {
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	logger := &rangeLimitedLogger{maxRange: 0}
+	web3Service, err := NewWeb3Service(context.Background(), &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.ContractAddr,
+		Reader:          &goodReader{},
+		Logger:          logger,
+		HTTPLogger:      logger,
+		ContractBackend: testAcc.Backend,
+		BeaconDB:        &db.BeaconDB{},
+		LogChunkSize:    8,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	if err := web3Service.fetchPastLogs(0, 10); err == nil {
+		t.Error("Expected error once the range cannot shrink any further")
+	}
+}
+
+func TestIsLogRangeError(t *testing.T) {
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{err: errors.New("query returned more than 10000 results"), want: true},
+		{err: errors.New("Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"), want: true},
+		{err: errors.New("request timed out"), want: true},
+		{err: context.DeadlineExceeded, want: true},
+		{err: errors.New("connection refused"), want: false},
+	}
+	for _, tt := range tests {
+		if got := isLogRangeError(tt.err); got != tt.want {
+			t.Errorf("isLogRangeError(%v) = %v, want %v", tt.err, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package powchain
+
+import (
+	"fmt"
+	"math/big"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-ssz"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	reorgCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_reorgs_detected",
+		Help: "The number of reorgs of the ETH1.0 chain detected",
+	})
+	revertedDepositsCount = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "powchain_reverted_deposits",
+		Help: "The number of deposits reverted because their ETH1.0 block was reorged out",
+	})
+)
+
+// processNewHead processes every block between the previously observed head
+// and the new one. A reorg is detected when the parent hash of a new header
+// does not match the block known at the previous height. The blocks and the
+// deposits above the fork point are then reverted, and the deposit logs are
+// requested again from the new canonical chain.
+func (w *Web3Service) processNewHead(head *gethTypes.Header) error {
+	if w.blockHeight == nil {
+		w.processSubscribedHeaders(head)
+		return nil
+	}
+	if head.Hash() == w.blockHash {
+		return nil
+	}
+
+	headers, err := w.headersSinceKnownAncestor(head)
+	if err != nil {
+		return err
+	}
+	forkPoint := new(big.Int).Sub(headers[0].Number, big.NewInt(1))
+	if forkPoint.Cmp(w.blockHeight) < 0 {
+		reorgCount.Inc()
+		log.WithFields(logrus.Fields{
+			"depth":       new(big.Int).Sub(w.blockHeight, forkPoint),
+			"oldHeadHash": w.blockHash.Hex(),
+			"newHeadHash": head.Hash().Hex(),
+			"forkPoint":   forkPoint,
+		}).Warn("Detected ETH1.0 chain reorg")
+		for height := new(big.Int).Add(forkPoint, big.NewInt(1)); height.Cmp(w.blockHeight) <= 0; height.Add(height, big.NewInt(1)) {
+			if err := w.blockCache.RemoveBlockByHeight(height); err != nil {
+				return err
+			}
+		}
+		if err := w.revertDepositsAfter(forkPoint); err != nil {
+			return errors.Wrap(err, "could not revert deposits of reorged blocks")
+		}
+	}
+	for _, header := range headers {
+		w.processSubscribedHeaders(header)
+	}
+	return nil
+}
+
+// headersSinceKnownAncestor walks back from the given head until it reaches a
+// block the service has already observed, and returns the headers after that
+// block in ascending order. The walk is bounded by the size of the block
+// cache, past which older blocks are no longer known to the service anyway.
+func (w *Web3Service) headersSinceKnownAncestor(head *gethTypes.Header) ([]*gethTypes.Header, error) {
+	headers := []*gethTypes.Header{head}
+	current := head
+	for len(headers) < maxCacheSize && current.Number.Sign() > 0 {
+		parentHeight := new(big.Int).Sub(current.Number, big.NewInt(1))
+		if parentHeight.Cmp(w.blockHeight) == 0 && current.ParentHash == w.blockHash {
+			break
+		}
+		exists, info, err := w.blockCache.BlockInfoByHeight(parentHeight)
+		if err != nil {
+			return nil, err
+		}
+		if exists && info.Hash == current.ParentHash {
+			break
+		}
+		// Blocks ahead of our head which are not yet cached are fetched to fill
+		// the gap between two heads. Below our head, a missing or different block
+		// means the chain has been reorganized.
+		if !exists && parentHeight.Cmp(w.blockHeight) < 0 {
+			break
+		}
+		parent, err := w.activeBlockFetcher().HeaderByNumber(w.ctx, parentHeight)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not retrieve ETH1.0 chain header at height %d", parentHeight)
+		}
+		if parent.Hash() != current.ParentHash {
+			return nil, errors.Errorf("ETH1.0 chain header at height %d changed while processing new head", parentHeight)
+		}
+		headers = append(headers, parent)
+		current = parent
+	}
+	for i, j := 0, len(headers)-1; i < j; i, j = i+1, j-1 {
+		headers[i], headers[j] = headers[j], headers[i]
+	}
+	return headers, nil
+}
+
+// revertDepositsAfter removes the deposits included in eth1 blocks after the
+// fork point and rebuilds the deposit trie from the remaining deposits. The
+// deposit logs after the fork point are requested again on the next tick.
+func (w *Web3Service) revertDepositsAfter(forkPoint *big.Int) error {
+	w.processingLock.Lock()
+	removed, err := w.beaconDB.RemoveDepositsAfterBlock(w.ctx, forkPoint)
+	if err != nil {
+		w.processingLock.Unlock()
+		return err
+	}
+	if w.lastRequestedBlock.Cmp(forkPoint) > 0 {
+		w.lastRequestedBlock.Set(forkPoint)
+	}
+	if removed == 0 {
+		w.processingLock.Unlock()
+		return nil
+	}
+
+	deposits := w.beaconDB.AllDeposits(w.ctx, nil)
+	depositTrie, err := depositTrieFromDeposits(deposits)
+	if err != nil {
+		w.processingLock.Unlock()
+		return err
+	}
+	w.depositTrie = depositTrie
+	w.lastReceivedMerkleIndex = int64(len(deposits)) - 1
+
+	if !w.chainStarted {
+		// The deposits counting towards the genesis validators are rebuilt
+		// from the remaining deposits.
+		if len(w.chainStartDeposits) > len(deposits) {
+			w.chainStartDeposits = w.chainStartDeposits[:len(deposits)]
+		}
+		w.activeValidatorCount = 0
+		w.depositedPubkeys = make(map[[48]byte]uint64)
+		for _, deposit := range deposits {
+			if err := w.trackDepositedBalance(deposit); err != nil {
+				log.WithError(err).Debug("Invalid deposit kept after reorg")
+			}
+		}
+	}
+	w.processingLock.Unlock()
+
+	revertedDepositsCount.Add(float64(removed))
+	log.WithFields(logrus.Fields{
+		"reverted":    removed,
+		"forkPoint":   forkPoint,
+		"depositRoot": fmt.Sprintf("%#x", depositTrie.Root()),
+	}).Warn("Reverted deposits of reorged ETH1.0 blocks")
+	return w.saveDepositCache()
+}
+
+// depositTrieFromDeposits rebuilds the deposit trie from the given deposits,
+// in order of their index.
+func depositTrieFromDeposits(deposits []*ethpb.Deposit) (*trieutil.MerkleTrie, error) {
+	depth := int(params.BeaconConfig().DepositContractTreeDepth)
+	if len(deposits) == 0 {
+		return trieutil.NewTrie(depth)
+	}
+	depositHashes := make([][]byte, len(deposits))
+	for i, deposit := range deposits {
+		hash, err := ssz.HashTreeRoot(deposit.Data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash deposit data")
+		}
+		depositHashes[i] = hash[:]
+	}
+	depositTrie, err := trieutil.GenerateTrieFromItems(depositHashes, depth)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not rebuild deposit trie")
+	}
+	return depositTrie, nil
+}
@@ -0,0 +1,141 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+// reorgLogger serves the deposit logs of the simulated backend, leaving out
+// the logs of the blocks after the fork point once the chain is reorged.
+type reorgLogger struct {
+	backend   bind.ContractFilterer
+	reorged   bool
+	forkPoint uint64
+}
+
+func (r *reorgLogger) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return r.backend.SubscribeFilterLogs(ctx, q, ch)
+}
+
+func (r *reorgLogger) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	logs, err := r.backend.FilterLogs(ctx, q)
+	if err != nil || !r.reorged {
+		return logs, err
+	}
+	var canonical []gethTypes.Log
+	for _, log := range logs {
+		if log.BlockNumber <= r.forkPoint {
+			canonical = append(canonical, log)
+		}
+	}
+	return canonical, nil
+}
+
+func TestProcessNewHead_ReorgRevertsDeposit(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+	testAcc, err := contracts.Setup()
+	if err != nil {
+		t.Fatalf("Unable to set up simulated backend %v", err)
+	}
+	beaconDB, err := db.SetupDB()
+	if err != nil {
+		t.Fatalf("Could not set up simulated beacon DB: %v", err)
+	}
+	defer db.TeardownDB(beaconDB)
+	logger := &reorgLogger{backend: testAcc.Backend}
+	web3Service, err := NewWeb3Service(ctx, &Web3ServiceConfig{
+		Endpoint:        endpoint,
+		DepositContract: testAcc.ContractAddr,
+		Reader:          &goodReader{},
+		Logger:          logger,
+		HTTPLogger:      logger,
+		ContractBackend: testAcc.Backend,
+		BeaconDB:        beaconDB,
+	})
+	if err != nil {
+		t.Fatalf("unable to setup web3 ETH1.0 chain service: %v", err)
+	}
+	web3Service.chainStarted = true
+
+	// Each deposit is included in its own block.
+	deposits, _ := testutil.SetupInitialDeposits(t, 2)
+	testAcc.TxOpts.Value = contracts.Amount32Eth()
+	testAcc.TxOpts.GasLimit = 1000000
+	depositBlocks := make([]uint64, len(deposits))
+	for i, deposit := range deposits {
+		data := deposit.Data
+		tx, err := testAcc.Contract.Deposit(testAcc.TxOpts, data.PublicKey, data.WithdrawalCredentials, data.Signature)
+		if err != nil {
+			t.Fatalf("Could not deposit to deposit contract %v", err)
+		}
+		testAcc.Backend.Commit()
+		receipt, err := testAcc.Backend.TransactionReceipt(ctx, tx.Hash())
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositBlocks[i] = receipt.BlockNumber.Uint64()
+	}
+	forkPoint := depositBlocks[0]
+	head := depositBlocks[1]
+
+	// The service follows a chain of headers with the heights of the simulated backend.
+	fetcher := newPollingFetcher(forkPoint - 1)
+	web3Service.blockFetcher = fetcher
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	fetcher.extend(forkPoint-1, head, 0)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := web3Service.fetchPastLogs(0, forkPoint); err != nil {
+		t.Fatal(err)
+	}
+	rootBeforeSecondDeposit := web3Service.DepositRoot()
+	if err := web3Service.fetchPastLogs(forkPoint+1, head); err != nil {
+		t.Fatal(err)
+	}
+	if len(beaconDB.AllDeposits(ctx, nil)) != 2 || len(beaconDB.PendingDeposits(ctx, nil)) != 2 {
+		t.Fatalf("Expected 2 deposits before the reorg, received %d", len(beaconDB.AllDeposits(ctx, nil)))
+	}
+
+	// The block of the second deposit is reorged out by a longer fork.
+	logger.reorged = true
+	logger.forkPoint = forkPoint
+	fetcher.extend(forkPoint, head+3, 1)
+	if err := web3Service.pollLatestHeader(); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Reverted deposits of reorged ETH1.0 blocks")
+	if web3Service.lastRequestedBlock.Uint64() != forkPoint {
+		t.Errorf("Expected logs to be requested again from the fork point %d, last requested block is %v", forkPoint, web3Service.lastRequestedBlock)
+	}
+
+	// Deposit logs are requested again from the new canonical chain.
+	web3Service.handleDelayTicker()
+	if len(beaconDB.AllDeposits(ctx, nil)) != 1 {
+		t.Errorf("Expected 1 deposit after the reorg, received %d", len(beaconDB.AllDeposits(ctx, nil)))
+	}
+	if len(beaconDB.PendingDeposits(ctx, nil)) != 1 {
+		t.Errorf("Expected 1 pending deposit after the reorg, received %d", len(beaconDB.PendingDeposits(ctx, nil)))
+	}
+	if web3Service.DepositRoot() != rootBeforeSecondDeposit {
+		t.Errorf("Expected deposit root %#x after the reorg, received %#x", rootBeforeSecondDeposit, web3Service.DepositRoot())
+	}
+	requestedBlock := new(big.Int).Sub(web3Service.blockHeight, big.NewInt(params.BeaconConfig().LogBlockDelay))
+	if web3Service.lastRequestedBlock.Cmp(requestedBlock) != 0 {
+		t.Errorf("Expected logs to be requested up to the new head, last requested block is %v", web3Service.lastRequestedBlock)
+	}
+}
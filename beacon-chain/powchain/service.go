@@ -21,6 +21,7 @@ import (
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -98,6 +99,14 @@ type Web3Service struct {
 	depositedPubkeys        map[[48]byte]uint64
 	eth2GenesisTime         uint64
 	processingLock          sync.RWMutex
+	logChunkSize            uint64
+	headPollInterval        time.Duration
+	endpoints               []*endpointHealth // the primary endpoint followed by the fallback endpoints.
+	activeEndpoint          int
+	endpointsLock           sync.RWMutex
+	healthCheckInterval     time.Duration
+	interop                 bool // there is no ETH1.0 chain, see NewInteropService.
+	clock                   clockutil.Clock
 }
 
 // Web3ServiceConfig defines a config struct for web3 service to use through its life cycle.
@@ -111,16 +120,30 @@ type Web3ServiceConfig struct {
 	BlockFetcher    POWBlockFetcher
 	ContractBackend bind.ContractBackend
 	BeaconDB        *db.BeaconDB
+	LogChunkSize    uint64
+	// HeadPollInterval is the interval at which new headers are requested
+	// when the endpoint is an HTTP endpoint without subscription support.
+	HeadPollInterval time.Duration
+	// FallbackEndpoints are switched to when the endpoint in use is unhealthy.
+	FallbackEndpoints []*Eth1Endpoint
+	// HealthCheckInterval is the interval at which the health of the
+	// endpoints is checked.
+	HealthCheckInterval time.Duration
+	// Clock is the clock the age of ETH1.0 blocks is measured by, the system
+	// clock if it is not set.
+	Clock clockutil.Clock
 }
 
 // NewWeb3Service sets up a new instance with an ethclient when
 // given a web3 endpoint as a string in the config.
 func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Service, error) {
-	if !strings.HasPrefix(config.Endpoint, "ws") && !strings.HasPrefix(config.Endpoint, "ipc") {
-		return nil, fmt.Errorf(
-			"powchain service requires either an IPC or WebSocket endpoint, provided %s",
-			config.Endpoint,
-		)
+	if err := validateEndpoint(config.Endpoint); err != nil {
+		return nil, err
+	}
+	for _, fallback := range config.FallbackEndpoints {
+		if err := validateEndpoint(fallback.URL); err != nil {
+			return nil, err
+		}
 	}
 
 	depositContractCaller, err := contracts.NewDepositContractCaller(config.DepositContract, config.ContractBackend)
@@ -128,6 +151,31 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 		return nil, errors.Wrap(err, "could not create deposit contract caller")
 	}
 
+	logChunkSize := config.LogChunkSize
+	if logChunkSize == 0 {
+		logChunkSize = defaultLogChunkSize
+	}
+	headPollInterval := config.HeadPollInterval
+	if headPollInterval == 0 {
+		headPollInterval = defaultHeadPollInterval
+	}
+	healthCheckInterval := config.HealthCheckInterval
+	if healthCheckInterval == 0 {
+		healthCheckInterval = defaultHealthCheckInterval
+	}
+	endpoints := []*endpointHealth{{
+		url:             config.Endpoint,
+		reader:          config.Reader,
+		logger:          config.Logger,
+		httpLogger:      config.HTTPLogger,
+		blockFetcher:    config.BlockFetcher,
+		client:          config.Client,
+		contractBackend: config.ContractBackend,
+	}}
+	for _, fallback := range config.FallbackEndpoints {
+		endpoints = append(endpoints, newFallbackEndpoint(fallback))
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 	depositTrie, err := trieutil.NewTrie(int(params.BeaconConfig().DepositContractTreeDepth))
 	if err != nil {
@@ -157,13 +205,44 @@ func NewWeb3Service(ctx context.Context, config *Web3ServiceConfig) (*Web3Servic
 		lastRequestedBlock:      big.NewInt(0),
 		chainStartETH1Data:      &ethpb.Eth1Data{},
 		depositedPubkeys:        make(map[[48]byte]uint64),
+		logChunkSize:            logChunkSize,
+		headPollInterval:        headPollInterval,
+		endpoints:               endpoints,
+		healthCheckInterval:     healthCheckInterval,
+		clock:                   config.Clock,
 	}, nil
 }
 
+// wallClock returns the clock of the service, which is the system clock
+// unless the service was configured with another clock.
+func (w *Web3Service) wallClock() clockutil.Clock {
+	if w.clock == nil {
+		return clockutil.System{}
+	}
+	return w.clock
+}
+
+// validateEndpoint checks that the endpoint uses a transport supported by the
+// service.
+func validateEndpoint(endpoint string) error {
+	if !strings.HasPrefix(endpoint, "ws") && !strings.HasPrefix(endpoint, "ipc") && !isHTTPEndpoint(endpoint) {
+		return fmt.Errorf(
+			"powchain service requires either an IPC, WebSocket or HTTP endpoint, provided %s",
+			endpoint,
+		)
+	}
+	return nil
+}
+
 // Start a web3 service's main event loop.
 func (w *Web3Service) Start() {
+	if w.interop {
+		log.WithField("genesisTime", w.eth2GenesisTime).Info("Starting service in interop mode without an ETH1.0 chain")
+		return
+	}
 	log.WithFields(logrus.Fields{
-		"endpoint": w.endpoint,
+		"endpoint":  redactEndpoint(w.activeEndpointURL()),
+		"fallbacks": len(w.endpoints) - 1,
 	}).Info("Starting service")
 	go w.run(w.ctx.Done())
 }
@@ -216,7 +295,7 @@ func (w *Web3Service) Status() error {
 	}
 	// use a 5 minutes timeout for block time, because the max mining time is 278 sec (block 7208027)
 	// (analyzed the time of the block from 2018-09-01 to 2019-02-13)
-	fiveMinutesTimeout := time.Now().Add(-5 * time.Minute)
+	fiveMinutesTimeout := w.wallClock().Now().Add(-5 * time.Minute)
 	// check that web3 client is syncing
 	if w.blockTime.Before(fiveMinutesTimeout) {
 		return errors.New("eth1 client is not syncing")
@@ -248,15 +327,20 @@ func (w *Web3Service) LatestBlockHash() common.Hash {
 
 // Client for interacting with the ETH1.0 chain.
 func (w *Web3Service) Client() Client {
+	w.endpointsLock.RLock()
+	defer w.endpointsLock.RUnlock()
 	return w.client
 }
 
 // AreAllDepositsProcessed determines if all the logs from the deposit contract
 // are processed.
 func (w *Web3Service) AreAllDepositsProcessed() (bool, error) {
+	if w.interop {
+		return true, nil
+	}
 	w.processingLock.RLock()
 	defer w.processingLock.RUnlock()
-	countByte, err := w.depositContractCaller.GetDepositCount(&bind.CallOpts{})
+	countByte, err := w.activeDepositContractCaller().GetDepositCount(&bind.CallOpts{})
 	if err != nil {
 		return false, errors.Wrap(err, "could not get deposit count")
 	}
@@ -271,7 +355,7 @@ func (w *Web3Service) AreAllDepositsProcessed() (bool, error) {
 // initDataFromContract calls the deposit contract and finds the deposit count
 // and deposit root.
 func (w *Web3Service) initDataFromContract() error {
-	root, err := w.depositContractCaller.GetHashTreeRoot(&bind.CallOpts{})
+	root, err := w.activeDepositContractCaller().GetHashTreeRoot(&bind.CallOpts{})
 	if err != nil {
 		return errors.Wrap(err, "could not retrieve deposit root")
 	}
@@ -320,6 +404,7 @@ func (w *Web3Service) handleDelayTicker() {
 	}
 	if err := w.requestBatchedLogs(); err != nil {
 		w.runError = err
+		w.recordEndpointError(err)
 		log.Error(err)
 	}
 }
@@ -333,14 +418,25 @@ func (w *Web3Service) run(done <-chan struct{}) {
 		return
 	}
 
-	headSub, err := w.reader.SubscribeNewHead(w.ctx, w.headerChan)
+	// A single endpoint which cannot be tracked is a fatal error, otherwise
+	// the service fails over to the next endpoint.
+	var tracker *headTracker
+	var err error
+	if len(w.endpoints) > 1 {
+		tracker, err = w.trackHeadWithFailover()
+	} else {
+		tracker, err = w.trackHead()
+	}
 	if err != nil {
 		log.Errorf("Unable to subscribe to incoming ETH1.0 chain headers: %v", err)
 		w.runError = err
 		return
 	}
+	defer func() {
+		tracker.stop()
+	}()
 
-	header, err := w.blockFetcher.HeaderByNumber(w.ctx, nil)
+	header, err := w.activeBlockFetcher().HeaderByNumber(w.ctx, nil)
 	if err != nil {
 		log.Errorf("Unable to retrieve latest ETH1.0 chain header: %v", err)
 		w.runError = err
@@ -357,8 +453,15 @@ func (w *Web3Service) run(done <-chan struct{}) {
 	}
 
 	ticker := time.NewTicker(1 * time.Second)
-	defer headSub.Unsubscribe()
 	defer ticker.Stop()
+	// The health of the endpoints is only checked when there are endpoints
+	// to fail over to.
+	var healthTick <-chan time.Time
+	if len(w.endpoints) > 1 {
+		healthTicker := time.NewTicker(w.healthCheckInterval)
+		defer healthTicker.Stop()
+		healthTick = healthTicker.C
+	}
 
 	for {
 		select {
@@ -367,13 +470,51 @@ func (w *Web3Service) run(done <-chan struct{}) {
 			w.runError = nil
 			log.Debug("ETH1.0 chain service context closed, exiting goroutine")
 			return
-		case w.runError = <-headSub.Err():
-			log.Debugf("Unsubscribed to head events, exiting goroutine: %v", w.runError)
-			return
+		case err := <-tracker.err:
+			w.runError = err
+			if len(w.endpoints) < 2 {
+				log.Debugf("Unsubscribed to head events, exiting goroutine: %v", w.runError)
+				return
+			}
+			log.WithError(err).Warn("Lost subscription to ETH1.0 chain headers")
+			tracker.stop()
+			if !w.failOver(err) {
+				// Without a healthy endpoint to switch to, the subscription is
+				// retried on the next health check.
+				tracker = &headTracker{failed: true}
+				continue
+			}
+			if tracker, err = w.trackHeadWithFailover(); err != nil {
+				log.WithError(err).Error("Unable to track head of any ETH1.0 endpoint")
+				tracker = &headTracker{failed: true}
+			}
 		case header, ok := <-w.headerChan:
-			if ok {
-				w.processSubscribedHeaders(header)
+			if !ok {
+				continue
+			}
+			if err := w.processNewHead(header); err != nil {
+				log.Errorf("Unable to process new ETH1.0 chain header: %v", err)
+			}
+		case <-tracker.poll:
+			w.runError = w.pollLatestHeader()
+			if w.runError != nil {
+				w.recordEndpointError(w.runError)
+				log.Errorf("Unable to poll latest ETH1.0 chain header: %v", w.runError)
 			}
+		case <-healthTick:
+			w.checkEndpointHealth()
+			if !w.selectEndpoint() && !tracker.failed {
+				continue
+			}
+			tracker.stop()
+			newTracker, err := w.trackHeadWithFailover()
+			if err != nil {
+				log.WithError(err).Error("Unable to track head of any ETH1.0 endpoint")
+				tracker = &headTracker{failed: true}
+				continue
+			}
+			tracker = newTracker
+			w.runError = nil
 		case <-ticker.C:
 			w.handleDelayTicker()
 		}
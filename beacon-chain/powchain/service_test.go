@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	contracts "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
 	depositcontract "github.com/prysmaticlabs/prysm/contracts/deposit-contract"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -102,8 +103,8 @@ func TestNewWeb3Service_OK(t *testing.T) {
 		DepositContract: common.Address{},
 		Reader:          &goodReader{},
 		Logger:          &goodLogger{},
-	}); err == nil {
-		t.Errorf("passing in an HTTP endpoint should throw an error, received nil")
+	}); err != nil {
+		t.Errorf("passing in an HTTP endpoint should not throw error, received %v", err)
 	}
 	endpoint = "ftp://127.0.0.1"
 	if _, err = NewWeb3Service(ctx, &Web3ServiceConfig{
@@ -297,6 +298,18 @@ func TestStatus(t *testing.T) {
 	}
 }
 
+func TestStatus_FollowsClock(t *testing.T) {
+	clock := clockutil.NewManual(time.Unix(1567000000, 0))
+	w := &Web3Service{isRunning: true, blockTime: clock.Now(), clock: clock}
+	if err := w.Status(); err != nil {
+		t.Fatalf("Expected a healthy status, received %v", err)
+	}
+	clock.Advance(5*time.Minute + time.Second)
+	if err := w.Status(); err == nil || err.Error() != "eth1 client is not syncing" {
+		t.Errorf("Expected the eth1 client not to be syncing, received %v", err)
+	}
+}
+
 func TestHandlePanic_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 
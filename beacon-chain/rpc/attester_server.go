@@ -3,7 +3,10 @@ package rpc
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
@@ -22,10 +25,15 @@ import (
 // AttesterServer defines a server implementation of the gRPC Attester service,
 // providing RPC methods for validators acting as attesters to broadcast votes on beacon blocks.
 type AttesterServer struct {
+	ctx              context.Context
 	p2p              p2p.Broadcaster
+	subnets          p2p.SubnetManager
 	beaconDB         *db.BeaconDB
 	operationService operationService
 	cache            *cache.AttestationCache
+	aggregationDelay time.Duration
+	aggregatingLock  sync.Mutex
+	aggregating      map[[32]byte]bool
 }
 
 // SubmitAttestation is a function called by an attester in a sharding validator to vote
@@ -61,13 +69,88 @@ func (as *AttesterServer) SubmitAttestation(ctx context.Context, att *ethpb.Atte
 		return nil, fmt.Errorf("could not save attestation target")
 	}
 
-	as.p2p.Broadcast(ctx, &pbp2p.AttestationAnnounce{
-		Hash: h[:],
+	// Unaggregated attestations are only gossiped on the subnet of the
+	// committee. The node aggregates the attestations of the committee it
+	// receives on the subnet and broadcasts the aggregate globally.
+	subnet := as.subnets.AttestationSubnetForShard(att.Data.Crosslink.Shard)
+	as.subnets.BroadcastToSubnet(ctx, subnet, &pbp2p.UnaggregatedAttestation{
+		Attestation: att,
 	})
+	as.scheduleAggregation(att.Data)
 
 	return &pb.AttestResponse{Root: h[:]}, nil
 }
 
+// scheduleAggregation broadcasts the aggregate of the attestations for the
+// given data once the aggregation delay has passed. Attestations of several
+// local validators for the same data result in a single aggregate.
+func (as *AttesterServer) scheduleAggregation(data *ethpb.AttestationData) {
+	root, err := hashutil.HashProto(data)
+	if err != nil {
+		log.WithError(err).Error("Could not hash attestation data")
+		return
+	}
+	as.aggregatingLock.Lock()
+	defer as.aggregatingLock.Unlock()
+	if as.aggregating == nil {
+		as.aggregating = make(map[[32]byte]bool)
+	}
+	if as.aggregating[root] {
+		return
+	}
+	as.aggregating[root] = true
+
+	go func() {
+		defer func() {
+			as.aggregatingLock.Lock()
+			delete(as.aggregating, root)
+			as.aggregatingLock.Unlock()
+		}()
+		select {
+		case <-as.ctx.Done():
+			return
+		case <-time.After(as.aggregationDelay):
+		}
+		if err := as.aggregateAndBroadcast(as.ctx, data); err != nil {
+			log.WithError(err).Error("Could not broadcast aggregate attestation")
+		}
+	}()
+}
+
+// aggregateAndBroadcast aggregates the known attestations for the given data
+// and broadcasts the aggregate on the global aggregate attestation topic.
+func (as *AttesterServer) aggregateAndBroadcast(ctx context.Context, data *ethpb.AttestationData) error {
+	atts, err := as.beaconDB.Attestations()
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve attestations")
+	}
+	var aggregate *ethpb.Attestation
+	for _, att := range atts {
+		if !proto.Equal(att.Data, data) {
+			continue
+		}
+		if aggregate == nil {
+			aggregate = att
+			continue
+		}
+		aggregated, err := helpers.AggregateAttestation(aggregate, att)
+		if err == helpers.ErrAttestationAggregationBitsOverlap {
+			continue
+		}
+		if err != nil {
+			return errors.Wrap(err, "could not aggregate attestation")
+		}
+		aggregate = aggregated
+	}
+	if aggregate == nil {
+		return nil
+	}
+	as.p2p.Broadcast(ctx, &pbp2p.AggregateAttestation{
+		Aggregate: aggregate,
+	})
+	return nil
+}
+
 // RequestAttestation requests that the beacon node produce an IndexedAttestation,
 // with a blank signature field, which the validator will then sign.
 func (as *AttesterServer) RequestAttestation(ctx context.Context, req *pb.AttestationRequest) (*ethpb.AttestationData, error) {
@@ -112,15 +195,16 @@ func (as *AttesterServer) RequestAttestation(ctx context.Context, req *pb.Attest
 	}
 
 	// Let head state be the state of head block processed through empty slots up to assigned slot.
-	headState, err := as.beaconDB.HeadState(ctx)
+	s, err := as.beaconDB.HeadStateTrie(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not fetch head state")
 	}
 
-	headState, err = state.ProcessSlots(ctx, headState, req.Slot)
+	s, err = state.ProcessSlots(ctx, s, req.Slot)
 	if err != nil {
 		return nil, errors.Wrapf(err, "could not process slots up to %d", req.Slot)
 	}
+	headState := s.InnerStateUnsafe()
 
 	targetEpoch := helpers.CurrentEpoch(headState)
 	epochStartSlot := helpers.StartSlot(targetEpoch)
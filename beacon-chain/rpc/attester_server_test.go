@@ -2,10 +2,13 @@ package rpc
 
 import (
 	"context"
+	"crypto/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -13,6 +16,7 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
@@ -22,15 +26,62 @@ type mockBroadcaster struct{}
 func (m *mockBroadcaster) Broadcast(ctx context.Context, msg proto.Message) {
 }
 
+const mockSubnetCount = 8
+
+type mockSubnetManager struct {
+	lock      sync.Mutex
+	joined    []uint64
+	expiries  []time.Time
+	broadcast map[uint64][]proto.Message
+}
+
+func (m *mockSubnetManager) AttestationSubnetForShard(shard uint64) uint64 {
+	return shard % mockSubnetCount
+}
+
+func (m *mockSubnetManager) JoinAttestationSubnet(subnet uint64, expiry time.Time) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.joined = append(m.joined, subnet)
+	m.expiries = append(m.expiries, expiry)
+	return nil
+}
+
+func (m *mockSubnetManager) BroadcastToSubnet(ctx context.Context, subnet uint64, msg proto.Message) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.broadcast == nil {
+		m.broadcast = make(map[uint64][]proto.Message)
+	}
+	m.broadcast[subnet] = append(m.broadcast[subnet], msg)
+}
+
+type recordingBroadcaster struct {
+	lock sync.Mutex
+	msgs []proto.Message
+}
+
+func (r *recordingBroadcaster) Broadcast(ctx context.Context, msg proto.Message) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.msgs = append(r.msgs, msg)
+}
+
 func TestSubmitAttestation_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	mockOperationService := &mockOperationService{}
+	subnets := &mockSubnetManager{}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	attesterServer := &AttesterServer{
+		ctx:              ctx,
 		operationService: mockOperationService,
 		p2p:              &mockBroadcaster{},
+		subnets:          subnets,
 		beaconDB:         db,
 		cache:            cache.NewAttestationCache(),
+		aggregationDelay: time.Hour,
 	}
 	head := &ethpb.BeaconBlock{
 		Slot:       999,
@@ -77,6 +128,57 @@ func TestSubmitAttestation_OK(t *testing.T) {
 	if _, err := attesterServer.SubmitAttestation(context.Background(), req); err != nil {
 		t.Errorf("Could not attest head correctly: %v", err)
 	}
+	wantSubnet := uint64(935 % mockSubnetCount)
+	if len(subnets.broadcast[wantSubnet]) != 1 {
+		t.Errorf("Expected the attestation to be broadcast on subnet %d, broadcasts: %v", wantSubnet, subnets.broadcast)
+	}
+}
+
+func TestAggregateAndBroadcast_AggregatesDisjointAttestations(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	broadcaster := &recordingBroadcaster{}
+	attesterServer := &AttesterServer{
+		p2p:      broadcaster,
+		beaconDB: db,
+	}
+	data := &ethpb.AttestationData{
+		Crosslink: &ethpb.Crosslink{Shard: 3},
+		Source:    &ethpb.Checkpoint{},
+		Target:    &ethpb.Checkpoint{Epoch: 1},
+	}
+	otherData := proto.Clone(data).(*ethpb.AttestationData)
+	otherData.Crosslink.Shard = 4
+	for i, d := range []*ethpb.AttestationData{data, data, otherData} {
+		key, err := bls.RandKey(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bits := bitfield.NewBitlist(4)
+		bits.SetBitAt(uint64(i), true)
+		att := &ethpb.Attestation{
+			AggregationBits: bits,
+			Data:            d,
+			CustodyBits:     bitfield.NewBitlist(4),
+			Signature:       key.Sign([]byte{'a'}, 0).Marshal(),
+		}
+		if err := db.SaveAttestation(context.Background(), att); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := attesterServer.aggregateAndBroadcast(context.Background(), data); err != nil {
+		t.Fatal(err)
+	}
+	if len(broadcaster.msgs) != 1 {
+		t.Fatalf("Expected 1 aggregate to be broadcast, received %d", len(broadcaster.msgs))
+	}
+	aggregate := broadcaster.msgs[0].(*pbp2p.AggregateAttestation).Aggregate
+	for i, want := range []bool{true, true, false, false} {
+		if aggregate.AggregationBits.BitAt(uint64(i)) != want {
+			t.Errorf("Expected aggregation bit %d to be %v", i, want)
+		}
+	}
 }
 
 func TestRequestAttestation_OK(t *testing.T) {
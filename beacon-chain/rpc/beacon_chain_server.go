@@ -5,18 +5,25 @@ import (
 	"sort"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/epoch"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sszproof"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// maxProofPaths is the maximum number of fields proven by a single state proof.
+const maxProofPaths = 256
+
 // BeaconChainServer defines a server implementation of the gRPC Beacon Chain service,
 // providing RPC endpoints to access data relevant to the Ethereum 2.0 phase 0
 // beacon chain.
@@ -197,14 +204,37 @@ func (bs *BeaconChainServer) ListBlocks(
 func (bs *BeaconChainServer) GetChainHead(
 	ctx context.Context, _ *ptypes.Empty,
 ) (*ethpb.ChainHead, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	head, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve chain head: %v", err)
+	}
+	if head == nil {
+		return nil, status.Error(codes.Internal, "no chain head saved")
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not hash chain head: %v", err)
+	}
+	s, err := bs.headState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.ChainHead{
+		BlockRoot:                  headRoot[:],
+		BlockSlot:                  head.Slot,
+		FinalizedSlot:              helpers.StartSlot(s.FinalizedCheckpoint.Epoch),
+		FinalizedBlockRoot:         s.FinalizedCheckpoint.Root,
+		JustifiedSlot:              helpers.StartSlot(s.CurrentJustifiedCheckpoint.Epoch),
+		JustifiedBlockRoot:         s.CurrentJustifiedCheckpoint.Root,
+		PreviousJustifiedSlot:      helpers.StartSlot(s.PreviousJustifiedCheckpoint.Epoch),
+		PreviousJustifiedBlockRoot: s.PreviousJustifiedCheckpoint.Root,
+	}, nil
 }
 
 // ListValidatorBalances retrieves the validator balances for a given set of public key at
-// a specific epoch in time.
-//
-// TODO(#3064): Implement balances for a specific epoch. Current implementation returns latest balances,
-// this is blocked by DB refactor.
+// a specific epoch in time. The balances are read from the selected state, or from the
+// state at the start of the requested epoch.
 func (bs *BeaconChainServer) ListValidatorBalances(
 	ctx context.Context,
 	req *ethpb.GetValidatorBalancesRequest) (*ethpb.ValidatorBalances, error) {
@@ -212,14 +242,19 @@ func (bs *BeaconChainServer) ListValidatorBalances(
 	res := make([]*ethpb.ValidatorBalances_Balance, 0, len(req.PublicKeys)+len(req.Indices))
 	filtered := map[uint64]bool{} // track filtered validators to prevent duplication in the response.
 
-	balances, err := bs.beaconDB.Balances(ctx)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve validator balances: %v", err)
+	var s *pbp2p.BeaconState
+	var err error
+	if req.GetGenesis() && req.State == nil {
+		s, err = bs.stateAtSlot(ctx, 0)
+	} else {
+		_, hasEpoch := req.QueryFilter.(*ethpb.GetValidatorBalancesRequest_Epoch)
+		s, err = bs.epochState(ctx, req.State, req.GetEpoch(), hasEpoch, helpers.StartSlot(req.GetEpoch()))
 	}
-	validators, err := bs.beaconDB.Validators(ctx)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve validators: %v", err)
+		return nil, err
 	}
+	balances := s.Balances
+	validators := s.Validators
 
 	for _, pubKey := range req.PublicKeys {
 		// Skip empty public key
@@ -263,10 +298,8 @@ func (bs *BeaconChainServer) ListValidatorBalances(
 }
 
 // GetValidators retrieves the current list of active validators with an optional historical epoch flag to
-// to retrieve validator set in time.
-//
-// TODO(#3064): Implement validator set for a specific epoch. Current implementation returns latest set,
-// this is blocked by DB refactor.
+// to retrieve validator set in time. The validators are read from the selected state, or from the
+// state at the start of the requested epoch.
 func (bs *BeaconChainServer) GetValidators(
 	ctx context.Context,
 	req *ethpb.GetValidatorsRequest) (*ethpb.Validators, error) {
@@ -275,10 +308,18 @@ func (bs *BeaconChainServer) GetValidators(
 			req.PageSize, params.BeaconConfig().MaxPageSize)
 	}
 
-	validators, err := bs.beaconDB.Validators(ctx)
+	var s *pbp2p.BeaconState
+	var err error
+	if req.GetGenesis() && req.State == nil {
+		s, err = bs.stateAtSlot(ctx, 0)
+	} else {
+		_, hasEpoch := req.QueryFilter.(*ethpb.GetValidatorsRequest_Epoch)
+		s, err = bs.epochState(ctx, req.State, req.GetEpoch(), hasEpoch, helpers.StartSlot(req.GetEpoch()))
+	}
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve validators: %v", err)
+		return nil, err
 	}
+	validators := s.Validators
 	validatorCount := len(validators)
 
 	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), validatorCount)
@@ -287,6 +328,7 @@ func (bs *BeaconChainServer) GetValidators(
 	}
 
 	res := &ethpb.Validators{
+		Epoch:         helpers.CurrentEpoch(s),
 		Validators:    validators[start:end],
 		TotalSize:     int32(validatorCount),
 		NextPageToken: nextPageToken,
@@ -304,18 +346,62 @@ func (bs *BeaconChainServer) GetValidatorActiveSetChanges(
 	return nil, status.Error(codes.Unimplemented, "not implemented")
 }
 
-// GetValidatorQueue retrieves the current validator queue information.
+// GetValidatorQueue retrieves the validator queue information of the selected
+// state. The activation queue is ordered by activation eligibility epoch and the
+// exit queue by exit epoch, ties are broken by validator index.
 func (bs *BeaconChainServer) GetValidatorQueue(
-	ctx context.Context, _ *ptypes.Empty,
+	ctx context.Context, req *ethpb.GetValidatorQueueRequest,
 ) (*ethpb.ValidatorQueue, error) {
-	return nil, status.Error(codes.Unimplemented, "not implemented")
+	s, err := bs.selectState(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+	churnLimit, err := helpers.ValidatorChurnLimit(s)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not compute churn limit: %v", err)
+	}
+
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	currentEpoch := helpers.CurrentEpoch(s)
+	activationFloor := helpers.DelayedActivationExitEpoch(s.FinalizedCheckpoint.Epoch)
+	var activationQ, exitQ []uint64
+	for idx, validator := range s.Validators {
+		eligibleActivated := validator.ActivationEligibilityEpoch != farFutureEpoch
+		if eligibleActivated && validator.ActivationEpoch >= activationFloor {
+			activationQ = append(activationQ, uint64(idx))
+		}
+		if validator.ExitEpoch != farFutureEpoch && validator.ExitEpoch > currentEpoch {
+			exitQ = append(exitQ, uint64(idx))
+		}
+	}
+	// The indices are collected in increasing order, a stable sort keeps
+	// them ordered within the same epoch.
+	sort.SliceStable(activationQ, func(i, j int) bool {
+		return s.Validators[activationQ[i]].ActivationEligibilityEpoch <
+			s.Validators[activationQ[j]].ActivationEligibilityEpoch
+	})
+	sort.SliceStable(exitQ, func(i, j int) bool {
+		return s.Validators[exitQ[i]].ExitEpoch < s.Validators[exitQ[j]].ExitEpoch
+	})
+
+	activationKeys := make([][]byte, len(activationQ))
+	for i, idx := range activationQ {
+		activationKeys[i] = s.Validators[idx].PublicKey
+	}
+	exitKeys := make([][]byte, len(exitQ))
+	for i, idx := range exitQ {
+		exitKeys[i] = s.Validators[idx].PublicKey
+	}
+	return &ethpb.ValidatorQueue{
+		ChurnLimit:           churnLimit,
+		ActivationPublicKeys: activationKeys,
+		ExitPublicKeys:       exitKeys,
+	}, nil
 }
 
 // ListValidatorAssignments retrieves the validator assignments for a given epoch,
 // optional validator indices or public keys may be included to filter validator assignments.
-//
-// TODO(#3045): Implement validator set for a specific epoch. Current implementation returns latest set,
-// this is blocked by DB refactor.
+// Past epochs are computed from the state at their start slot.
 func (bs *BeaconChainServer) ListValidatorAssignments(
 	ctx context.Context, req *ethpb.ListValidatorAssignmentsRequest,
 ) (*ethpb.ValidatorAssignments, error) {
@@ -324,10 +410,14 @@ func (bs *BeaconChainServer) ListValidatorAssignments(
 			req.PageSize, params.BeaconConfig().MaxPageSize)
 	}
 
-	e := req.Epoch
-	s, err := bs.beaconDB.HeadState(ctx)
+	_, hasEpoch := req.QueryFilter.(*ethpb.ListValidatorAssignmentsRequest_Epoch)
+	e := req.GetEpoch()
+	s, err := bs.epochState(ctx, req.State, e, hasEpoch || req.GetGenesis(), helpers.StartSlot(e))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve current state: %v", err)
+		return nil, err
+	}
+	if !hasEpoch && !req.GetGenesis() {
+		e = helpers.CurrentEpoch(s)
 	}
 
 	var res []*ethpb.ValidatorAssignments_CommitteeAssignment
@@ -399,7 +489,7 @@ func (bs *BeaconChainServer) ListValidatorAssignments(
 	}
 
 	// If no filter was specified, return assignments from active validator indices with pagination.
-	activeIndices, err := helpers.ActiveValidatorIndices(s, req.Epoch)
+	activeIndices, err := helpers.ActiveValidatorIndices(s, e)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not retrieve active validator indices: %v", err)
 	}
@@ -433,17 +523,17 @@ func (bs *BeaconChainServer) ListValidatorAssignments(
 }
 
 // GetValidatorParticipation retrieves the validator participation information for a given epoch,
-// it returns the information about validator's participation rate
-//
-// TODO(#3064): Implement validator participation for a specific epoch. Current implementation returns latest,
-// this is blocked by DB refactor.
+// it returns the information about validator's participation rate. Past epochs are computed
+// from the state at their last slot, before the epoch transition clears their attestations.
 func (bs *BeaconChainServer) GetValidatorParticipation(
 	ctx context.Context, req *ethpb.GetValidatorParticipationRequest,
 ) (*ethpb.ValidatorParticipation, error) {
 
-	s, err := bs.beaconDB.HeadState(ctx)
+	_, hasEpoch := req.QueryFilter.(*ethpb.GetValidatorParticipationRequest_Epoch)
+	e := req.GetEpoch()
+	s, err := bs.epochState(ctx, req.State, e, hasEpoch || req.GetGenesis(), helpers.StartSlot(e+1)-1)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "could not retrieve current state: %v", err)
+		return nil, err
 	}
 
 	currentEpoch := helpers.SlotToEpoch(s.Slot)
@@ -471,3 +561,123 @@ func (bs *BeaconChainServer) GetValidatorParticipation(
 		EligibleEther:           totalBalances,
 	}, nil
 }
+
+// GetValidatorRewards retrieves the rewards and penalties of validators for the attestations of a
+// given epoch. They are computed from the state at the last slot of the following epoch, before the
+// epoch transition applies them to the balances.
+func (bs *BeaconChainServer) GetValidatorRewards(
+	ctx context.Context, req *ethpb.GetValidatorRewardsRequest,
+) (*ethpb.ValidatorRewards, error) {
+	head, err := bs.headState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	slot := helpers.StartSlot(req.Epoch+2) - 1
+	if slot > head.Slot {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"rewards of epoch %d are applied after slot %d, ahead of the head slot %d", req.Epoch, slot, head.Slot)
+	}
+	s, err := bs.stateAtSlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+	breakdown, err := state.EpochRewardsBreakdown(ctx, s)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not compute rewards breakdown: %v", err)
+	}
+
+	rewardsFor := func(index uint64) *ethpb.ValidatorRewards_Breakdown {
+		return &ethpb.ValidatorRewards_Breakdown{
+			PublicKey:            s.Validators[index].PublicKey,
+			Index:                index,
+			SourceReward:         breakdown.Source.Rewards[index],
+			SourcePenalty:        breakdown.Source.Penalties[index],
+			TargetReward:         breakdown.Target.Rewards[index],
+			TargetPenalty:        breakdown.Target.Penalties[index],
+			HeadReward:           breakdown.Head.Rewards[index],
+			HeadPenalty:          breakdown.Head.Penalties[index],
+			InclusionDelayReward: breakdown.InclusionDelay.Rewards[index],
+			CrosslinkReward:      breakdown.Crosslink.Rewards[index],
+			CrosslinkPenalty:     breakdown.Crosslink.Penalties[index],
+			InactivityPenalty:    breakdown.Inactivity.Penalties[index],
+			ProposerReward:       breakdown.Proposer.Rewards[index],
+		}
+	}
+
+	if len(req.PublicKeys) == 0 && len(req.Indices) == 0 {
+		res := make([]*ethpb.ValidatorRewards_Breakdown, len(s.Validators))
+		for i := range s.Validators {
+			res[i] = rewardsFor(uint64(i))
+		}
+		return &ethpb.ValidatorRewards{Epoch: req.Epoch, Rewards: res}, nil
+	}
+
+	res := make([]*ethpb.ValidatorRewards_Breakdown, 0, len(req.PublicKeys)+len(req.Indices))
+	filtered := map[uint64]bool{} // track filtered validators to prevent duplication in the response.
+	for _, pubKey := range req.PublicKeys {
+		// Skip empty public key
+		if len(pubKey) == 0 {
+			continue
+		}
+
+		index, err := bs.beaconDB.ValidatorIndex(pubKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve validator index: %v", err)
+		}
+		if int(index) >= len(s.Validators) {
+			return nil, status.Errorf(codes.InvalidArgument, "validator index %d >= validator count %d",
+				index, len(s.Validators))
+		}
+		if !filtered[index] {
+			filtered[index] = true
+			res = append(res, rewardsFor(index))
+		}
+	}
+
+	for _, index := range req.Indices {
+		if int(index) >= len(s.Validators) {
+			return nil, status.Errorf(codes.InvalidArgument, "validator index %d >= validator count %d",
+				index, len(s.Validators))
+		}
+		if !filtered[index] {
+			filtered[index] = true
+			res = append(res, rewardsFor(index))
+		}
+	}
+	return &ethpb.ValidatorRewards{Epoch: req.Epoch, Rewards: res}, nil
+}
+
+// GetStateProof retrieves the leaves of the requested fields of a state with a
+// multiproof of them against the state root.
+func (bs *BeaconChainServer) GetStateProof(
+	ctx context.Context, req *ethpb.StateProofRequest,
+) (*ethpb.StateProof, error) {
+	if len(req.Paths) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no field paths requested")
+	}
+	if len(req.Paths) > maxProofPaths {
+		return nil, status.Errorf(codes.InvalidArgument, "requested %d field paths, the maximum is %d",
+			len(req.Paths), maxProofPaths)
+	}
+
+	s, err := bs.selectState(ctx, req.State)
+	if err != nil {
+		return nil, err
+	}
+	proof, err := sszproof.Prove(s, req.Paths)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "could not prove state fields: %v", err)
+	}
+	root, err := sszproof.HashTreeRoot(s)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not hash state: %v", err)
+	}
+
+	return &ethpb.StateProof{
+		StateRoot:          root[:],
+		Slot:               s.Slot,
+		GeneralizedIndices: proof.Indices,
+		Leaves:             proof.Leaves,
+		Hashes:             proof.Hashes,
+	}, nil
+}
@@ -13,10 +13,17 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sszproof"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type mockPool struct{}
@@ -376,6 +383,154 @@ func TestBeaconChainServer_ListValidatorBalancesOutOfRange(t *testing.T) {
 	}
 }
 
+func TestBeaconChainServer_ListValidatorBalancesFromHistoricalState(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	validators := []*ethpb.Validator{{PublicKey: []byte{0}}, {PublicKey: []byte{1}}}
+	oldBlock := &ethpb.BeaconBlock{Slot: 2, StateRoot: bytesutil.Bytes32(1)}
+	oldState := &pbp2p.BeaconState{Slot: 2, Validators: validators, Balances: []uint64{10, 11}}
+	oldRoot, err := ssz.SigningRoot(oldBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headBlock := &ethpb.BeaconBlock{Slot: 4, ParentRoot: oldRoot[:], StateRoot: bytesutil.Bytes32(2)}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = make([]byte, 32)
+	}
+	// The slot 3 was skipped, the old block is the latest block at both slots.
+	blockRoots[2] = oldRoot[:]
+	blockRoots[3] = oldRoot[:]
+	headState := &pbp2p.BeaconState{Slot: 4, Validators: validators, Balances: []uint64{20, 21}, BlockRoots: blockRoots}
+	for _, c := range []struct {
+		block *ethpb.BeaconBlock
+		state *pbp2p.BeaconState
+	}{{oldBlock, oldState}, {headBlock, headState}} {
+		if err := db.SaveBlock(c.block); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, c.block, c.state); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(c.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveHistoricalState(ctx, c.state, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	tests := []struct {
+		state   *ethpb.StateSelector
+		balance uint64
+	}{
+		{state: nil, balance: 20},
+		{state: &ethpb.StateSelector{Selector: &ethpb.StateSelector_Head{Head: true}}, balance: 20},
+		{state: &ethpb.StateSelector{Selector: &ethpb.StateSelector_Slot{Slot: 2}}, balance: 10},
+		{state: &ethpb.StateSelector{Selector: &ethpb.StateSelector_BlockRoot{BlockRoot: oldRoot[:]}}, balance: 10},
+		{state: &ethpb.StateSelector{Selector: &ethpb.StateSelector_StateRoot{StateRoot: oldBlock.StateRoot}}, balance: 10},
+	}
+	for _, test := range tests {
+		res, err := bs.ListValidatorBalances(ctx, &ethpb.GetValidatorBalancesRequest{Indices: []uint64{0}, State: test.state})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Balances) != 1 || res.Balances[0].Balance != test.balance {
+			t.Errorf("Expected balance %d for selector %v, received %v", test.balance, test.state, res.Balances)
+		}
+	}
+
+	errTests := []struct {
+		req  *ethpb.GetValidatorBalancesRequest
+		code codes.Code
+	}{
+		{req: &ethpb.GetValidatorBalancesRequest{State: &ethpb.StateSelector{Selector: &ethpb.StateSelector_Slot{Slot: 5}}},
+			code: codes.InvalidArgument},
+		{req: &ethpb.GetValidatorBalancesRequest{State: &ethpb.StateSelector{Selector: &ethpb.StateSelector_BlockRoot{BlockRoot: make([]byte, 32)}}},
+			code: codes.NotFound},
+		{req: &ethpb.GetValidatorBalancesRequest{State: &ethpb.StateSelector{Selector: &ethpb.StateSelector_StateRoot{StateRoot: make([]byte, 32)}}},
+			code: codes.NotFound},
+		{req: &ethpb.GetValidatorBalancesRequest{State: &ethpb.StateSelector{Selector: &ethpb.StateSelector_StateRoot{StateRoot: []byte{'a'}}}},
+			code: codes.InvalidArgument},
+		{req: &ethpb.GetValidatorBalancesRequest{QueryFilter: &ethpb.GetValidatorBalancesRequest_Epoch{Epoch: 2}}, code: codes.InvalidArgument},
+	}
+	for _, test := range errTests {
+		if _, err := bs.ListValidatorBalances(ctx, test.req); status.Code(err) != test.code {
+			t.Errorf("Expected code %v for request %v, received %v", test.code, test.req, err)
+		}
+	}
+}
+
+func TestBeaconChainServer_ListValidatorBalancesEpochZero(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	validators := []*ethpb.Validator{{PublicKey: []byte{0}}}
+	genesisBlock := &ethpb.BeaconBlock{Slot: 0}
+	genesisState := &pbp2p.BeaconState{Slot: 0, Validators: validators, Balances: []uint64{10}}
+	genesisRoot, err := ssz.SigningRoot(genesisBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	headSlot := params.BeaconConfig().SlotsPerEpoch + 2
+	headBlock := &ethpb.BeaconBlock{Slot: headSlot, ParentRoot: genesisRoot[:]}
+	blockRoots := make([][]byte, params.BeaconConfig().SlotsPerHistoricalRoot)
+	for i := range blockRoots {
+		blockRoots[i] = genesisRoot[:]
+	}
+	headState := &pbp2p.BeaconState{Slot: headSlot, Validators: validators, Balances: []uint64{20}, BlockRoots: blockRoots}
+	for _, c := range []struct {
+		block *ethpb.BeaconBlock
+		state *pbp2p.BeaconState
+	}{{genesisBlock, genesisState}, {headBlock, headState}} {
+		if err := db.SaveBlock(c.block); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.UpdateChainHead(ctx, c.block, c.state); err != nil {
+			t.Fatal(err)
+		}
+		root, err := ssz.SigningRoot(c.block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.SaveHistoricalState(ctx, c.state, root); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	// An explicit zero epoch selects the epoch 0, not the head.
+	tests := []struct {
+		req     *ethpb.GetValidatorBalancesRequest
+		balance uint64
+	}{
+		{req: &ethpb.GetValidatorBalancesRequest{}, balance: 20},
+		{req: &ethpb.GetValidatorBalancesRequest{QueryFilter: &ethpb.GetValidatorBalancesRequest_Epoch{Epoch: 0}}, balance: 10},
+		{req: &ethpb.GetValidatorBalancesRequest{QueryFilter: &ethpb.GetValidatorBalancesRequest_Genesis{Genesis: true}}, balance: 10},
+		{req: &ethpb.GetValidatorBalancesRequest{QueryFilter: &ethpb.GetValidatorBalancesRequest_Epoch{Epoch: 1}}, balance: 20},
+	}
+	for _, test := range tests {
+		test.req.Indices = []uint64{0}
+		res, err := bs.ListValidatorBalances(ctx, test.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Balances) != 1 || res.Balances[0].Balance != test.balance {
+			t.Errorf("Expected balance %d for request %v, received %v", test.balance, test.req, res.Balances)
+		}
+	}
+}
+
 func TestBeaconChainServer_GetValidatorsNoPagination(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -580,7 +735,7 @@ func TestBeaconChainServer_ListAssignmentsInputOutOfRange(t *testing.T) {
 	bs := &BeaconChainServer{beaconDB: db}
 
 	wanted := fmt.Sprintf("page start %d >= list %d", 0, 0)
-	if _, err := bs.ListValidatorAssignments(context.Background(), &ethpb.ListValidatorAssignmentsRequest{Epoch: 0}); !strings.Contains(err.Error(), wanted) {
+	if _, err := bs.ListValidatorAssignments(context.Background(), &ethpb.ListValidatorAssignmentsRequest{QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0}}); !strings.Contains(err.Error(), wanted) {
 		t.Errorf("Expected error %v, received %v", wanted, err)
 	}
 }
@@ -626,7 +781,7 @@ func TestBeaconChainServer_ListAssignmentsDefaultPageSize(t *testing.T) {
 		beaconDB: db,
 	}
 
-	res, err := bs.ListValidatorAssignments(context.Background(), &ethpb.ListValidatorAssignmentsRequest{Epoch: 0})
+	res, err := bs.ListValidatorAssignments(context.Background(), &ethpb.ListValidatorAssignmentsRequest{QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0}})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -683,7 +838,7 @@ func TestBeaconChainServer_ListAssignmentsFilterPubkeysIndicesNoPage(t *testing.
 		beaconDB: db,
 	}
 
-	req := &ethpb.ListValidatorAssignmentsRequest{Epoch: 0, PublicKeys: [][]byte{{1}, {2}}, Indices: []uint64{2, 3}}
+	req := &ethpb.ListValidatorAssignmentsRequest{QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0}, PublicKeys: [][]byte{{1}, {2}}, Indices: []uint64{2, 3}}
 	res, err := bs.ListValidatorAssignments(context.Background(), req)
 	if err != nil {
 		t.Fatal(err)
@@ -741,7 +896,7 @@ func TestBeaconChainServer_ListAssignmentsCanFilterPubkeysIndicesWithPages(t *te
 		beaconDB: db,
 	}
 
-	req := &ethpb.ListValidatorAssignmentsRequest{Epoch: 0, Indices: []uint64{1, 2, 3, 4, 5, 6}, PageSize: 2, PageToken: "1"}
+	req := &ethpb.ListValidatorAssignmentsRequest{QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0}, Indices: []uint64{1, 2, 3, 4, 5, 6}, PageSize: 2, PageToken: "1"}
 	res, err := bs.ListValidatorAssignments(context.Background(), req)
 	if err != nil {
 		t.Fatal(err)
@@ -780,7 +935,7 @@ func TestBeaconChainServer_ListAssignmentsCanFilterPubkeysIndicesWithPages(t *te
 
 	// Test the wrap around scenario
 	assignments = nil
-	req = &ethpb.ListValidatorAssignmentsRequest{Epoch: 0, Indices: []uint64{1, 2, 3, 4, 5, 6}, PageSize: 5, PageToken: "1"}
+	req = &ethpb.ListValidatorAssignmentsRequest{QueryFilter: &ethpb.ListValidatorAssignmentsRequest_Epoch{Epoch: 0}, Indices: []uint64{1, 2, 3, 4, 5, 6}, PageSize: 5, PageToken: "1"}
 	res, err = bs.ListValidatorAssignments(context.Background(), req)
 	if err != nil {
 		t.Fatal(err)
@@ -811,6 +966,70 @@ func TestBeaconChainServer_ListAssignmentsCanFilterPubkeysIndicesWithPages(t *te
 	}
 }
 
+func TestBeaconChainServer_GetValidatorQueue(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	validators := []*ethpb.Validator{
+		// Active validator, neither queued for activation nor exit.
+		{PublicKey: []byte{0}, ActivationEligibilityEpoch: 0, ActivationEpoch: 0, ExitEpoch: farFuture},
+		// Queued for activation, behind the next validator.
+		{PublicKey: []byte{1}, ActivationEligibilityEpoch: 3, ActivationEpoch: farFuture, ExitEpoch: farFuture},
+		{PublicKey: []byte{2}, ActivationEligibilityEpoch: 1, ActivationEpoch: farFuture, ExitEpoch: farFuture},
+		// Not yet eligible for activation.
+		{PublicKey: []byte{3}, ActivationEligibilityEpoch: farFuture, ActivationEpoch: farFuture, ExitEpoch: farFuture},
+		// Queued for exit, behind the next validator.
+		{PublicKey: []byte{4}, ActivationEligibilityEpoch: 0, ActivationEpoch: 0, ExitEpoch: 6},
+		{PublicKey: []byte{5}, ActivationEligibilityEpoch: 0, ActivationEpoch: 0, ExitEpoch: 5},
+	}
+	headState := &pbp2p.BeaconState{
+		Slot:                0,
+		Validators:          validators,
+		FinalizedCheckpoint: &ethpb.Checkpoint{},
+	}
+	if err := db.SaveState(ctx, headState); err != nil {
+		t.Fatal(err)
+	}
+	finalizedState := &pbp2p.BeaconState{
+		Slot:                0,
+		Validators:          validators[:2],
+		FinalizedCheckpoint: &ethpb.Checkpoint{},
+	}
+	if err := db.SaveFinalizedState(finalizedState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	res, err := bs.GetValidatorQueue(ctx, &ethpb.GetValidatorQueueRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &ethpb.ValidatorQueue{
+		ChurnLimit:           params.BeaconConfig().MinPerEpochChurnLimit,
+		ActivationPublicKeys: [][]byte{{2}, {1}},
+		ExitPublicKeys:       [][]byte{{5}, {4}},
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+
+	res, err = bs.GetValidatorQueue(ctx, &ethpb.GetValidatorQueueRequest{
+		State: &ethpb.StateSelector{Selector: &ethpb.StateSelector_Finalized{Finalized: true}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.ActivationPublicKeys) != 1 || len(res.ExitPublicKeys) != 0 {
+		t.Errorf("Expected the queue of the finalized state, received %v", res)
+	}
+}
+
 func TestBeaconChainServer_GetValidatorsParticipation(t *testing.T) {
 	helpers.ClearAllCaches()
 
@@ -867,7 +1086,7 @@ func TestBeaconChainServer_GetValidatorsParticipation(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	res, err := bs.GetValidatorParticipation(context.Background(), &ethpb.GetValidatorParticipationRequest{Epoch: epoch})
+	res, err := bs.GetValidatorParticipation(context.Background(), &ethpb.GetValidatorParticipationRequest{QueryFilter: &ethpb.GetValidatorParticipationRequest_Epoch{Epoch: epoch}})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -884,6 +1103,95 @@ func TestBeaconChainServer_GetValidatorsParticipation(t *testing.T) {
 	}
 }
 
+func TestBeaconChainServer_GetValidatorRewards(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, _ := testutil.SetupInitialDeposits(t, 64)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The rewards of epoch 0 are applied by the transition out of epoch 1.
+	slotState, err := state.ProcessSlots(ctx, statetrie.New(genesisState), helpers.StartSlot(2)-1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := slotState.InnerStateUnsafe()
+	if err := db.SaveState(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveValidatorIndex(s.Validators[3].PublicKey, 3); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	breakdown, err := state.EpochRewardsBreakdown(ctx, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := bs.GetValidatorRewards(ctx, &ethpb.GetValidatorRewardsRequest{
+		Epoch:      0,
+		PublicKeys: [][]byte{s.Validators[3].PublicKey},
+		Indices:    []uint64{3, 5},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Rewards) != 2 {
+		t.Fatalf("Wanted rewards of 2 validators, received %d", len(res.Rewards))
+	}
+	for i, index := range []uint64{3, 5} {
+		r := res.Rewards[i]
+		// Nobody attested, every validator pays the penalties of a missed attestation.
+		if r.SourcePenalty == 0 || r.TargetPenalty == 0 || r.HeadPenalty == 0 {
+			t.Errorf("Wanted penalties for missed attestations of validator %d, received %v", index, r)
+		}
+		wanted := &ethpb.ValidatorRewards_Breakdown{
+			PublicKey:         s.Validators[index].PublicKey,
+			Index:             index,
+			SourcePenalty:     breakdown.Source.Penalties[index],
+			TargetPenalty:     breakdown.Target.Penalties[index],
+			HeadPenalty:       breakdown.Head.Penalties[index],
+			CrosslinkPenalty:  breakdown.Crosslink.Penalties[index],
+			InactivityPenalty: breakdown.Inactivity.Penalties[index],
+		}
+		if !proto.Equal(r, wanted) {
+			t.Errorf("Wanted rewards %v of validator %d, received %v", wanted, index, r)
+		}
+	}
+
+	res, err = bs.GetValidatorRewards(ctx, &ethpb.GetValidatorRewardsRequest{Epoch: 0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Rewards) != len(s.Validators) {
+		t.Errorf("Wanted rewards of all %d validators, received %d", len(s.Validators), len(res.Rewards))
+	}
+}
+
+func TestBeaconChainServer_GetValidatorRewardsNotProcessedYet(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	if err := db.SaveState(ctx, &pbp2p.BeaconState{Slot: helpers.StartSlot(2) - 2}); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	wanted := "rewards of epoch 0 are applied after slot"
+	if _, err := bs.GetValidatorRewards(ctx, &ethpb.GetValidatorRewardsRequest{Epoch: 0}); err == nil || !strings.Contains(err.Error(), wanted) {
+		t.Errorf("Expected error %v, received %v", wanted, err)
+	}
+}
+
 func TestBeaconChainServer_ListBlocksPagination(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -1050,3 +1358,105 @@ func TestBeaconChainServer_ListBlocksErrors(t *testing.T) {
 
 	}
 }
+
+func TestBeaconChainServer_GetStateProof(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	s := &pbp2p.BeaconState{
+		Slot:                10,
+		Validators:          []*ethpb.Validator{{PublicKey: []byte{1}}, {PublicKey: []byte{2}}},
+		Balances:            []uint64{32, 31},
+		FinalizedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: bytesutil.Bytes32(3)},
+	}
+	if err := db.SaveState(ctx, s); err != nil {
+		t.Fatal(err)
+	}
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	paths := []string{"balances/1", "finalized_checkpoint/epoch", "validators/0/pubkey"}
+	res, err := bs.GetStateProof(ctx, &ethpb.StateProofRequest{Paths: paths})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Slot != s.Slot {
+		t.Errorf("Expected a proof of the state at slot %d, received slot %d", s.Slot, res.Slot)
+	}
+	proof := &sszproof.Multiproof{Indices: res.GeneralizedIndices, Leaves: res.Leaves, Hashes: res.Hashes}
+	if err := sszproof.VerifyPaths(&pbp2p.BeaconState{}, bytesutil.ToBytes32(res.StateRoot), paths, proof); err != nil {
+		t.Fatalf("Could not verify state proof: %v", err)
+	}
+	loc, err := sszproof.Locate(&pbp2p.BeaconState{}, paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance := bytesutil.FromBytes8(loc.Value(res.Leaves[0])); balance != 31 {
+		t.Errorf("Expected balance 31, received %d", balance)
+	}
+
+	for _, req := range []*ethpb.StateProofRequest{
+		{},
+		{Paths: []string{"balances/2"}},
+		{Paths: []string{"unknown_field"}},
+	} {
+		if _, err := bs.GetStateProof(ctx, req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Expected invalid argument for paths %v, received %v", req.Paths, err)
+		}
+	}
+}
+
+func TestBeaconChainServer_GetChainHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	s := &pbp2p.BeaconState{
+		Slot:                        3*params.BeaconConfig().SlotsPerEpoch + 2,
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 1, Root: []byte("A")},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 2, Root: []byte("B")},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Epoch: 1, Root: []byte("A")},
+	}
+	head := &ethpb.BeaconBlock{Slot: s.Slot}
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, s); err != nil {
+		t.Fatal(err)
+	}
+	headRoot, err := ssz.SigningRoot(head)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{beaconDB: db}
+	res, err := bs.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wanted := &ethpb.ChainHead{
+		BlockRoot:                  headRoot[:],
+		BlockSlot:                  s.Slot,
+		FinalizedSlot:              params.BeaconConfig().SlotsPerEpoch,
+		FinalizedBlockRoot:         []byte("A"),
+		JustifiedSlot:              2 * params.BeaconConfig().SlotsPerEpoch,
+		JustifiedBlockRoot:         []byte("B"),
+		PreviousJustifiedSlot:      params.BeaconConfig().SlotsPerEpoch,
+		PreviousJustifiedBlockRoot: []byte("A"),
+	}
+	if !proto.Equal(res, wanted) {
+		t.Errorf("Expected chain head %v, received %v", wanted, res)
+	}
+}
+
+func TestBeaconChainServer_GetChainHeadNoHead(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+
+	bs := &BeaconChainServer{beaconDB: db}
+	if _, err := bs.GetChainHead(context.Background(), &ptypes.Empty{}); status.Code(err) != codes.Internal {
+		t.Errorf("Expected an internal error without a chain head, received %v", err)
+	}
+}
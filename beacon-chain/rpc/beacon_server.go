@@ -206,6 +206,34 @@ func (bs *BeaconServer) BlockTreeBySlots(ctx context.Context, req *pb.TreeBlockS
 	}, nil
 }
 
+// Eth1ConnectionStatus returns the health of the ETH1.0 endpoints of the beacon node
+// and which one of them is in use.
+func (bs *BeaconServer) Eth1ConnectionStatus(ctx context.Context, _ *ptypes.Empty) (*pb.Eth1ConnectionStatusResponse, error) {
+	statuses := bs.powChainService.EndpointStatuses()
+	res := &pb.Eth1ConnectionStatusResponse{
+		Endpoints: make([]*pb.Eth1EndpointStatus, len(statuses)),
+	}
+	for i, status := range statuses {
+		res.Endpoints[i] = &pb.Eth1EndpointStatus{
+			Url:             status.URL,
+			Active:          status.Active,
+			Healthy:         status.Healthy,
+			Score:           status.Score,
+			HeadBlockNumber: status.HeadNumber,
+			HeadBlockTime:   status.HeadTime,
+			ErrorRate:       status.ErrorRate,
+			LatencyMs:       uint64(status.Latency / time.Millisecond),
+		}
+		if status.LastError != nil {
+			res.Endpoints[i].LastError = status.LastError.Error()
+		}
+		if status.Active {
+			res.ActiveEndpoint = status.URL
+		}
+	}
+	return res, nil
+}
+
 func constructMerkleProof(trie *trieutil.MerkleTrie, index int, deposit *ethpb.Deposit) (*ethpb.Deposit, error) {
 	proof, err := trie.MerkleProof(index)
 	if err != nil {
@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
@@ -90,6 +91,10 @@ func (f *faultyPOWChainService) ChainStartETH1Data() *ethpb.Eth1Data {
 	return &ethpb.Eth1Data{}
 }
 
+func (f *faultyPOWChainService) EndpointStatuses() []*powchain.EndpointStatus {
+	return []*powchain.EndpointStatus{}
+}
+
 type mockPOWChainService struct {
 	chainStartFeed      *event.Feed
 	latestBlockNumber   *big.Int
@@ -97,6 +102,7 @@ type mockPOWChainService struct {
 	blockTimeByHeight   map[int]uint64
 	blockNumberByHeight map[uint64]*big.Int
 	eth1Data            *ethpb.Eth1Data
+	endpointStatuses    []*powchain.EndpointStatus
 }
 
 func (m *mockPOWChainService) HasChainStarted() bool {
@@ -166,6 +172,10 @@ func (m *mockPOWChainService) ChainStartETH1Data() *ethpb.Eth1Data {
 	return m.eth1Data
 }
 
+func (m *mockPOWChainService) EndpointStatuses() []*powchain.EndpointStatus {
+	return m.endpointStatuses
+}
+
 func TestWaitForChainStart_ContextClosed(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	beaconServer := &BeaconServer{
@@ -888,3 +898,45 @@ func TestBlockTreeBySlots_OK(t *testing.T) {
 		t.Logf("Incorrect number of nodes in tree, expected: %d, actual: %d", 2, len(resp.Tree))
 	}
 }
+
+func TestEth1ConnectionStatus_ReportsActiveEndpoint(t *testing.T) {
+	beaconServer := &BeaconServer{
+		powChainService: &mockPOWChainService{
+			endpointStatuses: []*powchain.EndpointStatus{
+				{
+					URL:        "wss://primary.example.com",
+					Score:      20,
+					HeadNumber: 90,
+					ErrorRate:  0.8,
+					LastError:  errors.New("connection refused"),
+				},
+				{
+					URL:        "https://fallback.example.com",
+					Active:     true,
+					Healthy:    true,
+					Score:      98,
+					HeadNumber: 100,
+					HeadTime:   1000,
+					Latency:    150 * time.Millisecond,
+				},
+			},
+		},
+	}
+	res, err := beaconServer.Eth1ConnectionStatus(context.Background(), &ptypes.Empty{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ActiveEndpoint != "https://fallback.example.com" {
+		t.Errorf("Expected the fallback endpoint to be active, received %s", res.ActiveEndpoint)
+	}
+	if len(res.Endpoints) != 2 {
+		t.Fatalf("Expected 2 endpoint statuses, received %d", len(res.Endpoints))
+	}
+	if res.Endpoints[0].Healthy || res.Endpoints[0].LastError != "connection refused" {
+		t.Errorf("Expected the primary endpoint to be reported unhealthy, received %v", res.Endpoints[0])
+	}
+	fallback := res.Endpoints[1]
+	if !fallback.Active || !fallback.Healthy || fallback.HeadBlockNumber != 100 || fallback.LatencyMs != 150 || fallback.LastError != "" {
+		t.Errorf("Unexpected status of the fallback endpoint %v", fallback)
+	}
+}
@@ -0,0 +1,155 @@
+package rpc
+
+import (
+	"sync/atomic"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// eventQueueSize is the number of events buffered for a subscriber before
+// newer events are dropped and reported as missed.
+const eventQueueSize = 256
+
+// eventStream queues the events of a single subscriber. The node feeds are
+// never blocked by a slow subscriber: events which do not fit in the queue
+// are dropped and their count is reported on the next event sent.
+type eventStream struct {
+	queue  chan *pb.Event
+	missed uint64
+}
+
+func newEventStream() *eventStream {
+	return &eventStream{queue: make(chan *pb.Event, eventQueueSize)}
+}
+
+func (s *eventStream) push(e *pb.Event) {
+	select {
+	case s.queue <- e:
+	default:
+		atomic.AddUint64(&s.missed, 1)
+	}
+}
+
+// send forwards the queued events to the subscriber until the stream fails.
+func (s *eventStream) send(stream pb.BeaconService_StreamEventsServer) error {
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case e := <-s.queue:
+			e.MissedEvents = atomic.SwapUint64(&s.missed, 0)
+			if err := stream.Send(e); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StreamEvents sends the head changes, new blocks, attestations, justified and
+// finalized checkpoints, chain reorgs and voluntary exits observed by the node
+// to the subscriber, filtered by the requested topics.
+func (bs *BeaconServer) StreamEvents(req *pb.StreamEventsRequest, stream pb.BeaconService_StreamEventsServer) error {
+	topics := make(map[pb.EventTopic]bool)
+	for _, topic := range req.Topics {
+		if _, ok := pb.EventTopic_name[int32(topic)]; !ok || topic == pb.EventTopic_UNKNOWN_TOPIC {
+			return status.Errorf(codes.InvalidArgument, "unknown event topic %d", topic)
+		}
+		topics[topic] = true
+	}
+	subscribed := func(topic pb.EventTopic) bool {
+		return len(topics) == 0 || topics[topic]
+	}
+
+	var subs []event.Subscription
+	defer func() {
+		for _, sub := range subs {
+			sub.Unsubscribe()
+		}
+	}()
+	// Only the feeds of the requested topics are subscribed to. The nil
+	// channels of the others block forever.
+	var headChan chan *blockchain.HeadEvent
+	if subscribed(pb.EventTopic_HEAD) || subscribed(pb.EventTopic_CHAIN_REORG) {
+		headChan = make(chan *blockchain.HeadEvent, 1)
+		subs = append(subs, bs.chainService.HeadFeed().Subscribe(headChan))
+	}
+	var checkpointChan chan *blockchain.CheckpointEvent
+	if subscribed(pb.EventTopic_FINALIZED_CHECKPOINT) || subscribed(pb.EventTopic_JUSTIFIED_CHECKPOINT) {
+		checkpointChan = make(chan *blockchain.CheckpointEvent, 1)
+		subs = append(subs, bs.chainService.CheckpointFeed().Subscribe(checkpointChan))
+	}
+	var blockChan chan *ethpb.BeaconBlock
+	if subscribed(pb.EventTopic_BLOCK) {
+		blockChan = make(chan *ethpb.BeaconBlock, 1)
+		subs = append(subs, bs.operationService.IncomingProcessedBlockFeed().Subscribe(blockChan))
+	}
+	var attChan chan *ethpb.Attestation
+	if subscribed(pb.EventTopic_ATTESTATION) {
+		attChan = make(chan *ethpb.Attestation, 1)
+		subs = append(subs, bs.operationService.IncomingAttFeed().Subscribe(attChan))
+	}
+	var exitChan chan *ethpb.VoluntaryExit
+	if subscribed(pb.EventTopic_VOLUNTARY_EXIT) {
+		exitChan = make(chan *ethpb.VoluntaryExit, 1)
+		subs = append(subs, bs.operationService.IncomingExitFeed().Subscribe(exitChan))
+	}
+
+	events := newEventStream()
+	sendErr := make(chan error, 1)
+	go func() {
+		sendErr <- events.send(stream)
+	}()
+
+	for {
+		select {
+		case head := <-headChan:
+			if subscribed(pb.EventTopic_HEAD) {
+				events.push(&pb.Event{
+					Topic: pb.EventTopic_HEAD,
+					Head: &pb.HeadEvent{
+						Slot:      head.Slot,
+						BlockRoot: head.BlockRoot[:],
+						StateRoot: head.StateRoot[:],
+					},
+				})
+			}
+			if head.Reorg && subscribed(pb.EventTopic_CHAIN_REORG) {
+				events.push(&pb.Event{
+					Topic: pb.EventTopic_CHAIN_REORG,
+					ChainReorg: &pb.ChainReorgEvent{
+						OldHeadSlot: head.PreviousSlot,
+						OldHeadRoot: head.PreviousRoot[:],
+						NewHeadSlot: head.Slot,
+						NewHeadRoot: head.BlockRoot[:],
+					},
+				})
+			}
+		case checkpoint := <-checkpointChan:
+			topic := pb.EventTopic_JUSTIFIED_CHECKPOINT
+			if checkpoint.Finalized {
+				topic = pb.EventTopic_FINALIZED_CHECKPOINT
+			}
+			if subscribed(topic) {
+				events.push(&pb.Event{Topic: topic, Checkpoint: checkpoint.Checkpoint})
+			}
+		case block := <-blockChan:
+			events.push(&pb.Event{Topic: pb.EventTopic_BLOCK, Block: block})
+		case att := <-attChan:
+			events.push(&pb.Event{Topic: pb.EventTopic_ATTESTATION, Attestation: att})
+		case exit := <-exitChan:
+			events.push(&pb.Event{Topic: pb.EventTopic_VOLUNTARY_EXIT, VoluntaryExit: exit})
+		case err := <-sendErr:
+			return err
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "stream context canceled")
+		case <-bs.ctx.Done():
+			return status.Error(codes.Canceled, "rpc context canceled")
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"google.golang.org/grpc"
+)
+
+// eventsStream records the events sent to a subscriber.
+type eventsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.Event
+}
+
+func (s *eventsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *eventsStream) Send(e *pb.Event) error {
+	s.events <- e
+	return nil
+}
+
+// eventsOperationService serves the same operation feeds on every call.
+type eventsOperationService struct {
+	mockOperationService
+	attFeed   *event.Feed
+	exitFeed  *event.Feed
+	blockFeed *event.Feed
+}
+
+func (ms *eventsOperationService) IncomingAttFeed() *event.Feed {
+	return ms.attFeed
+}
+
+func (ms *eventsOperationService) IncomingExitFeed() *event.Feed {
+	return ms.exitFeed
+}
+
+func (ms *eventsOperationService) IncomingProcessedBlockFeed() *event.Feed {
+	return ms.blockFeed
+}
+
+func receiveEvent(t *testing.T, stream *eventsStream) *pb.Event {
+	select {
+	case e := <-stream.events:
+		return e
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for event")
+		return nil
+	}
+}
+
+func TestStreamEvents_FiltersTopics(t *testing.T) {
+	chainService := newMockChainService()
+	opService := &eventsOperationService{
+		attFeed:   new(event.Feed),
+		exitFeed:  new(event.Feed),
+		blockFeed: new(event.Feed),
+	}
+	beaconServer := &BeaconServer{
+		ctx:              context.Background(),
+		chainService:     chainService,
+		operationService: opService,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &eventsStream{ctx: ctx, events: make(chan *pb.Event, 10)}
+	req := &pb.StreamEventsRequest{Topics: []pb.EventTopic{pb.EventTopic_CHAIN_REORG, pb.EventTopic_ATTESTATION}}
+	exited := make(chan error)
+	go func() {
+		exited <- beaconServer.StreamEvents(req, stream)
+	}()
+
+	// Wait for the subscriptions to the requested feeds only.
+	for chainService.headFeed.Send(&blockchain.HeadEvent{Slot: 1}) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if opService.exitFeed.Send(&ethpb.VoluntaryExit{Epoch: 1}) != 0 {
+		t.Error("Expected no subscription to voluntary exits")
+	}
+
+	chainService.headFeed.Send(&blockchain.HeadEvent{
+		Slot:         5,
+		BlockRoot:    [32]byte{'b'},
+		PreviousSlot: 4,
+		PreviousRoot: [32]byte{'a'},
+		Reorg:        true,
+	})
+	e := receiveEvent(t, stream)
+	if e.Topic != pb.EventTopic_CHAIN_REORG || e.Head != nil {
+		t.Fatalf("Expected only a chain reorg event, received %v", e)
+	}
+	if e.ChainReorg.OldHeadSlot != 4 || e.ChainReorg.NewHeadSlot != 5 || e.ChainReorg.NewHeadRoot[0] != 'b' {
+		t.Errorf("Unexpected chain reorg event %v", e.ChainReorg)
+	}
+
+	att := &ethpb.Attestation{AggregationBits: []byte{0x01}}
+	opService.attFeed.Send(att)
+	e = receiveEvent(t, stream)
+	if e.Topic != pb.EventTopic_ATTESTATION || e.Attestation != att || e.MissedEvents != 0 {
+		t.Errorf("Expected attestation event, received %v", e)
+	}
+
+	cancel()
+	<-exited
+}
+
+func TestStreamEvents_UnknownTopic(t *testing.T) {
+	beaconServer := &BeaconServer{ctx: context.Background()}
+	stream := &eventsStream{ctx: context.Background()}
+	req := &pb.StreamEventsRequest{Topics: []pb.EventTopic{pb.EventTopic_UNKNOWN_TOPIC}}
+	if err := beaconServer.StreamEvents(req, stream); err == nil {
+		t.Error("Expected an error for an unknown event topic")
+	}
+}
+
+func TestEventStream_ReportsMissedEvents(t *testing.T) {
+	events := newEventStream()
+	for i := 0; i < eventQueueSize+5; i++ {
+		events.push(&pb.Event{Topic: pb.EventTopic_HEAD, Head: &pb.HeadEvent{Slot: uint64(i)}})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &eventsStream{ctx: ctx, events: make(chan *pb.Event, eventQueueSize)}
+	exited := make(chan error)
+	go func() {
+		exited <- events.send(stream)
+	}()
+	first := receiveEvent(t, stream)
+	if first.MissedEvents != 5 {
+		t.Errorf("Expected 5 missed events to be reported, received %d", first.MissedEvents)
+	}
+	for i := 1; i < eventQueueSize; i++ {
+		e := receiveEvent(t, stream)
+		if e.MissedEvents != 0 {
+			t.Fatalf("Expected missed events to be reported once, event %d reports %d", i, e.MissedEvents)
+		}
+		if e.Head.Slot != uint64(i) {
+			t.Fatalf("Expected events to be sent in order, received slot %d at %d", e.Head.Slot, i)
+		}
+	}
+
+	// The missed events are only reported once.
+	events.push(&pb.Event{Topic: pb.EventTopic_HEAD})
+	if e := receiveEvent(t, stream); e.MissedEvents != 0 {
+		t.Errorf("Expected no missed events, received %d", e.MissedEvents)
+	}
+	cancel()
+	<-exited
+}
@@ -19,16 +19,19 @@ import (
 // providing RPC endpoints for verifying a beacon node's sync status, genesis and
 // version information, and services the node implements and runs.
 type NodeServer struct {
-	syncChecker sync.Checker
-	server      *grpc.Server
-	beaconDB    *db.BeaconDB
+	syncStatus sync.StatusReporter
+	server     *grpc.Server
+	beaconDB   *db.BeaconDB
 }
 
-// GetSyncStatus checks the current network sync status of the node.
+// GetSyncStatus checks the current network sync status of the node, along
+// with the sync phase, progress and the peers used as sync targets.
 func (ns *NodeServer) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
-	return &ethpb.SyncStatus{
-		Syncing: ns.syncChecker.Syncing(),
-	}, nil
+	syncStatus, err := ns.syncStatus.SyncStatus()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve sync status: %v", err)
+	}
+	return syncStatus, nil
 }
 
 // GetGenesis fetches genesis chain information of Ethereum 2.0.
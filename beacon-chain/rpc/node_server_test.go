@@ -17,33 +17,40 @@ import (
 	"google.golang.org/grpc/reflection"
 )
 
-type mockSyncChecker struct {
-	syncing bool
+type mockSyncStatusReporter struct {
+	status *ethpb.SyncStatus
 }
 
-func (m *mockSyncChecker) Syncing() bool {
-	return m.syncing
+func (m *mockSyncStatusReporter) SyncStatus() (*ethpb.SyncStatus, error) {
+	return m.status, nil
 }
 
 func TestNodeServer_GetSyncStatus(t *testing.T) {
-	mSync := &mockSyncChecker{false}
+	mSync := &mockSyncStatusReporter{&ethpb.SyncStatus{Syncing: false, Phase: ethpb.SyncStatus_REGULAR}}
 	ns := &NodeServer{
-		syncChecker: mSync,
+		syncStatus: mSync,
 	}
 	res, err := ns.GetSyncStatus(context.Background(), &ptypes.Empty{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if res.Syncing != mSync.syncing {
-		t.Errorf("Wanted GetSyncStatus() = %v, received %v", mSync.syncing, res.Syncing)
+	if res.Syncing != mSync.status.Syncing {
+		t.Errorf("Wanted GetSyncStatus() = %v, received %v", mSync.status.Syncing, res.Syncing)
+	}
+	mSync.status = &ethpb.SyncStatus{
+		Syncing:         true,
+		Phase:           ethpb.SyncStatus_BLOCK_DOWNLOAD,
+		HeadSlot:        20,
+		HighestPeerSlot: 100,
+		BlocksPerSecond: 4,
+		Targets:         []*ethpb.SyncTarget{{PeerId: "peer", HeadSlot: 100}},
 	}
-	mSync.syncing = true
 	res, err = ns.GetSyncStatus(context.Background(), &ptypes.Empty{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	if res.Syncing != mSync.syncing {
-		t.Errorf("Wanted GetSyncStatus() = %v, received %v", mSync.syncing, res.Syncing)
+	if !proto.Equal(res, mSync.status) {
+		t.Errorf("Wanted GetSyncStatus() = %v, received %v", mSync.status, res)
 	}
 }
 
@@ -1,6 +1,7 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"math/big"
@@ -10,11 +11,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/mathutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
 	"github.com/sirupsen/logrus"
@@ -133,7 +137,7 @@ func (ps *ProposerServer) ProposeBlock(ctx context.Context, blk *ethpb.BeaconBlo
 // attestations which are ready for inclusion. That is, attestations that satisfy:
 // attestation.slot + MIN_ATTESTATION_INCLUSION_DELAY <= state.slot.
 func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64) ([]*ethpb.Attestation, error) {
-	beaconState, err := ps.beaconDB.HeadState(ctx)
+	s, err := ps.beaconDB.HeadStateTrie(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not retrieve beacon state")
 	}
@@ -143,12 +147,13 @@ func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64)
 	}
 
 	// advance slot, if it is behind
-	if beaconState.Slot < expectedSlot {
-		beaconState, err = state.ProcessSlots(ctx, beaconState, expectedSlot)
+	if s.Slot() < expectedSlot {
+		s, err = state.ProcessSlots(ctx, s, expectedSlot)
 		if err != nil {
 			return nil, err
 		}
 	}
+	beaconState := s.InnerStateUnsafe()
 
 	var attsReadyForInclusion []*ethpb.Attestation
 	for _, att := range atts {
@@ -169,7 +174,15 @@ func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64)
 			return nil, errors.Wrap(err, "could not get attestation slot")
 		}
 
-		if _, err := blocks.ProcessAttestationNoVerify(beaconState, att); err != nil {
+		// The attestation is appended to the pending attestations of its
+		// target epoch.
+		if err := s.Modify(func(beaconState *pbp2p.BeaconState) error {
+			_, err := blocks.ProcessAttestationNoVerify(beaconState, att)
+			return err
+		},
+			statetrie.Change{Field: statetrie.CurrentEpochAttestations, Indices: []uint64{uint64(len(beaconState.CurrentEpochAttestations))}},
+			statetrie.Change{Field: statetrie.PreviousEpochAttestations, Indices: []uint64{uint64(len(beaconState.PreviousEpochAttestations))}},
+		); err != nil {
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
 			}
@@ -203,11 +216,17 @@ func (ps *ProposerServer) attestations(ctx context.Context, expectedSlot uint64)
 }
 
 // eth1Data determines the appropriate eth1data for a block proposal. The algorithm for this method
-// is as follows:
+// follows the honest validator specification:
 //  - Determine the timestamp for the start slot for the eth1 voting period.
-//  - Determine the most recent eth1 block before that timestamp.
-//  - Subtract that eth1block.number by ETH1_FOLLOW_DISTANCE.
-//  - This is the eth1block to use for the block proposal.
+//  - Determine the most recent eth1 block before that timestamp, the head of the voting period.
+//  - Blocks between ETH1_FOLLOW_DISTANCE and 2 * ETH1_FOLLOW_DISTANCE before the period head are
+//    new candidates. Past the first integer_squareroot(SLOTS_PER_ETH1_VOTING_PERIOD) votes, blocks
+//    between ETH1_FOLLOW_DISTANCE before the period head and the block of the state's eth1data are
+//    candidates as well.
+//  - Vote for the candidate with the most votes in the period, breaking ties in favour of the
+//    most recent eth1 block.
+//  - If no vote in the period is for a valid candidate, vote for the eth1block ETH1_FOLLOW_DISTANCE
+//    before the period head.
 func (ps *ProposerServer) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth1Data, error) {
 	eth1VotingPeriodStartTime := ps.powChainService.ETH2GenesisTime()
 	eth1VotingPeriodStartTime += (slot - (slot % params.BeaconConfig().SlotsPerEth1VotingPeriod)) * params.BeaconConfig().SecondsPerSlot
@@ -217,14 +236,132 @@ func (ps *ProposerServer) eth1Data(ctx context.Context, slot uint64) (*ethpb.Eth
 	if err != nil {
 		return nil, err
 	}
+	if blockNumber == nil {
+		return nil, fmt.Errorf("no eth1 block known before the voting period start time %d", eth1VotingPeriodStartTime)
+	}
+
+	beaconState, err := ps.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get beacon state")
+	}
+	if beaconState != nil {
+		vote, err := ps.mostPopularEth1Vote(ctx, beaconState, blockNumber)
+		if err != nil {
+			return nil, err
+		}
+		if vote != nil {
+			return vote, nil
+		}
+	}
 
 	return ps.defaultEth1DataResponse(ctx, blockNumber)
 }
 
+// eth1Vote is the tally of a distinct eth1data vote in the current voting period.
+type eth1Vote struct {
+	data   *ethpb.Eth1Data
+	height *big.Int
+	count  uint64
+}
+
+// mostPopularEth1Vote tallies the votes in the state's current eth1 voting period which are for
+// valid candidates, as described in eth1Data, and returns the one with the most votes. Nil is
+// returned if there is no vote for a valid candidate.
+func (ps *ProposerServer) mostPopularEth1Vote(ctx context.Context, beaconState *pbp2p.BeaconState, periodHead *big.Int) (*ethpb.Eth1Data, error) {
+	if len(beaconState.Eth1DataVotes) == 0 {
+		return nil, nil
+	}
+	followDistance := new(big.Int).SetUint64(params.BeaconConfig().Eth1FollowDistance)
+	newestHeight := new(big.Int).Sub(periodHead, followDistance)
+	if newestHeight.Sign() < 0 {
+		return nil, nil
+	}
+	// Candidates are strictly more recent than these heights.
+	newCandidatesAfter := new(big.Int).Sub(newestHeight, followDistance)
+	allCandidatesAfter := newCandidatesAfter
+	if _, height, err := ps.powChainService.BlockExists(ctx, bytesutil.ToBytes32(beaconState.Eth1Data.BlockHash)); err == nil && height != nil {
+		if height.Cmp(allCandidatesAfter) < 0 {
+			allCandidatesAfter = height
+		}
+	}
+	periodTailStart := mathutil.IntegerSquareRoot(params.BeaconConfig().SlotsPerEth1VotingPeriod)
+
+	votes := make(map[[32]byte]*eth1Vote)
+	for i, data := range beaconState.Eth1DataVotes {
+		key, err := hashutil.HashProto(data)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not hash eth1data vote")
+		}
+		vote, ok := votes[key]
+		if !ok {
+			height, err := ps.eth1DataHeight(ctx, data)
+			if err != nil {
+				return nil, err
+			}
+			vote = &eth1Vote{data: data, height: height}
+			votes[key] = vote
+		}
+		if vote.height == nil || vote.height.Cmp(newestHeight) > 0 {
+			continue
+		}
+		// As in the spec, the tail of the period is counted in votes, a vote
+		// being cast at each slot of the period.
+		periodTail := uint64(i)%params.BeaconConfig().SlotsPerEth1VotingPeriod >= periodTailStart
+		if vote.height.Cmp(newCandidatesAfter) > 0 || (periodTail && vote.height.Cmp(allCandidatesAfter) > 0) {
+			vote.count++
+		}
+	}
+
+	// Valid votes at the same height are for the same eth1data, so breaking ties by
+	// height makes the choice deterministic.
+	var best *eth1Vote
+	for _, vote := range votes {
+		if vote.count == 0 {
+			continue
+		}
+		if best == nil || vote.count > best.count || (vote.count == best.count && vote.height.Cmp(best.height) > 0) {
+			best = vote
+		}
+	}
+	if best == nil {
+		return nil, nil
+	}
+	log.WithFields(logrus.Fields{
+		"blockHeight": best.height,
+		"voteCount":   best.count,
+	}).Debug("Voting for most popular eth1data of the period")
+	return best.data, nil
+}
+
+// eth1DataHeight returns the height of the eth1 block an eth1data vote refers to, if the block
+// is canonical and the deposit count and root match the deposits observed up to that block.
+// Nil is returned otherwise. An error is returned if the canonical block at the height cannot be
+// fetched, rather than ignoring a vote which may be the one of the majority.
+func (ps *ProposerServer) eth1DataHeight(ctx context.Context, data *ethpb.Eth1Data) (*big.Int, error) {
+	blockHash := bytesutil.ToBytes32(data.BlockHash)
+	exists, height, err := ps.powChainService.BlockExists(ctx, blockHash)
+	if err != nil || !exists {
+		log.WithError(err).WithField("blockHash", fmt.Sprintf("%#x", blockHash)).Debug("Ignoring eth1data vote for unknown block")
+		return nil, nil
+	}
+	canonicalHash, err := ps.powChainService.BlockHashByHeight(ctx, height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not fetch eth1 block hash by height")
+	}
+	if canonicalHash != blockHash {
+		return nil, nil
+	}
+	depositCount, depositRoot := ps.beaconDB.DepositsNumberAndRootAtHeight(ctx, height)
+	if depositCount != data.DepositCount || !bytes.Equal(depositRoot[:], data.DepositRoot) {
+		return nil, nil
+	}
+	return height, nil
+}
+
 // computeStateRoot computes the state root after a block has been processed through a state transition and
 // returns it to the validator client.
 func (ps *ProposerServer) computeStateRoot(ctx context.Context, block *ethpb.BeaconBlock) ([]byte, error) {
-	beaconState, err := ps.beaconDB.HeadState(ctx)
+	beaconState, err := ps.beaconDB.HeadStateTrie(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get beacon state")
 	}
@@ -234,10 +371,10 @@ func (ps *ProposerServer) computeStateRoot(ctx context.Context, block *ethpb.Bea
 		block,
 	)
 	if err != nil {
-		return nil, errors.Wrapf(err, "could not execute state transition for state at slot %d", beaconState.Slot)
+		return nil, errors.Wrapf(err, "could not execute state transition for state at slot %d", beaconState.Slot())
 	}
 
-	root, err := ssz.HashTreeRoot(s)
+	root, err := s.HashTreeRoot()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not tree hash beacon state")
 	}
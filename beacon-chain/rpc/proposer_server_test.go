@@ -3,11 +3,13 @@ package rpc
 import (
 	"context"
 	"crypto/rand"
+	"fmt"
 	"math/big"
 	"reflect"
 	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gogo/protobuf/proto"
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
@@ -19,6 +21,7 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -1141,6 +1144,163 @@ func TestEth1Data(t *testing.T) {
 	}
 }
 
+func TestEth1Data_MostPopularValidVote(t *testing.T) {
+	followDistance := int(params.BeaconConfig().Eth1FollowDistance)
+	periodHead := 2*followDistance + 100
+	// Heights of the candidates, relative to the head of the voting period.
+	recent := periodHead - followDistance
+	older := periodHead - followDistance - 50
+	tooOld := periodHead - 2*followDistance - 50
+	tooNew := periodHead - followDistance + 10
+	stateHeight := 10
+
+	hashesByHeight := map[int][]byte{
+		stateHeight: []byte("state"),
+		recent:      []byte("recent"),
+		older:       []byte("older"),
+		tooOld:      []byte("tooOld"),
+		tooNew:      []byte("tooNew"),
+	}
+	eth1Data := func(height int) *ethpb.Eth1Data {
+		hash := bytesutil.ToBytes32(hashesByHeight[height])
+		return &ethpb.Eth1Data{
+			BlockHash:   hash[:],
+			DepositRoot: make([]byte, 32),
+		}
+	}
+	unknown := &ethpb.Eth1Data{BlockHash: []byte("unknown"), DepositRoot: make([]byte, 32)}
+	wrongDeposits := eth1Data(recent)
+	wrongDeposits.DepositCount = 5
+	defaultEth1Data := &ethpb.Eth1Data{DepositCount: 77}
+
+	tests := []struct {
+		name  string
+		votes []*ethpb.Eth1Data
+		want  *ethpb.Eth1Data
+	}{
+		{
+			name:  "no votes",
+			votes: []*ethpb.Eth1Data{},
+			want:  defaultEth1Data,
+		},
+		{
+			name:  "most votes wins",
+			votes: []*ethpb.Eth1Data{eth1Data(recent), eth1Data(older), eth1Data(older)},
+			want:  eth1Data(older),
+		},
+		{
+			name:  "tie broken by most recent block",
+			votes: []*ethpb.Eth1Data{eth1Data(older), eth1Data(recent), eth1Data(older), eth1Data(recent)},
+			want:  eth1Data(recent),
+		},
+		{
+			name:  "invalid votes are ignored",
+			votes: []*ethpb.Eth1Data{unknown, unknown, eth1Data(tooNew), eth1Data(tooNew), wrongDeposits, wrongDeposits, eth1Data(older)},
+			want:  eth1Data(older),
+		},
+		{
+			name:  "only invalid votes",
+			votes: []*ethpb.Eth1Data{unknown, eth1Data(tooNew), eth1Data(tooOld), wrongDeposits},
+			want:  defaultEth1Data,
+		},
+		{
+			name: "older block counted in the period tail",
+			votes: []*ethpb.Eth1Data{
+				eth1Data(tooOld), eth1Data(tooOld), eth1Data(tooOld), eth1Data(tooOld),
+				eth1Data(tooOld), eth1Data(tooOld), eth1Data(recent),
+			},
+			want: eth1Data(tooOld),
+		},
+	}
+	for _, tt := range tests {
+		beaconDB := internal.SetupDB(t)
+		ctx := context.Background()
+		beaconState := &pbp2p.BeaconState{
+			Eth1Data:      eth1Data(stateHeight),
+			Eth1DataVotes: tt.votes,
+		}
+		if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+			t.Fatal(err)
+		}
+		ps := &ProposerServer{
+			beaconDB: beaconDB,
+			powChainService: &mockPOWChainService{
+				hashesByHeight: hashesByHeight,
+				blockNumberByHeight: map[uint64]*big.Int{
+					0: big.NewInt(int64(periodHead)),
+				},
+				eth1Data: defaultEth1Data,
+			},
+		}
+		result, err := ps.eth1Data(ctx, 0)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !proto.Equal(result, tt.want) {
+			t.Errorf("%s: expected eth1data %v, received %v", tt.name, tt.want, result)
+		}
+		internal.TeardownDB(t, beaconDB)
+	}
+}
+
+// unfetchableHashPOWChainService fails to fetch the block hash at a given height.
+type unfetchableHashPOWChainService struct {
+	*mockPOWChainService
+	height int
+}
+
+func (u *unfetchableHashPOWChainService) BlockHashByHeight(ctx context.Context, height *big.Int) (common.Hash, error) {
+	if height.Int64() == int64(u.height) {
+		return [32]byte{}, fmt.Errorf("could not fetch hash for height: %v", height)
+	}
+	return u.mockPOWChainService.BlockHashByHeight(ctx, height)
+}
+
+func TestEth1Data_FailsWhenBlockHashFetchFails(t *testing.T) {
+	beaconDB := internal.SetupDB(t)
+	defer internal.TeardownDB(t, beaconDB)
+	ctx := context.Background()
+
+	followDistance := int(params.BeaconConfig().Eth1FollowDistance)
+	periodHead := 2*followDistance + 100
+	recent := periodHead - followDistance
+	older := periodHead - followDistance - 50
+	hashesByHeight := map[int][]byte{
+		recent: []byte("recent"),
+		older:  []byte("older"),
+	}
+	eth1Data := func(height int) *ethpb.Eth1Data {
+		hash := bytesutil.ToBytes32(hashesByHeight[height])
+		return &ethpb.Eth1Data{
+			BlockHash:   hash[:],
+			DepositRoot: make([]byte, 32),
+		}
+	}
+	beaconState := &pbp2p.BeaconState{
+		Eth1Data:      eth1Data(older),
+		Eth1DataVotes: []*ethpb.Eth1Data{eth1Data(recent), eth1Data(recent), eth1Data(older)},
+	}
+	if err := beaconDB.SaveState(ctx, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	ps := &ProposerServer{
+		beaconDB: beaconDB,
+		powChainService: &unfetchableHashPOWChainService{
+			mockPOWChainService: &mockPOWChainService{
+				hashesByHeight: hashesByHeight,
+				blockNumberByHeight: map[uint64]*big.Int{
+					0: big.NewInt(int64(periodHead)),
+				},
+			},
+			height: recent,
+		},
+	}
+	want := "could not fetch eth1 block hash by height"
+	if _, err := ps.eth1Data(ctx, 0); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected error %q, received %v", want, err)
+	}
+}
+
 func Benchmark_Eth1Data(b *testing.B) {
 	beaconDB := internal.SetupDB(b)
 	defer internal.TeardownDB(b, beaconDB)
@@ -1203,6 +1363,9 @@ func Benchmark_Eth1Data(b *testing.B) {
 		powChainService: &mockPOWChainService{
 			latestBlockNumber: big.NewInt(int64(currentHeight)),
 			hashesByHeight:    hashesByHeight,
+			blockNumberByHeight: map[uint64]*big.Int{
+				0: big.NewInt(int64(currentHeight)),
+			},
 		},
 	}
 	b.ResetTimer()
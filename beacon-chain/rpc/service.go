@@ -17,10 +17,12 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -40,6 +42,7 @@ func init() {
 
 type chainService interface {
 	StateInitializedFeed() *event.Feed
+	blockchain.EventFeeds
 	blockchain.BlockReceiver
 	blockchain.ForkChoice
 	blockchain.TargetsFetcher
@@ -50,6 +53,8 @@ type operationService interface {
 	IsAttCanonical(ctx context.Context, att *ethpb.Attestation) (bool, error)
 	HandleAttestations(context.Context, proto.Message) error
 	IncomingAttFeed() *event.Feed
+	IncomingExitFeed() *event.Feed
+	IncomingProcessedBlockFeed() *event.Feed
 }
 
 type powChainService interface {
@@ -66,11 +71,13 @@ type powChainService interface {
 	ChainStartDepositHashes() ([][]byte, error)
 	ChainStartDeposits() []*ethpb.Deposit
 	ChainStartETH1Data() *ethpb.Eth1Data
+	EndpointStatuses() []*powchain.EndpointStatus
 }
 
 type syncService interface {
 	Status() error
 	sync.Checker
+	sync.StatusReporter
 }
 
 // Service defining an RPC server for a beacon node.
@@ -91,6 +98,8 @@ type Service struct {
 	incomingAttestation chan *ethpb.Attestation
 	credentialError     error
 	p2p                 p2p.Broadcaster
+	subnets             p2p.SubnetManager
+	clock               clockutil.Clock
 }
 
 // Config options for the beacon node RPC server.
@@ -104,17 +113,27 @@ type Config struct {
 	OperationService operationService
 	SyncService      syncService
 	Broadcaster      p2p.Broadcaster
+	SubnetManager    p2p.SubnetManager
+	// Clock is the local clock the subnets joined for validator duties
+	// expire on, the system clock if it is not set.
+	Clock clockutil.Clock
 }
 
 // NewRPCService creates a new instance of a struct implementing the BeaconServiceServer
 // interface.
 func NewRPCService(ctx context.Context, cfg *Config) *Service {
 	ctx, cancel := context.WithCancel(ctx)
+	clock := cfg.Clock
+	if clock == nil {
+		clock = clockutil.System{}
+	}
 	return &Service{
 		ctx:                 ctx,
 		cancel:              cancel,
 		beaconDB:            cfg.BeaconDB,
 		p2p:                 cfg.Broadcaster,
+		subnets:             cfg.SubnetManager,
+		clock:               clock,
 		chainService:        cfg.ChainService,
 		powChainService:     cfg.POWChainService,
 		operationService:    cfg.OperationService,
@@ -181,10 +200,13 @@ func (s *Service) Start() {
 		canonicalStateChan: s.canonicalStateChan,
 	}
 	attesterServer := &AttesterServer{
+		ctx:              s.ctx,
 		beaconDB:         s.beaconDB,
 		operationService: s.operationService,
 		p2p:              s.p2p,
+		subnets:          s.subnets,
 		cache:            cache.NewAttestationCache(),
+		aggregationDelay: time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 2,
 	}
 	validatorServer := &ValidatorServer{
 		ctx:                s.ctx,
@@ -192,11 +214,13 @@ func (s *Service) Start() {
 		chainService:       s.chainService,
 		canonicalStateChan: s.canonicalStateChan,
 		powChainService:    s.powChainService,
+		subnets:            s.subnets,
+		clock:              s.clock,
 	}
 	nodeServer := &NodeServer{
-		beaconDB:    s.beaconDB,
-		server:      s.grpcServer,
-		syncChecker: s.syncService,
+		beaconDB:   s.beaconDB,
+		server:     s.grpcServer,
+		syncStatus: s.syncService,
 	}
 	beaconChainServer := &BeaconChainServer{
 		beaconDB: s.beaconDB,
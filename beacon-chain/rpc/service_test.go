@@ -35,6 +35,10 @@ func (ms *mockOperationService) IncomingExitFeed() *event.Feed {
 	return new(event.Feed)
 }
 
+func (ms *mockOperationService) IncomingProcessedBlockFeed() *event.Feed {
+	return new(event.Feed)
+}
+
 func (ms *mockOperationService) HandleAttestations(_ context.Context, _ proto.Message) error {
 	return nil
 }
@@ -83,6 +87,8 @@ type mockChainService struct {
 	stateFeed            *event.Feed
 	attestationFeed      *event.Feed
 	stateInitializedFeed *event.Feed
+	headFeed             *event.Feed
+	checkpointFeed       *event.Feed
 	canonicalBlocks      map[uint64][]byte
 	targets              map[uint64]*pb.AttestationTarget
 }
@@ -91,6 +97,14 @@ func (m *mockChainService) StateInitializedFeed() *event.Feed {
 	return m.stateInitializedFeed
 }
 
+func (m *mockChainService) HeadFeed() *event.Feed {
+	return m.headFeed
+}
+
+func (m *mockChainService) CheckpointFeed() *event.Feed {
+	return m.checkpointFeed
+}
+
 func (m *mockChainService) ReceiveBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pb.BeaconState, error) {
 	return &pb.BeaconState{}, nil
 }
@@ -125,6 +139,8 @@ func newMockChainService() *mockChainService {
 		stateFeed:            new(event.Feed),
 		attestationFeed:      new(event.Feed),
 		stateInitializedFeed: new(event.Feed),
+		headFeed:             new(event.Feed),
+		checkpointFeed:       new(event.Feed),
 	}
 }
 
@@ -139,6 +155,10 @@ func (ms *mockSyncService) Syncing() bool {
 	return false
 }
 
+func (ms *mockSyncService) SyncStatus() (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{}, nil
+}
+
 func TestLifecycle_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	rpcService := NewRPCService(context.Background(), &Config{
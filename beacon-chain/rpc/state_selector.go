@@ -0,0 +1,252 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// selectState resolves the state selected by a request, the head state is
+// returned when no selector is given. The returned errors are gRPC status
+// errors.
+func (bs *BeaconChainServer) selectState(ctx context.Context, selector *ethpb.StateSelector) (*pbp2p.BeaconState, error) {
+	switch sel := selector.GetSelector().(type) {
+	case nil, *ethpb.StateSelector_Head:
+		return bs.headState(ctx)
+	case *ethpb.StateSelector_Finalized:
+		s, err := bs.beaconDB.FinalizedState()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve finalized state: %v", err)
+		}
+		return s, nil
+	case *ethpb.StateSelector_Justified:
+		s, err := bs.beaconDB.JustifiedState()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve justified state: %v", err)
+		}
+		return s, nil
+	case *ethpb.StateSelector_Slot:
+		return bs.stateAtSlot(ctx, sel.Slot)
+	case *ethpb.StateSelector_BlockRoot:
+		if len(sel.BlockRoot) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "block root must be 32 bytes, received %d", len(sel.BlockRoot))
+		}
+		block, err := bs.beaconDB.Block(bytesutil.ToBytes32(sel.BlockRoot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve block: %v", err)
+		}
+		if block == nil {
+			return nil, status.Errorf(codes.NotFound, "block %#x not found", sel.BlockRoot)
+		}
+		return bs.stateAtBlock(ctx, block)
+	case *ethpb.StateSelector_StateRoot:
+		if len(sel.StateRoot) != 32 {
+			return nil, status.Errorf(codes.InvalidArgument, "state root must be 32 bytes, received %d", len(sel.StateRoot))
+		}
+		return bs.stateWithRoot(ctx, sel.StateRoot)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown state selector %T", sel)
+	}
+}
+
+func (bs *BeaconChainServer) headState(ctx context.Context) (*pbp2p.BeaconState, error) {
+	s, err := bs.beaconDB.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve current state: %v", err)
+	}
+	if s == nil {
+		return nil, status.Error(codes.Internal, "no head state saved")
+	}
+	return s, nil
+}
+
+// stateAtSlot regenerates the canonical state at the given slot from the state
+// of the last canonical block at or before it.
+func (bs *BeaconChainServer) stateAtSlot(ctx context.Context, slot uint64) (*pbp2p.BeaconState, error) {
+	head, err := bs.headState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if slot > head.Slot {
+		return nil, status.Errorf(codes.InvalidArgument, "slot %d is ahead of the head slot %d", slot, head.Slot)
+	}
+	if slot == head.Slot {
+		return head, nil
+	}
+
+	block, err := bs.canonicalBlockAtOrBefore(ctx, head, slot)
+	if err != nil {
+		return nil, err
+	}
+	s, err := bs.stateAtBlock(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	if s.Slot < slot {
+		slotState, err := state.ProcessSlots(ctx, statetrie.New(s), slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not process slots up to %d: %v", slot, err)
+		}
+		s = slotState.InnerStateUnsafe()
+	}
+	return s, nil
+}
+
+// stateAtBlock returns the post state of the block. If it is not saved, the
+// state is regenerated by replaying the ancestors of the block on top of the
+// closest ancestor with a saved state.
+func (bs *BeaconChainServer) stateAtBlock(ctx context.Context, block *ethpb.BeaconBlock) (*pbp2p.BeaconState, error) {
+	headBlock, err := bs.beaconDB.ChainHead()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve chain head: %v", err)
+	}
+	headBlockRoot, err := ssz.SigningRoot(headBlock)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not hash chain head: %v", err)
+	}
+
+	// Collect the blocks to replay, from the block down to the closest
+	// ancestor with a saved state.
+	var replay []*ethpb.BeaconBlock
+	var s *pbp2p.BeaconState
+	for b := block; ; {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		root, err := ssz.SigningRoot(b)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not hash block: %v", err)
+		}
+		switch {
+		case root == headBlockRoot:
+			s, err = bs.headState(ctx)
+		case b.Slot == 0:
+			// The genesis state is only saved under the genesis slot.
+			s, err = bs.beaconDB.HistoricalStateFromSlot(ctx, 0, root)
+		default:
+			s, err = bs.beaconDB.HistoricalStateAtBlock(ctx, b.Slot, root)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve state of block at slot %d: %v", b.Slot, err)
+		}
+		if s != nil {
+			break
+		}
+		replay = append(replay, b)
+		parent, err := bs.beaconDB.Block(bytesutil.ToBytes32(b.ParentRoot))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve parent block: %v", err)
+		}
+		if parent == nil {
+			return nil, status.Errorf(codes.NotFound, "parent block %#x of slot %d not found", b.ParentRoot, b.Slot)
+		}
+		b = parent
+	}
+	postState := statetrie.New(s)
+	for i := len(replay) - 1; i >= 0; i-- {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		postState, err = state.ExecuteStateTransitionNoVerify(ctx, postState, replay[i])
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not replay block at slot %d: %v", replay[i].Slot, err)
+		}
+	}
+	return postState.InnerStateUnsafe(), nil
+}
+
+// stateWithRoot looks up the block whose post state has the given root and
+// returns its state, provided the block is canonical.
+func (bs *BeaconChainServer) stateWithRoot(ctx context.Context, root []byte) (*pbp2p.BeaconState, error) {
+	block, err := bs.beaconDB.BlockByStateRoot(bytesutil.ToBytes32(root))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve block by state root: %v", err)
+	}
+	if block == nil {
+		return nil, status.Errorf(codes.NotFound, "no canonical state with root %#x", root)
+	}
+	canonical, err := bs.beaconDB.CanonicalBlockBySlot(ctx, block.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not retrieve canonical block at slot %d: %v", block.Slot, err)
+	}
+	if canonical == nil || !bytes.Equal(canonical.StateRoot, root) {
+		return nil, status.Errorf(codes.NotFound, "no canonical state with root %#x", root)
+	}
+	return bs.stateAtBlock(ctx, block)
+}
+
+// canonicalBlockAtOrBefore returns the last canonical block at or before the
+// slot. The block roots of the head state are used for recent slots, older
+// slots are looked up at most SlotsPerHistoricalRoot slots back.
+func (bs *BeaconChainServer) canonicalBlockAtOrBefore(
+	ctx context.Context, head *pbp2p.BeaconState, slot uint64,
+) (*ethpb.BeaconBlock, error) {
+	slotsPerHistoricalRoot := params.BeaconConfig().SlotsPerHistoricalRoot
+	if slot < head.Slot && head.Slot <= slot+slotsPerHistoricalRoot {
+		root, err := helpers.BlockRootAtSlot(head, slot)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve block root at slot %d: %v", slot, err)
+		}
+		block, err := bs.beaconDB.Block(bytesutil.ToBytes32(root))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve block: %v", err)
+		}
+		if block == nil {
+			return nil, status.Errorf(codes.NotFound, "canonical block %#x at slot %d not found", root, slot)
+		}
+		return block, nil
+	}
+
+	lowest := uint64(0)
+	if slot > slotsPerHistoricalRoot {
+		lowest = slot - slotsPerHistoricalRoot
+	}
+	for s := slot; ; s-- {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		block, err := bs.beaconDB.CanonicalBlockBySlot(ctx, s)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "could not retrieve canonical block at slot %d: %v", s, err)
+		}
+		if block != nil {
+			return block, nil
+		}
+		if s == lowest {
+			return nil, status.Errorf(codes.NotFound, "no canonical block at or before slot %d", slot)
+		}
+	}
+}
+
+// epochState returns the state selected by the request, or the state at the
+// given slot of the epoch when no selector is given. The head state is used
+// when no epoch is requested and for the epochs which have not completed yet.
+func (bs *BeaconChainServer) epochState(
+	ctx context.Context, selector *ethpb.StateSelector, epoch uint64, hasEpoch bool, slot uint64,
+) (*pbp2p.BeaconState, error) {
+	if selector != nil || !hasEpoch {
+		return bs.selectState(ctx, selector)
+	}
+	head, err := bs.headState(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if epoch > helpers.NextEpoch(head) {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d is later than the next epoch %d",
+			epoch, helpers.NextEpoch(head))
+	}
+	if epoch >= helpers.CurrentEpoch(head) {
+		return head, nil
+	}
+	return bs.stateAtSlot(ctx, slot)
+}
@@ -0,0 +1,144 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// childBlock builds the block at the slot on top of the parent state, with its
+// state root set to the root of the returned post state.
+func childBlock(
+	t *testing.T, parentState *pbp2p.BeaconState, parentRoot [32]byte, slot uint64, privKeys []*bls.SecretKey,
+) (*ethpb.BeaconBlock, *pbp2p.BeaconState) {
+	ctx := context.Background()
+	preState := statetrie.New(parentState)
+	slotState, err := state.ProcessSlots(ctx, preState.Copy(), slot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := slotState.InnerStateUnsafe()
+	randaoReveal, err := testutil.CreateRandaoReveal(s, helpers.CurrentEpoch(s), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       slot,
+		ParentRoot: parentRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			RandaoReveal: randaoReveal,
+			Eth1Data:     &ethpb.Eth1Data{},
+		},
+	}
+	postState, err := state.ExecuteStateTransitionNoVerify(ctx, preState, block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stateRoot, err := postState.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	block.StateRoot = stateRoot[:]
+	return block, postState.CloneInnerState()
+}
+
+func TestStateAtBlock_ReplaysFromForkAncestor(t *testing.T) {
+	helpers.ClearAllCaches()
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	ctx := context.Background()
+
+	deposits, privKeys := testutil.SetupInitialDeposits(t, 64)
+	genesisState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesisStateRoot, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	genesis := b.NewGenesisBlock(genesisStateRoot[:])
+	if err := db.SaveBlock(genesis); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, genesis, genesisState); err != nil {
+		t.Fatal(err)
+	}
+	genesisRoot, err := ssz.SigningRoot(genesis)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The fork is genesis <- A <- B, only the state of A is saved.
+	forkA, forkAState := childBlock(t, genesisState, genesisRoot, 1, privKeys)
+	if err := db.SaveBlock(forkA); err != nil {
+		t.Fatal(err)
+	}
+	forkARoot, err := ssz.SigningRoot(forkA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SaveHistoricalState(ctx, forkAState, forkARoot); err != nil {
+		t.Fatal(err)
+	}
+	forkB, _ := childBlock(t, forkAState, forkARoot, 3, privKeys)
+	if err := db.SaveBlock(forkB); err != nil {
+		t.Fatal(err)
+	}
+
+	// The canonical chain is genesis <- C.
+	head, headState := childBlock(t, genesisState, genesisRoot, 2, privKeys)
+	if err := db.SaveBlock(head); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, head, headState); err != nil {
+		t.Fatal(err)
+	}
+
+	bs := &BeaconChainServer{
+		beaconDB: db,
+	}
+
+	s, err := bs.stateAtBlock(ctx, forkB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Slot != forkB.Slot {
+		t.Errorf("Wanted state at slot %d, received %d", forkB.Slot, s.Slot)
+	}
+	if !bytes.Equal(s.LatestBlockHeader.ParentRoot, forkARoot[:]) {
+		t.Errorf("Wanted state built on block %#x, received parent %#x", forkARoot, s.LatestBlockHeader.ParentRoot)
+	}
+	stateRoot, err := ssz.HashTreeRoot(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stateRoot[:], forkB.StateRoot) {
+		t.Errorf("Wanted state root %#x, received %#x", forkB.StateRoot, stateRoot)
+	}
+
+	// Only the states of canonical blocks can be selected by their root.
+	s, err = bs.stateWithRoot(ctx, head.StateRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Slot != head.Slot {
+		t.Errorf("Wanted state at slot %d, received %d", head.Slot, s.Slot)
+	}
+	if _, err := bs.stateWithRoot(ctx, forkB.StateRoot); status.Code(err) != codes.NotFound {
+		t.Errorf("Wanted code %v for a state off the canonical chain, received %v", codes.NotFound, err)
+	}
+}
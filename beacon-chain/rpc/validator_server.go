@@ -16,6 +16,8 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -29,6 +31,8 @@ type ValidatorServer struct {
 	chainService       chainService
 	canonicalStateChan chan *pbp2p.BeaconState
 	powChainService    powChainService
+	subnets            p2p.SubnetManager
+	clock              clockutil.Clock
 }
 
 // WaitForActivation checks if a validator public key exists in the active validator registry of the current
@@ -134,17 +138,18 @@ func (vs *ValidatorServer) ValidatorPerformance(
 //	3.) The slot at which the committee is assigned.
 //	4.) The bool signaling if the validator is expected to propose a block at the assigned slot.
 func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.AssignmentRequest) (*pb.AssignmentResponse, error) {
-	s, err := vs.beaconDB.HeadState(ctx)
+	headState, err := vs.beaconDB.HeadStateTrie(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not fetch beacon state")
 	}
 
 	// Advance state with empty transitions up to the requested slot.
 	slotsToAdvance := req.EpochStart * params.BeaconConfig().SlotsPerEpoch
-	s, err = state.ProcessSlots(ctx, s, slotsToAdvance)
+	headState, err = state.ProcessSlots(ctx, headState, slotsToAdvance)
 	if err != nil {
 		return nil, fmt.Errorf("could not process slots up to %d", slotsToAdvance)
 	}
+	s := headState.InnerStateUnsafe()
 
 	validatorIndexMap := stateutils.ValidatorIndexMap(s)
 	var assignments []*pb.AssignmentResponse_ValidatorAssignment
@@ -167,6 +172,7 @@ func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.Assi
 			if err != nil {
 				return nil, err
 			}
+			vs.joinDutySubnet(s.GenesisTime, assignment)
 		} else if ok {
 			// Update inactive validator's status
 			status := vs.lookupValidatorStatus(uint64(idx), s)
@@ -180,6 +186,24 @@ func (vs *ValidatorServer) CommitteeAssignment(ctx context.Context, req *pb.Assi
 	}, nil
 }
 
+// joinDutySubnet subscribes the node to the attestation subnet of the
+// validator's committee until the slot after its attestation duty, leaving
+// time to aggregate the attestations of the committee. The expiry is set on
+// the clock of the server, duties which already passed are ignored.
+func (vs *ValidatorServer) joinDutySubnet(genesisTime uint64, assignment *pb.AssignmentResponse_ValidatorAssignment) {
+	subnet := vs.subnets.AttestationSubnetForShard(assignment.Shard)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	now := vs.clock.Now()
+	sinceGenesis := now.Sub(time.Unix(int64(genesisTime), 0))
+	untilExpiry := time.Duration(assignment.Slot+2)*slotDuration - sinceGenesis
+	if untilExpiry <= 0 {
+		return
+	}
+	if err := vs.subnets.JoinAttestationSubnet(subnet, now.Add(untilExpiry)); err != nil {
+		log.WithError(err).WithField("subnet", subnet).Error("Could not join attestation subnet")
+	}
+}
+
 func (vs *ValidatorServer) assignment(
 	pubkey []byte,
 	beaconState *pbp2p.BeaconState,
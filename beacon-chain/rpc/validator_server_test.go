@@ -25,6 +25,7 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/trieutil"
@@ -116,6 +117,8 @@ func TestNextEpochCommitteeAssignment_WrongPubkeyLength(t *testing.T) {
 	}
 	validatorServer := &ValidatorServer{
 		beaconDB: db,
+		subnets:  &mockSubnetManager{},
+		clock:    clockutil.NewManual(time.Unix(0, 0)),
 	}
 	req := &pb.AssignmentRequest{
 		PublicKeys: [][]byte{{1}},
@@ -143,6 +146,8 @@ func TestNextEpochCommitteeAssignment_CantFindValidatorIdx(t *testing.T) {
 	db.UpdateChainHead(ctx, genesis, state)
 	vs := &ValidatorServer{
 		beaconDB: db,
+		subnets:  &mockSubnetManager{},
+		clock:    clockutil.NewManual(time.Unix(0, 0)),
 	}
 
 	pubKey := make([]byte, 96)
@@ -195,8 +200,11 @@ func TestCommitteeAssignment_OK(t *testing.T) {
 		}
 	}
 
+	subnets := &mockSubnetManager{}
 	vs := &ValidatorServer{
 		beaconDB: db,
+		subnets:  subnets,
+		clock:    clockutil.NewManual(time.Unix(0, 0)),
 	}
 
 	// Test the first validator in registry.
@@ -208,6 +216,15 @@ func TestCommitteeAssignment_OK(t *testing.T) {
 	if err != nil {
 		t.Fatalf("Could not call epoch committee assignment %v", err)
 	}
+	wantSubnet := res.ValidatorAssignment[0].Shard % mockSubnetCount
+	if len(subnets.joined) != 1 || subnets.joined[0] != wantSubnet {
+		t.Errorf("Expected the node to join subnet %d for the duty, joined %v", wantSubnet, subnets.joined)
+	}
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	wantExpiry := time.Unix(0, 0).Add(time.Duration(res.ValidatorAssignment[0].Slot+2) * slotDuration)
+	if len(subnets.expiries) != 1 || !subnets.expiries[0].Equal(wantExpiry) {
+		t.Errorf("Expected the duty subnet to expire at %v, expiries %v", wantExpiry, subnets.expiries)
+	}
 	if res.ValidatorAssignment[0].Shard >= params.BeaconConfig().ShardCount {
 		t.Errorf("Assigned shard %d can't be higher than %d",
 			res.ValidatorAssignment[0].Shard, params.BeaconConfig().ShardCount)
@@ -237,6 +254,31 @@ func TestCommitteeAssignment_OK(t *testing.T) {
 	}
 }
 
+func TestJoinDutySubnet_ExpiresOnServerClock(t *testing.T) {
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	genesis := time.Unix(1000, 0)
+	subnets := &mockSubnetManager{}
+	vs := &ValidatorServer{
+		subnets: subnets,
+		clock:   clockutil.NewManual(genesis.Add(10 * slotDuration)),
+	}
+
+	// Duties expire two slots after their slot, the duties of slots 7 and 8
+	// have expired by slot 10.
+	for slot := uint64(7); slot <= 9; slot++ {
+		vs.joinDutySubnet(uint64(genesis.Unix()), &pb.AssignmentResponse_ValidatorAssignment{
+			Shard: slot,
+			Slot:  slot,
+		})
+	}
+	if len(subnets.joined) != 1 || subnets.joined[0] != 9%mockSubnetCount {
+		t.Fatalf("Expected only the subnet of the slot 9 duty to be joined, joined %v", subnets.joined)
+	}
+	if want := genesis.Add(11 * slotDuration); !subnets.expiries[0].Equal(want) {
+		t.Errorf("Expected the duty subnet to expire at %v, received %v", want, subnets.expiries[0])
+	}
+}
+
 func TestCommitteeAssignment_multipleKeys_OK(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
@@ -275,6 +317,8 @@ func TestCommitteeAssignment_multipleKeys_OK(t *testing.T) {
 
 	vs := &ValidatorServer{
 		beaconDB: db,
+		subnets:  &mockSubnetManager{},
+		clock:    clockutil.NewManual(time.Unix(0, 0)),
 	}
 
 	pubkey0 := deposits[0].Data.PublicKey
@@ -993,6 +1037,8 @@ func BenchmarkAssignment(b *testing.B) {
 
 	vs := &ValidatorServer{
 		beaconDB: db,
+		subnets:  &mockSubnetManager{},
+		clock:    clockutil.NewManual(time.Unix(0, 0)),
 	}
 
 	// Set up request for 100 public keys at a time
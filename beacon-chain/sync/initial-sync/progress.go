@@ -0,0 +1,70 @@
+package initialsync
+
+import (
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+// Progress is a snapshot of how far initial sync has come.
+type Progress struct {
+	// Started is the time initial sync began, zero if it never ran.
+	Started time.Time
+	// TargetPeer is the peer the node is currently syncing from.
+	TargetPeer peer.ID
+	// TargetSlot is the head slot reported by the target peer.
+	TargetSlot uint64
+	// StateReceived is true once the finalized state was downloaded from the
+	// target peer, after which blocks are downloaded.
+	StateReceived bool
+	// StateSlot is the slot of the finalized state blocks are synced from.
+	StateSlot uint64
+	// BlocksProcessed is the number of blocks processed since the first block
+	// was received.
+	BlocksProcessed uint64
+	// FirstBlock is the time the first block was processed.
+	FirstBlock time.Time
+}
+
+// BlocksPerSecond returns the rate at which blocks were processed up to now.
+func (p Progress) BlocksPerSecond(now time.Time) float64 {
+	elapsed := now.Sub(p.FirstBlock).Seconds()
+	if p.BlocksProcessed == 0 || elapsed <= 0 {
+		return 0
+	}
+	return float64(p.BlocksProcessed) / elapsed
+}
+
+// Progress returns a snapshot of the progress of initial sync.
+func (s *InitialSync) Progress() Progress {
+	s.progressLock.RLock()
+	defer s.progressLock.RUnlock()
+	return s.progress
+}
+
+func (s *InitialSync) startProgress(target peer.ID, targetSlot uint64) {
+	s.progressLock.Lock()
+	defer s.progressLock.Unlock()
+	if s.progress.Started.IsZero() {
+		s.progress.Started = time.Now()
+	}
+	s.progress.TargetPeer = target
+	s.progress.TargetSlot = targetSlot
+	s.progress.StateReceived = false
+}
+
+func (s *InitialSync) stateProgress(stateSlot uint64) {
+	s.progressLock.Lock()
+	defer s.progressLock.Unlock()
+	s.progress.StateReceived = true
+	s.progress.StateSlot = stateSlot
+}
+
+func (s *InitialSync) blockProgress() {
+	s.progressLock.Lock()
+	defer s.progressLock.Unlock()
+	if s.progress.FirstBlock.IsZero() {
+		s.progress.FirstBlock = time.Now()
+	}
+	s.progress.BlocksProcessed++
+}
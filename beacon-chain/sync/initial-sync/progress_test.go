@@ -0,0 +1,57 @@
+package initialsync
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func TestProgress_BlocksPerSecond(t *testing.T) {
+	now := time.Now()
+	p := Progress{}
+	if rate := p.BlocksPerSecond(now); rate != 0 {
+		t.Errorf("Expected no rate before any block, received %f", rate)
+	}
+	p = Progress{
+		BlocksProcessed: 50,
+		FirstBlock:      now.Add(-10 * time.Second),
+	}
+	if rate := p.BlocksPerSecond(now); rate != 5 {
+		t.Errorf("Expected 5 blocks per second, received %f", rate)
+	}
+}
+
+func TestInitialSync_Progress(t *testing.T) {
+	ss := NewInitialSyncService(context.Background(), &Config{})
+	if !ss.Progress().Started.IsZero() {
+		t.Fatal("Expected initial sync not to be started")
+	}
+
+	target := peer.ID("peer")
+	ss.startProgress(target, 100)
+	ss.stateProgress(64)
+	ss.blockProgress()
+	ss.blockProgress()
+
+	progress := ss.Progress()
+	if progress.Started.IsZero() || progress.FirstBlock.IsZero() {
+		t.Error("Expected start times to be recorded")
+	}
+	if progress.TargetPeer != target || progress.TargetSlot != 100 {
+		t.Errorf("Unexpected sync target %s at slot %d", progress.TargetPeer, progress.TargetSlot)
+	}
+	if !progress.StateReceived || progress.StateSlot != 64 {
+		t.Errorf("Expected state at slot 64 to be received, received %v", progress)
+	}
+	if progress.BlocksProcessed != 2 {
+		t.Errorf("Expected 2 processed blocks, received %d", progress.BlocksProcessed)
+	}
+
+	// Switching to the next peer requests the state again.
+	ss.startProgress(peer.ID("other"), 90)
+	if ss.Progress().StateReceived {
+		t.Error("Expected state to be requested again from the next peer")
+	}
+}
@@ -104,6 +104,8 @@ type InitialSync struct {
 	stateReceived       bool
 	mutex               *sync.Mutex
 	nodeIsSynced        bool
+	progress            Progress
+	progressLock        sync.RWMutex
 }
 
 // NewInitialSyncService constructs a new InitialSyncService.
@@ -134,8 +136,8 @@ func NewInitialSyncService(ctx context.Context,
 }
 
 // Start begins the goroutine.
-func (s *InitialSync) Start(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) {
-	go s.run(chainHeadResponses)
+func (s *InitialSync) Start(peerStatuses map[peer.ID]*pb.Handshake) {
+	go s.run(peerStatuses)
 }
 
 // Stop kills the initial sync goroutine.
@@ -150,7 +152,7 @@ func (s *InitialSync) NodeIsSynced() bool {
 	return s.nodeIsSynced
 }
 
-func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBlock, chainHead *pb.ChainHeadResponse) error {
+func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBlock, chainHead *pb.Handshake) error {
 	if s.nodeIsSynced {
 		return nil
 	}
@@ -203,11 +205,11 @@ func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBl
 
 	stateRoot := s.db.HeadStateRoot()
 
-	if stateRoot != bytesutil.ToBytes32(chainHead.CanonicalStateRootHash32) {
+	if stateRoot != bytesutil.ToBytes32(chainHead.HeadStateRoot) {
 		log.Errorf(
 			"Canonical state root %#x does not match highest observed root from peer %#x",
 			stateRoot,
-			chainHead.CanonicalStateRootHash32,
+			chainHead.HeadStateRoot,
 		)
 
 		return ErrCanonicalStateMismatch
@@ -222,7 +224,7 @@ func (s *InitialSync) exitInitialSync(ctx context.Context, block *ethpb.BeaconBl
 // run is the main goroutine for the initial sync service.
 // delayChan is explicitly passed into this function to facilitate tests that don't require a timeout.
 // It is assumed that the goroutine `run` is only called once per instance.
-func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse) {
+func (s *InitialSync) run(peerStatuses map[peer.ID]*pb.Handshake) {
 	batchedBlocksub := s.p2p.Subscribe(&pb.BatchedBeaconBlockResponse{}, s.batchedBlockBuf)
 	beaconStateSub := s.p2p.Subscribe(&pb.BeaconStateResponse{}, s.stateBuf)
 	defer func() {
@@ -235,17 +237,17 @@ func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse)
 	ctx := s.ctx
 
 	var peers []peer.ID
-	for k := range chainHeadResponses {
+	for k := range peerStatuses {
 		peers = append(peers, k)
 	}
 
-	// Sort peers in descending order based on their canonical slot.
+	// Sort peers in descending order based on their head slot.
 	sort.Slice(peers, func(i, j int) bool {
-		return chainHeadResponses[peers[i]].CanonicalSlot > chainHeadResponses[peers[j]].CanonicalSlot
+		return peerStatuses[peers[i]].HeadSlot > peerStatuses[peers[j]].HeadSlot
 	})
 
 	for _, peer := range peers {
-		chainHead := chainHeadResponses[peer]
+		chainHead := peerStatuses[peer]
 		if err := s.syncToPeer(ctx, chainHead, peer); err != nil {
 			log.WithError(err).WithField("peer", peer.Pretty()).Warn("Failed to sync with peer, trying next best peer")
 			continue
@@ -259,14 +261,15 @@ func (s *InitialSync) run(chainHeadResponses map[peer.ID]*pb.ChainHeadResponse)
 	}
 }
 
-func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.ChainHeadResponse, peer peer.ID) error {
+func (s *InitialSync) syncToPeer(ctx context.Context, peerStatus *pb.Handshake, peer peer.ID) error {
 	fields := logrus.Fields{
 		"peer":          peer.Pretty(),
-		"canonicalSlot": chainHeadResponse.CanonicalSlot,
+		"canonicalSlot": peerStatus.HeadSlot,
 	}
 
+	s.startProgress(peer, peerStatus.HeadSlot)
 	log.WithFields(fields).Info("Requesting state from peer")
-	if err := s.requestStateFromPeer(ctx, bytesutil.ToBytes32(chainHeadResponse.FinalizedStateRootHash32S), peer); err != nil {
+	if err := s.requestStateFromPeer(ctx, bytesutil.ToBytes32(peerStatus.FinalizedStateRoot), peer); err != nil {
 		log.Errorf("Could not request state from peer %v", err)
 	}
 
@@ -280,7 +283,7 @@ func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.Chai
 			return ctx.Err()
 		case msg := <-s.stateBuf:
 			log.WithFields(fields).Info("Received state resp from peer")
-			if err := s.processState(msg, chainHeadResponse); err != nil {
+			if err := s.processState(msg, peerStatus); err != nil {
 				return err
 			}
 		case msg := <-s.batchedBlockBuf:
@@ -288,7 +291,7 @@ func (s *InitialSync) syncToPeer(ctx context.Context, chainHeadResponse *pb.Chai
 				continue
 			}
 			log.WithFields(fields).Info("Received batched blocks from peer")
-			if err := s.processBatchedBlocks(msg, chainHeadResponse); err != nil {
+			if err := s.processBatchedBlocks(msg, peerStatus); err != nil {
 				log.WithError(err).WithField("peer", peer).Error("Failed to sync with peer.")
 				s.p2p.Reputation(msg.Peer, p2p.RepPenalityInitialSyncFailure)
 				continue
@@ -160,7 +160,7 @@ func TestProcessingBatchedBlocks_OK(t *testing.T) {
 		},
 	}
 
-	chainHead := &pb.ChainHeadResponse{}
+	chainHead := &pb.Handshake{}
 
 	ss.processBatchedBlocks(msg, chainHead)
 }
@@ -200,7 +200,7 @@ func TestProcessingBlocks_SkippedSlots(t *testing.T) {
 			ParentRoot: parentHash,
 		}
 
-		chainHead := &pb.ChainHeadResponse{}
+		chainHead := &pb.Handshake{}
 
 		ss.processBlock(context.Background(), block, chainHead)
 
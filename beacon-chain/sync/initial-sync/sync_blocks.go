@@ -19,29 +19,31 @@ import (
 // processBlock is the main method that validates each block which is received
 // for initial sync. It checks if the blocks are valid and then will continue to
 // process and save it into the db.
-func (s *InitialSync) processBlock(ctx context.Context, block *ethpb.BeaconBlock, chainHead *pb.ChainHeadResponse) error {
+func (s *InitialSync) processBlock(ctx context.Context, block *ethpb.BeaconBlock, chainHead *pb.Handshake) error {
 	ctx, span := trace.StartSpan(ctx, "beacon-chain.sync.initial-sync.processBlock")
 	defer span.End()
 	recBlock.Inc()
 
-	if block.Slot == chainHead.CanonicalSlot {
+	if block.Slot == chainHead.HeadSlot {
 		if err := s.exitInitialSync(s.ctx, block, chainHead); err != nil {
 			log.Errorf("Could not exit initial sync: %v", err)
 			return err
 		}
+		s.blockProgress()
 		return nil
 	}
 
 	if err := s.validateAndSaveNextBlock(ctx, block); err != nil {
 		return err
 	}
+	s.blockProgress()
 
 	return nil
 }
 
 // processBatchedBlocks processes all the received blocks from
 // the p2p message.
-func (s *InitialSync) processBatchedBlocks(msg p2p.Message, chainHead *pb.ChainHeadResponse) error {
+func (s *InitialSync) processBatchedBlocks(msg p2p.Message, chainHead *pb.Handshake) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processBatchedBlocks")
 	defer span.End()
 	batchedBlockReq.Inc()
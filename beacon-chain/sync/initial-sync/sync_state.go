@@ -12,7 +12,7 @@ import (
 	"go.opencensus.io/trace"
 )
 
-func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.ChainHeadResponse) error {
+func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.Handshake) error {
 	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.initial-sync.processState")
 	defer span.End()
 	data := msg.Data.(*pb.BeaconStateResponse)
@@ -86,12 +86,13 @@ func (s *InitialSync) processState(msg p2p.Message, chainHead *pb.ChainHeadRespo
 	validators.InitializeValidatorStore(finalizedState)
 
 	s.stateReceived = true
+	s.stateProgress(finalizedState.Slot)
 	log.Debugf(
 		"Successfully saved beacon state with the last finalized slot: %d",
 		finalizedState.Slot,
 	)
 	log.WithField("peer", msg.Peer.Pretty()).Info("Requesting batch blocks from peer")
-	s.requestBatchedBlocks(ctx, finalizedBlockRoot[:], chainHead.CanonicalBlockRoot, msg.Peer)
+	s.requestBatchedBlocks(ctx, finalizedBlockRoot[:], chainHead.HeadRoot, msg.Peer)
 
 	return nil
 }
@@ -60,6 +60,26 @@ var (
 		Name: "regsync_received_attestation",
 		Help: "The number of received attestations",
 	})
+	recSubnetAttestation = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_subnet_attestation",
+		Help: "The number of received unaggregated attestations from attestation subnets",
+	})
+	pendingBlocksRejected = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_pending_blocks_rejected",
+		Help: "The number of blocks with an unknown parent rejected by the pending block pool",
+	})
+	pendingBlocksEvicted = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_pending_blocks_evicted",
+		Help: "The number of pending blocks evicted to make room for lower slot blocks",
+	})
+	pendingBlocksExpired = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_pending_blocks_expired",
+		Help: "The number of pending blocks evicted because their parent never arrived",
+	})
+	recAggregateAttestation = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "regsync_received_aggregate_attestation",
+		Help: "The number of received aggregate attestations",
+	})
 	sentAttestation = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "regsync_sent_attestation",
 		Help: "The number of sent attestations",
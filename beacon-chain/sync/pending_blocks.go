@@ -0,0 +1,171 @@
+package sync
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/p2p"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+var (
+	errPendingBlockTooFarAhead = errors.New("block is too far ahead of the head to be kept pending")
+	errPendingPoolFull         = errors.New("pending block pool is full")
+)
+
+// pendingBlock is a block received before its parent, kept until the parent
+// is processed or the block expires.
+type pendingBlock struct {
+	msg        p2p.Message
+	root       [32]byte
+	parentRoot [32]byte
+	slot       uint64
+	received   time.Time
+}
+
+// pendingBlocks is a pool of blocks awaiting their parent. The pool is bounded
+// both in the number of blocks and in how far ahead of the head a block may
+// be. Blocks whose parent does not arrive before the expiry are evicted.
+type pendingBlocks struct {
+	lock          sync.Mutex
+	maxSize       int
+	maxSlotsAhead uint64
+	expiry        time.Duration
+	blocks        map[[32]byte]*pendingBlock
+	children      map[[32]byte]map[[32]byte]bool
+}
+
+// newPendingBlocks creates a pending block pool. Zero values fall back to the
+// defaults of DefaultRegularSyncConfig.
+func newPendingBlocks(maxSize int, maxSlotsAhead uint64, expiry time.Duration) *pendingBlocks {
+	defaults := DefaultRegularSyncConfig()
+	if maxSize == 0 {
+		maxSize = defaults.MaxPendingBlocks
+	}
+	if maxSlotsAhead == 0 {
+		maxSlotsAhead = defaults.MaxPendingSlotsAhead
+	}
+	if expiry == 0 {
+		expiry = defaults.PendingBlockExpiry
+	}
+	return &pendingBlocks{
+		maxSize:       maxSize,
+		maxSlotsAhead: maxSlotsAhead,
+		expiry:        expiry,
+		blocks:        make(map[[32]byte]*pendingBlock),
+		children:      make(map[[32]byte]map[[32]byte]bool),
+	}
+}
+
+// insert adds a block to the pool. Blocks too far ahead of the head slot are
+// rejected. When the pool is full, the block with the highest slot is evicted
+// to make room, unless the new block is itself the highest.
+func (p *pendingBlocks) insert(b *pendingBlock, headSlot uint64) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if b.slot > headSlot+p.maxSlotsAhead {
+		pendingBlocksRejected.Inc()
+		return errPendingBlockTooFarAhead
+	}
+	if _, ok := p.blocks[b.root]; ok {
+		return nil
+	}
+	if len(p.blocks) >= p.maxSize {
+		var highest *pendingBlock
+		for _, pending := range p.blocks {
+			if highest == nil || pending.slot > highest.slot {
+				highest = pending
+			}
+		}
+		if highest == nil || highest.slot <= b.slot {
+			pendingBlocksRejected.Inc()
+			return errPendingPoolFull
+		}
+		p.remove(highest.root)
+		pendingBlocksEvicted.Inc()
+	}
+
+	p.blocks[b.root] = b
+	if p.children[b.parentRoot] == nil {
+		p.children[b.parentRoot] = make(map[[32]byte]bool)
+	}
+	p.children[b.parentRoot][b.root] = true
+	blocksAwaitingProcessingGauge.Set(float64(len(p.blocks)))
+	return nil
+}
+
+// has returns true if the block with the given root is in the pool.
+func (p *pendingBlocks) has(root [32]byte) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	_, ok := p.blocks[root]
+	return ok
+}
+
+// takeChildren removes and returns the pending children of a block, ordered
+// by slot.
+func (p *pendingBlocks) takeChildren(parentRoot [32]byte) []*pendingBlock {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var children []*pendingBlock
+	for root := range p.children[parentRoot] {
+		if b, ok := p.blocks[root]; ok {
+			children = append(children, b)
+		}
+		p.remove(root)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].slot < children[j].slot
+	})
+	blocksAwaitingProcessingGauge.Set(float64(len(p.blocks)))
+	return children
+}
+
+// pruneExpired evicts the blocks which have been pending for longer than the
+// expiry, as well as the blocks at or before the finalized slot. It returns
+// the number of evicted blocks.
+func (p *pendingBlocks) pruneExpired(now time.Time, finalizedSlot uint64) int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	pruned := 0
+	for root, b := range p.blocks {
+		if now.Sub(b.received) < p.expiry && b.slot > finalizedSlot {
+			continue
+		}
+		p.remove(root)
+		pruned++
+	}
+	pendingBlocksExpired.Add(float64(pruned))
+	blocksAwaitingProcessingGauge.Set(float64(len(p.blocks)))
+	return pruned
+}
+
+func (p *pendingBlocks) len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.blocks)
+}
+
+// remove deletes a block from the pool. The caller must hold the lock.
+func (p *pendingBlocks) remove(root [32]byte) {
+	b, ok := p.blocks[root]
+	if !ok {
+		return
+	}
+	delete(p.blocks, root)
+	delete(p.children[b.parentRoot], root)
+	if len(p.children[b.parentRoot]) == 0 {
+		delete(p.children, b.parentRoot)
+	}
+}
+
+// defaultPendingBlockExpiry is the time a block may wait for its parent,
+// two epochs worth of slots.
+func defaultPendingBlockExpiry() time.Duration {
+	return time.Duration(2*params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+}
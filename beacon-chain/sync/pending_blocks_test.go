@@ -0,0 +1,96 @@
+package sync
+
+import (
+	"testing"
+	"time"
+)
+
+func testPendingBlock(root byte, parent byte, slot uint64, received time.Time) *pendingBlock {
+	return &pendingBlock{
+		root:       [32]byte{root},
+		parentRoot: [32]byte{parent},
+		slot:       slot,
+		received:   received,
+	}
+}
+
+func TestPendingBlocks_RejectsBlocksTooFarAhead(t *testing.T) {
+	pool := newPendingBlocks(10, 4, time.Minute)
+	now := time.Now()
+	if err := pool.insert(testPendingBlock('a', 'z', 14, now), 10); err != nil {
+		t.Fatalf("Expected block within bound to be accepted: %v", err)
+	}
+	if err := pool.insert(testPendingBlock('b', 'z', 15, now), 10); err != errPendingBlockTooFarAhead {
+		t.Errorf("Expected error %v, received %v", errPendingBlockTooFarAhead, err)
+	}
+	if pool.len() != 1 {
+		t.Errorf("Expected 1 pending block, received %d", pool.len())
+	}
+}
+
+func TestPendingBlocks_EvictsHighestSlotWhenFull(t *testing.T) {
+	pool := newPendingBlocks(2, 100, time.Minute)
+	now := time.Now()
+	if err := pool.insert(testPendingBlock('a', 'z', 3, now), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.insert(testPendingBlock('b', 'z', 5, now), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.insert(testPendingBlock('c', 'z', 6, now), 0); err != errPendingPoolFull {
+		t.Errorf("Expected error %v, received %v", errPendingPoolFull, err)
+	}
+	if err := pool.insert(testPendingBlock('d', 'z', 4, now), 0); err != nil {
+		t.Fatal(err)
+	}
+	if pool.has([32]byte{'b'}) {
+		t.Error("Expected the highest slot block to be evicted")
+	}
+	if !pool.has([32]byte{'a'}) || !pool.has([32]byte{'d'}) {
+		t.Error("Expected the lower slot blocks to be kept")
+	}
+}
+
+func TestPendingBlocks_PruneExpired(t *testing.T) {
+	pool := newPendingBlocks(10, 100, time.Minute)
+	now := time.Now()
+	if err := pool.insert(testPendingBlock('a', 'z', 10, now.Add(-2*time.Minute)), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.insert(testPendingBlock('b', 'z', 4, now), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := pool.insert(testPendingBlock('c', 'z', 12, now), 0); err != nil {
+		t.Fatal(err)
+	}
+	if pruned := pool.pruneExpired(now, 8); pruned != 2 {
+		t.Errorf("Expected 2 pruned blocks, received %d", pruned)
+	}
+	if !pool.has([32]byte{'c'}) || pool.len() != 1 {
+		t.Error("Expected only the recent block ahead of finalization to remain")
+	}
+}
+
+func TestPendingBlocks_TakeChildrenOrderedBySlot(t *testing.T) {
+	pool := newPendingBlocks(10, 100, time.Minute)
+	now := time.Now()
+	for _, b := range []*pendingBlock{
+		testPendingBlock('a', 'p', 7, now),
+		testPendingBlock('b', 'p', 5, now),
+		testPendingBlock('c', 'q', 6, now),
+	} {
+		if err := pool.insert(b, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	children := pool.takeChildren([32]byte{'p'})
+	if len(children) != 2 {
+		t.Fatalf("Expected 2 children, received %d", len(children))
+	}
+	if children[0].slot != 5 || children[1].slot != 7 {
+		t.Errorf("Expected children ordered by slot, received slots %d and %d", children[0].slot, children[1].slot)
+	}
+	if pool.len() != 1 || pool.has([32]byte{'a'}) {
+		t.Error("Expected children to be removed from the pool")
+	}
+}
@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"math/big"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -11,8 +12,6 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
-	"github.com/prysmaticlabs/prysm/shared/p2p"
-	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
@@ -28,19 +27,19 @@ type powChainService interface {
 
 // QuerierConfig defines the configurable properties of SyncQuerier.
 type QuerierConfig struct {
-	ResponseBufferSize int
-	P2P                p2pAPI
-	BeaconDB           *db.BeaconDB
-	PowChain           powChainService
-	CurrentHeadSlot    uint64
-	ChainService       chainService
+	StatusQueryInterval time.Duration
+	P2P                 p2pAPI
+	BeaconDB            *db.BeaconDB
+	PowChain            powChainService
+	CurrentHeadSlot     uint64
+	ChainService        chainService
 }
 
 // DefaultQuerierConfig provides the default configuration for a sync service.
-// ResponseBufferSize determines that buffer size of the `responseBuf` channel.
+// StatusQueryInterval determines how often peers' chain status is refreshed.
 func DefaultQuerierConfig() *QuerierConfig {
 	return &QuerierConfig{
-		ResponseBufferSize: params.BeaconConfig().DefaultBufferSize,
+		StatusQueryInterval: 1 * time.Second,
 	}
 }
 
@@ -55,13 +54,15 @@ type Querier struct {
 	currentHeadSlot           uint64
 	currentStateRoot          []byte
 	currentFinalizedStateRoot [32]byte
-	responseBuf               chan p2p.Message
+	statusQueryInterval       time.Duration
 	chainStartBuf             chan time.Time
 	powchain                  powChainService
 	chainStarted              bool
 	atGenesis                 bool
 	bestPeer                  peer.ID
-	chainHeadResponses        map[peer.ID]*pb.ChainHeadResponse
+	peerStatuses              map[peer.ID]*pb.Handshake
+	statusLock                sync.RWMutex
+	startSlot                 uint64
 	canonicalBlockRoot        []byte
 	finalizedBlockRoot        []byte
 }
@@ -73,21 +74,19 @@ func NewQuerierService(ctx context.Context,
 ) *Querier {
 	ctx, cancel := context.WithCancel(ctx)
 
-	responseBuf := make(chan p2p.Message, cfg.ResponseBufferSize)
-
 	return &Querier{
-		ctx:                ctx,
-		cancel:             cancel,
-		p2p:                cfg.P2P,
-		db:                 cfg.BeaconDB,
-		chainService:       cfg.ChainService,
-		responseBuf:        responseBuf,
-		currentHeadSlot:    cfg.CurrentHeadSlot,
-		chainStarted:       false,
-		atGenesis:          true,
-		powchain:           cfg.PowChain,
-		chainStartBuf:      make(chan time.Time, 1),
-		chainHeadResponses: make(map[peer.ID]*pb.ChainHeadResponse),
+		ctx:                 ctx,
+		cancel:              cancel,
+		p2p:                 cfg.P2P,
+		db:                  cfg.BeaconDB,
+		chainService:        cfg.ChainService,
+		statusQueryInterval: cfg.StatusQueryInterval,
+		currentHeadSlot:     cfg.CurrentHeadSlot,
+		chainStarted:        false,
+		atGenesis:           true,
+		powchain:            cfg.PowChain,
+		chainStartBuf:       make(chan time.Time, 1),
+		peerStatuses:        make(map[peer.ID]*pb.Handshake),
 	}
 }
 
@@ -96,8 +95,10 @@ func (q *Querier) Start() {
 	q.waitForAllDepositsToBeProcessed()
 	hasChainStarted := q.powchain.HasChainStarted()
 
+	q.statusLock.Lock()
 	q.chainStarted = hasChainStarted
 	q.atGenesis = !hasChainStarted
+	q.statusLock.Unlock()
 
 	bState, err := q.db.HeadState(q.ctx)
 	if err != nil {
@@ -112,7 +113,7 @@ func (q *Querier) Start() {
 		q.listenForStateInitialization()
 
 		// Return, if the node is at genesis.
-		if q.atGenesis {
+		if q.isAtGenesis() {
 			return
 		}
 	}
@@ -133,7 +134,9 @@ func (q *Querier) listenForStateInitialization() {
 		select {
 		case <-q.chainStartBuf:
 			queryLog.Info("State has been initialized")
+			q.statusLock.Lock()
 			q.chainStarted = true
+			q.statusLock.Unlock()
 			return
 		case <-sub.Err():
 			log.Fatal("Subscriber closed, unable to continue on with sync")
@@ -146,19 +149,23 @@ func (q *Querier) listenForStateInitialization() {
 }
 
 func (q *Querier) run() {
-	responseSub := q.p2p.Subscribe(&pb.ChainHeadResponse{}, q.responseBuf)
-	// Ticker so that service will keep on requesting for chain head
-	// until they get a response.
-	ticker := time.NewTicker(1 * time.Second)
-
-	defer func() {
-		responseSub.Unsubscribe()
-		close(q.responseBuf)
-		ticker.Stop()
-	}()
-
-	queryLog.Info("Polling peers for latest chain head...")
-	hasReceivedResponse := false
+	// Ticker so that service will keep on refreshing the status of peers
+	// until at least one of them responds.
+	ticker := time.NewTicker(q.statusQueryInterval)
+	defer ticker.Stop()
+
+	head, err := q.db.ChainHead()
+	if err != nil {
+		queryLog.WithError(err).Error("Could not retrieve chain head")
+	}
+	if head != nil {
+		q.statusLock.Lock()
+		q.startSlot = head.Slot
+		q.statusLock.Unlock()
+	}
+
+	queryLog.Info("Querying peers for their chain status...")
+	hasReceivedStatus := false
 	var timeout <-chan time.Time
 	for {
 		select {
@@ -166,7 +173,16 @@ func (q *Querier) run() {
 			queryLog.Info("Finished querying state of the network, importing blocks...")
 			return
 		case <-ticker.C:
-			q.RequestLatestHead()
+			if err := q.p2p.RefreshPeerStatuses(q.ctx); err != nil {
+				queryLog.WithError(err).Debug("Could not refresh peer statuses")
+			}
+			// If this is the first status a node receives, we start
+			// a timeout that will keep refreshing statuses over a
+			// certain time interval to ensure we get the best head from our peers.
+			if q.updateSyncTargets() && !hasReceivedStatus {
+				timeout = time.After(10 * time.Second)
+				hasReceivedStatus = true
+			}
 		case <-timeout:
 			queryLog.WithField("peerID", q.bestPeer.Pretty()).Info("Peer with highest canonical head")
 			queryLog.Infof(
@@ -174,36 +190,80 @@ func (q *Querier) run() {
 				q.currentHeadSlot, q.currentStateRoot,
 			)
 			ticker.Stop()
-			responseSub.Unsubscribe()
 			q.cancel()
-		case msg := <-q.responseBuf:
-			// If this is the first response a node receives, we start
-			// a timeout that will keep listening for more responses over a
-			// certain time interval to ensure we get the best head from our peers.
-			if !hasReceivedResponse {
-				timeout = time.After(10 * time.Second)
-				hasReceivedResponse = true
-			}
-			response := msg.Data.(*pb.ChainHeadResponse)
-			if _, ok := q.chainHeadResponses[msg.Peer]; !ok {
-				queryLog.WithFields(logrus.Fields{
-					"peerID":      msg.Peer.Pretty(),
-					"highestSlot": response.CanonicalSlot,
-				}).Info("Received chain head from peer")
-				q.chainHeadResponses[msg.Peer] = response
-			}
-			if response.CanonicalSlot > q.currentHeadSlot {
-				q.bestPeer = msg.Peer
-				q.currentHeadSlot = response.CanonicalSlot
-				q.currentStateRoot = response.CanonicalStateRootHash32
-				q.currentFinalizedStateRoot = bytesutil.ToBytes32(response.FinalizedStateRootHash32S)
-				q.canonicalBlockRoot = response.CanonicalBlockRoot
-				q.finalizedBlockRoot = response.FinalizedBlockRoot
-			}
 		}
 	}
 }
 
+// updateSyncTargets records the chain status that peers reported in their
+// handshake and selects the peer with the highest head slot as the best sync
+// target. It returns true if any peer status is known.
+func (q *Querier) updateSyncTargets() bool {
+	statuses := q.p2p.PeerStatuses()
+	q.statusLock.Lock()
+	defer q.statusLock.Unlock()
+	for pid, status := range statuses {
+		if _, ok := q.peerStatuses[pid]; !ok {
+			queryLog.WithFields(logrus.Fields{
+				"peerID":         pid.Pretty(),
+				"highestSlot":    status.HeadSlot,
+				"finalizedEpoch": status.FinalizedEpoch,
+			}).Info("Received chain status from peer")
+		}
+		q.peerStatuses[pid] = status
+		if status.HeadSlot > q.currentHeadSlot {
+			q.bestPeer = pid
+			q.currentHeadSlot = status.HeadSlot
+			q.currentStateRoot = status.HeadStateRoot
+			q.currentFinalizedStateRoot = bytesutil.ToBytes32(status.FinalizedStateRoot)
+			q.canonicalBlockRoot = status.HeadRoot
+			q.finalizedBlockRoot = status.FinalizedRoot
+		}
+	}
+	return len(statuses) > 0
+}
+
+// PeerStatuses returns the chain status of the peers the querier received a
+// status from.
+func (q *Querier) PeerStatuses() map[peer.ID]*pb.Handshake {
+	q.statusLock.RLock()
+	defer q.statusLock.RUnlock()
+	statuses := make(map[peer.ID]*pb.Handshake, len(q.peerStatuses))
+	for pid, status := range q.peerStatuses {
+		statuses[pid] = status
+	}
+	return statuses
+}
+
+// HighestPeerSlot returns the highest head slot announced by the peers the
+// querier received a status from, or 0 if no peer status is known.
+func (q *Querier) HighestPeerSlot() uint64 {
+	q.statusLock.RLock()
+	defer q.statusLock.RUnlock()
+	var highest uint64
+	for _, status := range q.peerStatuses {
+		if status.HeadSlot > highest {
+			highest = status.HeadSlot
+		}
+	}
+	return highest
+}
+
+// isAtGenesis returns true if the chain had not started when the querier
+// began, in which case there is nothing to query peers for.
+func (q *Querier) isAtGenesis() bool {
+	q.statusLock.RLock()
+	defer q.statusLock.RUnlock()
+	return q.atGenesis
+}
+
+// StartSlot returns the slot of the local chain head when the querier started.
+func (q *Querier) StartSlot() uint64 {
+	q.statusLock.RLock()
+	defer q.statusLock.RUnlock()
+	return q.startSlot
+}
+
 func (q *Querier) waitForAllDepositsToBeProcessed() {
 	for {
 		processed, err := q.powchain.AreAllDepositsProcessed()
@@ -218,20 +278,16 @@ func (q *Querier) waitForAllDepositsToBeProcessed() {
 	}
 }
 
-// RequestLatestHead broadcasts a request for
-// the latest chain head slot and state root to a peer.
-func (q *Querier) RequestLatestHead() {
-	request := &pb.ChainHeadRequest{}
-	q.p2p.Broadcast(context.Background(), request)
-}
-
 // IsSynced checks if the node is currently synced with the
 // rest of the network.
 func (q *Querier) IsSynced() (bool, error) {
-	if !q.chainStarted {
+	q.statusLock.RLock()
+	chainStarted, atGenesis, currentHeadSlot := q.chainStarted, q.atGenesis, q.currentHeadSlot
+	q.statusLock.RUnlock()
+	if !chainStarted {
 		return true, nil
 	}
-	if q.atGenesis {
+	if atGenesis {
 		return true, nil
 	}
 	block, err := q.db.ChainHead()
@@ -243,5 +299,5 @@ func (q *Querier) IsSynced() (bool, error) {
 		return false, nil
 	}
 
-	return block.Slot >= q.currentHeadSlot, nil
+	return block.Slot >= currentHeadSlot, nil
 }
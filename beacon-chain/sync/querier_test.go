@@ -8,11 +8,11 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/event"
-	"github.com/prysmaticlabs/prysm/shared/p2p"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -63,11 +63,11 @@ func TestQuerier_StartStop(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		PowChain:           &afterGenesisPowChain{},
-		BeaconDB:           db,
-		ChainService:       &mockChainService{},
+		P2P:                 &mockP2P{},
+		StatusQueryInterval: 10 * time.Millisecond,
+		PowChain:            &afterGenesisPowChain{},
+		BeaconDB:            db,
+		ChainService:        &mockChainService{},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -94,10 +94,10 @@ func TestListenForStateInitialization_ContextCancelled(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
+		P2P:                 &mockP2P{},
+		StatusQueryInterval: 10 * time.Millisecond,
+		ChainService:        &mockChainService{},
+		BeaconDB:            db,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 	exitRoutine := make(chan bool)
@@ -123,10 +123,10 @@ func TestListenForStateInitialization(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
+		P2P:                 &mockP2P{},
+		StatusQueryInterval: 10 * time.Millisecond,
+		ChainService:        &mockChainService{},
+		BeaconDB:            db,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -139,12 +139,18 @@ func TestListenForStateInitialization(t *testing.T) {
 	sq.cancel()
 }
 
-func TestQuerier_ChainReqResponse(t *testing.T) {
+func TestQuerier_PeerStatus(t *testing.T) {
 	hook := logTest.NewGlobal()
+	status := &pb.Handshake{
+		HeadSlot:      1,
+		HeadStateRoot: []byte{'a', 'b'},
+	}
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		PowChain:           &afterGenesisPowChain{},
+		P2P: &mockP2P{
+			statuses: map[peer.ID]*pb.Handshake{"": status},
+		},
+		StatusQueryInterval: 10 * time.Millisecond,
+		PowChain:            &afterGenesisPowChain{},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -154,20 +160,9 @@ func TestQuerier_ChainReqResponse(t *testing.T) {
 		exitRoutine <- true
 	}()
 
-	response := &pb.ChainHeadResponse{
-		CanonicalSlot:            1,
-		CanonicalStateRootHash32: []byte{'a', 'b'},
-	}
-
-	msg := p2p.Message{
-		Data: response,
-	}
-
-	sq.responseBuf <- msg
-
 	expMsg := fmt.Sprintf(
 		"Latest chain head is at slot: %d and state root: %#x",
-		response.CanonicalSlot, response.CanonicalStateRootHash32,
+		status.HeadSlot, status.HeadStateRoot,
 	)
 
 	<-exitRoutine
@@ -179,9 +174,20 @@ func TestQuerier_ChainReqResponse(t *testing.T) {
 func TestQuerier_BestPeerAssignment(t *testing.T) {
 	hook := logTest.NewGlobal()
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		PowChain:           &afterGenesisPowChain{},
+		P2P: &mockP2P{
+			statuses: map[peer.ID]*pb.Handshake{
+				"TestQuerier_BestPeerAssignment": {
+					HeadSlot:      2,
+					HeadStateRoot: []byte{'a', 'b'},
+				},
+				"TestQuerier_LowerPeer": {
+					HeadSlot:      1,
+					HeadStateRoot: []byte{'c', 'd'},
+				},
+			},
+		},
+		StatusQueryInterval: 10 * time.Millisecond,
+		PowChain:            &afterGenesisPowChain{},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -191,34 +197,47 @@ func TestQuerier_BestPeerAssignment(t *testing.T) {
 		exitRoutine <- true
 	}()
 
-	response := &pb.ChainHeadResponse{
-		CanonicalSlot:            1,
-		CanonicalStateRootHash32: []byte{'a', 'b'},
-	}
-
-	msg := p2p.Message{
-		Data: response,
-		Peer: "TestQuerier_BestPeerAssignment",
-	}
-
-	sq.responseBuf <- msg
-
 	<-exitRoutine
 	testutil.AssertLogsContain(t, hook, "level=info msg=\"Peer with highest canonical head\" peerID=HupjP1BPtXeX766WHAeYyATx9MJ3RFe5MZCwC3UEw")
+	if len(sq.peerStatuses) != 2 {
+		t.Errorf("Expected 2 peer statuses, received %d", len(sq.peerStatuses))
+	}
 
 	close(exitRoutine)
 	hook.Reset()
 }
 
+func TestQuerier_HighestPeerSlot(t *testing.T) {
+	cfg := &QuerierConfig{
+		P2P: &mockP2P{
+			statuses: map[peer.ID]*pb.Handshake{
+				"a": {HeadSlot: 7},
+				"b": {HeadSlot: 3},
+			},
+		},
+		StatusQueryInterval: 10 * time.Millisecond,
+		CurrentHeadSlot:     20,
+	}
+	sq := NewQuerierService(context.Background(), cfg)
+
+	if slot := sq.HighestPeerSlot(); slot != 0 {
+		t.Errorf("Expected highest peer slot 0 without peer statuses, received %d", slot)
+	}
+	sq.updateSyncTargets()
+	if slot := sq.HighestPeerSlot(); slot != 7 {
+		t.Errorf("Expected highest peer slot 7, received %d", slot)
+	}
+}
+
 func TestSyncedInGenesis(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
-		PowChain:           &genesisPowChain{depositsProcessed: true},
+		P2P:                 &mockP2P{},
+		StatusQueryInterval: 10 * time.Millisecond,
+		ChainService:        &mockChainService{},
+		BeaconDB:            db,
+		PowChain:            &genesisPowChain{depositsProcessed: true},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -239,11 +258,18 @@ func TestSyncedInRestarts(t *testing.T) {
 	db := internal.SetupDB(t)
 	defer internal.TeardownDB(t, db)
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
-		PowChain:           &afterGenesisPowChain{},
+		P2P: &mockP2P{
+			statuses: map[peer.ID]*pb.Handshake{
+				"": {
+					HeadSlot:      10,
+					HeadStateRoot: []byte{'a', 'b'},
+				},
+			},
+		},
+		StatusQueryInterval: 10 * time.Millisecond,
+		ChainService:        &mockChainService{},
+		BeaconDB:            db,
+		PowChain:            &afterGenesisPowChain{},
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -265,17 +291,6 @@ func TestSyncedInRestarts(t *testing.T) {
 		exitRoutine <- true
 	}()
 
-	response := &pb.ChainHeadResponse{
-		CanonicalSlot:            10,
-		CanonicalStateRootHash32: []byte{'a', 'b'},
-	}
-
-	msg := p2p.Message{
-		Data: response,
-	}
-
-	sq.responseBuf <- msg
-
 	<-exitRoutine
 
 	synced, err := sq.IsSynced()
@@ -293,11 +308,11 @@ func TestWaitForDepositsProcessed_OK(t *testing.T) {
 	defer internal.TeardownDB(t, db)
 	powchain := &genesisPowChain{depositsProcessed: false}
 	cfg := &QuerierConfig{
-		P2P:                &mockP2P{},
-		ResponseBufferSize: 100,
-		ChainService:       &mockChainService{},
-		BeaconDB:           db,
-		PowChain:           powchain,
+		P2P:                 &mockP2P{},
+		StatusQueryInterval: 10 * time.Millisecond,
+		ChainService:        &mockChainService{},
+		BeaconDB:            db,
+		PowChain:            powchain,
 	}
 	sq := NewQuerierService(context.Background(), cfg)
 
@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -96,15 +97,18 @@ func (rs *RegularSync) processBlockAndFetchAncestors(ctx context.Context, msg p2
 		return nil
 	}
 
-	// If the block has a child, we then clear it from the blocks pending processing
-	// and call receiveBlock recursively. The recursive function call will stop once
+	// If the block has children, we then take them out of the pending block pool
+	// and process them recursively. The recursive function call will stop once
 	// the block we process no longer has children.
-	if child, ok := rs.hasChild(blockRoot); ok {
-		// We clear the block root from the pending processing map.
-		rs.clearPendingBlock(blockRoot)
-		return rs.processBlockAndFetchAncestors(ctx, child)
+	var lastErr error
+	for _, child := range rs.pendingBlocks.takeChildren(blockRoot) {
+		if err := rs.processBlockAndFetchAncestors(ctx, child.msg); err != nil {
+			log.WithError(err).WithField("blockRoot", fmt.Sprintf("%#x", bytesutil.Trunc(child.root[:]))).
+				Error("Could not process pending block")
+			lastErr = err
+		}
 	}
-	return nil
+	return lastErr
 }
 
 func (rs *RegularSync) validateAndProcessBlock(
@@ -155,8 +159,15 @@ func (rs *RegularSync) validateAndProcessBlock(
 	span.AddAttributes(trace.BoolAttribute("hasParent", hasParent))
 
 	if !hasParent {
-		// If we do not have the parent, we insert it into a pending block's map.
-		rs.insertPendingBlock(ctx, parentRoot, blockMsg)
+		// If we do not have the parent, we insert the block into the pending block
+		// pool and request the parent from the peer which sent the block.
+		rs.insertPendingBlock(ctx, &pendingBlock{
+			msg:        blockMsg,
+			root:       blockRoot,
+			parentRoot: parentRoot,
+			slot:       block.Slot,
+			received:   time.Now(),
+		}, beaconState.Slot)
 		// We update the last observed slot to the received canonical block's slot.
 		if block.Slot > rs.highestObservedSlot {
 			rs.highestObservedSlot = block.Slot
@@ -215,28 +226,42 @@ func (rs *RegularSync) validateAndProcessBlock(
 	return block, beaconState, true, nil
 }
 
-func (rs *RegularSync) insertPendingBlock(ctx context.Context, blockRoot [32]byte, blockMsg p2p.Message) {
-	rs.blocksAwaitingProcessingLock.Lock()
-	defer rs.blocksAwaitingProcessingLock.Unlock()
-	// Do not reinsert into the map if block root was previously added.
-	if _, ok := rs.blocksAwaitingProcessing[blockRoot]; ok {
+// insertPendingBlock adds a block whose parent is unknown to the pending block
+// pool, and requests the missing parent by root from the peer which sent the
+// block. No request is made when the parent is itself pending, as its own
+// parent was already requested.
+func (rs *RegularSync) insertPendingBlock(ctx context.Context, b *pendingBlock, headSlot uint64) {
+	if err := rs.pendingBlocks.insert(b, headSlot); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"slot":      b.slot,
+			"headSlot":  headSlot,
+			"blockRoot": fmt.Sprintf("%#x", bytesutil.Trunc(b.root[:])),
+		}).Debug("Dropping block with unknown parent")
 		return
 	}
-	rs.blocksAwaitingProcessing[blockRoot] = blockMsg
-	blocksAwaitingProcessingGauge.Inc()
-	rs.p2p.Broadcast(ctx, &pb.BeaconBlockRequest{Hash: blockRoot[:]})
-}
-
-func (rs *RegularSync) clearPendingBlock(blockRoot [32]byte) {
-	rs.blocksAwaitingProcessingLock.Lock()
-	defer rs.blocksAwaitingProcessingLock.Unlock()
-	delete(rs.blocksAwaitingProcessing, blockRoot)
-	blocksAwaitingProcessingGauge.Dec()
+	if rs.pendingBlocks.has(b.parentRoot) {
+		return
+	}
+	if err := rs.p2p.Send(ctx, &pb.BeaconBlockRequest{Hash: b.parentRoot[:]}, b.msg.Peer); err != nil {
+		log.WithError(err).Error("Could not request missing parent block")
+		return
+	}
+	sentBlockReq.Inc()
 }
 
-func (rs *RegularSync) hasChild(blockRoot [32]byte) (p2p.Message, bool) {
-	rs.blocksAwaitingProcessingLock.Lock()
-	defer rs.blocksAwaitingProcessingLock.Unlock()
-	child, ok := rs.blocksAwaitingProcessing[blockRoot]
-	return child, ok
+// prunePendingBlocks evicts the pending blocks whose parent did not arrive in
+// time, or which are no longer ahead of the finalized checkpoint.
+func (rs *RegularSync) prunePendingBlocks(now time.Time) {
+	headState, err := rs.db.HeadState(rs.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not retrieve head state")
+		return
+	}
+	var finalizedSlot uint64
+	if headState != nil && headState.FinalizedCheckpoint != nil {
+		finalizedSlot = helpers.StartSlot(headState.FinalizedCheckpoint.Epoch)
+	}
+	if pruned := rs.pendingBlocks.pruneExpired(now, finalizedSlot); pruned > 0 {
+		log.WithField("pruned", pruned).Debug("Evicted orphaned pending blocks")
+	}
 }
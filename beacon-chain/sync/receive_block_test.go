@@ -137,11 +137,11 @@ func TestReceiveBlock_RecursivelyProcessesChildren(t *testing.T) {
 			t.Fatalf("Could not receive block: %v", err)
 		}
 	}
-	if len(rs.blocksAwaitingProcessing) != len(blocksMissingParent) {
+	if rs.pendingBlocks.len() != len(blocksMissingParent) {
 		t.Errorf(
 			"Expected blocks awaiting processing map len = %d, received len = %d",
 			len(blocksMissingParent),
-			len(rs.blocksAwaitingProcessing),
+			rs.pendingBlocks.len(),
 		)
 	}
 	for _, block := range parents {
@@ -155,7 +155,7 @@ func TestReceiveBlock_RecursivelyProcessesChildren(t *testing.T) {
 			t.Fatalf("Could not receive block: %v", err)
 		}
 	}
-	if len(rs.blocksAwaitingProcessing) > 0 {
-		t.Errorf("Expected blocks awaiting processing map to be empty, received len = %d", len(rs.blocksAwaitingProcessing))
+	if rs.pendingBlocks.len() > 0 {
+		t.Errorf("Expected blocks awaiting processing map to be empty, received len = %d", rs.pendingBlocks.len())
 	}
 }
@@ -10,9 +10,11 @@ import (
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
@@ -53,6 +55,7 @@ type p2pAPI interface {
 	p2p.Sender
 	p2p.Subscriber
 	p2p.ReputationManager
+	p2p.PeerStatusProvider
 }
 
 // RegularSync is the gateway and the bridge between the p2p network and the local beacon chain.
@@ -68,31 +71,33 @@ type p2pAPI interface {
 //     *  Drop peers that send invalid data
 //     *  Throttle incoming requests
 type RegularSync struct {
-	ctx                          context.Context
-	cancel                       context.CancelFunc
-	p2p                          p2pAPI
-	chainService                 chainService
-	attsService                  attsService
-	operationsService            operations.OperationFeeds
-	db                           *db.BeaconDB
-	blockAnnouncementFeed        *event.Feed
-	announceBlockBuf             chan p2p.Message
-	blockBuf                     chan p2p.Message
-	blockRequestByHash           chan p2p.Message
-	batchedRequestBuf            chan p2p.Message
-	stateRequestBuf              chan p2p.Message
-	chainHeadReqBuf              chan p2p.Message
-	attestationBuf               chan p2p.Message
-	attestationReqByHashBuf      chan p2p.Message
-	announceAttestationBuf       chan p2p.Message
-	exitBuf                      chan p2p.Message
-	canonicalBuf                 chan *pb.BeaconBlockAnnounce
-	highestObservedSlot          uint64
-	blocksAwaitingProcessing     map[[32]byte]p2p.Message
-	blocksAwaitingProcessingLock sync.RWMutex
-	blockProcessingLock          sync.RWMutex
-	blockAnnouncements           map[uint64][]byte
-	blockAnnouncementsLock       sync.RWMutex
+	ctx                     context.Context
+	cancel                  context.CancelFunc
+	p2p                     p2pAPI
+	chainService            chainService
+	attsService             attsService
+	operationsService       operations.OperationFeeds
+	db                      *db.BeaconDB
+	blockAnnouncementFeed   *event.Feed
+	announceBlockBuf        chan p2p.Message
+	blockBuf                chan p2p.Message
+	blockRequestByHash      chan p2p.Message
+	batchedRequestBuf       chan p2p.Message
+	stateRequestBuf         chan p2p.Message
+	chainHeadReqBuf         chan p2p.Message
+	attestationBuf          chan p2p.Message
+	attestationReqByHashBuf chan p2p.Message
+	announceAttestationBuf  chan p2p.Message
+	subnetAttestationBuf    chan p2p.Message
+	aggregateAttestationBuf chan p2p.Message
+	exitBuf                 chan p2p.Message
+	canonicalBuf            chan *pb.BeaconBlockAnnounce
+	highestObservedSlot     uint64
+	pendingBlocks           *pendingBlocks
+	pendingPruneInterval    time.Duration
+	blockProcessingLock     sync.RWMutex
+	blockAnnouncements      map[uint64][]byte
+	blockAnnouncementsLock  sync.RWMutex
 }
 
 // RegularSyncConfig allows the channel's buffer sizes to be changed.
@@ -105,9 +110,14 @@ type RegularSyncConfig struct {
 	AttestationBufferSize       int
 	AttestationReqHashBufSize   int
 	AttestationsAnnounceBufSize int
+	SubnetAttestationBufSize    int
+	AggregateAttestationBufSize int
 	ExitBufferSize              int
 	ChainHeadReqBufferSize      int
 	CanonicalBufferSize         int
+	MaxPendingBlocks            int
+	MaxPendingSlotsAhead        uint64
+	PendingBlockExpiry          time.Duration
 	ChainService                chainService
 	OperationService            operations.OperationFeeds
 	AttsService                 attsService
@@ -127,8 +137,13 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 		AttestationBufferSize:       params.BeaconConfig().DefaultBufferSize,
 		AttestationReqHashBufSize:   params.BeaconConfig().DefaultBufferSize,
 		AttestationsAnnounceBufSize: params.BeaconConfig().DefaultBufferSize,
+		SubnetAttestationBufSize:    params.BeaconConfig().DefaultBufferSize,
+		AggregateAttestationBufSize: params.BeaconConfig().DefaultBufferSize,
 		ExitBufferSize:              params.BeaconConfig().DefaultBufferSize,
 		CanonicalBufferSize:         params.BeaconConfig().DefaultBufferSize,
+		MaxPendingBlocks:            256,
+		MaxPendingSlotsAhead:        4 * params.BeaconConfig().SlotsPerEpoch,
+		PendingBlockExpiry:          defaultPendingBlockExpiry(),
 	}
 }
 
@@ -136,27 +151,30 @@ func DefaultRegularSyncConfig() *RegularSyncConfig {
 func NewRegularSyncService(ctx context.Context, cfg *RegularSyncConfig) *RegularSync {
 	ctx, cancel := context.WithCancel(ctx)
 	return &RegularSync{
-		ctx:                      ctx,
-		cancel:                   cancel,
-		p2p:                      cfg.P2P,
-		chainService:             cfg.ChainService,
-		db:                       cfg.BeaconDB,
-		operationsService:        cfg.OperationService,
-		attsService:              cfg.AttsService,
-		blockAnnouncementFeed:    new(event.Feed),
-		announceBlockBuf:         make(chan p2p.Message, cfg.BlockAnnounceBufferSize),
-		blockBuf:                 make(chan p2p.Message, cfg.BlockBufferSize),
-		blockRequestByHash:       make(chan p2p.Message, cfg.BlockReqHashBufferSize),
-		batchedRequestBuf:        make(chan p2p.Message, cfg.BatchedBufferSize),
-		stateRequestBuf:          make(chan p2p.Message, cfg.StateReqBufferSize),
-		attestationBuf:           make(chan p2p.Message, cfg.AttestationBufferSize),
-		attestationReqByHashBuf:  make(chan p2p.Message, cfg.AttestationReqHashBufSize),
-		announceAttestationBuf:   make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
-		exitBuf:                  make(chan p2p.Message, cfg.ExitBufferSize),
-		chainHeadReqBuf:          make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
-		canonicalBuf:             make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
-		blocksAwaitingProcessing: make(map[[32]byte]p2p.Message),
-		blockAnnouncements:       make(map[uint64][]byte),
+		ctx:                     ctx,
+		cancel:                  cancel,
+		p2p:                     cfg.P2P,
+		chainService:            cfg.ChainService,
+		db:                      cfg.BeaconDB,
+		operationsService:       cfg.OperationService,
+		attsService:             cfg.AttsService,
+		blockAnnouncementFeed:   new(event.Feed),
+		announceBlockBuf:        make(chan p2p.Message, cfg.BlockAnnounceBufferSize),
+		blockBuf:                make(chan p2p.Message, cfg.BlockBufferSize),
+		blockRequestByHash:      make(chan p2p.Message, cfg.BlockReqHashBufferSize),
+		batchedRequestBuf:       make(chan p2p.Message, cfg.BatchedBufferSize),
+		stateRequestBuf:         make(chan p2p.Message, cfg.StateReqBufferSize),
+		attestationBuf:          make(chan p2p.Message, cfg.AttestationBufferSize),
+		attestationReqByHashBuf: make(chan p2p.Message, cfg.AttestationReqHashBufSize),
+		announceAttestationBuf:  make(chan p2p.Message, cfg.AttestationsAnnounceBufSize),
+		subnetAttestationBuf:    make(chan p2p.Message, cfg.SubnetAttestationBufSize),
+		aggregateAttestationBuf: make(chan p2p.Message, cfg.AggregateAttestationBufSize),
+		exitBuf:                 make(chan p2p.Message, cfg.ExitBufferSize),
+		chainHeadReqBuf:         make(chan p2p.Message, cfg.ChainHeadReqBufferSize),
+		canonicalBuf:            make(chan *pb.BeaconBlockAnnounce, cfg.CanonicalBufferSize),
+		pendingBlocks:           newPendingBlocks(cfg.MaxPendingBlocks, cfg.MaxPendingSlotsAhead, cfg.PendingBlockExpiry),
+		pendingPruneInterval:    time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second,
+		blockAnnouncements:      make(map[uint64][]byte),
 	}
 }
 
@@ -193,9 +211,12 @@ func (rs *RegularSync) run() {
 	attestationSub := rs.p2p.Subscribe(&pb.AttestationResponse{}, rs.attestationBuf)
 	attestationReqSub := rs.p2p.Subscribe(&pb.AttestationRequest{}, rs.attestationReqByHashBuf)
 	announceAttestationSub := rs.p2p.Subscribe(&pb.AttestationAnnounce{}, rs.announceAttestationBuf)
+	subnetAttestationSub := rs.p2p.Subscribe(&pb.UnaggregatedAttestation{}, rs.subnetAttestationBuf)
+	aggregateAttestationSub := rs.p2p.Subscribe(&pb.AggregateAttestation{}, rs.aggregateAttestationBuf)
 	exitSub := rs.p2p.Subscribe(&ethpb.VoluntaryExit{}, rs.exitBuf)
 	chainHeadReqSub := rs.p2p.Subscribe(&pb.ChainHeadRequest{}, rs.chainHeadReqBuf)
 	canonicalBlockSub := rs.chainService.CanonicalBlockFeed().Subscribe(rs.canonicalBuf)
+	pendingPruneTicker := time.NewTicker(rs.pendingPruneInterval)
 
 	defer announceBlockSub.Unsubscribe()
 	defer blockSub.Unsubscribe()
@@ -206,8 +227,11 @@ func (rs *RegularSync) run() {
 	defer attestationSub.Unsubscribe()
 	defer attestationReqSub.Unsubscribe()
 	defer announceAttestationSub.Unsubscribe()
+	defer subnetAttestationSub.Unsubscribe()
+	defer aggregateAttestationSub.Unsubscribe()
 	defer exitSub.Unsubscribe()
 	defer canonicalBlockSub.Unsubscribe()
+	defer pendingPruneTicker.Stop()
 
 	log.Info("Listening for regular sync messages from peers")
 
@@ -224,6 +248,10 @@ func (rs *RegularSync) run() {
 			go safelyHandleMessage(rs.handleAttestationRequestByHash, msg)
 		case msg := <-rs.announceAttestationBuf:
 			go safelyHandleMessage(rs.handleAttestationAnnouncement, msg)
+		case msg := <-rs.subnetAttestationBuf:
+			go safelyHandleMessage(rs.receiveSubnetAttestation, msg)
+		case msg := <-rs.aggregateAttestationBuf:
+			go safelyHandleMessage(rs.receiveAggregateAttestation, msg)
 		case msg := <-rs.exitBuf:
 			go safelyHandleMessage(rs.receiveExitRequest, msg)
 		case msg := <-rs.blockBuf:
@@ -238,6 +266,8 @@ func (rs *RegularSync) run() {
 			go safelyHandleMessage(rs.handleChainHeadRequest, msg)
 		case blockAnnounce := <-rs.canonicalBuf:
 			go rs.broadcastCanonicalBlock(rs.ctx, blockAnnounce)
+		case now := <-pendingPruneTicker.C:
+			rs.prunePendingBlocks(now)
 		}
 	}
 }
@@ -404,7 +434,56 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 	recAttestation.Inc()
 
 	resp := msg.Data.(*pb.AttestationResponse)
-	attestation := resp.Attestation
+	return rs.processAttestation(ctx, msg.Peer, resp.Attestation)
+}
+
+// receiveSubnetAttestation accepts an unaggregated attestation gossiped on one
+// of the attestation subnets the node participates in.
+func (rs *RegularSync) receiveSubnetAttestation(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveSubnetAttestation")
+	defer span.End()
+	recSubnetAttestation.Inc()
+
+	attestation := msg.Data.(*pb.UnaggregatedAttestation).Attestation
+	if attestation == nil || attestation.Data == nil {
+		return errors.New("received empty subnet attestation")
+	}
+	if attesters := countSetBits(attestation.AggregationBits); attesters != 1 {
+		rs.p2p.Reputation(msg.Peer, p2p.RepPenalityInvalidAttestation)
+		return fmt.Errorf("subnet attestation has %d attesters, expected a single one", attesters)
+	}
+	return rs.processAttestation(ctx, msg.Peer, attestation)
+}
+
+// receiveAggregateAttestation accepts an aggregate attestation gossiped on
+// the global aggregate attestation topic.
+func (rs *RegularSync) receiveAggregateAttestation(msg p2p.Message) error {
+	ctx, span := trace.StartSpan(msg.Ctx, "beacon-chain.sync.receiveAggregateAttestation")
+	defer span.End()
+	recAggregateAttestation.Inc()
+
+	aggregate := msg.Data.(*pb.AggregateAttestation).Aggregate
+	if aggregate == nil || aggregate.Data == nil {
+		return errors.New("received empty aggregate attestation")
+	}
+	return rs.processAttestation(ctx, msg.Peer, aggregate)
+}
+
+func countSetBits(bits bitfield.Bitlist) uint64 {
+	var count uint64
+	for i := uint64(0); i < bits.Len(); i++ {
+		if bits.BitAt(i) {
+			count++
+		}
+	}
+	return count
+}
+
+// processAttestation discards attestations which were already seen or which
+// target an epoch before the finalized epoch, and sends the others to the
+// attestation pool.
+func (rs *RegularSync) processAttestation(ctx context.Context, peerID peer.ID, attestation *ethpb.Attestation) error {
+	span := trace.FromContext(ctx)
 	attestationRoot, err := hashutil.HashProto(attestation)
 	if err != nil {
 		log.Errorf("Could not hash received attestation: %v", err)
@@ -449,7 +528,7 @@ func (rs *RegularSync) receiveAttestation(msg p2p.Message) error {
 	log.Debug("Sending newly received attestation to subscribers")
 	rs.operationsService.IncomingAttFeed().Send(attestation)
 	rs.attsService.IncomingAttestationFeed().Send(attestation)
-	rs.p2p.Reputation(msg.Peer, p2p.RepRewardValidAttestation)
+	rs.p2p.Reputation(peerID, p2p.RepRewardValidAttestation)
 	sentAttestation.Inc()
 	sendAttestationSpan.End()
 	return nil
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/go-ssz"
 	b "github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
@@ -34,7 +35,8 @@ func init() {
 }
 
 type mockP2P struct {
-	sentMsg proto.Message
+	sentMsg  proto.Message
+	statuses map[peer.ID]*pb.Handshake
 }
 
 func (mp *mockP2P) Subscribe(msg proto.Message, channel chan p2p.Message) event.Subscription {
@@ -53,6 +55,14 @@ func (mp *mockP2P) Reputation(_ peer.ID, val int) {
 
 }
 
+func (mp *mockP2P) PeerStatuses() map[peer.ID]*pb.Handshake {
+	return mp.statuses
+}
+
+func (mp *mockP2P) RefreshPeerStatuses(_ context.Context) error {
+	return nil
+}
+
 type mockChainService struct {
 	sFeed *event.Feed
 	cFeed *event.Feed
@@ -473,6 +483,94 @@ func TestReceiveAttestation_OlderThanPrevEpoch(t *testing.T) {
 	testutil.AssertLogsContain(t, hook, "Skipping received attestation with target epoch less than current finalized epoch")
 }
 
+func TestReceiveSubnetAttestation_RejectsAggregates(t *testing.T) {
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	cfg := &RegularSyncConfig{
+		ChainService:     &mockChainService{},
+		AttsService:      &mockAttestationService{},
+		OperationService: &mockOperationService{},
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	bits := bitfield.NewBitlist(4)
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(1, true)
+	msg := p2p.Message{
+		Ctx: context.Background(),
+		Data: &pb.UnaggregatedAttestation{
+			Attestation: &ethpb.Attestation{
+				AggregationBits: bits,
+				Data: &ethpb.AttestationData{
+					Crosslink: &ethpb.Crosslink{Shard: 1},
+					Source:    &ethpb.Checkpoint{},
+					Target:    &ethpb.Checkpoint{},
+				},
+			},
+		},
+		Peer: "",
+	}
+	if err := ss.receiveSubnetAttestation(msg); err == nil {
+		t.Error("Expected an aggregate on a subnet topic to be rejected")
+	}
+}
+
+func TestReceiveAggregateAttestation_OK(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctx := context.Background()
+
+	db := internal.SetupDB(t)
+	defer internal.TeardownDB(t, db)
+	beaconState := &pb.BeaconState{
+		Slot:                2,
+		FinalizedCheckpoint: &ethpb.Checkpoint{},
+	}
+	if err := db.SaveState(ctx, beaconState); err != nil {
+		t.Fatalf("Could not save state: %v", err)
+	}
+	beaconBlock := &ethpb.BeaconBlock{
+		Slot: beaconState.Slot,
+	}
+	if err := db.SaveBlock(beaconBlock); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UpdateChainHead(ctx, beaconBlock, beaconState); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &RegularSyncConfig{
+		ChainService:     &mockChainService{},
+		AttsService:      &mockAttestationService{},
+		OperationService: &mockOperationService{},
+		P2P:              &mockP2P{},
+		BeaconDB:         db,
+	}
+	ss := NewRegularSyncService(context.Background(), cfg)
+
+	bits := bitfield.NewBitlist(4)
+	bits.SetBitAt(0, true)
+	bits.SetBitAt(3, true)
+	msg := p2p.Message{
+		Ctx: context.Background(),
+		Data: &pb.AggregateAttestation{
+			Aggregate: &ethpb.Attestation{
+				AggregationBits: bits,
+				Data: &ethpb.AttestationData{
+					Crosslink: &ethpb.Crosslink{Shard: 1},
+					Source:    &ethpb.Checkpoint{},
+					Target:    &ethpb.Checkpoint{},
+				},
+			},
+		},
+		Peer: "",
+	}
+	if err := ss.receiveAggregateAttestation(msg); err != nil {
+		t.Error(err)
+	}
+	testutil.AssertLogsContain(t, hook, "Sending newly received attestation to subscribers")
+}
+
 func TestReceiveExitReq_OK(t *testing.T) {
 	hook := logTest.NewGlobal()
 	os := &mockOperationService{}
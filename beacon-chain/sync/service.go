@@ -3,10 +3,15 @@ package sync
 import (
 	"context"
 	"errors"
+	"sort"
+	"sync"
+	"time"
 
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/sirupsen/logrus"
 )
 
@@ -18,12 +23,20 @@ type Checker interface {
 	Syncing() bool
 }
 
+// StatusReporter defines a struct which can report the progress of the node
+// in synchronizing the chain with the rest of peers in the network.
+type StatusReporter interface {
+	SyncStatus() (*ethpb.SyncStatus, error)
+}
+
 // Service defines the main routines used in the sync service.
 type Service struct {
 	RegularSync     *RegularSync
 	InitialSync     *initialsync.InitialSync
 	Querier         *Querier
+	db              *db.BeaconDB
 	querierFinished bool
+	querierLock     sync.RWMutex
 }
 
 // Config defines the configured services required for sync to work.
@@ -68,6 +81,7 @@ func NewSyncService(ctx context.Context, cfg *Config) *Service {
 		RegularSync:     rs,
 		InitialSync:     is,
 		Querier:         sq,
+		db:              cfg.BeaconDB,
 		querierFinished: false,
 	}
 
@@ -97,7 +111,7 @@ func (ss *Service) Stop() error {
 // Status checks the status of the node. It returns nil if it's synced
 // with the rest of the network and no errors occurred. Otherwise, it returns an error.
 func (ss *Service) Status() error {
-	if !ss.querierFinished && !ss.Querier.atGenesis {
+	if ss.querying() {
 		return errors.New("querier is still running")
 	}
 	synced, err := ss.Querier.IsSynced()
@@ -126,6 +140,74 @@ func (ss *Service) Syncing() bool {
 	return !isSynced
 }
 
+// SyncStatus reports the sync phase of the node, the progress made from the
+// slot sync started at towards the highest slot of its peers, and the peers
+// used as sync targets.
+func (ss *Service) SyncStatus() (*ethpb.SyncStatus, error) {
+	head, err := ss.db.ChainHead()
+	if err != nil {
+		return nil, err
+	}
+	var headSlot uint64
+	if head != nil {
+		headSlot = head.Slot
+	}
+
+	progress := ss.InitialSync.Progress()
+	highestSlot := ss.Querier.HighestPeerSlot()
+	blocksPerSecond := progress.BlocksPerSecond(time.Now())
+	var remaining time.Duration
+	if blocksPerSecond > 0 && highestSlot > headSlot {
+		remaining = time.Duration(float64(highestSlot-headSlot) / blocksPerSecond * float64(time.Second))
+	}
+
+	statuses := ss.Querier.PeerStatuses()
+	targets := make([]*ethpb.SyncTarget, 0, len(statuses))
+	for pid, status := range statuses {
+		targets = append(targets, &ethpb.SyncTarget{
+			PeerId:         pid.Pretty(),
+			HeadSlot:       status.HeadSlot,
+			FinalizedEpoch: status.FinalizedEpoch,
+		})
+	}
+	// Targets are tried in descending order of their head slot.
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].HeadSlot > targets[j].HeadSlot
+	})
+
+	return &ethpb.SyncStatus{
+		Syncing:                ss.Syncing(),
+		Phase:                  ss.phase(progress),
+		HeadSlot:               headSlot,
+		HighestPeerSlot:        highestSlot,
+		StartSlot:              ss.Querier.StartSlot(),
+		BlocksPerSecond:        blocksPerSecond,
+		EstimatedTimeRemaining: ptypes.DurationProto(remaining),
+		Targets:                targets,
+	}, nil
+}
+
+func (ss *Service) phase(progress initialsync.Progress) ethpb.SyncStatus_Phase {
+	switch {
+	case ss.querying():
+		return ethpb.SyncStatus_QUERYING
+	case progress.Started.IsZero() || ss.InitialSync.NodeIsSynced():
+		return ethpb.SyncStatus_REGULAR
+	case !progress.StateReceived:
+		return ethpb.SyncStatus_STATE_DOWNLOAD
+	default:
+		return ethpb.SyncStatus_BLOCK_DOWNLOAD
+	}
+}
+
+// querying returns true while the querier is still asking peers for their
+// chain status.
+func (ss *Service) querying() bool {
+	ss.querierLock.RLock()
+	defer ss.querierLock.RUnlock()
+	return !ss.querierFinished && !ss.Querier.isAtGenesis()
+}
+
 func (ss *Service) run() {
 	ss.Querier.Start()
 
@@ -133,12 +215,14 @@ func (ss *Service) run() {
 	if err != nil {
 		slog.Fatalf("Unable to retrieve result from sync querier %v", err)
 	}
+	ss.querierLock.Lock()
 	ss.querierFinished = true
+	ss.querierLock.Unlock()
 
 	if synced {
 		ss.RegularSync.Start()
 		return
 	}
 
-	ss.InitialSync.Start(ss.Querier.chainHeadResponses)
+	ss.InitialSync.Start(ss.Querier.PeerStatuses())
 }
@@ -5,19 +5,22 @@ import (
 	"testing"
 	"time"
 
+	peer "github.com/libp2p/go-libp2p-peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/internal"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 )
 
 var _ = Checker(&Service{})
+var _ = StatusReporter(&Service{})
 
 func NotSyncQuerierConfig() *QuerierConfig {
 	return &QuerierConfig{
-		ResponseBufferSize: 100,
-		CurrentHeadSlot:    10,
+		StatusQueryInterval: time.Second,
+		CurrentHeadSlot:     10,
 	}
 }
 
@@ -74,3 +77,51 @@ func TestStatus_NotSynced(t *testing.T) {
 		t.Error("Wanted false, but got true")
 	}
 }
+
+func TestSyncStatus_ReportsPhaseAndTargets(t *testing.T) {
+	service, db := setupTestSyncService(t, false)
+	defer internal.TeardownDB(t, db)
+
+	service.Querier.atGenesis = false
+
+	status, err := service.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.HighestPeerSlot != 0 {
+		t.Errorf("Expected highest peer slot 0 without peer statuses, received %d", status.HighestPeerSlot)
+	}
+
+	service.Querier.peerStatuses[peer.ID("a")] = &pb.Handshake{HeadSlot: 4, FinalizedEpoch: 0}
+	service.Querier.peerStatuses[peer.ID("b")] = &pb.Handshake{HeadSlot: 12, FinalizedEpoch: 1}
+
+	status, err = service.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Phase != ethpb.SyncStatus_QUERYING {
+		t.Errorf("Expected phase %v, received %v", ethpb.SyncStatus_QUERYING, status.Phase)
+	}
+	if !status.Syncing {
+		t.Error("Expected node to be syncing")
+	}
+	if status.HeadSlot != 0 || status.HighestPeerSlot != 12 {
+		t.Errorf("Expected head slot 0 and highest peer slot 12, received %d and %d", status.HeadSlot, status.HighestPeerSlot)
+	}
+	if len(status.Targets) != 2 || status.Targets[0].PeerId != peer.ID("b").Pretty() {
+		t.Errorf("Expected targets in descending order of head slot, received %v", status.Targets)
+	}
+	if status.BlocksPerSecond != 0 || status.EstimatedTimeRemaining.Seconds != 0 {
+		t.Errorf("Expected no rate before any block is processed, received %v", status)
+	}
+
+	// Without initial sync, the querier hands over to regular sync.
+	service.querierFinished = true
+	status, err = service.SyncStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Phase != ethpb.SyncStatus_REGULAR {
+		t.Errorf("Expected phase %v, received %v", ethpb.SyncStatus_REGULAR, status.Phase)
+	}
+}
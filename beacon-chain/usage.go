@@ -49,6 +49,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RelayNode,
 			cmd.P2PPort,
 			cmd.DataDirFlag,
+			cmd.ChainConfigFileFlag,
 			cmd.VerbosityFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
@@ -83,15 +84,28 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableDBCleanup,
 			flags.GRPCGatewayPort,
 			flags.HTTPWeb3ProviderFlag,
+			flags.Eth1LogChunkSizeFlag,
+			flags.Eth1HeaderPollIntervalFlag,
+			flags.FallbackWeb3ProviderFlag,
+			flags.Eth1HealthCheckIntervalFlag,
+			flags.InteropGenesisStateFlag,
+			flags.InteropNumValidatorsFlag,
+			flags.InteropGenesisTimeFlag,
 		},
 	},
 	{
 		Name: "p2p",
 		Flags: []cli.Flag{
 			cmd.P2PHost,
+			cmd.P2PLocalIP,
+			cmd.P2PUDPPort,
+			cmd.EnableDiscv5,
+			cmd.Discv5BootstrapNode,
 			cmd.P2PMaxPeers,
 			cmd.P2PPrivKey,
 			cmd.P2PWhitelist,
+			cmd.AttestationSubnetCount,
+			cmd.PersistentSubnets,
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 		},
@@ -0,0 +1,115 @@
+package endtoend
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func TestEndToEnd_MinimalConfig(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping the devnet simulation in short mode")
+	}
+	sim, err := NewSimulator(&Config{
+		NumBeaconNodes:      3,
+		NumValidatorClients: 2,
+		NumValidators:       64,
+		SecondsPerSlot:      6,
+		GenesisDelay:        12 * time.Second,
+		StepDelay:           500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := sim.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer sim.Stop()
+	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch*params.BeaconConfig().SecondsPerSlot) * time.Second
+
+	t.Run("finality", func(t *testing.T) {
+		ctx, cancel := clockutil.WithDeadline(context.Background(), sim.Clock(), sim.EpochStart(6))
+		defer cancel()
+		for i := 0; i < 3; i++ {
+			if _, err := sim.WaitForChainHead(ctx, i, func(head *ethpb.ChainHead) bool {
+				return head.FinalizedSlot > 0
+			}); err != nil {
+				t.Fatalf("Beacon node %d did not finalize an epoch: %v", i, err)
+			}
+		}
+	})
+
+	t.Run("participation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		head, err := sim.BeaconChainClient(0).GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The previous epoch of the head is complete. All validators are
+		// online, only the attestations of its last slot are included in
+		// blocks of the next epoch.
+		epoch := head.BlockSlot/params.BeaconConfig().SlotsPerEpoch - 1
+		participation, err := sim.BeaconChainClient(0).GetValidatorParticipation(ctx, &ethpb.GetValidatorParticipationRequest{
+			QueryFilter: &ethpb.GetValidatorParticipationRequest_Epoch{Epoch: epoch},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if participation.GlobalParticipationRate < 0.75 {
+			t.Errorf("Expected a participation of at least 75%% in epoch %d, received %f",
+				epoch, participation.GlobalParticipationRate)
+		}
+	})
+
+	t.Run("late join sync", func(t *testing.T) {
+		index, err := sim.AddBeaconNode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := clockutil.WithDeadline(context.Background(), sim.Clock(), sim.Clock().Now().Add(3*epochDuration))
+		defer cancel()
+		waitForSameHead(ctx, t, sim, 0, index)
+	})
+
+	t.Run("restart recovery", func(t *testing.T) {
+		ctx, cancel := clockutil.WithDeadline(context.Background(), sim.Clock(), sim.Clock().Now().Add(3*epochDuration))
+		defer cancel()
+		before, err := sim.BeaconChainClient(1).GetChainHead(ctx, &ptypes.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sim.StopBeaconNode(1)
+		sim.Advance(epochDuration)
+		if err := sim.RestartBeaconNode(1); err != nil {
+			t.Fatal(err)
+		}
+		head := waitForSameHead(ctx, t, sim, 0, 1)
+		if head.FinalizedSlot < before.FinalizedSlot {
+			t.Errorf("Expected the finalized slot not to go back from %d, received %d",
+				before.FinalizedSlot, head.FinalizedSlot)
+		}
+	})
+}
+
+// waitForSameHead waits until the beacon node of the given index has the
+// head block of the reference node, and returns its chain head.
+func waitForSameHead(ctx context.Context, t *testing.T, sim *Simulator, reference int, index int) *ethpb.ChainHead {
+	var want *ethpb.ChainHead
+	head, err := sim.WaitForChainHead(ctx, index, func(head *ethpb.ChainHead) bool {
+		var err error
+		want, err = sim.BeaconChainClient(reference).GetChainHead(ctx, &ptypes.Empty{})
+		return err == nil && bytes.Equal(head.BlockRoot, want.BlockRoot)
+	})
+	if err != nil {
+		t.Fatalf("Beacon node %d did not sync to the head of beacon node %d at slot %d: %v",
+			index, reference, want.GetBlockSlot(), err)
+	}
+	return head
+}
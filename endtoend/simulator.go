@@ -0,0 +1,511 @@
+// Package endtoend runs a devnet of beacon nodes and validator clients in a
+// single process, for end to end tests of the beacon chain. The beacon nodes
+// are connected over libp2p on the loopback address, start from the
+// deterministic interop genesis state and run the minimal configuration.
+//
+// All nodes of the devnet share a manual clock, which the simulator advances
+// half a slot at a time. The nodes are given the step delay of the config in
+// real time to handle each step, so the duration of a test does not depend on
+// the seconds per slot of the devnet.
+package endtoend
+
+import (
+	"context"
+	"crypto/rand"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+	"github.com/pkg/errors"
+	beaconflags "github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	beaconnode "github.com/prysmaticlabs/prysm/beacon-chain/node"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	validatorflags "github.com/prysmaticlabs/prysm/validator/flags"
+	validatornode "github.com/prysmaticlabs/prysm/validator/node"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+	"google.golang.org/grpc"
+)
+
+var log = logrus.WithField("prefix", "endtoend")
+
+// host is the address all nodes of the devnet listen on.
+const host = "127.0.0.1"
+
+// beaconNodeFlags are the flags a beacon node of the devnet is configured
+// by. Flags which are not set by the simulator keep their default values.
+var beaconNodeFlags = append([]cli.Flag{
+	beaconflags.NoCustomConfigFlag,
+	beaconflags.DepositContractFlag,
+	beaconflags.Web3ProviderFlag,
+	beaconflags.HTTPWeb3ProviderFlag,
+	beaconflags.Eth1LogChunkSizeFlag,
+	beaconflags.Eth1HeaderPollIntervalFlag,
+	beaconflags.FallbackWeb3ProviderFlag,
+	beaconflags.Eth1HealthCheckIntervalFlag,
+	beaconflags.InteropGenesisStateFlag,
+	beaconflags.InteropNumValidatorsFlag,
+	beaconflags.InteropGenesisTimeFlag,
+	beaconflags.RPCPort,
+	beaconflags.CertFlag,
+	beaconflags.KeyFlag,
+	beaconflags.EnableDBCleanup,
+	beaconflags.GRPCGatewayPort,
+	cmd.BootstrapNode,
+	cmd.NoDiscovery,
+	cmd.EnableDiscv5,
+	cmd.Discv5BootstrapNode,
+	cmd.StaticPeers,
+	cmd.RelayNode,
+	cmd.P2PPort,
+	cmd.P2PUDPPort,
+	cmd.P2PHost,
+	cmd.P2PLocalIP,
+	cmd.P2PMaxPeers,
+	cmd.P2PPrivKey,
+	cmd.P2PWhitelist,
+	cmd.AttestationSubnetCount,
+	cmd.PersistentSubnets,
+	cmd.DataDirFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.EnableTracingFlag,
+	cmd.TracingProcessNameFlag,
+	cmd.TracingEndpointFlag,
+	cmd.TraceSampleFractionFlag,
+	cmd.MonitoringPortFlag,
+	cmd.DisableMonitoringFlag,
+	cmd.ClearDB,
+	cmd.MaxGoroutines,
+	cmd.EnableUPnPFlag,
+}, featureconfig.BeaconChainFlags...)
+
+// validatorClientFlags are the flags a validator client of the devnet is
+// configured by.
+var validatorClientFlags = append([]cli.Flag{
+	validatorflags.NoCustomConfigFlag,
+	validatorflags.BeaconRPCProviderFlag,
+	validatorflags.CertFlag,
+	validatorflags.KeystorePathFlag,
+	validatorflags.DisablePenaltyRewardLogFlag,
+	validatorflags.InteropStartIndexFlag,
+	validatorflags.InteropNumValidatorsFlag,
+	cmd.ChainConfigFileFlag,
+	cmd.EnableTracingFlag,
+	cmd.TracingProcessNameFlag,
+	cmd.TracingEndpointFlag,
+	cmd.TraceSampleFractionFlag,
+	cmd.MonitoringPortFlag,
+}, featureconfig.ValidatorFlags...)
+
+// Config of a simulated devnet.
+type Config struct {
+	NumBeaconNodes      int           // Beacon nodes started with the devnet.
+	NumValidatorClients int           // Validator clients, connected to the beacon nodes in turn.
+	NumValidators       uint64        // Interop validators, split between the validator clients.
+	SecondsPerSlot      uint64        // Slot duration of the devnet.
+	GenesisDelay        time.Duration // Delay of the genesis time after the devnet starts, on the devnet clock.
+	StepDelay           time.Duration // Real time the nodes are given to handle each half slot.
+}
+
+// Simulator runs the beacon nodes and validator clients of a devnet. Beacon
+// nodes are identified by the order they were added in, a stopped beacon
+// node can be started again from its database.
+type Simulator struct {
+	cfg         *Config
+	dir         string
+	clock       *clockutil.Manual
+	genesisTime uint64
+	lock        sync.Mutex
+	beaconNodes []*beaconNode
+	validators  []*validatorClient
+}
+
+type beaconNode struct {
+	dataDir string
+	keyPath string
+	id      peer.ID
+	rpcPort int
+	p2pPort int
+	node    *beaconnode.BeaconNode
+	done    chan struct{}
+	conn    *grpc.ClientConn
+}
+
+type validatorClient struct {
+	client *validatornode.ValidatorClient
+	done   chan struct{}
+}
+
+// NewSimulator prepares a devnet of the given config. The beacon chain config
+// of the process is replaced by the minimal config with the slot duration of
+// the devnet, as it is shared by all nodes.
+func NewSimulator(cfg *Config) (*Simulator, error) {
+	if cfg.NumBeaconNodes < 1 {
+		return nil, errors.New("a devnet needs at least one beacon node")
+	}
+	if cfg.NumValidatorClients < 1 || cfg.NumValidators < uint64(cfg.NumValidatorClients) {
+		return nil, errors.New("a devnet needs at least one validator per validator client")
+	}
+	if cfg.SecondsPerSlot == 0 {
+		return nil, errors.New("seconds per slot must be positive")
+	}
+	if cfg.StepDelay <= 0 {
+		return nil, errors.New("step delay must be positive")
+	}
+	dir, err := ioutil.TempDir("", "endtoend")
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create devnet directory")
+	}
+
+	chainConfig := params.MinimalSpecConfig()
+	chainConfig.SecondsPerSlot = cfg.SecondsPerSlot
+	params.OverrideBeaconConfig(chainConfig)
+
+	s := &Simulator{
+		cfg:   cfg,
+		dir:   dir,
+		clock: clockutil.NewManual(time.Unix(time.Now().Unix(), 0)),
+	}
+	for i := 0; i < cfg.NumBeaconNodes; i++ {
+		if _, err := s.newBeaconNode(); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Start the beacon nodes and validator clients of the devnet. The genesis
+// time is set by the genesis delay of the config, the devnet clock does not
+// advance until the simulator is told to.
+func (s *Simulator) Start() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.genesisTime = uint64(s.clock.Now().Add(s.cfg.GenesisDelay).Unix())
+	log.WithFields(logrus.Fields{
+		"beaconNodes":      len(s.beaconNodes),
+		"validatorClients": s.cfg.NumValidatorClients,
+		"validators":       s.cfg.NumValidators,
+		"genesisTime":      time.Unix(int64(s.genesisTime), 0),
+	}).Info("Starting devnet")
+
+	for i := range s.beaconNodes {
+		if err := s.startBeaconNode(i); err != nil {
+			return err
+		}
+	}
+	perClient := s.cfg.NumValidators / uint64(s.cfg.NumValidatorClients)
+	for i := 0; i < s.cfg.NumValidatorClients; i++ {
+		startIndex := uint64(i) * perClient
+		numValidators := perClient
+		if i == s.cfg.NumValidatorClients-1 {
+			numValidators = s.cfg.NumValidators - startIndex
+		}
+		if err := s.startValidatorClient(s.beaconNodes[i%len(s.beaconNodes)], startIndex, numValidators); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop all nodes of the devnet and remove their data.
+func (s *Simulator) Stop() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, v := range s.validators {
+		v.client.Close()
+		<-v.done
+	}
+	for i := range s.beaconNodes {
+		s.stopBeaconNode(i)
+	}
+	if err := os.RemoveAll(s.dir); err != nil {
+		log.WithError(err).Error("Could not remove devnet directory")
+	}
+}
+
+// AddBeaconNode starts a beacon node joining the running devnet, and returns
+// its index.
+func (s *Simulator) AddBeaconNode() (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	index, err := s.newBeaconNode()
+	if err != nil {
+		return 0, err
+	}
+	return index, s.startBeaconNode(index)
+}
+
+// StopBeaconNode stops the beacon node of the given index, keeping its
+// database.
+func (s *Simulator) StopBeaconNode(index int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.stopBeaconNode(index)
+}
+
+// RestartBeaconNode starts the stopped beacon node of the given index from
+// its database.
+func (s *Simulator) RestartBeaconNode(index int) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.beaconNodes[index].node != nil {
+		return fmt.Errorf("beacon node %d is running", index)
+	}
+	return s.startBeaconNode(index)
+}
+
+// BeaconChainClient returns a client of the beacon chain RPC service of the
+// beacon node of the given index.
+func (s *Simulator) BeaconChainClient(index int) ethpb.BeaconChainClient {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return ethpb.NewBeaconChainClient(s.beaconNodes[index].conn)
+}
+
+// NodeClient returns a client of the node RPC service of the beacon node of
+// the given index.
+func (s *Simulator) NodeClient(index int) ethpb.NodeClient {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return ethpb.NewNodeClient(s.beaconNodes[index].conn)
+}
+
+// Clock returns the clock shared by the nodes of the devnet. Deadlines of
+// tests are set on it with clockutil.WithDeadline.
+func (s *Simulator) Clock() clockutil.Clock {
+	return s.clock
+}
+
+// EpochStart returns the time of the devnet clock the given epoch starts at.
+func (s *Simulator) EpochStart(epoch uint64) time.Time {
+	slot := epoch * params.BeaconConfig().SlotsPerEpoch
+	return time.Unix(int64(s.genesisTime+slot*params.BeaconConfig().SecondsPerSlot), 0)
+}
+
+// Advance the devnet clock by the given duration, half a slot at a time.
+func (s *Simulator) Advance(d time.Duration) {
+	for end := s.clock.Now().Add(d); s.clock.Now().Before(end); {
+		s.step()
+	}
+}
+
+// WaitForChainHead polls the chain head of the beacon node of the given index,
+// advancing the devnet clock half a slot between polls, until the condition
+// holds for it or the context is done.
+func (s *Simulator) WaitForChainHead(ctx context.Context, index int, cond func(*ethpb.ChainHead) bool) (*ethpb.ChainHead, error) {
+	client := s.BeaconChainClient(index)
+	var head *ethpb.ChainHead
+	for {
+		var err error
+		head, err = client.GetChainHead(ctx, &ptypes.Empty{})
+		if err == nil && cond(head) {
+			return head, nil
+		}
+		s.step()
+		select {
+		case <-ctx.Done():
+			if head == nil {
+				return nil, errors.Wrapf(ctx.Err(), "could not retrieve chain head of beacon node %d", index)
+			}
+			return head, errors.Wrapf(ctx.Err(), "chain head of beacon node %d at slot %d, finalized slot %d",
+				index, head.BlockSlot, head.FinalizedSlot)
+		default:
+		}
+	}
+}
+
+// step advances the devnet clock by half a slot, and gives the nodes the step
+// delay to handle the slot events.
+func (s *Simulator) step() {
+	s.clock.Advance(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second / 2)
+	time.Sleep(s.cfg.StepDelay)
+}
+
+// newBeaconNode adds a beacon node with a new p2p identity and free ports
+// to the devnet.
+func (s *Simulator) newBeaconNode() (int, error) {
+	index := len(s.beaconNodes)
+	dataDir := path.Join(s.dir, fmt.Sprintf("beacon-%d", index))
+	if err := os.MkdirAll(dataDir, 0700); err != nil {
+		return 0, errors.Wrap(err, "could not create beacon node directory")
+	}
+	priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not generate p2p key")
+	}
+	id, err := peer.IDFromPrivateKey(priv)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not derive peer id")
+	}
+	keyBytes, err := crypto.MarshalPrivateKey(priv)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not marshal p2p key")
+	}
+	keyPath := path.Join(dataDir, "p2p.key")
+	if err := ioutil.WriteFile(keyPath, []byte(crypto.ConfigEncodeKey(keyBytes)), 0600); err != nil {
+		return 0, errors.Wrap(err, "could not write p2p key")
+	}
+	rpcPort, err := freePort()
+	if err != nil {
+		return 0, err
+	}
+	p2pPort, err := freePort()
+	if err != nil {
+		return 0, err
+	}
+	s.beaconNodes = append(s.beaconNodes, &beaconNode{
+		dataDir: dataDir,
+		keyPath: keyPath,
+		id:      id,
+		rpcPort: rpcPort,
+		p2pPort: p2pPort,
+	})
+	return index, nil
+}
+
+// startBeaconNode starts the beacon node of the given index with all other
+// beacon nodes of the devnet as static peers.
+func (s *Simulator) startBeaconNode(index int) error {
+	b := s.beaconNodes[index]
+	var peers []string
+	for i, other := range s.beaconNodes {
+		if i != index {
+			peers = append(peers, fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", host, other.p2pPort, other.id.Pretty()))
+		}
+	}
+	values := map[string]string{
+		beaconflags.NoCustomConfigFlag.Name:       "true",
+		beaconflags.InteropNumValidatorsFlag.Name: strconv.FormatUint(s.cfg.NumValidators, 10),
+		beaconflags.InteropGenesisTimeFlag.Name:   strconv.FormatUint(s.genesisTime, 10),
+		beaconflags.RPCPort.Name:                  strconv.Itoa(b.rpcPort),
+		cmd.DataDirFlag.Name:                      b.dataDir,
+		cmd.P2PPort.Name:                          strconv.Itoa(b.p2pPort),
+		cmd.P2PLocalIP.Name:                       host,
+		cmd.P2PPrivKey.Name:                       b.keyPath,
+		cmd.NoDiscovery.Name:                      "true",
+		cmd.DisableMonitoringFlag.Name:            "true",
+	}
+	if len(peers) > 0 {
+		values[cmd.StaticPeers.Name] = strings.Join(peers, ",")
+	}
+	ctx, err := newContext(beaconNodeFlags, values)
+	if err != nil {
+		return err
+	}
+	node, err := beaconnode.NewBeaconNodeWithClock(ctx, s.clock)
+	if err != nil {
+		return errors.Wrapf(err, "could not create beacon node %d", index)
+	}
+	conn, err := grpc.Dial(fmt.Sprintf("%s:%d", host, b.rpcPort), grpc.WithInsecure())
+	if err != nil {
+		node.Close()
+		return errors.Wrapf(err, "could not dial beacon node %d", index)
+	}
+	b.node = node
+	b.conn = conn
+	b.done = make(chan struct{})
+	go func() {
+		node.Start()
+		close(b.done)
+	}()
+	log.WithFields(logrus.Fields{
+		"index":   index,
+		"peerID":  b.id.Pretty(),
+		"rpcPort": b.rpcPort,
+		"p2pPort": b.p2pPort,
+	}).Info("Started beacon node")
+	return nil
+}
+
+func (s *Simulator) stopBeaconNode(index int) {
+	b := s.beaconNodes[index]
+	if b.node == nil {
+		return
+	}
+	if err := b.conn.Close(); err != nil {
+		log.WithError(err).Error("Could not close beacon node connection")
+	}
+	b.node.Close()
+	<-b.done
+	b.node = nil
+	log.WithField("index", index).Info("Stopped beacon node")
+}
+
+// startValidatorClient starts a validator client with the interop keys of
+// the given validators, connected to the given beacon node.
+func (s *Simulator) startValidatorClient(b *beaconNode, startIndex uint64, numValidators uint64) error {
+	monitoringPort, err := freePort()
+	if err != nil {
+		return err
+	}
+	ctx, err := newContext(validatorClientFlags, map[string]string{
+		validatorflags.NoCustomConfigFlag.Name:       "true",
+		validatorflags.BeaconRPCProviderFlag.Name:    fmt.Sprintf("%s:%d", host, b.rpcPort),
+		validatorflags.InteropStartIndexFlag.Name:    strconv.FormatUint(startIndex, 10),
+		validatorflags.InteropNumValidatorsFlag.Name: strconv.FormatUint(numValidators, 10),
+		cmd.MonitoringPortFlag.Name:                  strconv.Itoa(monitoringPort),
+	})
+	if err != nil {
+		return err
+	}
+	client, err := validatornode.NewValidatorClientWithClock(ctx, "" /*password*/, s.clock)
+	if err != nil {
+		return errors.Wrap(err, "could not create validator client")
+	}
+	v := &validatorClient{
+		client: client,
+		done:   make(chan struct{}),
+	}
+	s.validators = append(s.validators, v)
+	go func() {
+		client.Start()
+		close(v.done)
+	}()
+	return nil
+}
+
+// newContext returns a cli context holding the given flags with their
+// default values, overridden by the given values.
+func newContext(flags []cli.Flag, values map[string]string) (*cli.Context, error) {
+	set := flag.NewFlagSet("endtoend", flag.ContinueOnError)
+	for _, f := range flags {
+		f.Apply(set)
+	}
+	for name, value := range values {
+		if err := set.Set(name, value); err != nil {
+			return nil, errors.Wrapf(err, "could not set flag %s", name)
+		}
+	}
+	return cli.NewContext(cli.NewApp(), set, nil), nil
+}
+
+// freePort returns a TCP port which is free on the devnet host.
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not find a free port")
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
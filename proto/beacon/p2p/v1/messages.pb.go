@@ -42,6 +42,8 @@ const (
 	Topic_ATTESTATION_ANNOUNCE                Topic = 12
 	Topic_ATTESTATION_REQUEST                 Topic = 13
 	Topic_ATTESTATION_RESPONSE                Topic = 14
+	Topic_ATTESTATION_SUBNET                  Topic = 15
+	Topic_AGGREGATE_ATTESTATION               Topic = 16
 )
 
 var Topic_name = map[int32]string{
@@ -60,6 +62,8 @@ var Topic_name = map[int32]string{
 	12: "ATTESTATION_ANNOUNCE",
 	13: "ATTESTATION_REQUEST",
 	14: "ATTESTATION_RESPONSE",
+	15: "ATTESTATION_SUBNET",
+	16: "AGGREGATE_ATTESTATION",
 }
 
 var Topic_value = map[string]int32{
@@ -78,6 +82,8 @@ var Topic_value = map[string]int32{
 	"ATTESTATION_ANNOUNCE":                12,
 	"ATTESTATION_REQUEST":                 13,
 	"ATTESTATION_RESPONSE":                14,
+	"ATTESTATION_SUBNET":                  15,
+	"AGGREGATE_ATTESTATION":               16,
 }
 
 func (x Topic) String() string {
@@ -891,6 +897,100 @@ func (m *AttestationResponse) GetAttestation() *v1alpha1.Attestation {
 	return nil
 }
 
+type UnaggregatedAttestation struct {
+	Attestation          *v1alpha1.Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UnaggregatedAttestation) Reset()         { *m = UnaggregatedAttestation{} }
+func (m *UnaggregatedAttestation) String() string { return proto.CompactTextString(m) }
+func (*UnaggregatedAttestation) ProtoMessage()    {}
+func (*UnaggregatedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{15}
+}
+func (m *UnaggregatedAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnaggregatedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnaggregatedAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnaggregatedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnaggregatedAttestation.Merge(m, src)
+}
+func (m *UnaggregatedAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnaggregatedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnaggregatedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnaggregatedAttestation proto.InternalMessageInfo
+
+func (m *UnaggregatedAttestation) GetAttestation() *v1alpha1.Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+type AggregateAttestation struct {
+	Aggregate            *v1alpha1.Attestation `protobuf:"bytes,1,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *AggregateAttestation) Reset()         { *m = AggregateAttestation{} }
+func (m *AggregateAttestation) String() string { return proto.CompactTextString(m) }
+func (*AggregateAttestation) ProtoMessage()    {}
+func (*AggregateAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1d590cda035b632, []int{16}
+}
+func (m *AggregateAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AggregateAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AggregateAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AggregateAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AggregateAttestation.Merge(m, src)
+}
+func (m *AggregateAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AggregateAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AggregateAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AggregateAttestation proto.InternalMessageInfo
+
+func (m *AggregateAttestation) GetAggregate() *v1alpha1.Attestation {
+	if m != nil {
+		return m.Aggregate
+	}
+	return nil
+}
+
 type FinalizedStateAnnounce struct {
 	BlockRoot            []byte   `protobuf:"bytes,1,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
 	StateRoot            []byte   `protobuf:"bytes,2,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
@@ -904,7 +1004,7 @@ func (m *FinalizedStateAnnounce) Reset()         { *m = FinalizedStateAnnounce{}
 func (m *FinalizedStateAnnounce) String() string { return proto.CompactTextString(m) }
 func (*FinalizedStateAnnounce) ProtoMessage()    {}
 func (*FinalizedStateAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{15}
+	return fileDescriptor_a1d590cda035b632, []int{17}
 }
 func (m *FinalizedStateAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,7 +1065,7 @@ func (m *ProposerSlashingAnnounce) Reset()         { *m = ProposerSlashingAnnoun
 func (m *ProposerSlashingAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingAnnounce) ProtoMessage()    {}
 func (*ProposerSlashingAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{16}
+	return fileDescriptor_a1d590cda035b632, []int{18}
 }
 func (m *ProposerSlashingAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1112,7 @@ func (m *ProposerSlashingRequest) Reset()         { *m = ProposerSlashingRequest
 func (m *ProposerSlashingRequest) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingRequest) ProtoMessage()    {}
 func (*ProposerSlashingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{17}
+	return fileDescriptor_a1d590cda035b632, []int{19}
 }
 func (m *ProposerSlashingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1060,7 +1160,7 @@ func (m *ProposerSlashingResponse) Reset()         { *m = ProposerSlashingRespon
 func (m *ProposerSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*ProposerSlashingResponse) ProtoMessage()    {}
 func (*ProposerSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{18}
+	return fileDescriptor_a1d590cda035b632, []int{20}
 }
 func (m *ProposerSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1114,7 +1214,7 @@ func (m *AttesterSlashingAnnounce) Reset()         { *m = AttesterSlashingAnnoun
 func (m *AttesterSlashingAnnounce) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingAnnounce) ProtoMessage()    {}
 func (*AttesterSlashingAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{19}
+	return fileDescriptor_a1d590cda035b632, []int{21}
 }
 func (m *AttesterSlashingAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1161,7 +1261,7 @@ func (m *AttesterSlashingRequest) Reset()         { *m = AttesterSlashingRequest
 func (m *AttesterSlashingRequest) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingRequest) ProtoMessage()    {}
 func (*AttesterSlashingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{20}
+	return fileDescriptor_a1d590cda035b632, []int{22}
 }
 func (m *AttesterSlashingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1309,7 @@ func (m *AttesterSlashingResponse) Reset()         { *m = AttesterSlashingRespon
 func (m *AttesterSlashingResponse) String() string { return proto.CompactTextString(m) }
 func (*AttesterSlashingResponse) ProtoMessage()    {}
 func (*AttesterSlashingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{21}
+	return fileDescriptor_a1d590cda035b632, []int{23}
 }
 func (m *AttesterSlashingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,7 +1363,7 @@ func (m *DepositAnnounce) Reset()         { *m = DepositAnnounce{} }
 func (m *DepositAnnounce) String() string { return proto.CompactTextString(m) }
 func (*DepositAnnounce) ProtoMessage()    {}
 func (*DepositAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{22}
+	return fileDescriptor_a1d590cda035b632, []int{24}
 }
 func (m *DepositAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1310,7 +1410,7 @@ func (m *DepositRequest) Reset()         { *m = DepositRequest{} }
 func (m *DepositRequest) String() string { return proto.CompactTextString(m) }
 func (*DepositRequest) ProtoMessage()    {}
 func (*DepositRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{23}
+	return fileDescriptor_a1d590cda035b632, []int{25}
 }
 func (m *DepositRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1358,7 +1458,7 @@ func (m *DepositResponse) Reset()         { *m = DepositResponse{} }
 func (m *DepositResponse) String() string { return proto.CompactTextString(m) }
 func (*DepositResponse) ProtoMessage()    {}
 func (*DepositResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{24}
+	return fileDescriptor_a1d590cda035b632, []int{26}
 }
 func (m *DepositResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1412,7 +1512,7 @@ func (m *ExitAnnounce) Reset()         { *m = ExitAnnounce{} }
 func (m *ExitAnnounce) String() string { return proto.CompactTextString(m) }
 func (*ExitAnnounce) ProtoMessage()    {}
 func (*ExitAnnounce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{25}
+	return fileDescriptor_a1d590cda035b632, []int{27}
 }
 func (m *ExitAnnounce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1459,7 +1559,7 @@ func (m *ExitRequest) Reset()         { *m = ExitRequest{} }
 func (m *ExitRequest) String() string { return proto.CompactTextString(m) }
 func (*ExitRequest) ProtoMessage()    {}
 func (*ExitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{26}
+	return fileDescriptor_a1d590cda035b632, []int{28}
 }
 func (m *ExitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,7 +1607,7 @@ func (m *ExitResponse) Reset()         { *m = ExitResponse{} }
 func (m *ExitResponse) String() string { return proto.CompactTextString(m) }
 func (*ExitResponse) ProtoMessage()    {}
 func (*ExitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{27}
+	return fileDescriptor_a1d590cda035b632, []int{29}
 }
 func (m *ExitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1552,6 +1652,13 @@ func (m *ExitResponse) GetVoluntaryExit() *v1alpha1.VoluntaryExit {
 
 type Handshake struct {
 	DepositContractAddress string   `protobuf:"bytes,1,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
+	ForkVersion            []byte   `protobuf:"bytes,2,opt,name=fork_version,json=forkVersion,proto3" json:"fork_version,omitempty"`
+	FinalizedRoot          []byte   `protobuf:"bytes,3,opt,name=finalized_root,json=finalizedRoot,proto3" json:"finalized_root,omitempty"`
+	FinalizedEpoch         uint64   `protobuf:"varint,4,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	HeadRoot               []byte   `protobuf:"bytes,5,opt,name=head_root,json=headRoot,proto3" json:"head_root,omitempty"`
+	HeadSlot               uint64   `protobuf:"varint,6,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	FinalizedStateRoot     []byte   `protobuf:"bytes,7,opt,name=finalized_state_root,json=finalizedStateRoot,proto3" json:"finalized_state_root,omitempty"`
+	HeadStateRoot          []byte   `protobuf:"bytes,8,opt,name=head_state_root,json=headStateRoot,proto3" json:"head_state_root,omitempty"`
 	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
 	XXX_unrecognized       []byte   `json:"-"`
 	XXX_sizecache          int32    `json:"-"`
@@ -1561,7 +1668,7 @@ func (m *Handshake) Reset()         { *m = Handshake{} }
 func (m *Handshake) String() string { return proto.CompactTextString(m) }
 func (*Handshake) ProtoMessage()    {}
 func (*Handshake) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a1d590cda035b632, []int{28}
+	return fileDescriptor_a1d590cda035b632, []int{30}
 }
 func (m *Handshake) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1597,6 +1704,55 @@ func (m *Handshake) GetDepositContractAddress() string {
 	return ""
 }
 
+func (m *Handshake) GetForkVersion() []byte {
+	if m != nil {
+		return m.ForkVersion
+	}
+	return nil
+}
+
+func (m *Handshake) GetFinalizedRoot() []byte {
+	if m != nil {
+		return m.FinalizedRoot
+	}
+	return nil
+}
+
+func (m *Handshake) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
+func (m *Handshake) GetHeadRoot() []byte {
+	if m != nil {
+		return m.HeadRoot
+	}
+	return nil
+}
+
+func (m *Handshake) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *Handshake) GetFinalizedStateRoot() []byte {
+	if m != nil {
+		return m.FinalizedStateRoot
+	}
+	return nil
+}
+
+func (m *Handshake) GetHeadStateRoot() []byte {
+	if m != nil {
+		return m.HeadStateRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.p2p.v1.Topic", Topic_name, Topic_value)
 	proto.RegisterType((*Envelope)(nil), "ethereum.beacon.p2p.v1.Envelope")
@@ -1614,6 +1770,8 @@ func init() {
 	proto.RegisterType((*AttestationAnnounce)(nil), "ethereum.beacon.p2p.v1.AttestationAnnounce")
 	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.p2p.v1.AttestationRequest")
 	proto.RegisterType((*AttestationResponse)(nil), "ethereum.beacon.p2p.v1.AttestationResponse")
+	proto.RegisterType((*UnaggregatedAttestation)(nil), "ethereum.beacon.p2p.v1.UnaggregatedAttestation")
+	proto.RegisterType((*AggregateAttestation)(nil), "ethereum.beacon.p2p.v1.AggregateAttestation")
 	proto.RegisterType((*FinalizedStateAnnounce)(nil), "ethereum.beacon.p2p.v1.FinalizedStateAnnounce")
 	proto.RegisterType((*ProposerSlashingAnnounce)(nil), "ethereum.beacon.p2p.v1.ProposerSlashingAnnounce")
 	proto.RegisterType((*ProposerSlashingRequest)(nil), "ethereum.beacon.p2p.v1.ProposerSlashingRequest")
@@ -1633,78 +1791,87 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/messages.proto", fileDescriptor_a1d590cda035b632) }
 
 var fileDescriptor_a1d590cda035b632 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x52, 0xe3, 0xc6,
-	0x13, 0xfe, 0xc9, 0xc0, 0x82, 0xdb, 0xc6, 0xeb, 0x1d, 0xf8, 0x81, 0x21, 0x8b, 0x01, 0x2d, 0xd4,
-	0x92, 0x54, 0xad, 0xbc, 0xb0, 0x17, 0x2e, 0xa9, 0x94, 0x6c, 0x94, 0x32, 0x81, 0xc8, 0x1b, 0xd9,
-	0x24, 0x95, 0x93, 0x6a, 0x6c, 0xcf, 0x62, 0x67, 0x8d, 0x46, 0xd1, 0x8c, 0x5d, 0x90, 0x5b, 0xaa,
-	0xf2, 0x0a, 0xb9, 0xe6, 0x96, 0x53, 0x2e, 0x79, 0x8c, 0x1c, 0xf3, 0x08, 0x29, 0x9e, 0x24, 0xa5,
-	0x99, 0x91, 0x2c, 0xff, 0x41, 0x70, 0xc8, 0xcd, 0xea, 0xfe, 0xfa, 0xeb, 0xfe, 0xbe, 0x99, 0x9e,
-	0x32, 0xe8, 0x7e, 0x40, 0x39, 0xad, 0xb4, 0x09, 0xee, 0x50, 0xaf, 0xe2, 0x9f, 0xf8, 0x95, 0xd1,
-	0x71, 0xe5, 0x86, 0x30, 0x86, 0xaf, 0x09, 0x33, 0x44, 0x12, 0x6d, 0x10, 0xde, 0x23, 0x01, 0x19,
-	0xde, 0x18, 0x12, 0x66, 0xf8, 0x27, 0xbe, 0x31, 0x3a, 0xde, 0xde, 0x9d, 0x57, 0xcb, 0xef, 0xfc,
-	0xa8, 0x70, 0xfb, 0x40, 0x02, 0x08, 0xef, 0x55, 0x46, 0xc7, 0x78, 0xe0, 0xf7, 0xf0, 0x71, 0x05,
-	0x73, 0x4e, 0x18, 0xc7, 0xbc, 0x1f, 0xf2, 0x08, 0xd4, 0xe1, 0x1c, 0x94, 0xe4, 0x74, 0xdb, 0x03,
-	0xda, 0xf9, 0xa8, 0x60, 0xfa, 0x1c, 0xd8, 0x08, 0x0f, 0xfa, 0x5d, 0xcc, 0x69, 0xa0, 0x30, 0xbb,
-	0xd7, 0x94, 0x5e, 0x0f, 0x48, 0x45, 0x7c, 0xb5, 0x87, 0x1f, 0x2a, 0xbc, 0x7f, 0x13, 0x76, 0xbb,
-	0xf1, 0x25, 0x40, 0xff, 0x59, 0x83, 0x15, 0xcb, 0x1b, 0x91, 0x01, 0xf5, 0x09, 0xda, 0x87, 0x3c,
-	0xf3, 0xb1, 0xe7, 0x76, 0xa8, 0xc7, 0xc9, 0x2d, 0x2f, 0x69, 0x7b, 0xda, 0x51, 0xde, 0xc9, 0x85,
-	0xb1, 0x9a, 0x0c, 0xa1, 0x12, 0x2c, 0xfb, 0xf8, 0x6e, 0x40, 0x71, 0xb7, 0x94, 0x11, 0xd9, 0xe8,
-	0x13, 0x9d, 0x42, 0x36, 0x26, 0x2f, 0x2d, 0xec, 0x69, 0x47, 0xb9, 0x93, 0x6d, 0x43, 0xb6, 0x37,
-	0xa2, 0xf6, 0x46, 0x2b, 0x42, 0x38, 0x63, 0xb0, 0xfe, 0x15, 0xac, 0x55, 0x85, 0xbc, 0x6a, 0xa8,
-	0xce, 0xf4, 0x3c, 0x3a, 0xf4, 0x3a, 0x04, 0x21, 0x58, 0xec, 0x61, 0xd6, 0x53, 0x53, 0x88, 0xdf,
-	0x68, 0x17, 0x72, 0x6c, 0x40, 0xb9, 0xeb, 0x0d, 0x6f, 0xda, 0x24, 0x10, 0x23, 0x2c, 0x3a, 0x10,
-	0x86, 0x6c, 0x11, 0xd1, 0x8f, 0x00, 0x25, 0xb8, 0x1c, 0xf2, 0xe3, 0x90, 0x30, 0x3e, 0x8f, 0x4a,
-	0x37, 0xa1, 0x3c, 0x8b, 0xac, 0xde, 0x35, 0x63, 0xae, 0xe9, 0x66, 0xda, 0x4c, 0xb3, 0x5f, 0xb5,
-	0x89, 0xc9, 0x1d, 0xc2, 0x7c, 0xea, 0x31, 0x82, 0x4e, 0x61, 0x49, 0x1c, 0x94, 0x28, 0xc9, 0x9d,
-	0xe8, 0x46, 0x7c, 0x5f, 0x08, 0xef, 0x19, 0xd1, 0x61, 0x19, 0xc9, 0x52, 0x59, 0x80, 0xce, 0x20,
-	0x97, 0xb8, 0x0f, 0x42, 0xdf, 0xc3, 0xf5, 0xe6, 0x18, 0xe9, 0x24, 0xcb, 0xf4, 0x3f, 0x34, 0xd8,
-	0xaa, 0x62, 0xde, 0xe9, 0x91, 0xee, 0x1c, 0x33, 0xf6, 0x01, 0x18, 0xc7, 0x01, 0x77, 0x43, 0x25,
-	0x52, 0x55, 0x35, 0x53, 0xd2, 0x9c, 0xac, 0x88, 0x86, 0xfa, 0xd1, 0x0e, 0xac, 0x10, 0xaf, 0x2b,
-	0x01, 0x99, 0x18, 0xb0, 0x4c, 0xbc, 0xae, 0x48, 0x1f, 0x42, 0xe1, 0x43, 0xdf, 0xc3, 0x83, 0xfe,
-	0x4f, 0xa4, 0xeb, 0x06, 0x94, 0x72, 0x71, 0xde, 0x79, 0x67, 0x35, 0x8e, 0x3a, 0x54, 0xc2, 0x3a,
-	0xd8, 0xa3, 0x5e, 0xbf, 0x83, 0x07, 0x12, 0xb6, 0x28, 0x61, 0x71, 0x34, 0x84, 0xe9, 0xd7, 0xb0,
-	0x3d, 0x6f, 0x58, 0xe5, 0xe5, 0x39, 0x14, 0xda, 0x32, 0x2b, 0x2f, 0x3f, 0x2b, 0x69, 0x7b, 0x0b,
-	0x4f, 0x34, 0x75, 0x55, 0x55, 0x8a, 0x2f, 0xa6, 0x23, 0x28, 0xd6, 0x7a, 0xb8, 0xef, 0xd5, 0x09,
-	0xee, 0x2a, 0x33, 0xf4, 0xdf, 0x32, 0xf0, 0x22, 0x11, 0x54, 0x4d, 0x27, 0x26, 0x1f, 0xdb, 0x94,
-	0x98, 0x5c, 0xf8, 0xf0, 0x39, 0x7c, 0x92, 0x80, 0x71, 0xcc, 0x89, 0x90, 0xe9, 0x86, 0xf7, 0xeb,
-	0xdd, 0x89, 0x5a, 0x90, 0xd2, 0xb8, 0x26, 0x44, 0x84, 0x92, 0xeb, 0x22, 0x8f, 0xbe, 0x80, 0x97,
-	0x63, 0x1b, 0x67, 0xca, 0x99, 0x32, 0x75, 0x2b, 0xc6, 0x4c, 0xd5, 0x33, 0xf4, 0x16, 0xd6, 0xc7,
-	0xfd, 0x85, 0x3b, 0x49, 0x9b, 0x51, 0x9c, 0x93, 0x6e, 0x84, 0x47, 0xf2, 0x16, 0xd6, 0xc7, 0x2d,
-	0x13, 0x15, 0x4b, 0xb2, 0x22, 0xce, 0xc5, 0x15, 0xfa, 0x1b, 0xd8, 0x94, 0x96, 0x8a, 0xee, 0x61,
-	0xe7, 0xb4, 0x05, 0xd5, 0xaf, 0xa2, 0xfd, 0x93, 0xc3, 0xaa, 0x2b, 0xf7, 0x98, 0x52, 0xed, 0x11,
-	0xa5, 0xfa, 0x9f, 0xf1, 0xa6, 0x29, 0x5e, 0x75, 0x50, 0x97, 0xf0, 0x7c, 0x8a, 0x58, 0xed, 0xdc,
-	0x2b, 0x63, 0xfe, 0x1b, 0x6d, 0x24, 0x59, 0x0a, 0x93, 0x0d, 0xd1, 0x45, 0x92, 0x4d, 0x6e, 0x70,
-	0xe6, 0xc9, 0x1b, 0x5c, 0x98, 0x34, 0x4f, 0xff, 0x14, 0xd6, 0x12, 0x0b, 0x9a, 0x6a, 0xda, 0x11,
-	0xa0, 0xe4, 0x2e, 0xa7, 0x3c, 0x5a, 0x74, 0x82, 0x34, 0xb6, 0x61, 0xde, 0x53, 0xf9, 0xdf, 0x3c,
-	0x25, 0x3f, 0xc0, 0xc6, 0x97, 0x13, 0x26, 0xc5, 0x42, 0x76, 0x00, 0x12, 0x17, 0x48, 0x76, 0xce,
-	0xb6, 0xe3, 0x9b, 0xb6, 0x23, 0x5e, 0x19, 0x75, 0xd0, 0x6a, 0x15, 0xb2, 0x2c, 0x3a, 0xd7, 0x70,
-	0x62, 0xb1, 0x57, 0x0b, 0x62, 0xaf, 0xc4, 0x6f, 0xdd, 0x80, 0xd2, 0xfb, 0x80, 0xfa, 0x94, 0x91,
-	0xa0, 0x39, 0xc0, 0xac, 0xd7, 0xf7, 0xae, 0x53, 0x6d, 0x7b, 0x03, 0x9b, 0xd3, 0xf8, 0x34, 0xef,
-	0x7e, 0xd1, 0x66, 0xf9, 0x53, 0x1d, 0x6c, 0xc1, 0x0b, 0x5f, 0xe1, 0x5d, 0xa6, 0x0a, 0x94, 0x8f,
-	0xaf, 0x1f, 0xf0, 0x71, 0x86, 0xbf, 0xe8, 0x4f, 0x45, 0x42, 0x95, 0xd2, 0xed, 0xa7, 0xab, 0x9c,
-	0xc6, 0x3f, 0xa6, 0x72, 0x16, 0x9f, 0xae, 0x32, 0xc2, 0x3f, 0x55, 0xe5, 0x0c, 0x7f, 0x71, 0x3a,
-	0xa2, 0x1f, 0xc2, 0xf3, 0x33, 0xe2, 0x53, 0xd6, 0xe7, 0xa9, 0xe2, 0x0e, 0xa0, 0xa0, 0x60, 0x69,
-	0x9a, 0xdc, 0x98, 0x2c, 0x55, 0xc9, 0x29, 0x2c, 0x77, 0x25, 0x4c, 0xcd, 0x5f, 0x7e, 0x60, 0xfe,
-	0x88, 0x2c, 0x82, 0xeb, 0x3a, 0xe4, 0xad, 0xdb, 0x47, 0x46, 0xdd, 0x87, 0x5c, 0x88, 0x49, 0xdf,
-	0xce, 0xbc, 0x84, 0xa4, 0x0c, 0x79, 0x01, 0x85, 0x11, 0x1d, 0x0c, 0x3d, 0x8e, 0x83, 0x3b, 0x97,
-	0xdc, 0xc6, 0xb3, 0x1e, 0x3c, 0x30, 0xeb, 0xb7, 0x11, 0x58, 0x30, 0xaf, 0x8e, 0x92, 0x9f, 0xba,
-	0x05, 0xd9, 0x3a, 0xf6, 0xba, 0xac, 0x87, 0x3f, 0x86, 0xff, 0x3a, 0x4a, 0x4a, 0x8f, 0xf8, 0x03,
-	0x17, 0xe0, 0x0e, 0x77, 0x71, 0xb7, 0x1b, 0x10, 0x26, 0x1f, 0xd8, 0xac, 0xb3, 0xa1, 0xf2, 0x35,
-	0x95, 0x36, 0x65, 0xf6, 0xb3, 0xdf, 0x17, 0x60, 0xa9, 0x45, 0xfd, 0x7e, 0x07, 0xe5, 0x60, 0xf9,
-	0xca, 0xbe, 0xb0, 0x1b, 0xdf, 0xd9, 0xc5, 0xff, 0xa1, 0x2d, 0xf8, 0x7f, 0xd5, 0x32, 0x6b, 0x0d,
-	0xdb, 0xad, 0x5e, 0x36, 0x6a, 0x17, 0xae, 0x69, 0xdb, 0x8d, 0x2b, 0xbb, 0x66, 0x15, 0x35, 0x54,
-	0x82, 0xf5, 0x89, 0x94, 0x63, 0x7d, 0x73, 0x65, 0x35, 0x5b, 0xc5, 0x0c, 0x7a, 0x0d, 0xaf, 0xe6,
-	0x65, 0xdc, 0xea, 0xf7, 0x6e, 0xf3, 0xb2, 0xd1, 0x72, 0xed, 0xab, 0xaf, 0xab, 0x96, 0x53, 0x5c,
-	0x98, 0x61, 0x77, 0xac, 0xe6, 0xfb, 0x86, 0xdd, 0xb4, 0x8a, 0x8b, 0x68, 0x0f, 0x5e, 0x56, 0xcd,
-	0x56, 0xad, 0x6e, 0x9d, 0xb9, 0x73, 0xbb, 0x2c, 0xa1, 0x7d, 0xd8, 0x79, 0x00, 0xa1, 0x48, 0x9e,
-	0xa1, 0x0d, 0x40, 0xb5, 0xba, 0x79, 0x6e, 0xbb, 0x75, 0xcb, 0x3c, 0x8b, 0x4b, 0x97, 0xd1, 0x26,
-	0xac, 0x4d, 0xc4, 0x55, 0xc1, 0x0a, 0x2a, 0xc3, 0xb6, 0xe2, 0x6a, 0xb6, 0xcc, 0x96, 0xe5, 0xd6,
-	0xcd, 0x66, 0x7d, 0xac, 0x39, 0x9b, 0xd0, 0x2c, 0xf3, 0x11, 0x25, 0x24, 0xa4, 0x44, 0x19, 0x45,
-	0x9a, 0x0b, 0x8b, 0xcc, 0x56, 0xcb, 0x0a, 0xe3, 0xe7, 0x0d, 0x7b, 0x4c, 0x97, 0x0f, 0xe7, 0x48,
-	0x66, 0x22, 0xb6, 0xd5, 0xe9, 0x92, 0x98, 0xac, 0x50, 0xcd, 0xff, 0x75, 0x5f, 0xd6, 0xfe, 0xbe,
-	0x2f, 0x6b, 0xff, 0xdc, 0x97, 0xb5, 0xf6, 0x33, 0xf1, 0xaf, 0xfa, 0xdd, 0xbf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xd4, 0xb0, 0x5f, 0xa2, 0xb2, 0x0c, 0x00, 0x00,
+	// 1265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4d, 0x73, 0xdb, 0x54,
+	0x17, 0x7e, 0xe5, 0x24, 0x4d, 0x7c, 0xec, 0x38, 0xee, 0x6d, 0xde, 0xc4, 0x4d, 0x9b, 0x34, 0x51,
+	0x5b, 0x1a, 0x98, 0xa9, 0xdc, 0xa4, 0x9b, 0x6e, 0x18, 0x90, 0x1d, 0x51, 0x97, 0x14, 0xb9, 0xc8,
+	0x76, 0x81, 0x95, 0xe6, 0xda, 0xbe, 0xb5, 0x4d, 0x1d, 0x5d, 0x21, 0xc9, 0x9e, 0x84, 0x1d, 0x33,
+	0xfc, 0x05, 0xb6, 0xfc, 0x01, 0x36, 0xfc, 0x0c, 0x16, 0x2c, 0x58, 0xb2, 0x64, 0xf2, 0x4b, 0x98,
+	0xfb, 0x21, 0xf9, 0xfa, 0x23, 0x4a, 0x60, 0xd8, 0x59, 0xe7, 0x3c, 0xcf, 0x73, 0xbe, 0xee, 0x3d,
+	0x92, 0x41, 0xf7, 0x03, 0x1a, 0xd1, 0x72, 0x9b, 0xe0, 0x0e, 0xf5, 0xca, 0xfe, 0xb1, 0x5f, 0x1e,
+	0x1f, 0x95, 0xcf, 0x48, 0x18, 0xe2, 0x1e, 0x09, 0x0d, 0xee, 0x44, 0x5b, 0x24, 0xea, 0x93, 0x80,
+	0x8c, 0xce, 0x0c, 0x01, 0x33, 0xfc, 0x63, 0xdf, 0x18, 0x1f, 0xed, 0x3c, 0x58, 0xc4, 0x8d, 0x2e,
+	0xfc, 0x98, 0xb8, 0xf3, 0x48, 0x00, 0x48, 0xd4, 0x2f, 0x8f, 0x8f, 0xf0, 0xd0, 0xef, 0xe3, 0xa3,
+	0x32, 0x8e, 0x22, 0x12, 0x46, 0x38, 0x1a, 0x30, 0x1d, 0x8e, 0x7a, 0xbc, 0x00, 0x25, 0x34, 0xdd,
+	0xf6, 0x90, 0x76, 0xde, 0x4b, 0xd8, 0x83, 0x1e, 0xa5, 0xbd, 0x21, 0x29, 0xf3, 0xa7, 0xf6, 0xe8,
+	0x5d, 0x39, 0x1a, 0x9c, 0x31, 0xa5, 0x33, 0x5f, 0x00, 0xf4, 0x1f, 0x34, 0x58, 0xb3, 0xbc, 0x31,
+	0x19, 0x52, 0x9f, 0xa0, 0x03, 0xc8, 0x87, 0x3e, 0xf6, 0xdc, 0x0e, 0xf5, 0x22, 0x72, 0x1e, 0x95,
+	0xb4, 0x7d, 0xed, 0x30, 0xef, 0xe4, 0x98, 0xad, 0x2a, 0x4c, 0xa8, 0x04, 0xab, 0x3e, 0xbe, 0x18,
+	0x52, 0xdc, 0x2d, 0x65, 0xb8, 0x37, 0x7e, 0x44, 0x2f, 0x20, 0x9b, 0x88, 0x97, 0x96, 0xf6, 0xb5,
+	0xc3, 0xdc, 0xf1, 0x8e, 0x21, 0xc2, 0x1b, 0x71, 0x78, 0xa3, 0x19, 0x23, 0x9c, 0x09, 0x58, 0xff,
+	0x1c, 0xee, 0x54, 0x78, 0xea, 0x15, 0x96, 0xb9, 0xe9, 0x79, 0x74, 0xe4, 0x75, 0x08, 0x42, 0xb0,
+	0xdc, 0xc7, 0x61, 0x5f, 0x66, 0xc1, 0x7f, 0xa3, 0x07, 0x90, 0x0b, 0x87, 0x34, 0x72, 0xbd, 0xd1,
+	0x59, 0x9b, 0x04, 0x3c, 0x85, 0x65, 0x07, 0x98, 0xc9, 0xe6, 0x16, 0xfd, 0x10, 0x90, 0xa2, 0xe5,
+	0x90, 0xef, 0x46, 0x24, 0x8c, 0x16, 0x49, 0xe9, 0x26, 0xec, 0xcd, 0x23, 0x2b, 0x17, 0x8d, 0x44,
+	0x6b, 0x36, 0x98, 0x36, 0x17, 0xec, 0x27, 0x6d, 0x2a, 0x73, 0x87, 0x84, 0x3e, 0xf5, 0x42, 0x82,
+	0x5e, 0xc0, 0x0a, 0x1f, 0x02, 0xa7, 0xe4, 0x8e, 0x75, 0x23, 0x39, 0x0b, 0x24, 0xea, 0x1b, 0xf1,
+	0xbc, 0x0c, 0x95, 0x2a, 0x08, 0xe8, 0x04, 0x72, 0xca, 0xac, 0x4b, 0x99, 0x54, 0xbe, 0x39, 0x41,
+	0x3a, 0x2a, 0x4d, 0xff, 0x45, 0x83, 0xbb, 0x15, 0x1c, 0x75, 0xfa, 0xa4, 0xbb, 0xa0, 0x19, 0x07,
+	0x00, 0x61, 0x84, 0x83, 0xc8, 0x65, 0x95, 0x88, 0xaa, 0x2a, 0x99, 0x92, 0xe6, 0x64, 0xb9, 0x95,
+	0xd5, 0x8f, 0x76, 0x61, 0x8d, 0x78, 0x5d, 0x01, 0xc8, 0x24, 0x80, 0x55, 0xe2, 0x75, 0xb9, 0xfb,
+	0x31, 0x14, 0xde, 0x0d, 0x3c, 0x3c, 0x1c, 0x7c, 0x4f, 0xba, 0x6e, 0x40, 0x69, 0xc4, 0xe7, 0x9d,
+	0x77, 0xd6, 0x13, 0xab, 0x43, 0x05, 0xac, 0x83, 0x3d, 0xea, 0x0d, 0x3a, 0x78, 0x28, 0x60, 0xcb,
+	0x02, 0x96, 0x58, 0x19, 0x4c, 0xef, 0xc1, 0xce, 0xa2, 0x64, 0x65, 0x2f, 0x5f, 0x41, 0xa1, 0x2d,
+	0xbc, 0xe2, 0x60, 0x87, 0x25, 0x6d, 0x7f, 0xe9, 0x86, 0x4d, 0x5d, 0x97, 0x4c, 0xfe, 0x14, 0xea,
+	0x08, 0x8a, 0xd5, 0x3e, 0x1e, 0x78, 0x35, 0x82, 0xbb, 0xb2, 0x19, 0xfa, 0xcf, 0x19, 0xb8, 0xad,
+	0x18, 0x65, 0xd0, 0xa9, 0xcc, 0x27, 0x6d, 0x52, 0x32, 0xe7, 0x7d, 0xf8, 0x18, 0xee, 0x29, 0xb0,
+	0x08, 0x47, 0x84, 0x97, 0xe9, 0xb2, 0xf3, 0xf5, 0xfc, 0x58, 0x5e, 0x90, 0xd2, 0x84, 0xc3, 0x10,
+	0xac, 0xe4, 0x1a, 0xf7, 0xa3, 0x4f, 0xe0, 0xfe, 0xa4, 0x8d, 0x73, 0xf4, 0x50, 0x36, 0xf5, 0x6e,
+	0x82, 0x99, 0xe1, 0x87, 0xe8, 0x19, 0x6c, 0x4e, 0xe2, 0xf3, 0xee, 0xa8, 0x6d, 0x46, 0x89, 0x4f,
+	0x74, 0x83, 0x8d, 0xe4, 0x19, 0x6c, 0x4e, 0x42, 0x2a, 0x8c, 0x15, 0xc1, 0x48, 0x7c, 0x09, 0x43,
+	0x7f, 0x0a, 0xdb, 0xa2, 0xa5, 0x3c, 0x3a, 0x8b, 0x9c, 0x76, 0x41, 0xf5, 0x16, 0x20, 0x05, 0x1e,
+	0x1f, 0xb9, 0xeb, 0x2a, 0xd5, 0xae, 0xa9, 0x54, 0xff, 0x35, 0xb9, 0x69, 0x52, 0x57, 0x0e, 0xea,
+	0x35, 0x6c, 0xcc, 0x08, 0xcb, 0x3b, 0xf7, 0xd0, 0x58, 0xbc, 0x7f, 0x0d, 0x55, 0xa5, 0x30, 0x1d,
+	0x10, 0x9d, 0xc2, 0xc6, 0x4c, 0x77, 0xae, 0xb9, 0x81, 0xea, 0x61, 0x2b, 0x4c, 0x37, 0x4f, 0xff,
+	0x10, 0xee, 0x28, 0x17, 0x34, 0xb5, 0x69, 0x87, 0x80, 0xd4, 0xbb, 0x9c, 0xb2, 0xb4, 0xe8, 0x94,
+	0x68, 0xd2, 0x86, 0x05, 0xd0, 0xff, 0x68, 0x95, 0xb8, 0xb0, 0xdd, 0xf2, 0x70, 0xaf, 0x17, 0x90,
+	0x1e, 0x8e, 0x48, 0x57, 0xc1, 0xcd, 0x06, 0xd0, 0xfe, 0x5d, 0x80, 0xaf, 0x61, 0xd3, 0x8c, 0xe5,
+	0x55, 0xf5, 0x4f, 0x21, 0x9b, 0x84, 0xfd, 0x07, 0xda, 0x13, 0x92, 0xfe, 0x2d, 0x6c, 0x7d, 0x36,
+	0x35, 0xdf, 0x64, 0x06, 0xbb, 0x00, 0xca, 0xd9, 0x17, 0x4d, 0xcb, 0xb6, 0x93, 0x4b, 0xb2, 0x0b,
+	0x30, 0x39, 0xa3, 0xf2, 0x16, 0x67, 0xc3, 0xf8, 0x48, 0xb2, 0x66, 0xf3, 0x95, 0xb0, 0xc4, 0x57,
+	0x02, 0xff, 0xad, 0x1b, 0x50, 0x7a, 0x13, 0x50, 0x9f, 0x86, 0x24, 0x68, 0x0c, 0x71, 0xd8, 0x1f,
+	0x78, 0xbd, 0xd4, 0x89, 0x3f, 0x85, 0xed, 0x59, 0x7c, 0xda, 0xd8, 0x7f, 0xd4, 0xe6, 0xf5, 0x53,
+	0x87, 0xdf, 0x84, 0xdb, 0xbe, 0xc4, 0xbb, 0xa1, 0x24, 0xc8, 0x23, 0xf0, 0xe4, 0x8a, 0x2e, 0xce,
+	0xe9, 0x17, 0xfd, 0x19, 0x0b, 0xab, 0x52, 0xf4, 0xfa, 0xe6, 0x55, 0xce, 0xe2, 0xaf, 0xab, 0x72,
+	0x1e, 0x9f, 0x5e, 0x65, 0x8c, 0xbf, 0x69, 0x95, 0x73, 0xfa, 0xc5, 0x59, 0x8b, 0xfe, 0x18, 0x36,
+	0x4e, 0x88, 0x4f, 0xc3, 0x41, 0x94, 0x5a, 0xdc, 0x23, 0x28, 0x48, 0x58, 0x5a, 0x4d, 0x6e, 0x22,
+	0x96, 0x5a, 0xc9, 0x0b, 0x58, 0xed, 0x0a, 0x98, 0xcc, 0x7f, 0xef, 0x8a, 0xfc, 0x63, 0xb1, 0x18,
+	0xae, 0xeb, 0x90, 0xb7, 0xce, 0xaf, 0x49, 0xf5, 0x00, 0x72, 0xd6, 0x79, 0x7a, 0x9e, 0x54, 0xc8,
+	0xa4, 0x26, 0x79, 0x0a, 0x85, 0x31, 0x1d, 0x8e, 0xbc, 0x08, 0x07, 0x17, 0x2e, 0x39, 0x4f, 0x72,
+	0x7d, 0x74, 0x45, 0xae, 0x6f, 0x63, 0x30, 0x57, 0x5e, 0x1f, 0xab, 0x8f, 0xfa, 0xef, 0x19, 0xc8,
+	0xd6, 0xb0, 0xd7, 0x0d, 0xfb, 0xf8, 0x3d, 0xfb, 0x62, 0x2a, 0xc9, 0x82, 0xf8, 0xc7, 0x67, 0x80,
+	0x3b, 0x91, 0x8b, 0xbb, 0xdd, 0x80, 0x84, 0xe2, 0xe5, 0x90, 0x75, 0xb6, 0xa4, 0xbf, 0x2a, 0xdd,
+	0xa6, 0xf0, 0xb2, 0x6f, 0xd6, 0x77, 0x34, 0x78, 0xef, 0x8e, 0x49, 0x10, 0xc6, 0x7b, 0x2e, 0xef,
+	0xe4, 0x98, 0xed, 0xad, 0x30, 0xdd, 0xf4, 0x73, 0xe5, 0x89, 0xba, 0xfd, 0x89, 0x4f, 0x3b, 0x7d,
+	0xfe, 0x22, 0x5d, 0x56, 0x36, 0xbb, 0xc5, 0xac, 0xe8, 0x1e, 0x64, 0xfb, 0x04, 0x77, 0xd5, 0x37,
+	0xe7, 0x1a, 0x33, 0x70, 0x95, 0xd8, 0xc9, 0x57, 0xc4, 0x2d, 0xce, 0xe7, 0xce, 0xc6, 0x70, 0xf6,
+	0xf5, 0xab, 0xec, 0x98, 0xd5, 0x99, 0xd7, 0x6f, 0xf2, 0xfe, 0x43, 0x1f, 0xc0, 0x86, 0x90, 0x9b,
+	0x80, 0xd7, 0x44, 0xf2, 0x5c, 0x34, 0xc6, 0x7d, 0xf4, 0xe7, 0x12, 0xac, 0x34, 0xa9, 0x3f, 0xe8,
+	0xa0, 0x1c, 0xac, 0xb6, 0xec, 0x53, 0xbb, 0xfe, 0x95, 0x5d, 0xfc, 0x1f, 0xba, 0x0b, 0xff, 0xaf,
+	0x58, 0x66, 0xb5, 0x6e, 0xbb, 0x95, 0xd7, 0xf5, 0xea, 0xa9, 0x6b, 0xda, 0x76, 0xbd, 0x65, 0x57,
+	0xad, 0xa2, 0x86, 0x4a, 0xb0, 0x39, 0xe5, 0x72, 0xac, 0x2f, 0x5b, 0x56, 0xa3, 0x59, 0xcc, 0xa0,
+	0x27, 0xf0, 0x70, 0x91, 0xc7, 0xad, 0x7c, 0xe3, 0x36, 0x5e, 0xd7, 0x9b, 0xae, 0xdd, 0xfa, 0xa2,
+	0x62, 0x39, 0xc5, 0xa5, 0x39, 0x75, 0xc7, 0x6a, 0xbc, 0xa9, 0xdb, 0x0d, 0xab, 0xb8, 0x8c, 0xf6,
+	0xe1, 0x7e, 0xc5, 0x6c, 0x56, 0x6b, 0xd6, 0x89, 0xbb, 0x30, 0xca, 0x0a, 0x3a, 0x80, 0xdd, 0x2b,
+	0x10, 0x52, 0xe4, 0x16, 0xda, 0x02, 0x54, 0xad, 0x99, 0xaf, 0x6c, 0xb7, 0x66, 0x99, 0x27, 0x09,
+	0x75, 0x15, 0x6d, 0xc3, 0x9d, 0x29, 0xbb, 0x24, 0xac, 0xa1, 0x3d, 0xd8, 0x91, 0x5a, 0x8d, 0xa6,
+	0xd9, 0xb4, 0xdc, 0x9a, 0xd9, 0xa8, 0x4d, 0x6a, 0xce, 0x2a, 0x35, 0x0b, 0x7f, 0x2c, 0x09, 0x4a,
+	0x29, 0xb1, 0x47, 0x8a, 0xe6, 0x18, 0xc9, 0x6c, 0x36, 0x2d, 0x66, 0x7f, 0x55, 0xb7, 0x27, 0x72,
+	0x79, 0x96, 0x87, 0xea, 0x89, 0xd5, 0xd6, 0x67, 0x29, 0x89, 0x58, 0x81, 0x95, 0xa4, 0x7a, 0x1a,
+	0xad, 0x8a, 0x6d, 0x35, 0x8b, 0x1b, 0x2c, 0xbe, 0xf9, 0xf2, 0xa5, 0x63, 0xbd, 0x64, 0xc1, 0x15,
+	0x44, 0xb1, 0x58, 0xc9, 0xff, 0x76, 0xb9, 0xa7, 0xfd, 0x71, 0xb9, 0xa7, 0xfd, 0x75, 0xb9, 0xa7,
+	0xb5, 0x6f, 0xf1, 0xff, 0x52, 0xcf, 0xff, 0x1e, 0x00, 0xfc, 0xe8, 0x00, 0x99, 0x84, 0x0e, 0x00,
+	0x00,
 }
 
 func (m *Envelope) Marshal() (dAtA []byte, err error) {
@@ -2209,6 +2376,68 @@ func (m *AttestationResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *UnaggregatedAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnaggregatedAttestation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Attestation.Size()))
+		n7, err := m.Attestation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AggregateAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AggregateAttestation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Aggregate != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.Aggregate.Size()))
+		n8, err := m.Aggregate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *FinalizedStateAnnounce) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2326,11 +2555,11 @@ func (m *ProposerSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.ProposerSlashing.Size()))
-		n7, err := m.ProposerSlashing.MarshalTo(dAtA[i:])
+		n9, err := m.ProposerSlashing.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2417,11 +2646,11 @@ func (m *AttesterSlashingResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.AttesterSlashing.Size()))
-		n8, err := m.AttesterSlashing.MarshalTo(dAtA[i:])
+		n10, err := m.AttesterSlashing.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2508,11 +2737,11 @@ func (m *DepositResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.Deposit.Size()))
-		n9, err := m.Deposit.MarshalTo(dAtA[i:])
+		n11, err := m.Deposit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n11
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2599,11 +2828,11 @@ func (m *ExitResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintMessages(dAtA, i, uint64(m.VoluntaryExit.Size()))
-		n10, err := m.VoluntaryExit.MarshalTo(dAtA[i:])
+		n12, err := m.VoluntaryExit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2632,6 +2861,46 @@ func (m *Handshake) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintMessages(dAtA, i, uint64(len(m.DepositContractAddress)))
 		i += copy(dAtA[i:], m.DepositContractAddress)
 	}
+	if len(m.ForkVersion) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.ForkVersion)))
+		i += copy(dAtA[i:], m.ForkVersion)
+	}
+	if len(m.FinalizedRoot) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedRoot)))
+		i += copy(dAtA[i:], m.FinalizedRoot)
+	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.FinalizedEpoch))
+	}
+	if len(m.HeadRoot) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.HeadRoot)))
+		i += copy(dAtA[i:], m.HeadRoot)
+	}
+	if m.HeadSlot != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(m.HeadSlot))
+	}
+	if len(m.FinalizedStateRoot) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.FinalizedStateRoot)))
+		i += copy(dAtA[i:], m.FinalizedStateRoot)
+	}
+	if len(m.HeadStateRoot) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintMessages(dAtA, i, uint64(len(m.HeadStateRoot)))
+		i += copy(dAtA[i:], m.HeadStateRoot)
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -2932,6 +3201,38 @@ func (m *AttestationResponse) Size() (n int) {
 	return n
 }
 
+func (m *UnaggregatedAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AggregateAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Aggregate != nil {
+		l = m.Aggregate.Size()
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *FinalizedStateAnnounce) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3173,6 +3474,32 @@ func (m *Handshake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessages(uint64(l))
 	}
+	l = len(m.ForkVersion)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.FinalizedRoot)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovMessages(uint64(m.FinalizedEpoch))
+	}
+	l = len(m.HeadRoot)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovMessages(uint64(m.HeadSlot))
+	}
+	l = len(m.FinalizedStateRoot)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
+	l = len(m.HeadStateRoot)
+	if l > 0 {
+		n += 1 + l + sovMessages(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4857,7 +5184,7 @@ func (m *AttestationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalizedStateAnnounce) Unmarshal(dAtA []byte) error {
+func (m *UnaggregatedAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4880,17 +5207,197 @@ func (m *FinalizedStateAnnounce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalizedStateAnnounce: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnaggregatedAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalizedStateAnnounce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnaggregatedAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			var byteLen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Attestation == nil {
+				m.Attestation = &v1alpha1.Attestation{}
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AggregateAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AggregateAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AggregateAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Aggregate == nil {
+				m.Aggregate = &v1alpha1.Attestation{}
+			}
+			if err := m.Aggregate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessages(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizedStateAnnounce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessages
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizedStateAnnounce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizedStateAnnounce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessages
@@ -6259,6 +6766,214 @@ func (m *Handshake) Unmarshal(dAtA []byte) error {
 			}
 			m.DepositContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkVersion", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkVersion = append(m.ForkVersion[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkVersion == nil {
+				m.ForkVersion = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedRoot = append(m.FinalizedRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedRoot == nil {
+				m.FinalizedRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadRoot = append(m.HeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadRoot == nil {
+				m.HeadRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedStateRoot = append(m.FinalizedStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedStateRoot == nil {
+				m.FinalizedStateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadStateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessages
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessages
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessages
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeadStateRoot = append(m.HeadStateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.HeadStateRoot == nil {
+				m.HeadStateRoot = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessages(dAtA[iNdEx:])
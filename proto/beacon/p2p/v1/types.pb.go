@@ -461,6 +461,77 @@ func (m *AttestationTarget) GetParentRoot() []byte {
 	return nil
 }
 
+type DepositContainer struct {
+	Deposit              *v1alpha1.Deposit `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Eth1BlockHeight      uint64            `protobuf:"varint,2,opt,name=eth1_block_height,json=eth1BlockHeight,proto3" json:"eth1_block_height,omitempty"`
+	Index                uint64            `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	DepositRoot          []byte            `protobuf:"bytes,4,opt,name=deposit_root,json=depositRoot,proto3" json:"deposit_root,omitempty" ssz-size:"32"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DepositContainer) Reset()         { *m = DepositContainer{} }
+func (m *DepositContainer) String() string { return proto.CompactTextString(m) }
+func (*DepositContainer) ProtoMessage()    {}
+func (*DepositContainer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e719e7d82cfa7b0d, []int{4}
+}
+func (m *DepositContainer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositContainer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositContainer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositContainer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositContainer.Merge(m, src)
+}
+func (m *DepositContainer) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositContainer) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositContainer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositContainer proto.InternalMessageInfo
+
+func (m *DepositContainer) GetDeposit() *v1alpha1.Deposit {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *DepositContainer) GetEth1BlockHeight() uint64 {
+	if m != nil {
+		return m.Eth1BlockHeight
+	}
+	return 0
+}
+
+func (m *DepositContainer) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DepositContainer) GetDepositRoot() []byte {
+	if m != nil {
+		return m.DepositRoot
+	}
+	return nil
+}
+
 type AttestationDataAndCustodyBit struct {
 	Data                 *v1alpha1.AttestationData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	CustodyBit           bool                      `protobuf:"varint,2,opt,name=custody_bit,json=custodyBit,proto3" json:"custody_bit,omitempty"`
@@ -473,7 +544,7 @@ func (m *AttestationDataAndCustodyBit) Reset()         { *m = AttestationDataAnd
 func (m *AttestationDataAndCustodyBit) String() string { return proto.CompactTextString(m) }
 func (*AttestationDataAndCustodyBit) ProtoMessage()    {}
 func (*AttestationDataAndCustodyBit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{4}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{5}
 }
 func (m *AttestationDataAndCustodyBit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -528,7 +599,7 @@ func (m *HistoricalBatch) Reset()         { *m = HistoricalBatch{} }
 func (m *HistoricalBatch) String() string { return proto.CompactTextString(m) }
 func (*HistoricalBatch) ProtoMessage()    {}
 func (*HistoricalBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{5}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{6}
 }
 func (m *HistoricalBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +654,7 @@ func (m *CompactCommittee) Reset()         { *m = CompactCommittee{} }
 func (m *CompactCommittee) String() string { return proto.CompactTextString(m) }
 func (*CompactCommittee) ProtoMessage()    {}
 func (*CompactCommittee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e719e7d82cfa7b0d, []int{6}
+	return fileDescriptor_e719e7d82cfa7b0d, []int{7}
 }
 func (m *CompactCommittee) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -631,6 +702,7 @@ func init() {
 	proto.RegisterType((*Fork)(nil), "ethereum.beacon.p2p.v1.Fork")
 	proto.RegisterType((*PendingAttestation)(nil), "ethereum.beacon.p2p.v1.PendingAttestation")
 	proto.RegisterType((*AttestationTarget)(nil), "ethereum.beacon.p2p.v1.AttestationTarget")
+	proto.RegisterType((*DepositContainer)(nil), "ethereum.beacon.p2p.v1.DepositContainer")
 	proto.RegisterType((*AttestationDataAndCustodyBit)(nil), "ethereum.beacon.p2p.v1.AttestationDataAndCustodyBit")
 	proto.RegisterType((*HistoricalBatch)(nil), "ethereum.beacon.p2p.v1.HistoricalBatch")
 	proto.RegisterType((*CompactCommittee)(nil), "ethereum.beacon.p2p.v1.CompactCommittee")
@@ -639,89 +711,94 @@ func init() {
 func init() { proto.RegisterFile("proto/beacon/p2p/v1/types.proto", fileDescriptor_e719e7d82cfa7b0d) }
 
 var fileDescriptor_e719e7d82cfa7b0d = []byte{
-	// 1307 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x8f, 0xdb, 0xc4,
-	0x1b, 0x96, 0x77, 0xf3, 0xfb, 0xb5, 0x9d, 0x6c, 0x37, 0xc9, 0x6c, 0xd5, 0x35, 0x6d, 0x59, 0x07,
-	0x8b, 0xb6, 0x2b, 0xd4, 0x4d, 0x9a, 0x74, 0x9b, 0xec, 0xb6, 0x14, 0xd4, 0x6c, 0xbb, 0x2a, 0x48,
-	0x48, 0xc8, 0x2d, 0x95, 0x90, 0x10, 0xd6, 0xc4, 0x9e, 0xb5, 0x87, 0x75, 0x3c, 0x96, 0x67, 0x12,
-	0x75, 0x8b, 0x10, 0x07, 0x4e, 0x7c, 0x48, 0x1c, 0xe0, 0x04, 0x27, 0xb8, 0xf1, 0xf1, 0x0f, 0x00,
-	0x27, 0x38, 0x71, 0xe4, 0xeb, 0x02, 0x87, 0x08, 0xf5, 0x06, 0x9c, 0xc8, 0x91, 0x13, 0x9a, 0x19,
-	0xdb, 0x71, 0xb6, 0x9b, 0x36, 0x02, 0x6e, 0xf6, 0x3b, 0xcf, 0xf3, 0xbc, 0xf3, 0x7e, 0xcc, 0xcc,
-	0x0b, 0x8c, 0x28, 0xa6, 0x9c, 0xd6, 0xbb, 0x18, 0x39, 0x34, 0xac, 0x47, 0xcd, 0xa8, 0x3e, 0x68,
-	0xd4, 0xf9, 0x5e, 0x84, 0x59, 0x4d, 0xae, 0xc0, 0xe3, 0x98, 0xfb, 0x38, 0xc6, 0xfd, 0x5e, 0x4d,
-	0x61, 0x6a, 0x51, 0x33, 0xaa, 0x0d, 0x1a, 0x27, 0x1e, 0x57, 0x44, 0xcc, 0xfd, 0xfa, 0xa0, 0x81,
-	0x82, 0xc8, 0x47, 0x8d, 0x3a, 0xe2, 0x1c, 0x33, 0x8e, 0x38, 0x11, 0x30, 0xb1, 0x7c, 0xe2, 0xf4,
-	0x01, 0x28, 0xa5, 0x63, 0x77, 0x03, 0xea, 0xec, 0x26, 0x30, 0xf3, 0x00, 0xd8, 0x00, 0x05, 0xc4,
-	0x45, 0x9c, 0xc6, 0x09, 0x66, 0xcd, 0x23, 0xdc, 0xef, 0x77, 0x6b, 0x0e, 0xed, 0xd5, 0x3d, 0xea,
-	0xd1, 0xba, 0x34, 0x77, 0xfb, 0x3b, 0xf2, 0x4f, 0x09, 0x88, 0x2f, 0x05, 0x37, 0x3f, 0x2c, 0x81,
-	0x62, 0x47, 0x7a, 0xba, 0xc9, 0x11, 0xc7, 0xd0, 0x04, 0x0b, 0x1e, 0x0e, 0x31, 0x23, 0xcc, 0xe6,
-	0xa4, 0x87, 0xf5, 0xdf, 0x0e, 0x55, 0xb5, 0xd5, 0x82, 0x55, 0x4c, 0x8c, 0xb7, 0x48, 0x0f, 0xc3,
-	0x25, 0x50, 0x60, 0x01, 0xe5, 0xfa, 0xef, 0x6a, 0x4d, 0xfe, 0xc0, 0x06, 0x28, 0xec, 0xd0, 0x78,
-	0x57, 0xff, 0x43, 0x18, 0x8b, 0xcd, 0x53, 0xb5, 0x83, 0x13, 0x52, 0xdb, 0xa6, 0xf1, 0xae, 0x25,
-	0xa1, 0xf0, 0x45, 0xb0, 0x14, 0x20, 0x91, 0x0a, 0x15, 0xa4, 0xed, 0x63, 0xe4, 0xe2, 0x58, 0xff,
-	0xbe, 0x24, 0x15, 0x56, 0xc7, 0x0a, 0x98, 0xfb, 0xb5, 0x34, 0xe0, 0x9a, 0xda, 0x6d, 0x47, 0x30,
-	0x6e, 0x48, 0x82, 0x55, 0x51, 0x2a, 0x39, 0x13, 0xdc, 0x00, 0x45, 0xa5, 0x19, 0x53, 0xca, 0x99,
-	0xfe, 0x43, 0xa9, 0x3a, 0xbf, 0xba, 0xd0, 0x39, 0x3e, 0x1a, 0x1a, 0x90, 0xb1, 0xbb, 0x6b, 0x8c,
-	0xdc, 0xc5, 0x97, 0xcc, 0x8d, 0xc6, 0x66, 0xf3, 0xdc, 0x85, 0xa6, 0x69, 0x01, 0x89, 0xb5, 0x04,
-	0x54, 0x30, 0x45, 0x6d, 0x70, 0xc2, 0xfc, 0xf1, 0x21, 0x4c, 0x89, 0x55, 0x4c, 0x0b, 0x94, 0x7d,
-	0xc2, 0x38, 0x8d, 0x89, 0x83, 0x82, 0x84, 0xfe, 0x93, 0xa2, 0x9f, 0x19, 0x0d, 0x0d, 0x73, 0x4c,
-	0x7f, 0x5a, 0x70, 0xab, 0xe2, 0xbf, 0x87, 0xee, 0x5c, 0x32, 0x1b, 0xad, 0x76, 0xbb, 0xdd, 0x6c,
-	0xb4, 0x4c, 0xab, 0x34, 0x16, 0x50, 0x9a, 0x57, 0xc0, 0x11, 0xcc, 0xfd, 0x86, 0xed, 0x22, 0x8e,
-	0xf4, 0x2f, 0x96, 0x65, 0x62, 0x8c, 0x29, 0x89, 0xb9, 0xce, 0xfd, 0xc6, 0x35, 0xc4, 0x91, 0x75,
-	0x18, 0x27, 0x5f, 0xf0, 0x25, 0x50, 0xca, 0xe8, 0xf6, 0x80, 0x72, 0xcc, 0xf4, 0x2f, 0x97, 0xab,
-	0xf3, 0x33, 0x88, 0x74, 0xe0, 0x68, 0x68, 0x2c, 0x8e, 0xb7, 0x78, 0xbe, 0xb9, 0x6e, 0x5a, 0x47,
-	0x53, 0xe1, 0xdb, 0x42, 0x0a, 0xae, 0x01, 0xa8, 0xd4, 0x71, 0x44, 0x19, 0xe1, 0x36, 0x09, 0x5d,
-	0x7c, 0x47, 0xff, 0x6a, 0x59, 0x76, 0x45, 0x59, 0x62, 0xd5, 0xca, 0x33, 0x62, 0x01, 0xbe, 0x0c,
-	0x40, 0xd6, 0xac, 0x4c, 0xff, 0xc8, 0x90, 0xfb, 0xa8, 0x4e, 0xd9, 0xc7, 0xed, 0x14, 0xd9, 0x39,
-	0x39, 0x1a, 0x1a, 0xcb, 0xb9, 0x8d, 0x6c, 0x6e, 0x5e, 0x6c, 0x34, 0x5a, 0xcd, 0x76, 0xbb, 0xdd,
-	0x32, 0xad, 0x9c, 0x22, 0xdc, 0x00, 0x87, 0xbb, 0x28, 0x40, 0xa1, 0x83, 0x99, 0xfe, 0xb1, 0x50,
-	0x2f, 0x3c, 0x98, 0x9b, 0xa1, 0x61, 0x55, 0xd6, 0x3c, 0xe6, 0x36, 0xf3, 0x51, 0xec, 0xea, 0x6f,
-	0x9e, 0x95, 0x11, 0x00, 0x69, 0xbb, 0x29, 0x4c, 0xf0, 0x32, 0x58, 0x88, 0x51, 0xe8, 0x22, 0x6a,
-	0xf7, 0xc8, 0x1d, 0xcc, 0xf4, 0xb7, 0xce, 0xca, 0xba, 0x2e, 0x8f, 0x86, 0xc6, 0xd2, 0xb8, 0xae,
-	0xad, 0x8b, 0x17, 0x2f, 0xb4, 0x64, 0x5f, 0x14, 0x15, 0xfa, 0x39, 0x01, 0x86, 0xdb, 0x00, 0x22,
-	0x87, 0x93, 0x01, 0x56, 0x19, 0x4a, 0x5a, 0xe3, 0xed, 0x87, 0x48, 0x94, 0x15, 0x47, 0xe6, 0x2e,
-	0x6d, 0x30, 0xdd, 0xa1, 0xbd, 0x08, 0x39, 0xdc, 0x76, 0x68, 0xaf, 0x47, 0x38, 0xc7, 0x98, 0x25,
-	0x6a, 0xef, 0x3c, 0x44, 0xed, 0x78, 0xc2, 0xdc, 0xca, 0x88, 0x4a, 0xb3, 0x09, 0x8e, 0xb0, 0x00,
-	0x31, 0x9f, 0x84, 0x1e, 0xd3, 0xff, 0xac, 0xc9, 0xac, 0x2d, 0x8d, 0x86, 0x46, 0x69, 0xb2, 0xd9,
-	0x4d, 0x6b, 0x0c, 0x83, 0xaf, 0x83, 0x93, 0x51, 0x8c, 0x07, 0x84, 0xf6, 0x99, 0x8d, 0x23, 0xea,
-	0xf8, 0x76, 0xee, 0x46, 0x63, 0xfa, 0xcf, 0x2d, 0x59, 0xd9, 0x27, 0xa6, 0xdd, 0x00, 0xcf, 0xe3,
-	0xd0, 0x25, 0xa1, 0x77, 0x75, 0xcc, 0xd9, 0xd7, 0x6c, 0xca, 0xe1, 0x23, 0xa9, 0x8f, 0xeb, 0xc2,
-	0x45, 0x0e, 0xcd, 0xe0, 0x6b, 0xe0, 0x84, 0xd3, 0x8f, 0x63, 0x1c, 0xf2, 0x83, 0xfc, 0xff, 0xf2,
-	0xdf, 0xf8, 0xd7, 0x13, 0x17, 0xf7, 0xbb, 0xf7, 0xc0, 0x52, 0x16, 0xbf, 0x13, 0x53, 0xc6, 0x02,
-	0x12, 0xee, 0x32, 0xfd, 0xeb, 0xa7, 0x1e, 0xd8, 0xd1, 0x5b, 0x29, 0x72, 0x7f, 0x7e, 0xd5, 0xd9,
-	0x82, 0xa9, 0x64, 0x86, 0x63, 0x10, 0x03, 0x98, 0xc6, 0x99, 0xf3, 0xf3, 0xcd, 0xbf, 0xf2, 0x53,
-	0x49, 0x14, 0x73, 0x6e, 0x18, 0x80, 0xaf, 0xf4, 0x19, 0x27, 0x3b, 0xc4, 0x91, 0x11, 0xda, 0x5d,
-	0xc2, 0x99, 0xfe, 0xc9, 0x76, 0x55, 0x5b, 0x5d, 0xe8, 0x6c, 0x8d, 0x86, 0xc6, 0x42, 0x4e, 0xc4,
-	0xfc, 0x6b, 0x68, 0xd4, 0x73, 0x6f, 0x4c, 0x14, 0xef, 0xb1, 0x1e, 0xe2, 0xc4, 0x09, 0x50, 0x97,
-	0xd5, 0x3d, 0xba, 0xd6, 0x25, 0x7c, 0x87, 0xe0, 0xc0, 0xad, 0x75, 0x08, 0x1f, 0x60, 0x87, 0xd3,
-	0x78, 0xdd, 0xaa, 0x4c, 0xe8, 0x77, 0x08, 0x67, 0x70, 0x07, 0x3c, 0x9a, 0x25, 0x31, 0x59, 0xc5,
-	0xae, 0xed, 0xf8, 0xd8, 0xd9, 0x8d, 0x28, 0x09, 0xb9, 0xfe, 0xe9, 0xb6, 0xbc, 0xed, 0x1e, 0x9b,
-	0x16, 0x66, 0x86, 0xb4, 0xb2, 0x6e, 0x7c, 0x36, 0xd5, 0x19, 0x2f, 0x42, 0x17, 0x9c, 0x4a, 0x73,
-	0x78, 0xa0, 0x9b, 0xcf, 0x66, 0x76, 0x93, 0xf6, 0xdc, 0x41, 0x5e, 0x5e, 0x00, 0xc7, 0x76, 0x48,
-	0x88, 0x02, 0x72, 0x77, 0x52, 0xfd, 0xf3, 0x99, 0xd5, 0x97, 0x32, 0xfe, 0xd8, 0x68, 0xbe, 0xaf,
-	0x81, 0x82, 0x78, 0x30, 0xe1, 0x65, 0x50, 0xce, 0xb2, 0x35, 0xc0, 0x31, 0x23, 0x34, 0xd4, 0x35,
-	0x59, 0x9f, 0xf2, 0x64, 0x7d, 0xd6, 0x4d, 0xab, 0x94, 0x22, 0x6f, 0x2b, 0x20, 0xdc, 0x04, 0xa5,
-	0x34, 0x05, 0x29, 0x77, 0x6e, 0x0a, 0x77, 0x31, 0x01, 0xa6, 0xd4, 0x63, 0xe0, 0x7f, 0xf2, 0x84,
-	0xe9, 0xf3, 0xf2, 0x4a, 0x54, 0x3f, 0xe6, 0xbb, 0x73, 0x00, 0xde, 0x7f, 0x8a, 0x60, 0x0f, 0x94,
-	0x91, 0xe7, 0xc5, 0xd8, 0xcb, 0x75, 0x91, 0xda, 0x64, 0x67, 0xe2, 0x7c, 0xad, 0x9f, 0xdf, 0x6c,
-	0x89, 0x36, 0x3a, 0x37, 0x6b, 0x1b, 0x05, 0x84, 0x71, 0xab, 0x94, 0xd3, 0x96, 0x1d, 0x74, 0x09,
-	0x14, 0xe4, 0xb3, 0x38, 0x27, 0x53, 0x7c, 0x66, 0x4a, 0x8a, 0x73, 0x1b, 0x94, 0x8f, 0xa3, 0xe4,
-	0xc0, 0xb3, 0xa0, 0x44, 0x42, 0x27, 0xe8, 0x8b, 0x20, 0x6d, 0x17, 0x07, 0x68, 0x2f, 0x89, 0x70,
-	0x31, 0x33, 0x5f, 0x13, 0x56, 0x78, 0x1a, 0x2c, 0x46, 0x31, 0x8d, 0x28, 0xc3, 0x71, 0xf2, 0xbe,
-	0x15, 0x24, 0xee, 0x68, 0x6a, 0x95, 0xf7, 0xb3, 0xf9, 0x81, 0x06, 0x2a, 0x39, 0x4f, 0xb7, 0x50,
-	0xec, 0x61, 0x0e, 0x61, 0x32, 0x28, 0x69, 0xb9, 0x39, 0xe9, 0x0a, 0xa8, 0xe4, 0x27, 0x3b, 0x79,
-	0x7d, 0x27, 0xe5, 0xa8, 0x8c, 0x86, 0xc6, 0xd1, 0x71, 0x39, 0xc4, 0xb5, 0x5d, 0xea, 0x8e, 0xa7,
-	0x1d, 0x71, 0x61, 0xc3, 0x26, 0x28, 0x46, 0x48, 0x96, 0x52, 0x12, 0xe7, 0xa7, 0x11, 0x81, 0x42,
-	0x09, 0x8e, 0xf9, 0x2a, 0x38, 0xb5, 0x2f, 0x0b, 0x57, 0x43, 0x77, 0xab, 0xcf, 0x38, 0x75, 0xf7,
-	0x3a, 0x84, 0x67, 0x89, 0xd4, 0xfe, 0x41, 0x22, 0x0d, 0x50, 0x74, 0x94, 0x92, 0xa8, 0xb7, 0x0c,
-	0xe4, 0xb0, 0x05, 0x9c, 0x4c, 0xdc, 0x7c, 0x43, 0x03, 0xa5, 0x1b, 0xd9, 0x54, 0xd3, 0x41, 0xdc,
-	0xf1, 0x61, 0x7b, 0x72, 0x3a, 0xd3, 0x66, 0x1e, 0xce, 0xda, 0x93, 0xc3, 0xd9, 0xdc, 0xac, 0xb3,
-	0x99, 0xf9, 0x9e, 0x06, 0xca, 0x5b, 0xfb, 0x5e, 0x40, 0xf8, 0x24, 0x38, 0x14, 0xf5, 0xbb, 0xbb,
-	0x78, 0x2f, 0xdd, 0x82, 0x39, 0x1a, 0x1a, 0x2b, 0xf9, 0x31, 0x6d, 0x7d, 0x23, 0x37, 0xa6, 0xc9,
-	0xb6, 0xb5, 0x52, 0x0a, 0xbc, 0x0a, 0x60, 0xfa, 0x1a, 0xe7, 0xc6, 0x9a, 0x39, 0xf9, 0x82, 0xc2,
-	0xfb, 0xfb, 0xdd, 0xaa, 0x24, 0xe8, 0x6c, 0xb2, 0x61, 0x9d, 0x85, 0x6f, 0xef, 0xad, 0x68, 0xdf,
-	0xdd, 0x5b, 0xd1, 0x7e, 0xbd, 0xb7, 0xa2, 0x75, 0xff, 0x2f, 0x27, 0xf2, 0x0b, 0x7f, 0x07, 0x00,
-	0x00, 0xff, 0xff, 0x70, 0x1e, 0x6a, 0xd1, 0x6c, 0x0c, 0x00, 0x00,
+	// 1380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xd7, 0x26, 0x7e, 0xdf, 0xb6, 0x63, 0x37, 0xb6, 0x27, 0x55, 0xb3, 0x6f, 0xdb, 0x37, 0xeb,
+	0x77, 0xf5, 0xb6, 0x8d, 0xaa, 0xc6, 0xae, 0xdd, 0xd4, 0x4e, 0x5a, 0x0a, 0xaa, 0xd3, 0x46, 0x05,
+	0x09, 0x09, 0x6d, 0x4b, 0x25, 0x24, 0xc4, 0x6a, 0xbc, 0x3b, 0xd9, 0x1d, 0xb2, 0xde, 0x59, 0xed,
+	0x8c, 0xad, 0xa6, 0x08, 0x71, 0xe0, 0xc4, 0x87, 0xc4, 0x01, 0x4e, 0x70, 0x82, 0x1b, 0x1f, 0xff,
+	0x00, 0x70, 0x02, 0x71, 0xe0, 0xc8, 0xd7, 0x05, 0x0e, 0x16, 0xea, 0x0d, 0x38, 0xe1, 0x23, 0x27,
+	0x34, 0x33, 0xbb, 0xeb, 0x75, 0x1a, 0xb7, 0x11, 0x70, 0xf3, 0x3e, 0xf3, 0xfb, 0xfd, 0x9e, 0x79,
+	0x3e, 0x66, 0xe6, 0x31, 0x30, 0xa2, 0x98, 0x72, 0xda, 0xe8, 0x61, 0xe4, 0xd0, 0xb0, 0x11, 0xb5,
+	0xa2, 0xc6, 0xb0, 0xd9, 0xe0, 0xbb, 0x11, 0x66, 0x75, 0xb9, 0x02, 0x8f, 0x63, 0xee, 0xe3, 0x18,
+	0x0f, 0xfa, 0x75, 0x85, 0xa9, 0x47, 0xad, 0xa8, 0x3e, 0x6c, 0x9e, 0xf8, 0xbf, 0x22, 0x62, 0xee,
+	0x37, 0x86, 0x4d, 0x14, 0x44, 0x3e, 0x6a, 0x36, 0x10, 0xe7, 0x98, 0x71, 0xc4, 0x89, 0x80, 0x89,
+	0xe5, 0x13, 0xa7, 0xf7, 0x41, 0x29, 0x1d, 0xbb, 0x17, 0x50, 0x67, 0x27, 0x81, 0x99, 0xfb, 0xc0,
+	0x86, 0x28, 0x20, 0x2e, 0xe2, 0x34, 0x4e, 0x30, 0xab, 0x1e, 0xe1, 0xfe, 0xa0, 0x57, 0x77, 0x68,
+	0xbf, 0xe1, 0x51, 0x8f, 0x36, 0xa4, 0xb9, 0x37, 0xd8, 0x96, 0x5f, 0x4a, 0x40, 0xfc, 0x52, 0x70,
+	0xf3, 0xbd, 0x32, 0x28, 0x76, 0xa5, 0xa7, 0x5b, 0x1c, 0x71, 0x0c, 0x4d, 0x50, 0xf2, 0x70, 0x88,
+	0x19, 0x61, 0x36, 0x27, 0x7d, 0xac, 0xff, 0x72, 0xa8, 0xa6, 0xad, 0x14, 0xac, 0x62, 0x62, 0xbc,
+	0x4d, 0xfa, 0x18, 0x2e, 0x82, 0x02, 0x0b, 0x28, 0xd7, 0x7f, 0x55, 0x6b, 0xf2, 0x03, 0x36, 0x41,
+	0x61, 0x9b, 0xc6, 0x3b, 0xfa, 0x6f, 0xc2, 0x58, 0x6c, 0x9d, 0xaa, 0xef, 0x9f, 0x90, 0xfa, 0x16,
+	0x8d, 0x77, 0x2c, 0x09, 0x85, 0xcf, 0x81, 0xc5, 0x00, 0x89, 0x54, 0xa8, 0x20, 0x6d, 0x1f, 0x23,
+	0x17, 0xc7, 0xfa, 0xb7, 0x65, 0xa9, 0xb0, 0x32, 0x51, 0xc0, 0xdc, 0xaf, 0xa7, 0x01, 0xd7, 0xd5,
+	0x6e, 0xbb, 0x82, 0x71, 0x53, 0x12, 0xac, 0xaa, 0x52, 0xc9, 0x99, 0xe0, 0x3a, 0x28, 0x2a, 0xcd,
+	0x98, 0x52, 0xce, 0xf4, 0xef, 0xca, 0xb5, 0xf9, 0x95, 0x52, 0xf7, 0xf8, 0x78, 0x64, 0x40, 0xc6,
+	0xee, 0xad, 0x32, 0x72, 0x0f, 0x5f, 0x36, 0xd7, 0x9b, 0x1b, 0xad, 0xf3, 0x17, 0x5b, 0xa6, 0x05,
+	0x24, 0xd6, 0x12, 0x50, 0xc1, 0x14, 0xb5, 0xc1, 0x09, 0xf3, 0xfb, 0x47, 0x30, 0x25, 0x56, 0x31,
+	0x2d, 0x50, 0xf1, 0x09, 0xe3, 0x34, 0x26, 0x0e, 0x0a, 0x12, 0xfa, 0x0f, 0x8a, 0x7e, 0x66, 0x3c,
+	0x32, 0xcc, 0x09, 0xfd, 0x09, 0xc1, 0xad, 0x89, 0xef, 0x3e, 0xba, 0x7b, 0xd9, 0x6c, 0xb6, 0x3b,
+	0x9d, 0x4e, 0xab, 0xd9, 0x36, 0xad, 0xf2, 0x44, 0x40, 0x69, 0x5e, 0x05, 0x47, 0x30, 0xf7, 0x9b,
+	0xb6, 0x8b, 0x38, 0xd2, 0x3f, 0x5d, 0x92, 0x89, 0x31, 0x66, 0x24, 0xe6, 0x06, 0xf7, 0x9b, 0xd7,
+	0x11, 0x47, 0xd6, 0x61, 0x9c, 0xfc, 0x82, 0xcf, 0x83, 0x72, 0x46, 0xb7, 0x87, 0x94, 0x63, 0xa6,
+	0x7f, 0xb6, 0x54, 0x9b, 0x3f, 0x80, 0x48, 0x17, 0x8e, 0x47, 0xc6, 0xc2, 0x64, 0x8b, 0x17, 0x5a,
+	0x6b, 0xa6, 0x75, 0x34, 0x15, 0xbe, 0x23, 0xa4, 0xe0, 0x2a, 0x80, 0x4a, 0x1d, 0x47, 0x94, 0x11,
+	0x6e, 0x93, 0xd0, 0xc5, 0x77, 0xf5, 0xcf, 0x97, 0x64, 0x57, 0x54, 0x24, 0x56, 0xad, 0x3c, 0x29,
+	0x16, 0xe0, 0x0b, 0x00, 0x64, 0xcd, 0xca, 0xf4, 0xf7, 0x0d, 0xb9, 0x8f, 0xda, 0x8c, 0x7d, 0xdc,
+	0x49, 0x91, 0xdd, 0x93, 0xe3, 0x91, 0xb1, 0x94, 0xdb, 0xc8, 0xc6, 0xc6, 0xa5, 0x66, 0xb3, 0xdd,
+	0xea, 0x74, 0x3a, 0x6d, 0xd3, 0xca, 0x29, 0xc2, 0x75, 0x70, 0xb8, 0x87, 0x02, 0x14, 0x3a, 0x98,
+	0xe9, 0x1f, 0x08, 0xf5, 0xc2, 0xc3, 0xb9, 0x19, 0x1a, 0xd6, 0x64, 0xcd, 0x63, 0x6e, 0x33, 0x1f,
+	0xc5, 0xae, 0xfe, 0xda, 0x59, 0x19, 0x01, 0x90, 0xb6, 0x5b, 0xc2, 0x04, 0xaf, 0x80, 0x52, 0x8c,
+	0x42, 0x17, 0x51, 0xbb, 0x4f, 0xee, 0x62, 0xa6, 0xbf, 0x7e, 0x56, 0xd6, 0x75, 0x69, 0x3c, 0x32,
+	0x16, 0x27, 0x75, 0x6d, 0x5f, 0xba, 0x74, 0xb1, 0x2d, 0xfb, 0xa2, 0xa8, 0xd0, 0x4f, 0x0b, 0x30,
+	0xdc, 0x02, 0x10, 0x39, 0x9c, 0x0c, 0xb1, 0xca, 0x50, 0xd2, 0x1a, 0x6f, 0x3c, 0x42, 0xa2, 0xa2,
+	0x38, 0x32, 0x77, 0x69, 0x83, 0xe9, 0x0e, 0xed, 0x47, 0xc8, 0xe1, 0xb6, 0x43, 0xfb, 0x7d, 0xc2,
+	0x39, 0xc6, 0x2c, 0x51, 0x7b, 0xf3, 0x11, 0x6a, 0xc7, 0x13, 0xe6, 0x66, 0x46, 0x54, 0x9a, 0x2d,
+	0x70, 0x84, 0x05, 0x88, 0xf9, 0x24, 0xf4, 0x98, 0xfe, 0x7b, 0x5d, 0x66, 0x6d, 0x71, 0x3c, 0x32,
+	0xca, 0xd3, 0xcd, 0x6e, 0x5a, 0x13, 0x18, 0x7c, 0x05, 0x9c, 0x8c, 0x62, 0x3c, 0x24, 0x74, 0xc0,
+	0x6c, 0x1c, 0x51, 0xc7, 0xb7, 0x73, 0x37, 0x1a, 0xd3, 0x7f, 0x6c, 0xcb, 0xca, 0x9e, 0x9b, 0x75,
+	0x03, 0x3c, 0x83, 0x43, 0x97, 0x84, 0xde, 0xb5, 0x09, 0x67, 0x4f, 0xb3, 0x29, 0x87, 0xff, 0x49,
+	0x7d, 0xdc, 0x10, 0x2e, 0x72, 0x68, 0x06, 0x5f, 0x06, 0x27, 0x9c, 0x41, 0x1c, 0xe3, 0x90, 0xef,
+	0xe7, 0xff, 0xa7, 0x7f, 0xc6, 0xbf, 0x9e, 0xb8, 0x78, 0xd0, 0xbd, 0x07, 0x16, 0xb3, 0xf8, 0x9d,
+	0x98, 0x32, 0x16, 0x90, 0x70, 0x87, 0xe9, 0x5f, 0x3c, 0xfe, 0xd0, 0x8e, 0xde, 0x4c, 0x91, 0x7b,
+	0xf3, 0xab, 0xce, 0x16, 0x4c, 0x25, 0x33, 0x1c, 0x83, 0x18, 0xc0, 0x34, 0xce, 0x9c, 0x9f, 0x2f,
+	0xff, 0x96, 0x9f, 0x6a, 0xa2, 0x98, 0x73, 0xc3, 0x00, 0x7c, 0x71, 0xc0, 0x38, 0xd9, 0x26, 0x8e,
+	0x8c, 0xd0, 0xee, 0x11, 0xce, 0xf4, 0x0f, 0xb7, 0x6a, 0xda, 0x4a, 0xa9, 0xbb, 0x39, 0x1e, 0x19,
+	0xa5, 0x9c, 0x88, 0xf9, 0xc7, 0xc8, 0x68, 0xe4, 0xde, 0x98, 0x28, 0xde, 0x65, 0x7d, 0xc4, 0x89,
+	0x13, 0xa0, 0x1e, 0x6b, 0x78, 0x74, 0xb5, 0x47, 0xf8, 0x36, 0xc1, 0x81, 0x5b, 0xef, 0x12, 0x3e,
+	0xc4, 0x0e, 0xa7, 0xf1, 0x9a, 0x55, 0x9d, 0xd2, 0xef, 0x12, 0xce, 0xe0, 0x36, 0xf8, 0x6f, 0x96,
+	0xc4, 0x64, 0x15, 0xbb, 0xb6, 0xe3, 0x63, 0x67, 0x27, 0xa2, 0x24, 0xe4, 0xfa, 0x47, 0x5b, 0xf2,
+	0xb6, 0xfb, 0xdf, 0xac, 0x30, 0x33, 0xa4, 0x95, 0x75, 0xe3, 0x53, 0xa9, 0xce, 0x64, 0x11, 0xba,
+	0xe0, 0x54, 0x9a, 0xc3, 0x7d, 0xdd, 0x7c, 0x7c, 0x60, 0x37, 0x69, 0xcf, 0xed, 0xe7, 0xe5, 0x59,
+	0x70, 0x6c, 0x9b, 0x84, 0x28, 0x20, 0xf7, 0xa6, 0xd5, 0x3f, 0x39, 0xb0, 0xfa, 0x62, 0xc6, 0x9f,
+	0x18, 0xcd, 0x77, 0x34, 0x50, 0x10, 0x0f, 0x26, 0xbc, 0x02, 0x2a, 0x59, 0xb6, 0x86, 0x38, 0x66,
+	0x84, 0x86, 0xba, 0x26, 0xeb, 0x53, 0x99, 0xae, 0xcf, 0x9a, 0x69, 0x95, 0x53, 0xe4, 0x1d, 0x05,
+	0x84, 0x1b, 0xa0, 0x9c, 0xa6, 0x20, 0xe5, 0xce, 0xcd, 0xe0, 0x2e, 0x24, 0xc0, 0x94, 0x7a, 0x0c,
+	0xfc, 0x4b, 0x9e, 0x30, 0x7d, 0x5e, 0x5e, 0x89, 0xea, 0xc3, 0x7c, 0x6b, 0x0e, 0xc0, 0x07, 0x4f,
+	0x11, 0xec, 0x83, 0x0a, 0xf2, 0xbc, 0x18, 0x7b, 0xb9, 0x2e, 0x52, 0x9b, 0xec, 0x4e, 0x9d, 0xaf,
+	0xb5, 0x0b, 0x1b, 0x6d, 0xd1, 0x46, 0xe7, 0x0f, 0xda, 0x46, 0x01, 0x61, 0xdc, 0x2a, 0xe7, 0xb4,
+	0x65, 0x07, 0x5d, 0x06, 0x05, 0xf9, 0x2c, 0xce, 0xc9, 0x14, 0x9f, 0x99, 0x91, 0xe2, 0xdc, 0x06,
+	0xe5, 0xe3, 0x28, 0x39, 0xf0, 0x2c, 0x28, 0x93, 0xd0, 0x09, 0x06, 0x22, 0x48, 0xdb, 0xc5, 0x01,
+	0xda, 0x4d, 0x22, 0x5c, 0xc8, 0xcc, 0xd7, 0x85, 0x15, 0x9e, 0x06, 0x0b, 0x51, 0x4c, 0x23, 0xca,
+	0x70, 0x9c, 0xbc, 0x6f, 0x05, 0x89, 0x3b, 0x9a, 0x5a, 0xe5, 0xfd, 0x6c, 0xbe, 0xab, 0x81, 0x6a,
+	0xce, 0xd3, 0x6d, 0x14, 0x7b, 0x98, 0x43, 0x98, 0x0c, 0x4a, 0x5a, 0x6e, 0x4e, 0xba, 0x0a, 0xaa,
+	0xf9, 0xc9, 0x4e, 0x5e, 0xdf, 0x49, 0x39, 0xaa, 0xe3, 0x91, 0x71, 0x74, 0x52, 0x0e, 0x71, 0x6d,
+	0x97, 0x7b, 0x93, 0x69, 0x47, 0x5c, 0xd8, 0xb0, 0x05, 0x8a, 0x11, 0x92, 0xa5, 0x94, 0xc4, 0xf9,
+	0x59, 0x44, 0xa0, 0x50, 0x82, 0x63, 0x7e, 0xa5, 0x81, 0x4a, 0xf2, 0x12, 0x6f, 0xd2, 0x90, 0x23,
+	0x12, 0xca, 0x09, 0xe9, 0x50, 0xf2, 0x6e, 0xcb, 0xed, 0x15, 0x5b, 0xcb, 0x33, 0x12, 0x98, 0x30,
+	0xad, 0x14, 0x0e, 0xcf, 0x81, 0xaa, 0x7c, 0xf6, 0xd3, 0xa1, 0x8d, 0x78, 0xbe, 0x8a, 0xa0, 0x60,
+	0xc9, 0x69, 0x23, 0x99, 0xc3, 0x84, 0x59, 0xf4, 0x8f, 0xca, 0x5a, 0xd2, 0x3f, 0xf2, 0x03, 0xae,
+	0x81, 0x52, 0x3a, 0x33, 0xc8, 0x28, 0x0a, 0xb3, 0xa2, 0x28, 0x26, 0x30, 0x19, 0xc6, 0x4b, 0xe0,
+	0xd4, 0x9e, 0x62, 0x5e, 0x0b, 0xdd, 0xcd, 0x01, 0xe3, 0xd4, 0xdd, 0xed, 0x12, 0x9e, 0xf5, 0x83,
+	0xf6, 0x17, 0xfa, 0xc1, 0x00, 0x45, 0x47, 0x29, 0x89, 0xb6, 0x95, 0xd1, 0x1c, 0xb6, 0x80, 0x93,
+	0x89, 0x9b, 0xaf, 0x6a, 0xa0, 0x7c, 0x33, 0x1b, 0xce, 0xba, 0x88, 0x3b, 0x3e, 0xec, 0x4c, 0x0f,
+	0x99, 0xda, 0x81, 0x67, 0xcc, 0xce, 0xf4, 0x8c, 0x39, 0x77, 0xd0, 0x11, 0xd3, 0x7c, 0x5b, 0x03,
+	0x95, 0xcd, 0x3d, 0x0f, 0x39, 0x7c, 0x0c, 0x1c, 0x8a, 0x06, 0xbd, 0x1d, 0xbc, 0x9b, 0x6e, 0xc1,
+	0x1c, 0x8f, 0x8c, 0xe5, 0xfc, 0xb4, 0xb9, 0xb6, 0x6e, 0xd6, 0xa6, 0x4f, 0x9f, 0x95, 0x52, 0xe0,
+	0x35, 0x00, 0xd3, 0xa1, 0x22, 0x37, 0x9d, 0xcd, 0xc9, 0x41, 0x00, 0x3e, 0x78, 0x6c, 0xad, 0x6a,
+	0x82, 0xce, 0x06, 0x34, 0xd6, 0x2d, 0x7d, 0x7d, 0x7f, 0x59, 0xfb, 0xe6, 0xfe, 0xb2, 0xf6, 0xf3,
+	0xfd, 0x65, 0xad, 0xf7, 0x6f, 0xf9, 0xc7, 0xe2, 0xe2, 0x9f, 0x03, 0x00, 0xb2, 0x71, 0xcc, 0xf9,
+	0x33, 0x0d, 0x00, 0x00,
 }
 
 func (m *BeaconState) Marshal() (dAtA []byte, err error) {
@@ -1194,6 +1271,53 @@ func (m *AttestationTarget) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DepositContainer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositContainer) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Deposit.Size()))
+		n12, err := m.Deposit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if m.Eth1BlockHeight != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Eth1BlockHeight))
+	}
+	if m.Index != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+	}
+	if len(m.DepositRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DepositRoot)))
+		i += copy(dAtA[i:], m.DepositRoot)
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
 func (m *AttestationDataAndCustodyBit) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1213,11 +1337,11 @@ func (m *AttestationDataAndCustodyBit) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintTypes(dAtA, i, uint64(m.Data.Size()))
-		n12, err := m.Data.MarshalTo(dAtA[i:])
+		n13, err := m.Data.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n13
 	}
 	if m.CustodyBit {
 		dAtA[i] = 0x10
@@ -1296,21 +1420,21 @@ func (m *CompactCommittee) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.CompactValidators) > 0 {
-		dAtA14 := make([]byte, len(m.CompactValidators)*10)
-		var j13 int
+		dAtA15 := make([]byte, len(m.CompactValidators)*10)
+		var j14 int
 		for _, num := range m.CompactValidators {
 			for num >= 1<<7 {
-				dAtA14[j13] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j13++
+				j14++
 			}
-			dAtA14[j13] = uint8(num)
-			j13++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintTypes(dAtA, i, uint64(j13))
-		i += copy(dAtA[i:], dAtA14[:j13])
+		i = encodeVarintTypes(dAtA, i, uint64(j14))
+		i += copy(dAtA[i:], dAtA15[:j14])
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -1537,6 +1661,32 @@ func (m *AttestationTarget) Size() (n int) {
 	return n
 }
 
+func (m *DepositContainer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Eth1BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.Eth1BlockHeight))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.DepositRoot)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *AttestationDataAndCustodyBit) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2990,6 +3140,168 @@ func (m *AttestationTarget) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DepositContainer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositContainer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositContainer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &v1alpha1.Deposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1BlockHeight", wireType)
+			}
+			m.Eth1BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRoot = append(m.DepositRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DepositRoot == nil {
+				m.DepositRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AttestationDataAndCustodyBit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,7 +13,6 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/gogo/protobuf/types"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
-	_ "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -98,6 +97,49 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type EventTopic int32
+
+const (
+	EventTopic_UNKNOWN_TOPIC        EventTopic = 0
+	EventTopic_HEAD                 EventTopic = 1
+	EventTopic_BLOCK                EventTopic = 2
+	EventTopic_ATTESTATION          EventTopic = 3
+	EventTopic_FINALIZED_CHECKPOINT EventTopic = 4
+	EventTopic_JUSTIFIED_CHECKPOINT EventTopic = 5
+	EventTopic_CHAIN_REORG          EventTopic = 6
+	EventTopic_VOLUNTARY_EXIT       EventTopic = 7
+)
+
+var EventTopic_name = map[int32]string{
+	0: "UNKNOWN_TOPIC",
+	1: "HEAD",
+	2: "BLOCK",
+	3: "ATTESTATION",
+	4: "FINALIZED_CHECKPOINT",
+	5: "JUSTIFIED_CHECKPOINT",
+	6: "CHAIN_REORG",
+	7: "VOLUNTARY_EXIT",
+}
+
+var EventTopic_value = map[string]int32{
+	"UNKNOWN_TOPIC":        0,
+	"HEAD":                 1,
+	"BLOCK":                2,
+	"ATTESTATION":          3,
+	"FINALIZED_CHECKPOINT": 4,
+	"JUSTIFIED_CHECKPOINT": 5,
+	"CHAIN_REORG":          6,
+	"VOLUNTARY_EXIT":       7,
+}
+
+func (x EventTopic) String() string {
+	return proto.EnumName(EventTopic_name, int32(x))
+}
+
+func (EventTopic) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{2}
+}
+
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
@@ -1390,586 +1432,685 @@ func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
-	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
-	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
-	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
-	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
-	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
-	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
-	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
-	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
-	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
-	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
-	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
-	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
-	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
-	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
-	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
-	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
-	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
-	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
-	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
-	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
-	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
-	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
-	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
-	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+type Eth1ConnectionStatusResponse struct {
+	ActiveEndpoint       string                `protobuf:"bytes,1,opt,name=active_endpoint,json=activeEndpoint,proto3" json:"active_endpoint,omitempty"`
+	Endpoints            []*Eth1EndpointStatus `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
-
-var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1871 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x6f, 0x1b, 0xc7,
-	0x11, 0xcf, 0x51, 0x12, 0x2d, 0x8f, 0x28, 0x89, 0x5a, 0xcb, 0xb2, 0x4c, 0xff, 0xbb, 0x5e, 0x9d,
-	0x54, 0x12, 0xa2, 0xa3, 0x44, 0x07, 0x46, 0xaa, 0xc0, 0x4d, 0x29, 0x89, 0x96, 0x59, 0x0b, 0x94,
-	0x72, 0xa4, 0xed, 0x14, 0x79, 0xb8, 0x2e, 0x8f, 0x6b, 0xf2, 0x6a, 0xde, 0xed, 0xf9, 0x6e, 0xc9,
-	0x98, 0x7d, 0x28, 0xd0, 0xbe, 0xf6, 0xa9, 0xe9, 0x07, 0xc8, 0x87, 0xe8, 0x43, 0x81, 0xa2, 0xcf,
-	0x45, 0xd0, 0xa7, 0x02, 0x7d, 0x6c, 0x51, 0x14, 0x46, 0x1e, 0xfa, 0x31, 0x82, 0xfd, 0x73, 0xc7,
-	0x13, 0x29, 0x5a, 0x94, 0x9f, 0x78, 0x3b, 0x33, 0xbf, 0x99, 0xd9, 0xd9, 0xd9, 0x99, 0x59, 0x82,
-	0x11, 0x84, 0x94, 0xd1, 0x62, 0x93, 0x60, 0x87, 0xfa, 0xc5, 0x30, 0x70, 0x8a, 0xfd, 0xdd, 0x62,
-	0x44, 0xc2, 0xbe, 0xeb, 0x90, 0xc8, 0x14, 0x4c, 0xb4, 0x46, 0x58, 0x87, 0x84, 0xa4, 0xe7, 0x99,
-	0x52, 0xcc, 0x0c, 0x03, 0xc7, 0xec, 0xef, 0x16, 0x6e, 0xb5, 0x29, 0x6d, 0x77, 0x49, 0x51, 0x48,
-	0x35, 0x7b, 0x2f, 0x8b, 0xc4, 0x0b, 0xd8, 0x40, 0x82, 0x0a, 0xf7, 0xce, 0x28, 0x0e, 0x4a, 0x01,
-	0x57, 0xcc, 0x06, 0x41, 0xac, 0xb5, 0xf0, 0xa1, 0x14, 0x20, 0xac, 0x53, 0xec, 0xef, 0xe2, 0x6e,
-	0xd0, 0xc1, 0xbb, 0x4a, 0xda, 0x6e, 0x76, 0xa9, 0xf3, 0x4a, 0x89, 0xdd, 0x3f, 0x47, 0x0c, 0x33,
-	0x46, 0x22, 0x86, 0x99, 0x4b, 0x7d, 0x25, 0x75, 0x5b, 0xb9, 0x82, 0x03, 0xb7, 0x88, 0x7d, 0x9f,
-	0x4a, 0x66, 0x6c, 0xea, 0x63, 0xf1, 0xe3, 0x6c, 0xb7, 0x89, 0xbf, 0x1d, 0x7d, 0x8d, 0xdb, 0x6d,
-	0x12, 0x16, 0x69, 0x20, 0x24, 0xc6, 0xa5, 0x8d, 0x23, 0xc8, 0xed, 0x73, 0x07, 0x2c, 0xf2, 0xba,
-	0x47, 0x22, 0x86, 0x10, 0xcc, 0x46, 0x5d, 0xca, 0xd6, 0x35, 0x5d, 0xdb, 0x98, 0xb5, 0xc4, 0x37,
-	0xfa, 0x31, 0x2c, 0x86, 0xd8, 0x6f, 0x61, 0x6a, 0x87, 0xa4, 0x4f, 0x70, 0x77, 0x3d, 0xa3, 0x6b,
-	0x1b, 0x39, 0x2b, 0x27, 0x89, 0x96, 0xa0, 0x19, 0x3b, 0xb0, 0x7c, 0x1a, 0xd2, 0x80, 0x46, 0xc4,
-	0x22, 0x51, 0x40, 0xfd, 0x88, 0xa0, 0x3b, 0x00, 0x62, 0x73, 0x76, 0x48, 0x95, 0xc6, 0x9c, 0x75,
-	0x55, 0x50, 0x2c, 0x4a, 0x99, 0xd1, 0x07, 0x54, 0x1e, 0xee, 0x2d, 0x76, 0xe0, 0x0e, 0x40, 0xd0,
-	0x6b, 0x76, 0x5d, 0xc7, 0x7e, 0x45, 0x06, 0x31, 0x48, 0x52, 0x9e, 0x92, 0x01, 0xba, 0x01, 0x57,
-	0x02, 0xea, 0xd8, 0x4d, 0x97, 0x29, 0x2f, 0xb2, 0x01, 0x75, 0xf6, 0xdd, 0xa1, 0xe3, 0x33, 0x29,
-	0xc7, 0x57, 0x61, 0x2e, 0xea, 0xe0, 0xb0, 0xb5, 0x3e, 0x2b, 0x88, 0x72, 0x61, 0xdc, 0x87, 0x25,
-	0x69, 0x37, 0x71, 0x14, 0xc1, 0x6c, 0xca, 0x45, 0xf1, 0x6d, 0x9c, 0xc2, 0xad, 0xe7, 0xb8, 0xeb,
-	0xb6, 0x30, 0xa3, 0xe1, 0x29, 0x09, 0x5f, 0xd2, 0xd0, 0xc3, 0xbe, 0x43, 0xde, 0x15, 0xa7, 0xb3,
-	0xae, 0x67, 0x46, 0x5c, 0x37, 0xbe, 0xd7, 0xe0, 0xf6, 0xf9, 0x2a, 0x95, 0x1b, 0xeb, 0x70, 0xa5,
-	0x89, 0xbb, 0x9c, 0xa4, 0xd4, 0xc6, 0x4b, 0xb4, 0x09, 0x79, 0x46, 0x19, 0xee, 0xda, 0xfd, 0x18,
-	0x1f, 0x09, 0xfd, 0xb3, 0xd6, 0xb2, 0xa0, 0x27, 0x6a, 0x23, 0xf4, 0x10, 0x6e, 0x48, 0x51, 0xec,
-	0x30, 0xb7, 0x4f, 0xd2, 0x08, 0x19, 0x9a, 0xeb, 0x82, 0x5d, 0x16, 0xdc, 0x14, 0xee, 0x08, 0x74,
-	0xdc, 0x27, 0x21, 0x6e, 0x93, 0x31, 0xa4, 0x1d, 0x7b, 0xc5, 0xc3, 0x98, 0xb1, 0xee, 0x28, 0xb9,
-	0x11, 0x15, 0xfb, 0x52, 0xc8, 0x78, 0x04, 0x85, 0x84, 0x26, 0x44, 0xce, 0x1c, 0xef, 0x3d, 0x58,
-	0x18, 0xc6, 0x28, 0x5a, 0xd7, 0xf4, 0x99, 0x8d, 0x9c, 0x05, 0x49, 0x90, 0x22, 0xe3, 0xdb, 0x4c,
-	0x2a, 0xf0, 0x69, 0xbc, 0x0a, 0xd2, 0x43, 0xb8, 0x8e, 0x25, 0x95, 0xb4, 0xec, 0x31, 0x55, 0xfb,
-	0x99, 0x75, 0xcd, 0xba, 0x96, 0x08, 0x9c, 0x26, 0x7a, 0xd1, 0x73, 0x98, 0xe7, 0x99, 0xd6, 0x8b,
-	0x08, 0x0f, 0xdd, 0xcc, 0xc6, 0x42, 0x69, 0xcf, 0x3c, 0xff, 0xaa, 0x9b, 0xef, 0x30, 0x6f, 0xd6,
-	0x85, 0x0e, 0x2b, 0xd1, 0x55, 0x08, 0x20, 0x2b, 0x69, 0x17, 0x65, 0xee, 0x11, 0x64, 0x25, 0x48,
-	0x9c, 0xdc, 0x42, 0xa9, 0x78, 0xa1, 0x79, 0x65, 0x4b, 0x99, 0xb6, 0x14, 0xdc, 0xd8, 0x83, 0x1b,
-	0x95, 0x37, 0x2e, 0x23, 0xad, 0xe1, 0xe9, 0x4d, 0x1d, 0xdd, 0xcf, 0x60, 0x7d, 0x1c, 0xab, 0x22,
-	0x7b, 0x21, 0xf8, 0x0b, 0x40, 0x07, 0x1d, 0xec, 0xfa, 0x75, 0x86, 0x43, 0x96, 0xce, 0xda, 0x88,
-	0x13, 0x48, 0x4b, 0xec, 0x79, 0xde, 0x8a, 0x97, 0xe8, 0x47, 0x90, 0x6b, 0x13, 0x9f, 0x44, 0x6e,
-	0x64, 0x33, 0xd7, 0x23, 0x2a, 0x63, 0x17, 0x14, 0xad, 0xe1, 0x7a, 0xc4, 0x78, 0x08, 0xd7, 0x13,
-	0x4f, 0xaa, 0x7e, 0x8b, 0xbc, 0x99, 0xae, 0x0c, 0x18, 0x26, 0xac, 0x8d, 0xe2, 0x94, 0x3b, 0xab,
-	0x30, 0xe7, 0x72, 0x82, 0xba, 0x42, 0x72, 0x61, 0x3c, 0x83, 0x95, 0x72, 0x14, 0xb9, 0x6d, 0xdf,
-	0x23, 0x3e, 0x4b, 0x45, 0x8b, 0x04, 0xd4, 0xe9, 0xd8, 0xc2, 0x61, 0x05, 0x00, 0x41, 0x12, 0x5b,
-	0x1c, 0x8d, 0x48, 0x66, 0x2c, 0x22, 0xff, 0xcf, 0x00, 0x4a, 0xeb, 0x55, 0x3e, 0xbc, 0x86, 0xd5,
-	0xe1, 0xe5, 0xc1, 0x09, 0x5f, 0x84, 0x74, 0xa1, 0xf4, 0xb3, 0x49, 0x07, 0x3f, 0xae, 0x29, 0x95,
-	0x8a, 0x43, 0xde, 0xb5, 0xfe, 0x38, 0xb1, 0xf0, 0x5f, 0x0d, 0xae, 0x9d, 0x23, 0x8c, 0x6e, 0xc3,
-	0x55, 0x87, 0x7a, 0x9e, 0xcb, 0x18, 0x21, 0xc2, 0xfe, 0xac, 0x35, 0x24, 0x0c, 0x0b, 0x64, 0x26,
-	0x55, 0x20, 0xcf, 0x2d, 0xa5, 0xf7, 0x60, 0xc1, 0x8d, 0xec, 0x40, 0x56, 0xf8, 0x50, 0x54, 0x82,
-	0x79, 0x0b, 0xdc, 0x48, 0xd5, 0xfc, 0x70, 0xe4, 0xc0, 0xe6, 0x46, 0xb3, 0xff, 0xf3, 0x24, 0xfb,
-	0xb3, 0xba, 0xb6, 0xb1, 0x54, 0xfa, 0xc9, 0xb4, 0xd9, 0x1f, 0x67, 0xfd, 0x5f, 0x32, 0x70, 0x63,
-	0xc2, 0xcd, 0x48, 0x29, 0xd7, 0xde, 0x4b, 0x39, 0xfa, 0x29, 0xdc, 0x24, 0xac, 0xb3, 0x6b, 0xb7,
-	0x48, 0x40, 0x23, 0x97, 0xc9, 0x9e, 0x6c, 0xfb, 0x3d, 0xaf, 0x49, 0x42, 0x15, 0x1b, 0x3e, 0x17,
-	0xec, 0x1e, 0x4a, 0xbe, 0xe8, 0x98, 0x35, 0xc1, 0x45, 0x9f, 0xc0, 0x5a, 0x8c, 0x72, 0x7d, 0xa7,
-	0xdb, 0x8b, 0x5c, 0xea, 0xdb, 0xa9, 0xf0, 0xad, 0x2a, 0x6e, 0x35, 0x66, 0xd6, 0x79, 0x38, 0x37,
-	0x21, 0x8f, 0x93, 0xe2, 0x62, 0x8b, 0x94, 0x53, 0x4d, 0x6a, 0x79, 0x48, 0xaf, 0x70, 0x32, 0xfa,
-	0x1c, 0x6e, 0x0b, 0x05, 0x5c, 0xd0, 0xf5, 0xed, 0x14, 0xec, 0x75, 0x8f, 0xf4, 0x88, 0x08, 0xf5,
-	0xac, 0x75, 0x33, 0x96, 0xa9, 0xfa, 0xc3, 0xaa, 0xf5, 0x05, 0x17, 0x30, 0x1e, 0xc1, 0xe2, 0x21,
-	0xf5, 0xb0, 0x9b, 0xd4, 0xe0, 0x55, 0x98, 0x93, 0x16, 0xd5, 0x15, 0x11, 0x0b, 0xb4, 0x06, 0xd9,
-	0x96, 0x10, 0x8b, 0x1b, 0xab, 0x5c, 0x19, 0x9f, 0xc1, 0x52, 0x0c, 0x57, 0xe1, 0xde, 0x84, 0x3c,
-	0xcf, 0x2f, 0xcc, 0x7a, 0x21, 0xb1, 0x15, 0x46, 0xaa, 0x5a, 0x4e, 0xe8, 0x12, 0x62, 0xfc, 0x31,
-	0x03, 0x2b, 0x22, 0x5a, 0x8d, 0x90, 0x0c, 0x1b, 0xdd, 0x63, 0x98, 0x65, 0xa1, 0xca, 0xc7, 0x85,
-	0x52, 0x69, 0xd2, 0x69, 0x8d, 0x01, 0x4d, 0xbe, 0xa8, 0xd1, 0x16, 0xb1, 0x04, 0xbe, 0xf0, 0x67,
-	0x0d, 0xe6, 0x63, 0x12, 0xfa, 0x14, 0xe6, 0xc4, 0xb1, 0x09, 0x57, 0x16, 0x4a, 0xc6, 0x50, 0x2b,
-	0x61, 0x1d, 0x33, 0x1e, 0xa7, 0xcc, 0x7d, 0x61, 0x42, 0xce, 0x3c, 0x12, 0x30, 0x32, 0xa7, 0x64,
-	0x46, 0xe6, 0x14, 0xb4, 0x0d, 0x28, 0xc0, 0x21, 0x73, 0x1d, 0x37, 0x10, 0x4d, 0xa7, 0x4f, 0x19,
-	0x89, 0x9b, 0xe9, 0x4a, 0x9a, 0xf3, 0x9c, 0x33, 0xf8, 0x4d, 0x51, 0xbd, 0x5a, 0xc8, 0xc9, 0x53,
-	0x05, 0xd9, 0xa6, 0x39, 0xc5, 0x38, 0x86, 0x55, 0xee, 0xb4, 0x70, 0x81, 0x27, 0x43, 0x7c, 0x2c,
-	0xb7, 0xe0, 0x2a, 0xcf, 0x1b, 0xfb, 0x65, 0x48, 0x3d, 0x15, 0xcf, 0x79, 0x4e, 0x78, 0x1c, 0x52,
-	0x8f, 0xcf, 0x3d, 0x82, 0xc9, 0xa8, 0xca, 0xc7, 0x2c, 0x5f, 0x36, 0xe8, 0xd6, 0xa7, 0xb0, 0x98,
-	0x64, 0xb5, 0x45, 0xbb, 0x04, 0x2d, 0xc0, 0x95, 0x67, 0xb5, 0xa7, 0xb5, 0x93, 0x17, 0xb5, 0xfc,
-	0x07, 0x28, 0x07, 0xf3, 0xe5, 0x46, 0xa3, 0x52, 0x6f, 0x54, 0xac, 0xbc, 0xc6, 0x57, 0xa7, 0xd6,
-	0xc9, 0xe9, 0x49, 0xbd, 0x62, 0xe5, 0x33, 0x5b, 0x7f, 0xd0, 0x60, 0x79, 0xe4, 0x42, 0x20, 0x04,
-	0x4b, 0x0a, 0x6c, 0xd7, 0x1b, 0xe5, 0xc6, 0xb3, 0x7a, 0xfe, 0x03, 0x4e, 0x3b, 0xad, 0xd4, 0x0e,
-	0xab, 0xb5, 0x23, 0xbb, 0x7c, 0xd0, 0xa8, 0x3e, 0xaf, 0xe4, 0x35, 0x04, 0x90, 0x55, 0xdf, 0x19,
-	0xce, 0xaf, 0xd6, 0xaa, 0x8d, 0x6a, 0xb9, 0x51, 0x39, 0xb4, 0x2b, 0x5f, 0x56, 0x1b, 0xf9, 0x19,
-	0x94, 0x87, 0xdc, 0x8b, 0x6a, 0xe3, 0xc9, 0xa1, 0x55, 0x7e, 0x51, 0xde, 0x3f, 0xae, 0xe4, 0x67,
-	0x39, 0x82, 0xf3, 0x2a, 0x87, 0xf9, 0x39, 0x8e, 0x90, 0xdf, 0x76, 0xfd, 0xb8, 0x5c, 0x7f, 0x52,
-	0x39, 0xcc, 0x67, 0x4b, 0x7f, 0x9f, 0x81, 0x45, 0x79, 0x36, 0x75, 0x39, 0x90, 0xa3, 0x5f, 0xc2,
-	0xca, 0x0b, 0xec, 0xb2, 0xc7, 0x34, 0x1c, 0x76, 0x1d, 0xb4, 0x66, 0xca, 0xe1, 0xd7, 0x8c, 0xe7,
-	0x70, 0xb3, 0xc2, 0xe7, 0xf0, 0xc2, 0xd6, 0xa4, 0x24, 0x1a, 0xef, 0x58, 0x3b, 0x1a, 0x7a, 0x0a,
-	0x8b, 0x07, 0xd8, 0xa7, 0xbe, 0xeb, 0xe0, 0xee, 0x13, 0x82, 0x5b, 0x13, 0xd5, 0x4e, 0x91, 0x45,
-	0xe8, 0x5b, 0x0d, 0xae, 0x26, 0xa9, 0x3a, 0x51, 0xd3, 0xe6, 0xd4, 0x59, 0x6e, 0x9c, 0x7c, 0x53,
-	0xde, 0x41, 0xe6, 0x63, 0xc2, 0x9c, 0x0e, 0x89, 0x74, 0x91, 0x88, 0x3a, 0xcf, 0x77, 0x3d, 0x72,
-	0x7d, 0x87, 0xe8, 0x5d, 0x1c, 0x31, 0xfd, 0xa5, 0xeb, 0xe3, 0xae, 0xfb, 0x1b, 0xd2, 0x92, 0x7c,
-	0xf3, 0xf7, 0xff, 0xfa, 0xfe, 0x4f, 0x99, 0x35, 0xb4, 0xca, 0x1f, 0x1e, 0xea, 0x19, 0x22, 0x18,
-	0x1c, 0x87, 0x5e, 0x41, 0x3e, 0xb1, 0xb2, 0x3f, 0xe0, 0x39, 0x17, 0xa1, 0x8f, 0x27, 0xf9, 0x73,
-	0x5e, 0x6e, 0x5e, 0xc2, 0xfb, 0xd2, 0x7f, 0x34, 0x58, 0x96, 0xf3, 0x35, 0x09, 0xe3, 0xa3, 0xec,
-	0x00, 0x52, 0x9a, 0x52, 0x13, 0x3f, 0x9a, 0x78, 0x66, 0xe3, 0xcf, 0x82, 0xc2, 0x47, 0x13, 0x0e,
-	0x22, 0x25, 0x7a, 0x88, 0x19, 0x46, 0x36, 0xac, 0xd4, 0x7b, 0x4d, 0xcf, 0x3d, 0x63, 0xc8, 0xb8,
-	0x18, 0x9c, 0x36, 0x70, 0x9e, 0x33, 0xc9, 0xf6, 0xbe, 0xd3, 0x92, 0x87, 0x4e, 0xb2, 0xbd, 0x2f,
-	0x21, 0xa7, 0xfc, 0x94, 0x19, 0x71, 0xff, 0x9d, 0xd1, 0x8a, 0xb7, 0x34, 0x4d, 0x6e, 0x7d, 0x05,
-	0x39, 0x65, 0x4c, 0xae, 0xa7, 0xc0, 0x14, 0x26, 0x76, 0xbf, 0x91, 0xf7, 0x59, 0xe9, 0x6f, 0x59,
-	0xc8, 0x0f, 0x0b, 0x80, 0xda, 0xcb, 0x57, 0x00, 0xb2, 0x76, 0x8b, 0x70, 0x7e, 0x38, 0x49, 0xd7,
-	0x99, 0x8e, 0x32, 0x39, 0x78, 0x23, 0x9d, 0xe3, 0xb7, 0xc9, 0x95, 0x1e, 0x36, 0x29, 0x54, 0xba,
-	0xd4, 0x1c, 0x2e, 0x0d, 0x3e, 0x78, 0x8f, 0xd9, 0x7d, 0x47, 0x43, 0x14, 0x96, 0xce, 0x8e, 0x8d,
-	0x68, 0xfb, 0x42, 0x45, 0xe9, 0xb1, 0xb4, 0x60, 0x4e, 0x2b, 0xae, 0x36, 0xdc, 0x85, 0x6b, 0x07,
-	0xf1, 0xb4, 0x95, 0x9a, 0xca, 0x36, 0xa7, 0x19, 0x01, 0xa5, 0xc5, 0xad, 0xe9, 0xa7, 0x45, 0xf4,
-	0x7a, 0xbc, 0xa0, 0x5f, 0x72, 0x7f, 0x97, 0x7d, 0x94, 0xa0, 0xdf, 0x69, 0xb0, 0x7a, 0xde, 0xa3,
-	0x16, 0x5d, 0x7c, 0x42, 0xe3, 0xaf, 0xea, 0xc2, 0x27, 0x97, 0x03, 0x29, 0x1f, 0x7a, 0x90, 0x1f,
-	0x7d, 0xd4, 0xa0, 0x89, 0x1b, 0x99, 0xf0, 0x74, 0x2a, 0xec, 0x4c, 0x0f, 0x90, 0x66, 0xf7, 0xff,
-	0x31, 0xf3, 0x4d, 0xf9, 0xaf, 0x33, 0xe8, 0xdf, 0x1a, 0xcc, 0x9d, 0x86, 0x83, 0xc8, 0x43, 0xf7,
-	0x7f, 0x51, 0x3f, 0xa9, 0xe9, 0xd6, 0xe9, 0x81, 0x1e, 0xff, 0xa7, 0xa4, 0x07, 0x21, 0xed, 0xbb,
-	0x2d, 0x5e, 0xa5, 0x07, 0xba, 0x10, 0x32, 0x8d, 0x03, 0x58, 0x12, 0x5f, 0x98, 0xb9, 0x8e, 0x7e,
-	0x8c, 0x9b, 0x11, 0xba, 0xd9, 0x61, 0x2c, 0x88, 0xf6, 0x8a, 0xc5, 0x20, 0xa6, 0x77, 0x71, 0x33,
-	0x32, 0x1d, 0xea, 0x15, 0xd6, 0x18, 0xc1, 0xde, 0xcf, 0xc7, 0xe8, 0x5b, 0xbf, 0x82, 0x7b, 0x47,
-	0xb5, 0x67, 0xfa, 0x11, 0xf1, 0x49, 0x88, 0xbb, 0xba, 0x7c, 0xe7, 0xea, 0xc7, 0xae, 0x43, 0xfc,
-	0x88, 0xe8, 0xfd, 0x07, 0xe6, 0x0e, 0x7a, 0x14, 0x6b, 0x6d, 0xbb, 0xac, 0xd3, 0x6b, 0x72, 0xd8,
-	0x59, 0x03, 0x72, 0xc5, 0xdb, 0x44, 0xb3, 0xe8, 0x61, 0x5e, 0xae, 0x8b, 0xc7, 0xd5, 0x83, 0x4a,
-	0xad, 0x5e, 0x31, 0xbd, 0x56, 0x69, 0x6e, 0xc7, 0xdc, 0x31, 0x77, 0x0a, 0xcb, 0x38, 0x70, 0xcd,
-	0x20, 0x1c, 0x08, 0xcb, 0x3e, 0x61, 0x5b, 0x5a, 0xa6, 0x94, 0xc7, 0x41, 0xd0, 0x75, 0x1d, 0x71,
-	0xb9, 0x8a, 0xbf, 0x8e, 0xa8, 0x5f, 0xba, 0x99, 0xa6, 0xb4, 0xc3, 0xc0, 0xd9, 0xfe, 0x9a, 0x34,
-	0xb7, 0x19, 0x79, 0xc3, 0x26, 0xb0, 0xde, 0x81, 0xe2, 0xac, 0xbd, 0x31, 0x13, 0x7b, 0x93, 0x4d,
-	0x84, 0x0f, 0x79, 0x91, 0x1c, 0x44, 0x9e, 0x7e, 0x24, 0x76, 0x8a, 0x3e, 0x9a, 0x6e, 0xe7, 0xdf,
-	0xbd, 0xbd, 0xab, 0xfd, 0xf3, 0xed, 0x5d, 0xed, 0x7f, 0x6f, 0xef, 0x6a, 0xcd, 0xac, 0x68, 0xd7,
-	0x0f, 0x7e, 0x08, 0x00, 0x00, 0xff, 0xff, 0x61, 0x76, 0x11, 0xf1, 0x23, 0x14, 0x00, 0x00,
+func (m *Eth1ConnectionStatusResponse) Reset()         { *m = Eth1ConnectionStatusResponse{} }
+func (m *Eth1ConnectionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1ConnectionStatusResponse) ProtoMessage()    {}
+func (*Eth1ConnectionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{20}
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1ConnectionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1ConnectionStatusResponse.Merge(m, src)
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1ConnectionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1ConnectionStatusResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_Eth1ConnectionStatusResponse proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *Eth1ConnectionStatusResponse) GetActiveEndpoint() string {
+	if m != nil {
+		return m.ActiveEndpoint
+	}
+	return ""
+}
 
-// BeaconServiceClient is the client API for BeaconService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconServiceClient interface {
-	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
-	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
-	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+func (m *Eth1ConnectionStatusResponse) GetEndpoints() []*Eth1EndpointStatus {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
 }
 
-type beaconServiceClient struct {
-	cc *grpc.ClientConn
+type Eth1EndpointStatus struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Score                float64  `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	HeadBlockNumber      uint64   `protobuf:"varint,5,opt,name=head_block_number,json=headBlockNumber,proto3" json:"head_block_number,omitempty"`
+	HeadBlockTime        uint64   `protobuf:"varint,6,opt,name=head_block_time,json=headBlockTime,proto3" json:"head_block_time,omitempty"`
+	ErrorRate            float64  `protobuf:"fixed64,7,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	LatencyMs            uint64   `protobuf:"varint,8,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	LastError            string   `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
-	return &beaconServiceClient{cc}
+func (m *Eth1EndpointStatus) Reset()         { *m = Eth1EndpointStatus{} }
+func (m *Eth1EndpointStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1EndpointStatus) ProtoMessage()    {}
+func (*Eth1EndpointStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
+}
+func (m *Eth1EndpointStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Eth1EndpointStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Eth1EndpointStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Eth1EndpointStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1EndpointStatus.Merge(m, src)
+}
+func (m *Eth1EndpointStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *Eth1EndpointStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1EndpointStatus.DiscardUnknown(m)
 }
 
-func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Eth1EndpointStatus proto.InternalMessageInfo
+
+func (m *Eth1EndpointStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
 	}
-	x := &beaconServiceWaitForChainStartClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *Eth1EndpointStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
 	}
-	return x, nil
+	return false
 }
 
-type BeaconService_WaitForChainStartClient interface {
-	Recv() (*ChainStartResponse, error)
-	grpc.ClientStream
+func (m *Eth1EndpointStatus) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
 }
 
-type beaconServiceWaitForChainStartClient struct {
-	grpc.ClientStream
+func (m *Eth1EndpointStatus) GetHeadBlockNumber() uint64 {
+	if m != nil {
+		return m.HeadBlockNumber
+	}
+	return 0
 }
 
-func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
-	m := new(ChainStartResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *Eth1EndpointStatus) GetHeadBlockTime() uint64 {
+	if m != nil {
+		return m.HeadBlockTime
 	}
-	return m, nil
+	return 0
 }
 
-func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1EndpointStatus) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconServiceClient) BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1EndpointStatus) GetLatencyMs() uint64 {
+	if m != nil {
+		return m.LatencyMs
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
-	out := new(BlockTreeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Eth1EndpointStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
-	return out, nil
+	return ""
 }
 
-// BeaconServiceServer is the server API for BeaconService service.
-type BeaconServiceServer interface {
-	WaitForChainStart(*types.Empty, BeaconService_WaitForChainStartServer) error
-	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
-	BlockTree(context.Context, *types.Empty) (*BlockTreeResponse, error)
-	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+type StreamEventsRequest struct {
+	Topics               []EventTopic `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=ethereum.beacon.rpc.v1.EventTopic" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
-	s.RegisterService(&_BeaconService_serviceDesc, srv)
+func (m *StreamEventsRequest) Reset()         { *m = StreamEventsRequest{} }
+func (m *StreamEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamEventsRequest) ProtoMessage()    {}
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+func (m *StreamEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEventsRequest.Merge(m, src)
+}
+func (m *StreamEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEventsRequest.DiscardUnknown(m)
 }
 
-func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(types.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+var xxx_messageInfo_StreamEventsRequest proto.InternalMessageInfo
+
+func (m *StreamEventsRequest) GetTopics() []EventTopic {
+	if m != nil {
+		return m.Topics
 	}
-	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
+	return nil
 }
 
-type BeaconService_WaitForChainStartServer interface {
-	Send(*ChainStartResponse) error
-	grpc.ServerStream
+type Event struct {
+	Topic                EventTopic              `protobuf:"varint,1,opt,name=topic,proto3,enum=ethereum.beacon.rpc.v1.EventTopic" json:"topic,omitempty"`
+	MissedEvents         uint64                  `protobuf:"varint,2,opt,name=missed_events,json=missedEvents,proto3" json:"missed_events,omitempty"`
+	Head                 *HeadEvent              `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	Block                *v1alpha1.BeaconBlock   `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	Attestation          *v1alpha1.Attestation   `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	Checkpoint           *v1alpha1.Checkpoint    `protobuf:"bytes,6,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	ChainReorg           *ChainReorgEvent        `protobuf:"bytes,7,opt,name=chain_reorg,json=chainReorg,proto3" json:"chain_reorg,omitempty"`
+	VoluntaryExit        *v1alpha1.VoluntaryExit `protobuf:"bytes,8,opt,name=voluntary_exit,json=voluntaryExit,proto3" json:"voluntary_exit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-type beaconServiceWaitForChainStartServer struct {
-	grpc.ServerStream
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return m.Size()
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
-	return x.ServerStream.SendMsg(m)
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetTopic() EventTopic {
+	if m != nil {
+		return m.Topic
+	}
+	return EventTopic_UNKNOWN_TOPIC
 }
 
-func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Event) GetMissedEvents() uint64 {
+	if m != nil {
+		return m.MissedEvents
 	}
-	if interceptor == nil {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
+	return 0
+}
+
+func (m *Event) GetHead() *HeadEvent {
+	if m != nil {
+		return m.Head
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
+	return nil
+}
+
+func (m *Event) GetBlock() *v1alpha1.BeaconBlock {
+	if m != nil {
+		return m.Block
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*types.Empty))
+	return nil
+}
+
+func (m *Event) GetAttestation() *v1alpha1.Attestation {
+	if m != nil {
+		return m.Attestation
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *Event) GetCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.Checkpoint
 	}
-	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTree(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TreeBlockSlotRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
+func (m *Event) GetChainReorg() *ChainReorgEvent {
+	if m != nil {
+		return m.ChainReorg
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _BeaconService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
-	HandlerType: (*BeaconServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CanonicalHead",
-			Handler:    _BeaconService_CanonicalHead_Handler,
-		},
-		{
-			MethodName: "BlockTree",
-			Handler:    _BeaconService_BlockTree_Handler,
-		},
-		{
-			MethodName: "BlockTreeBySlots",
-			Handler:    _BeaconService_BlockTreeBySlots_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "WaitForChainStart",
-			Handler:       _BeaconService_WaitForChainStart_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func (m *Event) GetVoluntaryExit() *v1alpha1.VoluntaryExit {
+	if m != nil {
+		return m.VoluntaryExit
+	}
+	return nil
 }
 
-// AttesterServiceClient is the client API for AttesterService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type AttesterServiceClient interface {
-	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
+type HeadEvent struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type attesterServiceClient struct {
-	cc *grpc.ClientConn
+func (m *HeadEvent) Reset()         { *m = HeadEvent{} }
+func (m *HeadEvent) String() string { return proto.CompactTextString(m) }
+func (*HeadEvent) ProtoMessage()    {}
+func (*HeadEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
 }
-
-func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
-	return &attesterServiceClient{cc}
+func (m *HeadEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HeadEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HeadEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HeadEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadEvent.Merge(m, src)
+}
+func (m *HeadEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *HeadEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadEvent.DiscardUnknown(m)
 }
 
-func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
-	out := new(v1alpha1.AttestationData)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_HeadEvent proto.InternalMessageInfo
+
+func (m *HeadEvent) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return out, nil
+	return 0
 }
 
-func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
-	out := new(AttestResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *HeadEvent) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
 	}
-	return out, nil
+	return nil
 }
 
-// AttesterServiceServer is the server API for AttesterService service.
-type AttesterServiceServer interface {
-	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
-	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+func (m *HeadEvent) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
 }
 
-func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
-	s.RegisterService(&_AttesterService_serviceDesc, srv)
+type ChainReorgEvent struct {
+	OldHeadSlot          uint64   `protobuf:"varint,1,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	OldHeadRoot          []byte   `protobuf:"bytes,2,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,3,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,4,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AttestationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
+func (m *ChainReorgEvent) Reset()         { *m = ChainReorgEvent{} }
+func (m *ChainReorgEvent) String() string { return proto.CompactTextString(m) }
+func (*ChainReorgEvent) ProtoMessage()    {}
+func (*ChainReorgEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
+}
+func (m *ChainReorgEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChainReorgEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChainReorgEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *ChainReorgEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorgEvent.Merge(m, src)
+}
+func (m *ChainReorgEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChainReorgEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorgEvent.DiscardUnknown(m)
 }
 
-func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.Attestation)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_ChainReorgEvent proto.InternalMessageInfo
+
+func (m *ChainReorgEvent) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
 	}
-	if interceptor == nil {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
+	return 0
+}
+
+func (m *ChainReorgEvent) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
+	return nil
+}
+
+func (m *ChainReorgEvent) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+	return 0
+}
+
+func (m *ChainReorgEvent) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _AttesterService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
-	HandlerType: (*AttesterServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestAttestation",
-			Handler:    _AttesterService_RequestAttestation_Handler,
-		},
-		{
-			MethodName: "SubmitAttestation",
-			Handler:    _AttesterService_SubmitAttestation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
+func init() {
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.EventTopic", EventTopic_name, EventTopic_value)
+	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
+	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
+	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
+	proto.RegisterType((*AttestResponse)(nil), "ethereum.beacon.rpc.v1.AttestResponse")
+	proto.RegisterType((*ValidatorPerformanceRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceRequest")
+	proto.RegisterType((*ValidatorPerformanceResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorPerformanceResponse")
+	proto.RegisterType((*ValidatorActivationRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationRequest")
+	proto.RegisterType((*ValidatorActivationResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse")
+	proto.RegisterType((*ValidatorActivationResponse_Status)(nil), "ethereum.beacon.rpc.v1.ValidatorActivationResponse.Status")
+	proto.RegisterType((*ExitedValidatorsRequest)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsRequest")
+	proto.RegisterType((*ExitedValidatorsResponse)(nil), "ethereum.beacon.rpc.v1.ExitedValidatorsResponse")
+	proto.RegisterType((*ChainStartResponse)(nil), "ethereum.beacon.rpc.v1.ChainStartResponse")
+	proto.RegisterType((*ValidatorIndexRequest)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexRequest")
+	proto.RegisterType((*ValidatorIndexResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorIndexResponse")
+	proto.RegisterType((*AssignmentRequest)(nil), "ethereum.beacon.rpc.v1.AssignmentRequest")
+	proto.RegisterType((*AssignmentResponse)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse")
+	proto.RegisterType((*AssignmentResponse_ValidatorAssignment)(nil), "ethereum.beacon.rpc.v1.AssignmentResponse.ValidatorAssignment")
+	proto.RegisterType((*ValidatorStatusResponse)(nil), "ethereum.beacon.rpc.v1.ValidatorStatusResponse")
+	proto.RegisterType((*DomainRequest)(nil), "ethereum.beacon.rpc.v1.DomainRequest")
+	proto.RegisterType((*DomainResponse)(nil), "ethereum.beacon.rpc.v1.DomainResponse")
+	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
+	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
+	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*Eth1ConnectionStatusResponse)(nil), "ethereum.beacon.rpc.v1.Eth1ConnectionStatusResponse")
+	proto.RegisterType((*Eth1EndpointStatus)(nil), "ethereum.beacon.rpc.v1.Eth1EndpointStatus")
+	proto.RegisterType((*StreamEventsRequest)(nil), "ethereum.beacon.rpc.v1.StreamEventsRequest")
+	proto.RegisterType((*Event)(nil), "ethereum.beacon.rpc.v1.Event")
+	proto.RegisterType((*HeadEvent)(nil), "ethereum.beacon.rpc.v1.HeadEvent")
+	proto.RegisterType((*ChainReorgEvent)(nil), "ethereum.beacon.rpc.v1.ChainReorgEvent")
 }
 
-// ProposerServiceClient is the client API for ProposerService service.
+func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
+
+var fileDescriptor_9eb4e94b85965285 = []byte{
+	// 2454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0xcd, 0x72, 0x1b, 0xc7,
+	0xf1, 0xf7, 0x82, 0x00, 0x44, 0x36, 0xf8, 0xb1, 0x1c, 0xd1, 0x14, 0x04, 0x49, 0x36, 0xbc, 0x7f,
+	0x59, 0x96, 0xf8, 0xb7, 0x16, 0x24, 0xec, 0xa8, 0x1c, 0xb9, 0x1c, 0x07, 0x04, 0x21, 0x12, 0x16,
+	0x03, 0xd2, 0x0b, 0x48, 0xb2, 0xe3, 0xa4, 0x36, 0x83, 0xc5, 0x88, 0xd8, 0x08, 0xd8, 0x59, 0xed,
+	0x0e, 0x20, 0x31, 0x87, 0x54, 0x25, 0xc7, 0xe4, 0x64, 0xe7, 0x1e, 0x57, 0x9e, 0x21, 0x87, 0x54,
+	0xaa, 0xf2, 0x00, 0xce, 0x2d, 0x55, 0x39, 0x26, 0x95, 0x4a, 0xa9, 0x7c, 0xc8, 0x1b, 0xe4, 0x9a,
+	0x9a, 0x8f, 0x5d, 0x2c, 0x01, 0x82, 0x04, 0x7d, 0x22, 0xa6, 0xbb, 0x7f, 0xdd, 0x3d, 0x3d, 0x3d,
+	0xdd, 0xb3, 0x4d, 0x30, 0xfc, 0x80, 0x32, 0x5a, 0x6a, 0x13, 0xec, 0x50, 0xaf, 0x14, 0xf8, 0x4e,
+	0x69, 0xb8, 0x55, 0x0a, 0x49, 0x30, 0x74, 0x1d, 0x12, 0x9a, 0x82, 0x89, 0xd6, 0x09, 0xeb, 0x92,
+	0x80, 0x0c, 0xfa, 0xa6, 0x14, 0x33, 0x03, 0xdf, 0x31, 0x87, 0x5b, 0x85, 0x6b, 0x47, 0x94, 0x1e,
+	0xf5, 0x48, 0x49, 0x48, 0xb5, 0x07, 0x4f, 0x4b, 0xa4, 0xef, 0xb3, 0x63, 0x09, 0x2a, 0xbc, 0x2d,
+	0x15, 0x13, 0xd6, 0x2d, 0x0d, 0xb7, 0x70, 0xcf, 0xef, 0xe2, 0x2d, 0x65, 0xc5, 0x6e, 0xf7, 0xa8,
+	0xf3, 0x4c, 0x89, 0xdd, 0x3c, 0x45, 0x0c, 0x33, 0x46, 0x42, 0x86, 0x99, 0x4b, 0x3d, 0x25, 0x75,
+	0x5d, 0x59, 0xc2, 0xbe, 0x5b, 0xc2, 0x9e, 0x47, 0x25, 0x53, 0xf9, 0x57, 0x78, 0x57, 0xfc, 0x71,
+	0xee, 0x1e, 0x11, 0xef, 0x6e, 0xf8, 0x02, 0x1f, 0x1d, 0x91, 0xa0, 0x44, 0x7d, 0x21, 0x31, 0x29,
+	0x6d, 0xec, 0xc2, 0xe2, 0x36, 0x77, 0xc0, 0x22, 0xcf, 0x07, 0x24, 0x64, 0x08, 0x41, 0x3a, 0xec,
+	0x51, 0x96, 0xd7, 0x8a, 0xda, 0xed, 0xb4, 0x25, 0x7e, 0xa3, 0xff, 0x83, 0xa5, 0x00, 0x7b, 0x1d,
+	0x4c, 0xed, 0x80, 0x0c, 0x09, 0xee, 0xe5, 0x53, 0x45, 0xed, 0xf6, 0xa2, 0xb5, 0x28, 0x89, 0x96,
+	0xa0, 0x19, 0x9b, 0xb0, 0x72, 0x18, 0x50, 0x9f, 0x86, 0xc4, 0x22, 0xa1, 0x4f, 0xbd, 0x90, 0xa0,
+	0x1b, 0x00, 0x62, 0x73, 0x76, 0x40, 0x95, 0xc6, 0x45, 0x6b, 0x41, 0x50, 0x2c, 0x4a, 0x99, 0x31,
+	0x04, 0x54, 0x19, 0xed, 0x2d, 0x72, 0xe0, 0x06, 0x80, 0x3f, 0x68, 0xf7, 0x5c, 0xc7, 0x7e, 0x46,
+	0x8e, 0x23, 0x90, 0xa4, 0x3c, 0x24, 0xc7, 0xe8, 0x0a, 0x5c, 0xf2, 0xa9, 0x63, 0xb7, 0x5d, 0xa6,
+	0xbc, 0xc8, 0xfa, 0xd4, 0xd9, 0x76, 0x47, 0x8e, 0xcf, 0x25, 0x1c, 0x5f, 0x83, 0x4c, 0xd8, 0xc5,
+	0x41, 0x27, 0x9f, 0x16, 0x44, 0xb9, 0x30, 0x6e, 0xc2, 0xb2, 0xb4, 0x1b, 0x3b, 0x8a, 0x20, 0x9d,
+	0x70, 0x51, 0xfc, 0x36, 0x0e, 0xe1, 0xda, 0x63, 0xdc, 0x73, 0x3b, 0x98, 0xd1, 0xe0, 0x90, 0x04,
+	0x4f, 0x69, 0xd0, 0xc7, 0x9e, 0x43, 0xce, 0x8a, 0xd3, 0x49, 0xd7, 0x53, 0x63, 0xae, 0x1b, 0xdf,
+	0x6a, 0x70, 0xfd, 0x74, 0x95, 0xca, 0x8d, 0x3c, 0x5c, 0x6a, 0xe3, 0x1e, 0x27, 0x29, 0xb5, 0xd1,
+	0x12, 0xdd, 0x01, 0x9d, 0x51, 0x86, 0x7b, 0xf6, 0x30, 0xc2, 0x87, 0x42, 0x7f, 0xda, 0x5a, 0x11,
+	0xf4, 0x58, 0x6d, 0x88, 0xee, 0xc1, 0x15, 0x29, 0x8a, 0x1d, 0xe6, 0x0e, 0x49, 0x12, 0x21, 0x43,
+	0xf3, 0xba, 0x60, 0x57, 0x04, 0x37, 0x81, 0xdb, 0x85, 0x22, 0x1e, 0x92, 0x00, 0x1f, 0x91, 0x09,
+	0xa4, 0x1d, 0x79, 0xc5, 0xc3, 0x98, 0xb2, 0x6e, 0x28, 0xb9, 0x31, 0x15, 0xdb, 0x52, 0xc8, 0xf8,
+	0x08, 0x0a, 0x31, 0x4d, 0x88, 0x9c, 0x38, 0xde, 0x37, 0x21, 0x37, 0x8a, 0x51, 0x98, 0xd7, 0x8a,
+	0x73, 0xb7, 0x17, 0x2d, 0x88, 0x83, 0x14, 0x1a, 0x5f, 0xa7, 0xe0, 0xda, 0xa9, 0x78, 0x15, 0xa4,
+	0x7b, 0xf0, 0x3a, 0x96, 0x54, 0xd2, 0xb1, 0x27, 0x54, 0x6d, 0xa7, 0xf2, 0x9a, 0x75, 0x39, 0x16,
+	0x38, 0x8c, 0xf5, 0xa2, 0xc7, 0x30, 0xcf, 0x33, 0x6d, 0x10, 0x12, 0x1e, 0xba, 0xb9, 0xdb, 0xb9,
+	0xf2, 0x7d, 0xf3, 0xf4, 0x9b, 0x6c, 0x9e, 0x61, 0xde, 0x6c, 0x0a, 0x1d, 0x56, 0xac, 0xab, 0xe0,
+	0x43, 0x56, 0xd2, 0xce, 0xcb, 0xdc, 0x5d, 0xc8, 0x4a, 0x90, 0x38, 0xb9, 0x5c, 0xb9, 0x74, 0xae,
+	0x79, 0x65, 0x4b, 0x99, 0xb6, 0x14, 0xdc, 0xb8, 0x0f, 0x57, 0x6a, 0x2f, 0x5d, 0x46, 0x3a, 0xa3,
+	0xd3, 0x9b, 0x39, 0xba, 0x1f, 0x42, 0x7e, 0x12, 0xab, 0x22, 0x7b, 0x2e, 0xf8, 0x53, 0x40, 0xd5,
+	0x2e, 0x76, 0xbd, 0x26, 0xc3, 0x01, 0x4b, 0x66, 0x6d, 0xc8, 0x09, 0xa4, 0x23, 0xf6, 0x3c, 0x6f,
+	0x45, 0x4b, 0xf4, 0x16, 0x2c, 0x1e, 0x11, 0x8f, 0x84, 0x6e, 0x68, 0x33, 0xb7, 0x4f, 0x54, 0xc6,
+	0xe6, 0x14, 0xad, 0xe5, 0xf6, 0x89, 0x71, 0x0f, 0x5e, 0x8f, 0x3d, 0xa9, 0x7b, 0x1d, 0xf2, 0x72,
+	0xb6, 0x32, 0x60, 0x98, 0xb0, 0x3e, 0x8e, 0x53, 0xee, 0xac, 0x41, 0xc6, 0xe5, 0x04, 0x75, 0x85,
+	0xe4, 0xc2, 0x78, 0x04, 0xab, 0x95, 0x30, 0x74, 0x8f, 0xbc, 0x3e, 0xf1, 0x58, 0x22, 0x5a, 0xc4,
+	0xa7, 0x4e, 0xd7, 0x16, 0x0e, 0x2b, 0x00, 0x08, 0x92, 0xd8, 0xe2, 0x78, 0x44, 0x52, 0x13, 0x11,
+	0xf9, 0x4f, 0x0a, 0x50, 0x52, 0xaf, 0xf2, 0xe1, 0x39, 0xac, 0x8d, 0x2e, 0x0f, 0x8e, 0xf9, 0x22,
+	0xa4, 0xb9, 0xf2, 0x0f, 0xa6, 0x1d, 0xfc, 0xa4, 0xa6, 0x44, 0x2a, 0x8e, 0x78, 0x97, 0x87, 0x93,
+	0xc4, 0xc2, 0xbf, 0x34, 0xb8, 0x7c, 0x8a, 0x30, 0xba, 0x0e, 0x0b, 0x0e, 0xed, 0xf7, 0x5d, 0xc6,
+	0x08, 0x11, 0xf6, 0xd3, 0xd6, 0x88, 0x30, 0x2a, 0x90, 0xa9, 0x44, 0x81, 0x3c, 0xb5, 0x94, 0xbe,
+	0x09, 0x39, 0x37, 0xb4, 0x7d, 0x59, 0xe1, 0x03, 0x51, 0x09, 0xe6, 0x2d, 0x70, 0x43, 0x55, 0xf3,
+	0x83, 0xb1, 0x03, 0xcb, 0x8c, 0x67, 0xff, 0xc7, 0x71, 0xf6, 0x67, 0x8b, 0xda, 0xed, 0xe5, 0xf2,
+	0x3b, 0xb3, 0x66, 0x7f, 0x94, 0xf5, 0x7f, 0x4a, 0xc1, 0x95, 0x29, 0x37, 0x23, 0xa1, 0x5c, 0xfb,
+	0x4e, 0xca, 0xd1, 0xf7, 0xe1, 0x2a, 0x61, 0xdd, 0x2d, 0xbb, 0x43, 0x7c, 0x1a, 0xba, 0x4c, 0xf6,
+	0x64, 0xdb, 0x1b, 0xf4, 0xdb, 0x24, 0x50, 0xb1, 0xe1, 0x6d, 0x7f, 0x6b, 0x47, 0xf2, 0x45, 0xc7,
+	0x6c, 0x08, 0x2e, 0x7a, 0x1f, 0xd6, 0x23, 0x94, 0xeb, 0x39, 0xbd, 0x41, 0xe8, 0x52, 0xcf, 0x4e,
+	0x84, 0x6f, 0x4d, 0x71, 0xeb, 0x11, 0xb3, 0xc9, 0xc3, 0x79, 0x07, 0x74, 0x1c, 0x17, 0x17, 0x5b,
+	0xa4, 0x9c, 0x6a, 0x52, 0x2b, 0x23, 0x7a, 0x8d, 0x93, 0xd1, 0xc7, 0x70, 0x5d, 0x28, 0xe0, 0x82,
+	0xae, 0x67, 0x27, 0x60, 0xcf, 0x07, 0x64, 0x40, 0x44, 0xa8, 0xd3, 0xd6, 0xd5, 0x48, 0xa6, 0xee,
+	0x8d, 0xaa, 0xd6, 0xa7, 0x5c, 0xc0, 0xf8, 0x08, 0x96, 0x76, 0x68, 0x1f, 0xbb, 0x71, 0x0d, 0x5e,
+	0x83, 0x8c, 0xb4, 0xa8, 0xae, 0x88, 0x58, 0xa0, 0x75, 0xc8, 0x76, 0x84, 0x58, 0xd4, 0x58, 0xe5,
+	0xca, 0xf8, 0x10, 0x96, 0x23, 0xb8, 0x0a, 0xf7, 0x1d, 0xd0, 0x79, 0x7e, 0x61, 0x36, 0x08, 0x88,
+	0xad, 0x30, 0x52, 0xd5, 0x4a, 0x4c, 0x97, 0x10, 0xe3, 0xcb, 0x14, 0xac, 0x8a, 0x68, 0xb5, 0x02,
+	0x32, 0x6a, 0x74, 0x0f, 0x20, 0xcd, 0x02, 0x95, 0x8f, 0xb9, 0x72, 0x79, 0xda, 0x69, 0x4d, 0x00,
+	0x4d, 0xbe, 0x68, 0xd0, 0x0e, 0xb1, 0x04, 0xbe, 0xf0, 0x47, 0x0d, 0xe6, 0x23, 0x12, 0xfa, 0x00,
+	0x32, 0xe2, 0xd8, 0x84, 0x2b, 0xb9, 0xb2, 0x31, 0xd2, 0x4a, 0x58, 0xd7, 0x8c, 0x9e, 0x53, 0xe6,
+	0xb6, 0x30, 0x21, 0x54, 0x5b, 0x12, 0x30, 0xf6, 0x4e, 0x49, 0x8d, 0xbd, 0x53, 0xd0, 0x5d, 0x40,
+	0x3e, 0x0e, 0x98, 0xeb, 0xb8, 0xbe, 0x68, 0x3a, 0x43, 0xca, 0x48, 0xd4, 0x4c, 0x57, 0x93, 0x9c,
+	0xc7, 0x9c, 0xc1, 0x6f, 0x8a, 0xea, 0xd5, 0x42, 0x4e, 0x9e, 0x2a, 0xc8, 0x36, 0xcd, 0x29, 0xc6,
+	0x3e, 0xac, 0x71, 0xa7, 0x85, 0x0b, 0x3c, 0x19, 0xa2, 0x63, 0xb9, 0x06, 0x0b, 0x3c, 0x6f, 0xec,
+	0xa7, 0x01, 0xed, 0xab, 0x78, 0xce, 0x73, 0xc2, 0x83, 0x80, 0xf6, 0xf9, 0xbb, 0x47, 0x30, 0x19,
+	0x55, 0xf9, 0x98, 0xe5, 0xcb, 0x16, 0x35, 0xbe, 0xd4, 0xe0, 0x7a, 0x8d, 0x75, 0xb7, 0xaa, 0xd4,
+	0xf3, 0x88, 0xc3, 0x4f, 0x7d, 0xec, 0x72, 0xbc, 0x03, 0x2b, 0xaa, 0xa1, 0x13, 0xaf, 0xe3, 0x53,
+	0xd7, 0x93, 0x95, 0x6e, 0xc1, 0x5a, 0x96, 0xe4, 0x9a, 0xa2, 0xa2, 0x3d, 0x58, 0x88, 0x24, 0xa2,
+	0x16, 0xb9, 0x31, 0xed, 0x68, 0xb8, 0xc5, 0x08, 0xa8, 0xec, 0x8d, 0xc0, 0xbc, 0x87, 0xa3, 0x49,
+	0x09, 0xa4, 0xc3, 0xdc, 0x20, 0xe8, 0x29, 0xeb, 0xfc, 0x27, 0xcf, 0x39, 0xe9, 0x84, 0xd8, 0xd4,
+	0xbc, 0xa5, 0x56, 0xbc, 0xa7, 0x74, 0x09, 0xee, 0xb1, 0xee, 0xb1, 0x88, 0xf3, 0xbc, 0x15, 0x2d,
+	0x45, 0xc5, 0x72, 0x68, 0x20, 0xdf, 0x22, 0x9a, 0x25, 0x17, 0x68, 0x03, 0x56, 0xbb, 0x04, 0x77,
+	0x4e, 0xde, 0x5b, 0x79, 0x31, 0x56, 0x38, 0x23, 0x79, 0x61, 0x6f, 0xc1, 0x4a, 0x42, 0x56, 0x34,
+	0xa6, 0xac, 0x90, 0x5c, 0x8a, 0x25, 0x79, 0x6b, 0xe2, 0x59, 0x41, 0x82, 0x80, 0x06, 0x76, 0x80,
+	0x19, 0xc9, 0x5f, 0x12, 0xe6, 0x16, 0x04, 0xc5, 0xc2, 0x4c, 0xb0, 0x7b, 0x98, 0x11, 0xcf, 0x39,
+	0xb6, 0xfb, 0x61, 0x7e, 0x5e, 0x68, 0x58, 0x50, 0x94, 0x1f, 0x85, 0x92, 0x1d, 0x32, 0x5b, 0x00,
+	0xf2, 0x0b, 0x62, 0xcb, 0x0b, 0x9c, 0x52, 0xe3, 0x04, 0xe3, 0x53, 0xb8, 0xdc, 0x64, 0x01, 0xc1,
+	0xfd, 0xda, 0x90, 0x78, 0x2c, 0xee, 0xdf, 0xf7, 0x21, 0xcb, 0xa8, 0xef, 0x3a, 0xb2, 0xfb, 0x2e,
+	0x97, 0x8d, 0xa9, 0xf1, 0xe7, 0xb0, 0x16, 0x17, 0xb5, 0x14, 0xc2, 0xf8, 0xef, 0x1c, 0x64, 0x04,
+	0x99, 0xdf, 0x04, 0x41, 0x53, 0xd5, 0x70, 0x16, 0x25, 0x12, 0xc0, 0x5f, 0xfa, 0x7d, 0x37, 0x0c,
+	0x49, 0xc7, 0x26, 0xc2, 0x2f, 0x95, 0x6b, 0x8b, 0x92, 0x28, 0x7d, 0x45, 0xdf, 0x83, 0x34, 0x8f,
+	0x94, 0x38, 0x99, 0x5c, 0xf9, 0xad, 0x69, 0xda, 0xf7, 0x08, 0x96, 0x08, 0x4b, 0x88, 0x8f, 0xee,
+	0x67, 0xfa, 0xa2, 0xf7, 0x73, 0x07, 0x72, 0x89, 0x8f, 0xa0, 0x7c, 0xe6, 0x4c, 0x7c, 0xf2, 0x93,
+	0x22, 0x09, 0x43, 0x15, 0x00, 0xa7, 0x4b, 0x9c, 0x67, 0xf2, 0x0a, 0x64, 0xc7, 0x9d, 0x3f, 0xa1,
+	0xa4, 0x1a, 0x0b, 0x5a, 0x09, 0x10, 0xda, 0x83, 0x9c, 0xc3, 0x1f, 0x40, 0x76, 0x40, 0x68, 0x70,
+	0x24, 0x72, 0x22, 0x37, 0xbd, 0xd9, 0x88, 0xb7, 0x92, 0xc5, 0x25, 0x65, 0x18, 0xc0, 0x89, 0x09,
+	0xe8, 0x21, 0x2c, 0x0f, 0x69, 0x6f, 0xe0, 0x31, 0x1c, 0x1c, 0xdb, 0xe4, 0xa5, 0xcb, 0x44, 0x06,
+	0xe5, 0xca, 0x37, 0xa7, 0x38, 0xf4, 0x38, 0x12, 0xe6, 0xaf, 0x37, 0x6b, 0x69, 0x98, 0x5c, 0x1a,
+	0x3f, 0x85, 0x85, 0x38, 0xd8, 0xd3, 0x3e, 0x4c, 0xce, 0x2a, 0x70, 0x37, 0x00, 0x78, 0x90, 0x88,
+	0x64, 0xcf, 0x49, 0xb6, 0xa0, 0x70, 0xb6, 0xf1, 0x07, 0x0d, 0x56, 0xc6, 0xf6, 0x82, 0x0c, 0x58,
+	0xa2, 0xbd, 0x8e, 0x2d, 0x2e, 0x52, 0xc2, 0x5c, 0x8e, 0xf6, 0x3a, 0xdc, 0x15, 0xd1, 0xe3, 0x92,
+	0x32, 0x09, 0xc3, 0x91, 0x8c, 0x30, 0x6d, 0xc0, 0x92, 0x47, 0x5e, 0x24, 0xf4, 0xc8, 0xb2, 0x9a,
+	0xf3, 0xc8, 0x8b, 0xa4, 0x9e, 0x58, 0x46, 0xe8, 0x49, 0x4b, 0x3d, 0x4a, 0x86, 0xeb, 0xd9, 0xf8,
+	0x00, 0x96, 0xe2, 0xde, 0x6e, 0xd1, 0x1e, 0x41, 0x39, 0xb8, 0xf4, 0xa8, 0xf1, 0xb0, 0x71, 0xf0,
+	0xa4, 0xa1, 0xbf, 0x86, 0x16, 0x61, 0xbe, 0xd2, 0x6a, 0xd5, 0x9a, 0xad, 0x9a, 0xa5, 0x6b, 0x7c,
+	0x75, 0x68, 0x1d, 0x1c, 0x1e, 0x34, 0x6b, 0x96, 0x9e, 0xda, 0xf8, 0xad, 0x06, 0x2b, 0x63, 0xcf,
+	0x02, 0x84, 0x60, 0x59, 0x81, 0xed, 0x66, 0xab, 0xd2, 0x7a, 0xd4, 0xd4, 0x5f, 0xe3, 0xb4, 0xc3,
+	0x5a, 0x63, 0xa7, 0xde, 0xd8, 0xb5, 0x2b, 0xd5, 0x56, 0xfd, 0x71, 0x4d, 0xd7, 0x10, 0x40, 0x56,
+	0xfd, 0x4e, 0x71, 0x7e, 0xbd, 0x51, 0x6f, 0xd5, 0x2b, 0xad, 0xda, 0x8e, 0x5d, 0xfb, 0xac, 0xde,
+	0xd2, 0xe7, 0x90, 0x0e, 0x8b, 0x4f, 0xea, 0xad, 0xbd, 0x1d, 0xab, 0xf2, 0xa4, 0xb2, 0xbd, 0x5f,
+	0xd3, 0xd3, 0x1c, 0xc1, 0x79, 0xb5, 0x1d, 0x3d, 0xc3, 0x11, 0xf2, 0xb7, 0xdd, 0xdc, 0xaf, 0x34,
+	0xf7, 0x6a, 0x3b, 0x7a, 0x76, 0xe3, 0xf7, 0x1a, 0xc0, 0xe8, 0x5a, 0xa2, 0x55, 0x58, 0x8a, 0x1c,
+	0x69, 0x1d, 0x1c, 0xd6, 0xab, 0xfa, 0x6b, 0x68, 0x1e, 0xd2, 0x7b, 0xb5, 0xca, 0x8e, 0xae, 0xa1,
+	0x05, 0xc8, 0x6c, 0xef, 0x1f, 0x54, 0x1f, 0xea, 0x29, 0xb4, 0x02, 0x39, 0xb9, 0xc1, 0x4a, 0xab,
+	0x7e, 0xd0, 0xd0, 0xe7, 0x50, 0x1e, 0xd6, 0x1e, 0xd4, 0x1b, 0x95, 0xfd, 0xfa, 0x8f, 0x6b, 0x3b,
+	0x76, 0x75, 0xaf, 0x56, 0x7d, 0x78, 0x78, 0x50, 0x6f, 0xb4, 0xf4, 0x34, 0xe7, 0x7c, 0xf2, 0xa8,
+	0xd9, 0xaa, 0x3f, 0xa8, 0x9f, 0xe4, 0x64, 0xb8, 0x92, 0xea, 0x5e, 0xa5, 0xde, 0xb0, 0xad, 0xda,
+	0x81, 0xb5, 0xab, 0x67, 0xb9, 0x83, 0x8f, 0x0f, 0xf6, 0x1f, 0x35, 0x5a, 0x15, 0xeb, 0x73, 0xb9,
+	0xa5, 0x4b, 0xe5, 0xdf, 0x64, 0x60, 0x49, 0x5e, 0xd1, 0xa6, 0x1c, 0x8b, 0xa0, 0xcf, 0x61, 0xf5,
+	0x09, 0x76, 0xd9, 0x03, 0x1a, 0x8c, 0x3e, 0x0e, 0xd0, 0xba, 0x29, 0x67, 0x14, 0x66, 0x34, 0x0d,
+	0x31, 0x6b, 0x7c, 0x1a, 0x52, 0xd8, 0x38, 0xf3, 0xb2, 0x9c, 0xf8, 0xb0, 0xd8, 0xd4, 0xd0, 0x43,
+	0x58, 0xaa, 0x62, 0x8f, 0x7a, 0xae, 0x83, 0x7b, 0xfc, 0xa8, 0xa7, 0xaa, 0x9d, 0xa1, 0x98, 0xa0,
+	0xaf, 0x35, 0x58, 0x88, 0x5f, 0x14, 0x53, 0x35, 0xdd, 0x99, 0xf9, 0x31, 0x62, 0x1c, 0x7c, 0x55,
+	0xd9, 0x44, 0xe6, 0x03, 0xc2, 0x9c, 0x2e, 0x09, 0x8b, 0xe2, 0x3a, 0x15, 0x59, 0x40, 0x48, 0x31,
+	0x74, 0x3d, 0x87, 0x14, 0x79, 0xb1, 0x2f, 0x3e, 0x75, 0x3d, 0xdc, 0x73, 0x7f, 0x41, 0x3a, 0x92,
+	0x6f, 0xfe, 0xfa, 0xef, 0xdf, 0xfe, 0x2e, 0xb5, 0x8e, 0xd6, 0xf8, 0x5c, 0x49, 0x4d, 0x99, 0x04,
+	0x83, 0xe3, 0xd0, 0x33, 0xd0, 0x63, 0x2b, 0xdb, 0xc7, 0x3c, 0xf7, 0x43, 0xf4, 0xee, 0x34, 0x7f,
+	0x4e, 0x7b, 0x42, 0x5c, 0xc0, 0x7b, 0xd4, 0x81, 0xb5, 0xd3, 0x9e, 0x0d, 0x53, 0x03, 0xf3, 0xfe,
+	0x59, 0x4f, 0x81, 0xa9, 0x8f, 0x8f, 0x9f, 0xc0, 0x62, 0xb2, 0xcf, 0xa1, 0xff, 0x9f, 0xa6, 0xe5,
+	0x94, 0x6e, 0x58, 0xb8, 0x71, 0x66, 0xe3, 0xda, 0xd4, 0xca, 0xff, 0xd4, 0x60, 0x45, 0xd6, 0x7b,
+	0x12, 0x44, 0xe9, 0xd8, 0x05, 0xa4, 0xf0, 0x89, 0x4e, 0x80, 0xa6, 0xe6, 0xdd, 0xe4, 0x04, 0xaa,
+	0x70, 0xeb, 0xfc, 0xce, 0xb2, 0x83, 0x19, 0x46, 0x36, 0xac, 0x36, 0x07, 0xed, 0xbe, 0x7b, 0xc2,
+	0xd0, 0x0c, 0x6d, 0xa9, 0x70, 0xeb, 0x6c, 0x67, 0xa2, 0xe0, 0x95, 0xbf, 0xd1, 0xe2, 0x99, 0x5a,
+	0xbc, 0xbd, 0xcf, 0x60, 0x51, 0xf9, 0x29, 0xb3, 0xfa, 0xe6, 0x99, 0x27, 0x1e, 0x6d, 0x69, 0x96,
+	0xfb, 0xf1, 0x05, 0x2c, 0x2a, 0x63, 0x72, 0x3d, 0x03, 0xa6, 0x30, 0xb5, 0xf7, 0x8d, 0x8d, 0x02,
+	0xcb, 0x7f, 0xc9, 0x82, 0x3e, 0xaa, 0xb2, 0x6a, 0x2f, 0x5f, 0x00, 0xc8, 0xcf, 0x04, 0x11, 0xce,
+	0xb7, 0xa7, 0xe9, 0x3a, 0xf1, 0xf1, 0x52, 0xb8, 0x75, 0x9e, 0x98, 0xca, 0xbc, 0x5f, 0xc6, 0x65,
+	0x69, 0xf4, 0x3d, 0x84, 0xca, 0x17, 0x1a, 0xf9, 0x48, 0x83, 0xef, 0x7d, 0x87, 0x31, 0xd1, 0xa6,
+	0x86, 0x28, 0x2c, 0x9f, 0x9c, 0x50, 0xa0, 0xbb, 0xe7, 0x2a, 0x4a, 0x4e, 0x40, 0x0a, 0xe6, 0xac,
+	0xe2, 0x6a, 0xc3, 0x3d, 0xb8, 0x5c, 0x8d, 0x3e, 0xec, 0x13, 0x03, 0x80, 0x3b, 0xb3, 0x4c, 0x1b,
+	0xa4, 0xc5, 0x8d, 0xd9, 0x07, 0x13, 0xe8, 0xf9, 0x64, 0xd7, 0xbc, 0xe0, 0xfe, 0x2e, 0x3a, 0xff,
+	0x42, 0xbf, 0xd2, 0x60, 0xed, 0xb4, 0xf9, 0x29, 0x3a, 0xff, 0x84, 0x26, 0x07, 0xb8, 0x85, 0xf7,
+	0x2f, 0x06, 0x52, 0x3e, 0x0c, 0x40, 0x1f, 0x9f, 0x9f, 0xa1, 0xa9, 0x1b, 0x99, 0x32, 0xa5, 0x2b,
+	0x6c, 0xce, 0x0e, 0x90, 0x66, 0xb7, 0xff, 0x3a, 0xf7, 0x55, 0xe5, 0xcf, 0x73, 0xe8, 0x1f, 0x1a,
+	0x64, 0x0e, 0x83, 0xe3, 0xb0, 0x8f, 0x6e, 0x7e, 0xd2, 0x3c, 0x68, 0x14, 0xad, 0xc3, 0x6a, 0x31,
+	0xfa, 0xef, 0x44, 0xd1, 0x0f, 0xe8, 0xd0, 0xed, 0xf0, 0x4e, 0x73, 0x5c, 0x14, 0x42, 0xa6, 0x51,
+	0x85, 0x65, 0xf1, 0x0b, 0x33, 0xd7, 0x29, 0xee, 0xe3, 0x76, 0x88, 0xae, 0x76, 0x19, 0xf3, 0xc3,
+	0xfb, 0xa5, 0x92, 0x1f, 0xd1, 0x7b, 0xb8, 0x1d, 0x9a, 0x0e, 0xed, 0x17, 0xd6, 0x19, 0xc1, 0xfd,
+	0x1f, 0x4e, 0xd0, 0x37, 0x7e, 0x06, 0x6f, 0xee, 0x36, 0x1e, 0x15, 0x77, 0x89, 0x47, 0x02, 0xdc,
+	0x2b, 0xca, 0x91, 0x6a, 0x71, 0xdf, 0x75, 0x88, 0x17, 0x92, 0xe2, 0xf0, 0x3d, 0x73, 0x13, 0x7d,
+	0x14, 0x69, 0x3d, 0x72, 0x59, 0x77, 0xd0, 0xe6, 0xb0, 0x93, 0x06, 0xe4, 0x8a, 0xb7, 0xba, 0x76,
+	0xa9, 0x8f, 0x79, 0xb9, 0x2e, 0xed, 0xd7, 0xab, 0xb5, 0x46, 0xb3, 0x66, 0xf6, 0x3b, 0xe5, 0xcc,
+	0xa6, 0xb9, 0x69, 0x6e, 0x16, 0x56, 0xb0, 0xef, 0x9a, 0x7e, 0x70, 0x2c, 0x2c, 0x7b, 0x84, 0xdd,
+	0x4e, 0x95, 0x75, 0xec, 0xfb, 0x3d, 0xd7, 0x11, 0x77, 0xab, 0xf4, 0xf3, 0x90, 0x7a, 0xe5, 0xab,
+	0x49, 0xca, 0x51, 0xe0, 0x3b, 0x77, 0x5f, 0x90, 0xf6, 0x5d, 0x46, 0x5e, 0xb2, 0x29, 0xac, 0x33,
+	0x50, 0x9c, 0x75, 0x7f, 0xc2, 0xc4, 0xfd, 0xe9, 0x26, 0x82, 0x7b, 0xbc, 0x46, 0x1e, 0x87, 0xfd,
+	0xe2, 0xae, 0xd8, 0x28, 0xba, 0x35, 0xdb, 0xc6, 0xbf, 0x79, 0xf5, 0x86, 0xf6, 0xb7, 0x57, 0x6f,
+	0x68, 0xff, 0x7e, 0xf5, 0x86, 0xd6, 0xce, 0x8a, 0xc6, 0xfa, 0xde, 0xff, 0x06, 0x00, 0x4d, 0x71,
+	0x2a, 0x4d, 0x6c, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconServiceClient is the client API for BeaconService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProposerServiceClient interface {
-	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+type BeaconServiceClient interface {
+	WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error)
+	CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	Eth1ConnectionStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatusResponse, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (BeaconService_StreamEventsClient, error)
 }
 
-type proposerServiceClient struct {
+type beaconServiceClient struct {
 	cc *grpc.ClientConn
 }
 
-func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
-	return &proposerServiceClient{cc}
+func NewBeaconServiceClient(cc *grpc.ClientConn) BeaconServiceClient {
+	return &beaconServiceClient{cc}
 }
 
-func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
-	out := new(v1alpha1.BeaconBlock)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
+func (c *beaconServiceClient) WaitForChainStart(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (BeaconService_WaitForChainStartClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.BeaconService/WaitForChainStart", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
-}
-
-func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
-	out := new(ProposeResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// ProposerServiceServer is the server API for ProposerService service.
-type ProposerServiceServer interface {
-	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
-	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
-}
-
-func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
-	s.RegisterService(&_ProposerService_serviceDesc, srv)
-}
-
-func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(v1alpha1.BeaconBlock)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _ProposerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
-	HandlerType: (*ProposerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RequestBlock",
-			Handler:    _ProposerService_RequestBlock_Handler,
-		},
-		{
-			MethodName: "ProposeBlock",
-			Handler:    _ProposerService_ProposeBlock_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/beacon/rpc/v1/services.proto",
-}
-
-// ValidatorServiceClient is the client API for ValidatorService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ValidatorServiceClient interface {
-	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
-	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
-	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
-	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
-}
-
-type validatorServiceClient struct {
-	cc *grpc.ClientConn
-}
-
-func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
-	return &validatorServiceClient{cc}
-}
-
-func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
-	out := new(DomainResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &validatorServiceWaitForActivationClient{stream}
+	x := &beaconServiceWaitForChainStartClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -1979,620 +2120,740 @@ func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *Vali
 	return x, nil
 }
 
-type ValidatorService_WaitForActivationClient interface {
-	Recv() (*ValidatorActivationResponse, error)
+type BeaconService_WaitForChainStartClient interface {
+	Recv() (*ChainStartResponse, error)
 	grpc.ClientStream
 }
 
-type validatorServiceWaitForActivationClient struct {
+type beaconServiceWaitForChainStartClient struct {
 	grpc.ClientStream
 }
 
-func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
-	m := new(ValidatorActivationResponse)
+func (x *beaconServiceWaitForChainStartClient) Recv() (*ChainStartResponse, error) {
+	m := new(ChainStartResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
-	out := new(ValidatorIndexResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
+func (c *beaconServiceClient) CanonicalHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
-	out := new(AssignmentResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
+func (c *beaconServiceClient) BlockTree(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTree", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
-	out := new(ValidatorStatusResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error) {
+	out := new(BlockTreeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
-	out := new(ValidatorPerformanceResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
+func (c *beaconServiceClient) Eth1ConnectionStatus(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatusResponse, error) {
+	out := new(Eth1ConnectionStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1ConnectionStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
-	out := new(ExitedValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
+func (c *beaconServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (BeaconService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconService/StreamEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &beaconServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// ValidatorServiceServer is the server API for ValidatorService service.
-type ValidatorServiceServer interface {
-	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
-	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
-	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
-	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
-	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
-	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
-	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+type BeaconService_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
 }
 
-func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
-	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+type beaconServiceStreamEventsClient struct {
+	grpc.ClientStream
 }
 
-func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DomainRequest)
-	if err := dec(in); err != nil {
+func (x *beaconServiceStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).DomainData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return m, nil
 }
 
-func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ValidatorActivationRequest)
+// BeaconServiceServer is the server API for BeaconService service.
+type BeaconServiceServer interface {
+	WaitForChainStart(*types.Empty, BeaconService_WaitForChainStartServer) error
+	CanonicalHead(context.Context, *types.Empty) (*v1alpha1.BeaconBlock, error)
+	BlockTree(context.Context, *types.Empty) (*BlockTreeResponse, error)
+	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+	Eth1ConnectionStatus(context.Context, *types.Empty) (*Eth1ConnectionStatusResponse, error)
+	StreamEvents(*StreamEventsRequest, BeaconService_StreamEventsServer) error
+}
+
+func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
+	s.RegisterService(&_BeaconService_serviceDesc, srv)
+}
+
+func _BeaconService_WaitForChainStart_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(types.Empty)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
+	return srv.(BeaconServiceServer).WaitForChainStart(m, &beaconServiceWaitForChainStartServer{stream})
 }
 
-type ValidatorService_WaitForActivationServer interface {
-	Send(*ValidatorActivationResponse) error
+type BeaconService_WaitForChainStartServer interface {
+	Send(*ChainStartResponse) error
 	grpc.ServerStream
 }
 
-type validatorServiceWaitForActivationServer struct {
+type beaconServiceWaitForChainStartServer struct {
 	grpc.ServerStream
 }
 
-func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
+func (x *beaconServiceWaitForChainStartServer) Send(m *ChainStartResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
+func _BeaconService_CanonicalHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/CanonicalHead",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+		return srv.(BeaconServiceServer).CanonicalHead(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AssignmentRequest)
+func _BeaconService_BlockTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
+		return srv.(BeaconServiceServer).BlockTree(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTree",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+		return srv.(BeaconServiceServer).BlockTree(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorIndexRequest)
+func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TreeBlockSlotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/BlockTreeBySlots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+		return srv.(BeaconServiceServer).BlockTreeBySlots(ctx, req.(*TreeBlockSlotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ValidatorPerformanceRequest)
+func _BeaconService_Eth1ConnectionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+		return srv.(BeaconServiceServer).Eth1ConnectionStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1ConnectionStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+		return srv.(BeaconServiceServer).Eth1ConnectionStatus(ctx, req.(*types.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExitedValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+func _BeaconService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(BeaconServiceServer).StreamEvents(m, &beaconServiceStreamEventsServer{stream})
 }
 
-var _ValidatorService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
-	HandlerType: (*ValidatorServiceServer)(nil),
+type BeaconService_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type beaconServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _BeaconService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
+	HandlerType: (*BeaconServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "DomainData",
-			Handler:    _ValidatorService_DomainData_Handler,
-		},
-		{
-			MethodName: "ValidatorIndex",
-			Handler:    _ValidatorService_ValidatorIndex_Handler,
-		},
-		{
-			MethodName: "CommitteeAssignment",
-			Handler:    _ValidatorService_CommitteeAssignment_Handler,
+			MethodName: "CanonicalHead",
+			Handler:    _BeaconService_CanonicalHead_Handler,
 		},
 		{
-			MethodName: "ValidatorStatus",
-			Handler:    _ValidatorService_ValidatorStatus_Handler,
+			MethodName: "BlockTree",
+			Handler:    _BeaconService_BlockTree_Handler,
 		},
 		{
-			MethodName: "ValidatorPerformance",
-			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+			MethodName: "BlockTreeBySlots",
+			Handler:    _BeaconService_BlockTreeBySlots_Handler,
 		},
 		{
-			MethodName: "ExitedValidators",
-			Handler:    _ValidatorService_ExitedValidators_Handler,
+			MethodName: "Eth1ConnectionStatus",
+			Handler:    _BeaconService_Eth1ConnectionStatus_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "WaitForActivation",
-			Handler:       _ValidatorService_WaitForActivation_Handler,
+			StreamName:    "WaitForChainStart",
+			Handler:       _BeaconService_WaitForChainStart_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _BeaconService_StreamEvents_Handler,
 			ServerStreams: true,
 		},
 	},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
 
-func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// AttesterServiceClient is the client API for AttesterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AttesterServiceClient interface {
+	RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error)
 }
 
-func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if len(m.RandaoReveal) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
-		i += copy(dAtA[i:], m.RandaoReveal)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+type attesterServiceClient struct {
+	cc *grpc.ClientConn
 }
 
-func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func NewAttesterServiceClient(cc *grpc.ClientConn) AttesterServiceClient {
+	return &attesterServiceClient{cc}
 }
 
-func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *attesterServiceClient) RequestAttestation(ctx context.Context, in *AttestationRequest, opts ...grpc.CallOption) (*v1alpha1.AttestationData, error) {
+	out := new(v1alpha1.AttestationData)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *attesterServiceClient) SubmitAttestation(ctx context.Context, in *v1alpha1.Attestation, opts ...grpc.CallOption) (*AttestResponse, error) {
+	out := new(AttestResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if len(m.PocBit) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
-		i += copy(dAtA[i:], m.PocBit)
+// AttesterServiceServer is the server API for AttesterService service.
+type AttesterServiceServer interface {
+	RequestAttestation(context.Context, *AttestationRequest) (*v1alpha1.AttestationData, error)
+	SubmitAttestation(context.Context, *v1alpha1.Attestation) (*AttestResponse, error)
+}
+
+func RegisterAttesterServiceServer(s *grpc.Server, srv AttesterServiceServer) {
+	s.RegisterService(&_AttesterService_serviceDesc, srv)
+}
+
+func _AttesterService_RequestAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, in)
 	}
-	if m.Shard != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/RequestAttestation",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).RequestAttestation(ctx, req.(*AttestationRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _AttesterService_SubmitAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.Attestation)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Root) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
+	if interceptor == nil {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.AttesterService/SubmitAttestation",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttesterServiceServer).SubmitAttestation(ctx, req.(*v1alpha1.Attestation))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var _AttesterService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.AttesterService",
+	HandlerType: (*AttesterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestAttestation",
+			Handler:    _AttesterService_RequestAttestation_Handler,
+		},
+		{
+			MethodName: "SubmitAttestation",
+			Handler:    _AttesterService_SubmitAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ProposerServiceClient is the client API for ProposerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposerServiceClient interface {
+	RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error)
+}
+
+type proposerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProposerServiceClient(cc *grpc.ClientConn) ProposerServiceClient {
+	return &proposerServiceClient{cc}
+}
+
+func (c *proposerServiceClient) RequestBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error) {
+	out := new(v1alpha1.BeaconBlock)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Slot != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+func (c *proposerServiceClient) ProposeBlock(ctx context.Context, in *v1alpha1.BeaconBlock, opts ...grpc.CallOption) (*ProposeResponse, error) {
+	out := new(ProposeResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+// ProposerServiceServer is the server API for ProposerService service.
+type ProposerServiceServer interface {
+	RequestBlock(context.Context, *BlockRequest) (*v1alpha1.BeaconBlock, error)
+	ProposeBlock(context.Context, *v1alpha1.BeaconBlock) (*ProposeResponse, error)
+}
+
+func RegisterProposerServiceServer(s *grpc.Server, srv ProposerServiceServer) {
+	s.RegisterService(&_ProposerService_serviceDesc, srv)
+}
+
+func _ProposerService_RequestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/RequestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).RequestBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Balance != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Balance))
-	}
-	if m.TotalValidators != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
+func _ProposerService_ProposeBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v1alpha1.BeaconBlock)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.TotalActiveValidators != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
+	if interceptor == nil {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, in)
 	}
-	if m.AverageActiveValidatorBalance != 0 {
-		dAtA[i] = 0x25
-		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
-		i += 4
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ProposerService/ProposeBlock",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposerServiceServer).ProposeBlock(ctx, req.(*v1alpha1.BeaconBlock))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+var _ProposerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ProposerService",
+	HandlerType: (*ProposerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestBlock",
+			Handler:    _ProposerService_RequestBlock_Handler,
+		},
+		{
+			MethodName: "ProposeBlock",
+			Handler:    _ProposerService_ProposeBlock_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+// ValidatorServiceClient is the client API for ValidatorService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ValidatorServiceClient interface {
+	DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error)
+	WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error)
+	ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error)
+}
+
+type validatorServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewValidatorServiceClient(cc *grpc.ClientConn) ValidatorServiceClient {
+	return &validatorServiceClient{cc}
+}
+
+func (c *validatorServiceClient) DomainData(ctx context.Context, in *DomainRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
+	out := new(DomainResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/DomainData", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+func (c *validatorServiceClient) WaitForActivation(ctx context.Context, in *ValidatorActivationRequest, opts ...grpc.CallOption) (ValidatorService_WaitForActivationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ValidatorService_serviceDesc.Streams[0], "/ethereum.beacon.rpc.v1.ValidatorService/WaitForActivation", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	x := &validatorServiceWaitForActivationClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+type ValidatorService_WaitForActivationClient interface {
+	Recv() (*ValidatorActivationResponse, error)
+	grpc.ClientStream
+}
+
+type validatorServiceWaitForActivationClient struct {
+	grpc.ClientStream
+}
+
+func (x *validatorServiceWaitForActivationClient) Recv() (*ValidatorActivationResponse, error) {
+	m := new(ValidatorActivationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return m, nil
 }
 
-func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Statuses) > 0 {
-		for _, msg := range m.Statuses {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *validatorServiceClient) ValidatorIndex(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorIndexResponse, error) {
+	out := new(ValidatorIndexResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *validatorServiceClient) CommitteeAssignment(ctx context.Context, in *AssignmentRequest, opts ...grpc.CallOption) (*AssignmentResponse, error) {
+	out := new(AssignmentResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
-	}
-	if m.Status != nil {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status.Size()))
-		n1, err := m.Status.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *validatorServiceClient) ValidatorStatus(ctx context.Context, in *ValidatorIndexRequest, opts ...grpc.CallOption) (*ValidatorStatusResponse, error) {
+	out := new(ValidatorStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *validatorServiceClient) ValidatorPerformance(ctx context.Context, in *ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ValidatorPerformanceResponse, error) {
+	out := new(ValidatorPerformanceResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+func (c *validatorServiceClient) ExitedValidators(ctx context.Context, in *ExitedValidatorsRequest, opts ...grpc.CallOption) (*ExitedValidatorsResponse, error) {
+	out := new(ExitedValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	return out, nil
+}
+
+// ValidatorServiceServer is the server API for ValidatorService service.
+type ValidatorServiceServer interface {
+	DomainData(context.Context, *DomainRequest) (*DomainResponse, error)
+	WaitForActivation(*ValidatorActivationRequest, ValidatorService_WaitForActivationServer) error
+	ValidatorIndex(context.Context, *ValidatorIndexRequest) (*ValidatorIndexResponse, error)
+	CommitteeAssignment(context.Context, *AssignmentRequest) (*AssignmentResponse, error)
+	ValidatorStatus(context.Context, *ValidatorIndexRequest) (*ValidatorStatusResponse, error)
+	ValidatorPerformance(context.Context, *ValidatorPerformanceRequest) (*ValidatorPerformanceResponse, error)
+	ExitedValidators(context.Context, *ExitedValidatorsRequest) (*ExitedValidatorsResponse, error)
+}
+
+func RegisterValidatorServiceServer(s *grpc.Server, srv ValidatorServiceServer) {
+	s.RegisterService(&_ValidatorService_serviceDesc, srv)
+}
+
+func _ValidatorService_DomainData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return i, nil
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).DomainData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/DomainData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).DomainData(ctx, req.(*DomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _ValidatorService_WaitForActivation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ValidatorActivationRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ValidatorServiceServer).WaitForActivation(m, &validatorServiceWaitForActivationServer{stream})
+}
+
+type ValidatorService_WaitForActivationServer interface {
+	Send(*ValidatorActivationResponse) error
+	grpc.ServerStream
+}
+
+type validatorServiceWaitForActivationServer struct {
+	grpc.ServerStream
+}
+
+func (x *validatorServiceWaitForActivationServer) Send(m *ValidatorActivationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ValidatorService_ValidatorIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorIndex(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+func _ValidatorService_CommitteeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, in)
 	}
-	return i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/CommitteeAssignment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).CommitteeAssignment(ctx, req.(*AssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
+func _ValidatorService_ValidatorStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorStatus(ctx, req.(*ValidatorIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ValidatorPerformance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatorPerformanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ValidatorPerformance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ValidatorPerformance(ctx, req.(*ValidatorPerformanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ValidatorService_ExitedValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExitedValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.ValidatorService/ExitedValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ValidatorServiceServer).ExitedValidators(ctx, req.(*ExitedValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ValidatorService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.beacon.rpc.v1.ValidatorService",
+	HandlerType: (*ValidatorServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DomainData",
+			Handler:    _ValidatorService_DomainData_Handler,
+		},
+		{
+			MethodName: "ValidatorIndex",
+			Handler:    _ValidatorService_ValidatorIndex_Handler,
+		},
+		{
+			MethodName: "CommitteeAssignment",
+			Handler:    _ValidatorService_CommitteeAssignment_Handler,
+		},
+		{
+			MethodName: "ValidatorStatus",
+			Handler:    _ValidatorService_ValidatorStatus_Handler,
+		},
+		{
+			MethodName: "ValidatorPerformance",
+			Handler:    _ValidatorService_ValidatorPerformance_Handler,
+		},
+		{
+			MethodName: "ExitedValidators",
+			Handler:    _ValidatorService_ExitedValidators_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WaitForActivation",
+			Handler:       _ValidatorService_WaitForActivation_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/beacon/rpc/v1/services.proto",
+}
+
+func (m *BlockRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2602,25 +2863,21 @@ func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *BlockRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Started {
+	if m.Slot != 0 {
 		dAtA[i] = 0x8
 		i++
-		if m.Started {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 	}
-	if m.GenesisTime != 0 {
-		dAtA[i] = 0x10
+	if len(m.RandaoReveal) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
+		i = encodeVarintServices(dAtA, i, uint64(len(m.RandaoReveal)))
+		i += copy(dAtA[i:], m.RandaoReveal)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2628,7 +2885,7 @@ func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
+func (m *ProposeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2638,16 +2895,16 @@ func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProposeResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKey) > 0 {
+	if len(m.BlockRoot) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2655,7 +2912,7 @@ func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
+func (m *AttestationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2665,23 +2922,40 @@ func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttestationRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		dAtA[i] = 0x8
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Index))
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.PocBit) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PocBit)))
+		i += copy(dAtA[i:], m.PocBit)
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if m.Shard != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+func (m *AttestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2691,23 +2965,16 @@ func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttestResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.EpochStart != 0 {
-		dAtA[i] = 0x8
+	if len(m.Root) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2715,7 +2982,7 @@ func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorPerformanceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2725,22 +2992,21 @@ func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorPerformanceRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, msg := range m.ValidatorAssignment {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2748,7 +3014,7 @@ func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorPerformanceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2758,58 +3024,31 @@ func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorPerformanceResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Committee) > 0 {
-		dAtA3 := make([]byte, len(m.Committee)*10)
-		var j2 int
-		for _, num := range m.Committee {
-			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j2++
-			}
-			dAtA3[j2] = uint8(num)
-			j2++
-		}
-		dAtA[i] = 0xa
+	if m.Balance != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(j2))
-		i += copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintServices(dAtA, i, uint64(m.Balance))
 	}
-	if m.Shard != 0 {
+	if m.TotalValidators != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalValidators))
 	}
-	if m.Slot != 0 {
+	if m.TotalActiveValidators != 0 {
 		dAtA[i] = 0x18
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
-	}
-	if m.IsProposer {
-		dAtA[i] = 0x20
-		i++
-		if m.IsProposer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
-	}
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalActiveValidators))
 	}
-	if m.Status != 0 {
-		dAtA[i] = 0x30
+	if m.AverageActiveValidatorBalance != 0 {
+		dAtA[i] = 0x25
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageActiveValidatorBalance))))
+		i += 4
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2817,7 +3056,7 @@ func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, er
 	return i, nil
 }
 
-func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorActivationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2827,35 +3066,18 @@ func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorActivationRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Status))
-	}
-	if m.Eth1DepositBlockNumber != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
-	}
-	if m.DepositInclusionSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
-	}
-	if m.ActivationEpoch != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
-	}
-	if m.PositionInActivationQueue != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2863,7 +3085,7 @@ func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorActivationResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2873,21 +3095,30 @@ func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorActivationResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	if len(m.Domain) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
-		i += copy(dAtA[i:], m.Domain)
+	if len(m.Statuses) > 0 {
+		for _, msg := range m.Statuses {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2895,7 +3126,7 @@ func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+func (m *ValidatorActivationResponse_Status) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2905,15 +3136,26 @@ func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ValidatorActivationResponse_Status) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.SignatureDomain != 0 {
-		dAtA[i] = 0x8
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Status != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status.Size()))
+		n1, err := m.Status.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2921,7 +3163,7 @@ func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+func (m *ExitedValidatorsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2931,21 +3173,17 @@ func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExitedValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tree) > 0 {
-		for _, msg := range m.Tree {
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
 		}
 	}
 	if m.XXX_unrecognized != nil {
@@ -2954,7 +3192,7 @@ func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+func (m *ExitedValidatorsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2964,36 +3202,18 @@ func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExitedValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Block != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
-		n4, err := m.Block.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
 		}
-		i += n4
-	}
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.ParticipatedVotes != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
-	}
-	if m.TotalVotes != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3001,7 +3221,7 @@ func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+func (m *ChainStartResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3011,20 +3231,25 @@ func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainStartResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.SlotFrom != 0 {
+	if m.Started {
 		dAtA[i] = 0x8
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+		if m.Started {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
-	if m.SlotTo != 0 {
+	if m.GenesisTime != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
+		i = encodeVarintServices(dAtA, i, uint64(m.GenesisTime))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3032,393 +3257,758 @@ func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ValidatorIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *BlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.RandaoReveal)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ProposeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.Index != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Index))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AttestationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.PocBit)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if m.EpochStart != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.EpochStart))
 	}
-	if m.Shard != 0 {
-		n += 1 + sovServices(uint64(m.Shard))
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AttestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *AssignmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValidatorPerformanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AssignmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.ValidatorAssignment) > 0 {
+		for _, msg := range m.ValidatorAssignment {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorPerformanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssignmentResponse_ValidatorAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Balance != 0 {
-		n += 1 + sovServices(uint64(m.Balance))
+	if len(m.Committee) > 0 {
+		dAtA3 := make([]byte, len(m.Committee)*10)
+		var j2 int
+		for _, num := range m.Committee {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j2))
+		i += copy(dAtA[i:], dAtA3[:j2])
 	}
-	if m.TotalValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalValidators))
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Shard))
 	}
-	if m.TotalActiveValidators != 0 {
-		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 	}
-	if m.AverageActiveValidatorBalance != 0 {
-		n += 5
+	if m.IsProposer {
+		dAtA[i] = 0x20
+		i++
+		if m.IsProposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
+	}
+	if m.Status != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorActivationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	if m.Status != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Status))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.Eth1DepositBlockNumber != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Eth1DepositBlockNumber))
 	}
-	return n
-}
-
-func (m *ValidatorActivationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.DepositInclusionSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.DepositInclusionSlot))
 	}
-	var l int
-	_ = l
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	if m.ActivationEpoch != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ActivationEpoch))
 	}
-	if len(m.Statuses) > 0 {
-		for _, e := range m.Statuses {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
-		}
+	if m.PositionInActivationQueue != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.PositionInActivationQueue))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorActivationResponse_Status) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DomainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Epoch))
 	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovServices(uint64(l))
+	if len(m.Domain) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Domain)))
+		i += copy(dAtA[i:], m.Domain)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ExitedValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DomainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
-		}
+	if m.SignatureDomain != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SignatureDomain))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ExitedValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockTreeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+	if len(m.Tree) > 0 {
+		for _, msg := range m.Tree {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChainStartResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlockTreeResponse_TreeNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTreeResponse_TreeNode) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Started {
-		n += 2
+	if m.Block != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
+		n4, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	if m.GenesisTime != 0 {
-		n += 1 + sovServices(uint64(m.GenesisTime))
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	if m.ParticipatedVotes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.TotalVotes))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TreeBlockSlotRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TreeBlockSlotRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.SlotFrom != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.SlotTo))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Eth1ConnectionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Eth1ConnectionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Index != 0 {
-		n += 1 + sovServices(uint64(m.Index))
+	if len(m.ActiveEndpoint) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.ActiveEndpoint)))
+		i += copy(dAtA[i:], m.ActiveEndpoint)
+	}
+	if len(m.Endpoints) > 0 {
+		for _, msg := range m.Endpoints {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintServices(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AssignmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochStart != 0 {
-		n += 1 + sovServices(uint64(m.EpochStart))
+func (m *Eth1EndpointStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovServices(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Eth1EndpointStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Url) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.Url)))
+		i += copy(dAtA[i:], m.Url)
+	}
+	if m.Active {
+		dAtA[i] = 0x10
+		i++
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Healthy {
+		dAtA[i] = 0x18
+		i++
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
+	}
+	if m.Score != 0 {
+		dAtA[i] = 0x21
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Score))))
+		i += 8
+	}
+	if m.HeadBlockNumber != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadBlockNumber))
+	}
+	if m.HeadBlockTime != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.HeadBlockTime))
+	}
+	if m.ErrorRate != 0 {
+		dAtA[i] = 0x39
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ErrorRate))))
+		i += 8
+	}
+	if m.LatencyMs != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.LatencyMs))
+	}
+	if len(m.LastError) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.LastError)))
+		i += copy(dAtA[i:], m.LastError)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AssignmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StreamEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.ValidatorAssignment) > 0 {
-		for _, e := range m.ValidatorAssignment {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+	if len(m.Topics) > 0 {
+		dAtA6 := make([]byte, len(m.Topics)*10)
+		var j5 int
+		for _, num := range m.Topics {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Event) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Event) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Committee) > 0 {
-		l = 0
-		for _, e := range m.Committee {
-			l += sovServices(uint64(e))
+	if m.Topic != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Topic))
+	}
+	if m.MissedEvents != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.MissedEvents))
+	}
+	if m.Head != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Head.Size()))
+		n7, err := m.Head.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + sovServices(uint64(l)) + l
+		i += n7
 	}
-	if m.Shard != 0 {
-		n += 1 + sovServices(uint64(m.Shard))
+	if m.Block != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Block.Size()))
+		n8, err := m.Block.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
 	}
-	if m.Slot != 0 {
-		n += 1 + sovServices(uint64(m.Slot))
+	if m.Attestation != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Attestation.Size()))
+		n9, err := m.Attestation.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
 	}
-	if m.IsProposer {
-		n += 2
+	if m.Checkpoint != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Checkpoint.Size()))
+		n10, err := m.Checkpoint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
 	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
+	if m.ChainReorg != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.ChainReorg.Size()))
+		n11, err := m.ChainReorg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
 	}
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	if m.VoluntaryExit != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.VoluntaryExit.Size()))
+		n12, err := m.VoluntaryExit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *HeadEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *HeadEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovServices(uint64(m.Status))
+	if m.Slot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.Slot))
 	}
-	if m.Eth1DepositBlockNumber != 0 {
-		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
 	}
-	if m.DepositInclusionSlot != 0 {
-		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	if len(m.StateRoot) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.StateRoot)))
+		i += copy(dAtA[i:], m.StateRoot)
 	}
-	if m.ActivationEpoch != 0 {
-		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.PositionInActivationQueue != 0 {
-		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	return i, nil
+}
+
+func (m *ChainReorgEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainReorgEvent) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.OldHeadSlot != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.OldHeadSlot))
+	}
+	if len(m.OldHeadRoot) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.OldHeadRoot)))
+		i += copy(dAtA[i:], m.OldHeadRoot)
+	}
+	if m.NewHeadSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(m.NewHeadSlot))
+	}
+	if len(m.NewHeadRoot) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintServices(dAtA, i, uint64(len(m.NewHeadRoot)))
+		i += copy(dAtA[i:], m.NewHeadRoot)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DomainRequest) Size() (n int) {
+func encodeVarintServices(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *BlockRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovServices(uint64(m.Epoch))
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
 	}
-	l = len(m.Domain)
+	l = len(m.RandaoReveal)
 	if l > 0 {
 		n += 1 + l + sovServices(uint64(l))
 	}
@@ -3428,14 +4018,15 @@ func (m *DomainRequest) Size() (n int) {
 	return n
 }
 
-func (m *DomainResponse) Size() (n int) {
+func (m *ProposeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SignatureDomain != 0 {
-		n += 1 + sovServices(uint64(m.SignatureDomain))
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -3443,82 +4034,1624 @@ func (m *DomainResponse) Size() (n int) {
 	return n
 }
 
-func (m *BlockTreeResponse) Size() (n int) {
+func (m *AttestationRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Tree) > 0 {
-		for _, e := range m.Tree {
-			l = e.Size()
-			n += 1 + l + sovServices(uint64(l))
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.PocBit)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorPerformanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		n += 1 + sovServices(uint64(m.Balance))
+	}
+	if m.TotalValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalValidators))
+	}
+	if m.TotalActiveValidators != 0 {
+		n += 1 + sovServices(uint64(m.TotalActiveValidators))
+	}
+	if m.AverageActiveValidatorBalance != 0 {
+		n += 5
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorActivationResponse_Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExitedValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainStartResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Started {
+		n += 2
+	}
+	if m.GenesisTime != 0 {
+		n += 1 + sovServices(uint64(m.GenesisTime))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovServices(uint64(m.Index))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochStart != 0 {
+		n += 1 + sovServices(uint64(m.EpochStart))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorAssignment) > 0 {
+		for _, e := range m.ValidatorAssignment {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AssignmentResponse_ValidatorAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Committee) > 0 {
+		l = 0
+		for _, e := range m.Committee {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.Shard != 0 {
+		n += 1 + sovServices(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	if m.IsProposer {
+		n += 2
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovServices(uint64(m.Status))
+	}
+	if m.Eth1DepositBlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.Eth1DepositBlockNumber))
+	}
+	if m.DepositInclusionSlot != 0 {
+		n += 1 + sovServices(uint64(m.DepositInclusionSlot))
+	}
+	if m.ActivationEpoch != 0 {
+		n += 1 + sovServices(uint64(m.ActivationEpoch))
+	}
+	if m.PositionInActivationQueue != 0 {
+		n += 1 + sovServices(uint64(m.PositionInActivationQueue))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovServices(uint64(m.Epoch))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DomainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignatureDomain != 0 {
+		n += 1 + sovServices(uint64(m.SignatureDomain))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tree) > 0 {
+		for _, e := range m.Tree {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BlockTreeResponse_TreeNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.ParticipatedVotes != 0 {
+		n += 1 + sovServices(uint64(m.ParticipatedVotes))
+	}
+	if m.TotalVotes != 0 {
+		n += 1 + sovServices(uint64(m.TotalVotes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TreeBlockSlotRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlotFrom != 0 {
+		n += 1 + sovServices(uint64(m.SlotFrom))
+	}
+	if m.SlotTo != 0 {
+		n += 1 + sovServices(uint64(m.SlotTo))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1ConnectionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActiveEndpoint)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for _, e := range m.Endpoints {
+			l = e.Size()
+			n += 1 + l + sovServices(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Eth1EndpointStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Active {
+		n += 2
+	}
+	if m.Healthy {
+		n += 2
+	}
+	if m.Score != 0 {
+		n += 9
+	}
+	if m.HeadBlockNumber != 0 {
+		n += 1 + sovServices(uint64(m.HeadBlockNumber))
+	}
+	if m.HeadBlockTime != 0 {
+		n += 1 + sovServices(uint64(m.HeadBlockTime))
+	}
+	if m.ErrorRate != 0 {
+		n += 9
+	}
+	if m.LatencyMs != 0 {
+		n += 1 + sovServices(uint64(m.LatencyMs))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StreamEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Topics) > 0 {
+		l = 0
+		for _, e := range m.Topics {
+			l += sovServices(uint64(e))
+		}
+		n += 1 + sovServices(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Topic != 0 {
+		n += 1 + sovServices(uint64(m.Topic))
+	}
+	if m.MissedEvents != 0 {
+		n += 1 + sovServices(uint64(m.MissedEvents))
+	}
+	if m.Head != nil {
+		l = m.Head.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.Checkpoint != nil {
+		l = m.Checkpoint.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.ChainReorg != nil {
+		l = m.ChainReorg.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.VoluntaryExit != nil {
+		l = m.VoluntaryExit.Size()
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HeadEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Slot != 0 {
+		n += 1 + sovServices(uint64(m.Slot))
+	}
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainReorgEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldHeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.OldHeadSlot))
+	}
+	l = len(m.OldHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.NewHeadSlot != 0 {
+		n += 1 + sovServices(uint64(m.NewHeadSlot))
+	}
+	l = len(m.NewHeadRoot)
+	if l > 0 {
+		n += 1 + l + sovServices(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovServices(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozServices(x uint64) (n int) {
+	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
+			if m.RandaoReveal == nil {
+				m.RandaoReveal = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
+			if m.PocBit == nil {
+				m.PocBit = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			}
+			m.TotalValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
+			}
+			m.TotalActiveValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalActiveValidators |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *BlockTreeResponse_TreeNode) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Block != nil {
-		l = m.Block.Size()
-		n += 1 + l + sovServices(uint64(l))
-	}
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovServices(uint64(l))
-	}
-	if m.ParticipatedVotes != 0 {
-		n += 1 + sovServices(uint64(m.ParticipatedVotes))
-	}
-	if m.TotalVotes != 0 {
-		n += 1 + sovServices(uint64(m.TotalVotes))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *TreeBlockSlotRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SlotFrom != 0 {
-		n += 1 + sovServices(uint64(m.SlotFrom))
-	}
-	if m.SlotTo != 0 {
-		n += 1 + sovServices(uint64(m.SlotTo))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ValidatorStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovServices(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozServices(x uint64) (n int) {
-	return sovServices(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BlockRequest) Unmarshal(dAtA []byte) error {
+func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3541,17 +5674,17 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			m.Slot = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3561,14 +5694,81 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RandaoReveal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3595,10 +5795,8 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RandaoReveal = append(m.RandaoReveal[:0], dAtA[iNdEx:postIndex]...)
-			if m.RandaoReveal == nil {
-				m.RandaoReveal = []byte{}
-			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3625,7 +5823,7 @@ func (m *BlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
+func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3648,17 +5846,17 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProposeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3668,26 +5866,31 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Started = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.GenesisTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GenesisTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3713,7 +5916,7 @@ func (m *ProposeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3736,10 +5939,10 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3776,78 +5979,6 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 				m.PublicKey = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PocBit", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PocBit = append(m.PocBit[:0], dAtA[iNdEx:postIndex]...)
-			if m.PocBit == nil {
-				m.PocBit = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			m.Shard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3873,7 +6004,7 @@ func (m *AttestationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttestResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3896,17 +6027,17 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var byteLen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -3916,26 +6047,11 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
-			if m.Root == nil {
-				m.Root = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -3961,7 +6077,7 @@ func (m *AttestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3984,17 +6100,17 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
 			}
-			m.Slot = 0
+			m.EpochStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4004,14 +6120,14 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.EpochStart |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4038,10 +6154,8 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4068,7 +6182,7 @@ func (m *ValidatorPerformanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
+func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4091,36 +6205,17 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorPerformanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
-			}
-			m.Balance = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidators", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
 			}
-			m.TotalValidators = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4130,41 +6225,26 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalValidators |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalActiveValidators", wireType)
-			}
-			m.TotalActiveValidators = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalActiveValidators |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
 			}
-		case 4:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageActiveValidatorBalance", wireType)
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
 			}
-			var v uint32
-			if (iNdEx + 4) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.AverageActiveValidatorBalance = float32(math.Float32frombits(v))
+			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
+			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4190,7 +6270,7 @@ func (m *ValidatorPerformanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
+func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4213,15 +6293,149 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Committee = append(m.Committee, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Committee) == 0 {
+					m.Committee = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Committee = append(m.Committee, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+			}
+			m.Shard = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsProposer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsProposer = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4248,9 +6462,30 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ValidatorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4276,7 +6511,7 @@ func (m *ValidatorActivationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
+func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4299,17 +6534,17 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorActivationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var byteLen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4319,29 +6554,35 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Status |= ValidatorStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Eth1DepositBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
 			}
-			var msglen int
+			m.DepositInclusionSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4351,26 +6592,49 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
+			m.ActivationEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
 			}
-			m.Statuses = append(m.Statuses, &ValidatorActivationResponse_Status{})
-			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PositionInActivationQueue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4396,7 +6660,7 @@ func (m *ValidatorActivationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
+func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4419,17 +6683,17 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var byteLen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4439,31 +6703,16 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4473,26 +6722,24 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ValidatorStatusResponse{}
-			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
+			if m.Domain == nil {
+				m.Domain = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4520,7 +6767,7 @@ func (m *ValidatorActivationResponse_Status) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4543,17 +6790,17 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
 			}
-			var byteLen int
+			m.SignatureDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4563,24 +6810,11 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SignatureDomain |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -4606,7 +6840,7 @@ func (m *ExitedValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4629,17 +6863,17 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExitedValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4649,23 +6883,25 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
+			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4692,7 +6928,7 @@ func (m *ExitedValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
+func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4715,37 +6951,17 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainStartResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainStartResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Started = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.GenesisTime = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4755,68 +6971,31 @@ func (m *ChainStartResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GenesisTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlock{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4843,70 +7022,35 @@ func (m *ValidatorIndexRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthServices
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ParticipatedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParticipatedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
 			}
-			m.Index = 0
+			m.TotalVotes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4916,7 +7060,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				m.TotalVotes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4946,7 +7090,7 @@ func (m *ValidatorIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
+func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4969,17 +7113,17 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochStart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
 			}
-			m.EpochStart = 0
+			m.SlotFrom = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -4989,16 +7133,16 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochStart |= uint64(b&0x7F) << shift
+				m.SlotFrom |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
 			}
-			var byteLen int
+			m.SlotTo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5008,24 +7152,11 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SlotTo |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
@@ -5051,7 +7182,7 @@ func (m *AssignmentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
+func (m *Eth1ConnectionStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5074,15 +7205,47 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AssignmentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1ConnectionStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AssignmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1ConnectionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAssignment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveEndpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActiveEndpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoints", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5109,8 +7272,8 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAssignment = append(m.ValidatorAssignment, &AssignmentResponse_ValidatorAssignment{})
-			if err := m.ValidatorAssignment[len(m.ValidatorAssignment)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Endpoints = append(m.Endpoints, &Eth1EndpointStatus{})
+			if err := m.Endpoints[len(m.Endpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5139,7 +7302,7 @@ func (m *AssignmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
+func (m *Eth1EndpointStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5162,93 +7325,100 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: Eth1EndpointStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Eth1EndpointStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
 				}
-				m.Committee = append(m.Committee, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowServices
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthServices
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthServices
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Committee) == 0 {
-					m.Committee = make([]uint64, 0, elementCount)
+			}
+			m.Active = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowServices
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Committee = append(m.Committee, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committee", wireType)
 			}
-		case 2:
+			m.Healthy = bool(v != 0)
+		case 4:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Score = float64(math.Float64frombits(v))
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadBlockNumber", wireType)
 			}
-			m.Shard = 0
+			m.HeadBlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5258,16 +7428,16 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
+				m.HeadBlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadBlockTime", wireType)
 			}
-			m.Slot = 0
+			m.HeadBlockTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5277,16 +7447,27 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
+				m.HeadBlockTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 7:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorRate", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ErrorRate = float64(math.Float64frombits(v))
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsProposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LatencyMs", wireType)
 			}
-			var v int
+			m.LatencyMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5296,17 +7477,16 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.LatencyMs |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsProposer = bool(v != 0)
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5316,44 +7496,146 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipServices(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthServices
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowServices
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v EventTopic
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= EventTopic(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.Topics = append(m.Topics, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowServices
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthServices
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthServices
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.Topics) == 0 {
+					m.Topics = make([]EventTopic, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v EventTopic
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowServices
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= EventTopic(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Topics = append(m.Topics, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topics", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -5380,7 +7662,7 @@ func (m *AssignmentResponse_ValidatorAssignment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *Event) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5403,17 +7685,17 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Topic", wireType)
 			}
-			m.Status = 0
+			m.Topic = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5423,16 +7705,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ValidatorStatus(b&0x7F) << shift
+				m.Topic |= EventTopic(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Eth1DepositBlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedEvents", wireType)
 			}
-			m.Eth1DepositBlockNumber = 0
+			m.MissedEvents = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5442,16 +7724,16 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Eth1DepositBlockNumber |= uint64(b&0x7F) << shift
+				m.MissedEvents |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositInclusionSlot", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			m.DepositInclusionSlot = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5461,16 +7743,33 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositInclusionSlot |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Head == nil {
+				m.Head = &HeadEvent{}
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationEpoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			m.ActivationEpoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5480,16 +7779,33 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationEpoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &v1alpha1.BeaconBlock{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionInActivationQueue", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			m.PositionInActivationQueue = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5499,70 +7815,69 @@ func (m *ValidatorStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionInActivationQueue |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DomainRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
+			if m.Attestation == nil {
+				m.Attestation = &v1alpha1.Attestation{}
 			}
-			if iNdEx >= l {
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Checkpoint == nil {
+				m.Checkpoint = &v1alpha1.Checkpoint{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DomainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Epoch = 0
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainReorg", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5572,16 +7887,33 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthServices
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChainReorg == nil {
+				m.ChainReorg = &ChainReorgEvent{}
+			}
+			if err := m.ChainReorg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoluntaryExit", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5591,24 +7923,26 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Domain = append(m.Domain[:0], dAtA[iNdEx:postIndex]...)
-			if m.Domain == nil {
-				m.Domain = []byte{}
+			if m.VoluntaryExit == nil {
+				m.VoluntaryExit = &v1alpha1.VoluntaryExit{}
+			}
+			if err := m.VoluntaryExit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5636,7 +7970,7 @@ func (m *DomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DomainResponse) Unmarshal(dAtA []byte) error {
+func (m *HeadEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5659,17 +7993,17 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HeadEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HeadEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			m.SignatureDomain = 0
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5679,70 +8013,50 @@ func (m *DomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignatureDomain |= uint64(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthServices
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowServices
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthServices
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTreeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTreeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5752,24 +8066,24 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tree = append(m.Tree, &BlockTreeResponse_TreeNode{})
-			if err := m.Tree[len(m.Tree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5797,7 +8111,7 @@ func (m *BlockTreeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
+func (m *ChainReorgEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5820,17 +8134,17 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TreeNode: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChainReorgEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeNode: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChainReorgEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadSlot", wireType)
 			}
-			var msglen int
+			m.OldHeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5840,31 +8154,14 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OldHeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthServices
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthServices
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Block == nil {
-				m.Block = &v1alpha1.BeaconBlock{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldHeadRoot", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5891,16 +8188,16 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
+			m.OldHeadRoot = append(m.OldHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.OldHeadRoot == nil {
+				m.OldHeadRoot = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParticipatedVotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadSlot", wireType)
 			}
-			m.ParticipatedVotes = 0
+			m.NewHeadSlot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5910,16 +8207,16 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParticipatedVotes |= uint64(b&0x7F) << shift
+				m.NewHeadSlot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHeadRoot", wireType)
 			}
-			m.TotalVotes = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowServices
@@ -5929,103 +8226,26 @@ func (m *BlockTreeResponse_TreeNode) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotes |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipServices(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthServices
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TreeBlockSlotRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowServices
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TreeBlockSlotRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotFrom", wireType)
-			}
-			m.SlotFrom = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlotFrom |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlotTo", wireType)
-			}
-			m.SlotTo = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowServices
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlotTo |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.NewHeadRoot = append(m.NewHeadRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewHeadRoot == nil {
+				m.NewHeadRoot = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipServices(dAtA[iNdEx:])
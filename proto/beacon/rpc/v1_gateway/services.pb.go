@@ -11,7 +11,6 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
-	_ "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -96,6 +95,49 @@ func (ValidatorStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9eb4e94b85965285, []int{1}
 }
 
+type EventTopic int32
+
+const (
+	EventTopic_UNKNOWN_TOPIC        EventTopic = 0
+	EventTopic_HEAD                 EventTopic = 1
+	EventTopic_BLOCK                EventTopic = 2
+	EventTopic_ATTESTATION          EventTopic = 3
+	EventTopic_FINALIZED_CHECKPOINT EventTopic = 4
+	EventTopic_JUSTIFIED_CHECKPOINT EventTopic = 5
+	EventTopic_CHAIN_REORG          EventTopic = 6
+	EventTopic_VOLUNTARY_EXIT       EventTopic = 7
+)
+
+var EventTopic_name = map[int32]string{
+	0: "UNKNOWN_TOPIC",
+	1: "HEAD",
+	2: "BLOCK",
+	3: "ATTESTATION",
+	4: "FINALIZED_CHECKPOINT",
+	5: "JUSTIFIED_CHECKPOINT",
+	6: "CHAIN_REORG",
+	7: "VOLUNTARY_EXIT",
+}
+
+var EventTopic_value = map[string]int32{
+	"UNKNOWN_TOPIC":        0,
+	"HEAD":                 1,
+	"BLOCK":                2,
+	"ATTESTATION":          3,
+	"FINALIZED_CHECKPOINT": 4,
+	"JUSTIFIED_CHECKPOINT": 5,
+	"CHAIN_REORG":          6,
+	"VOLUNTARY_EXIT":       7,
+}
+
+func (x EventTopic) String() string {
+	return proto.EnumName(EventTopic_name, int32(x))
+}
+
+func (EventTopic) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{2}
+}
+
 type BlockRequest struct {
 	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
 	RandaoReveal         []byte   `protobuf:"bytes,2,opt,name=randao_reveal,json=randaoReveal,proto3" json:"randao_reveal,omitempty"`
@@ -1204,9 +1246,412 @@ func (m *TreeBlockSlotRequest) GetSlotTo() uint64 {
 	return 0
 }
 
+type Eth1ConnectionStatusResponse struct {
+	ActiveEndpoint       string                `protobuf:"bytes,1,opt,name=active_endpoint,json=activeEndpoint,proto3" json:"active_endpoint,omitempty"`
+	Endpoints            []*Eth1EndpointStatus `protobuf:"bytes,2,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Eth1ConnectionStatusResponse) Reset()         { *m = Eth1ConnectionStatusResponse{} }
+func (m *Eth1ConnectionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*Eth1ConnectionStatusResponse) ProtoMessage()    {}
+func (*Eth1ConnectionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{20}
+}
+
+func (m *Eth1ConnectionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1ConnectionStatusResponse.Unmarshal(m, b)
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1ConnectionStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1ConnectionStatusResponse.Merge(m, src)
+}
+func (m *Eth1ConnectionStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_Eth1ConnectionStatusResponse.Size(m)
+}
+func (m *Eth1ConnectionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1ConnectionStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1ConnectionStatusResponse proto.InternalMessageInfo
+
+func (m *Eth1ConnectionStatusResponse) GetActiveEndpoint() string {
+	if m != nil {
+		return m.ActiveEndpoint
+	}
+	return ""
+}
+
+func (m *Eth1ConnectionStatusResponse) GetEndpoints() []*Eth1EndpointStatus {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
+}
+
+type Eth1EndpointStatus struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Active               bool     `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	Healthy              bool     `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Score                float64  `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	HeadBlockNumber      uint64   `protobuf:"varint,5,opt,name=head_block_number,json=headBlockNumber,proto3" json:"head_block_number,omitempty"`
+	HeadBlockTime        uint64   `protobuf:"varint,6,opt,name=head_block_time,json=headBlockTime,proto3" json:"head_block_time,omitempty"`
+	ErrorRate            float64  `protobuf:"fixed64,7,opt,name=error_rate,json=errorRate,proto3" json:"error_rate,omitempty"`
+	LatencyMs            uint64   `protobuf:"varint,8,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	LastError            string   `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Eth1EndpointStatus) Reset()         { *m = Eth1EndpointStatus{} }
+func (m *Eth1EndpointStatus) String() string { return proto.CompactTextString(m) }
+func (*Eth1EndpointStatus) ProtoMessage()    {}
+func (*Eth1EndpointStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{21}
+}
+
+func (m *Eth1EndpointStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Eth1EndpointStatus.Unmarshal(m, b)
+}
+func (m *Eth1EndpointStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Eth1EndpointStatus.Marshal(b, m, deterministic)
+}
+func (m *Eth1EndpointStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Eth1EndpointStatus.Merge(m, src)
+}
+func (m *Eth1EndpointStatus) XXX_Size() int {
+	return xxx_messageInfo_Eth1EndpointStatus.Size(m)
+}
+func (m *Eth1EndpointStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_Eth1EndpointStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Eth1EndpointStatus proto.InternalMessageInfo
+
+func (m *Eth1EndpointStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Eth1EndpointStatus) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
+}
+
+func (m *Eth1EndpointStatus) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetHeadBlockNumber() uint64 {
+	if m != nil {
+		return m.HeadBlockNumber
+	}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetHeadBlockTime() uint64 {
+	if m != nil {
+		return m.HeadBlockTime
+	}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetErrorRate() float64 {
+	if m != nil {
+		return m.ErrorRate
+	}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetLatencyMs() uint64 {
+	if m != nil {
+		return m.LatencyMs
+	}
+	return 0
+}
+
+func (m *Eth1EndpointStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type StreamEventsRequest struct {
+	Topics               []EventTopic `protobuf:"varint,1,rep,packed,name=topics,proto3,enum=ethereum.beacon.rpc.v1.EventTopic" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *StreamEventsRequest) Reset()         { *m = StreamEventsRequest{} }
+func (m *StreamEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamEventsRequest) ProtoMessage()    {}
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{22}
+}
+
+func (m *StreamEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamEventsRequest.Unmarshal(m, b)
+}
+func (m *StreamEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamEventsRequest.Merge(m, src)
+}
+func (m *StreamEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamEventsRequest.Size(m)
+}
+func (m *StreamEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamEventsRequest proto.InternalMessageInfo
+
+func (m *StreamEventsRequest) GetTopics() []EventTopic {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+type Event struct {
+	Topic                EventTopic              `protobuf:"varint,1,opt,name=topic,proto3,enum=ethereum.beacon.rpc.v1.EventTopic" json:"topic,omitempty"`
+	MissedEvents         uint64                  `protobuf:"varint,2,opt,name=missed_events,json=missedEvents,proto3" json:"missed_events,omitempty"`
+	Head                 *HeadEvent              `protobuf:"bytes,3,opt,name=head,proto3" json:"head,omitempty"`
+	Block                *v1alpha1.BeaconBlock   `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	Attestation          *v1alpha1.Attestation   `protobuf:"bytes,5,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	Checkpoint           *v1alpha1.Checkpoint    `protobuf:"bytes,6,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	ChainReorg           *ChainReorgEvent        `protobuf:"bytes,7,opt,name=chain_reorg,json=chainReorg,proto3" json:"chain_reorg,omitempty"`
+	VoluntaryExit        *v1alpha1.VoluntaryExit `protobuf:"bytes,8,opt,name=voluntary_exit,json=voluntaryExit,proto3" json:"voluntary_exit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{23}
+}
+
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetTopic() EventTopic {
+	if m != nil {
+		return m.Topic
+	}
+	return EventTopic_UNKNOWN_TOPIC
+}
+
+func (m *Event) GetMissedEvents() uint64 {
+	if m != nil {
+		return m.MissedEvents
+	}
+	return 0
+}
+
+func (m *Event) GetHead() *HeadEvent {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *Event) GetBlock() *v1alpha1.BeaconBlock {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *Event) GetAttestation() *v1alpha1.Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return nil
+}
+
+func (m *Event) GetCheckpoint() *v1alpha1.Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return nil
+}
+
+func (m *Event) GetChainReorg() *ChainReorgEvent {
+	if m != nil {
+		return m.ChainReorg
+	}
+	return nil
+}
+
+func (m *Event) GetVoluntaryExit() *v1alpha1.VoluntaryExit {
+	if m != nil {
+		return m.VoluntaryExit
+	}
+	return nil
+}
+
+type HeadEvent struct {
+	Slot                 uint64   `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	BlockRoot            []byte   `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	StateRoot            []byte   `protobuf:"bytes,3,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HeadEvent) Reset()         { *m = HeadEvent{} }
+func (m *HeadEvent) String() string { return proto.CompactTextString(m) }
+func (*HeadEvent) ProtoMessage()    {}
+func (*HeadEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{24}
+}
+
+func (m *HeadEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HeadEvent.Unmarshal(m, b)
+}
+func (m *HeadEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HeadEvent.Marshal(b, m, deterministic)
+}
+func (m *HeadEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeadEvent.Merge(m, src)
+}
+func (m *HeadEvent) XXX_Size() int {
+	return xxx_messageInfo_HeadEvent.Size(m)
+}
+func (m *HeadEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeadEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HeadEvent proto.InternalMessageInfo
+
+func (m *HeadEvent) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
+	}
+	return 0
+}
+
+func (m *HeadEvent) GetBlockRoot() []byte {
+	if m != nil {
+		return m.BlockRoot
+	}
+	return nil
+}
+
+func (m *HeadEvent) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+type ChainReorgEvent struct {
+	OldHeadSlot          uint64   `protobuf:"varint,1,opt,name=old_head_slot,json=oldHeadSlot,proto3" json:"old_head_slot,omitempty"`
+	OldHeadRoot          []byte   `protobuf:"bytes,2,opt,name=old_head_root,json=oldHeadRoot,proto3" json:"old_head_root,omitempty"`
+	NewHeadSlot          uint64   `protobuf:"varint,3,opt,name=new_head_slot,json=newHeadSlot,proto3" json:"new_head_slot,omitempty"`
+	NewHeadRoot          []byte   `protobuf:"bytes,4,opt,name=new_head_root,json=newHeadRoot,proto3" json:"new_head_root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainReorgEvent) Reset()         { *m = ChainReorgEvent{} }
+func (m *ChainReorgEvent) String() string { return proto.CompactTextString(m) }
+func (*ChainReorgEvent) ProtoMessage()    {}
+func (*ChainReorgEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9eb4e94b85965285, []int{25}
+}
+
+func (m *ChainReorgEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainReorgEvent.Unmarshal(m, b)
+}
+func (m *ChainReorgEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainReorgEvent.Marshal(b, m, deterministic)
+}
+func (m *ChainReorgEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainReorgEvent.Merge(m, src)
+}
+func (m *ChainReorgEvent) XXX_Size() int {
+	return xxx_messageInfo_ChainReorgEvent.Size(m)
+}
+func (m *ChainReorgEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainReorgEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainReorgEvent proto.InternalMessageInfo
+
+func (m *ChainReorgEvent) GetOldHeadSlot() uint64 {
+	if m != nil {
+		return m.OldHeadSlot
+	}
+	return 0
+}
+
+func (m *ChainReorgEvent) GetOldHeadRoot() []byte {
+	if m != nil {
+		return m.OldHeadRoot
+	}
+	return nil
+}
+
+func (m *ChainReorgEvent) GetNewHeadSlot() uint64 {
+	if m != nil {
+		return m.NewHeadSlot
+	}
+	return 0
+}
+
+func (m *ChainReorgEvent) GetNewHeadRoot() []byte {
+	if m != nil {
+		return m.NewHeadRoot
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorRole", ValidatorRole_name, ValidatorRole_value)
 	proto.RegisterEnum("ethereum.beacon.rpc.v1.ValidatorStatus", ValidatorStatus_name, ValidatorStatus_value)
+	proto.RegisterEnum("ethereum.beacon.rpc.v1.EventTopic", EventTopic_name, EventTopic_value)
 	proto.RegisterType((*BlockRequest)(nil), "ethereum.beacon.rpc.v1.BlockRequest")
 	proto.RegisterType((*ProposeResponse)(nil), "ethereum.beacon.rpc.v1.ProposeResponse")
 	proto.RegisterType((*AttestationRequest)(nil), "ethereum.beacon.rpc.v1.AttestationRequest")
@@ -1230,128 +1675,171 @@ func init() {
 	proto.RegisterType((*BlockTreeResponse)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse")
 	proto.RegisterType((*BlockTreeResponse_TreeNode)(nil), "ethereum.beacon.rpc.v1.BlockTreeResponse.TreeNode")
 	proto.RegisterType((*TreeBlockSlotRequest)(nil), "ethereum.beacon.rpc.v1.TreeBlockSlotRequest")
+	proto.RegisterType((*Eth1ConnectionStatusResponse)(nil), "ethereum.beacon.rpc.v1.Eth1ConnectionStatusResponse")
+	proto.RegisterType((*Eth1EndpointStatus)(nil), "ethereum.beacon.rpc.v1.Eth1EndpointStatus")
+	proto.RegisterType((*StreamEventsRequest)(nil), "ethereum.beacon.rpc.v1.StreamEventsRequest")
+	proto.RegisterType((*Event)(nil), "ethereum.beacon.rpc.v1.Event")
+	proto.RegisterType((*HeadEvent)(nil), "ethereum.beacon.rpc.v1.HeadEvent")
+	proto.RegisterType((*ChainReorgEvent)(nil), "ethereum.beacon.rpc.v1.ChainReorgEvent")
 }
 
 func init() { proto.RegisterFile("proto/beacon/rpc/v1/services.proto", fileDescriptor_9eb4e94b85965285) }
 
 var fileDescriptor_9eb4e94b85965285 = []byte{
-	// 1855 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x0f, 0x28, 0x89, 0x91, 0x57, 0xb4, 0x44, 0x9f, 0x65, 0x59, 0xa6, 0xed, 0x31, 0x8a, 0x3a,
-	0xa9, 0xa4, 0x89, 0x40, 0x89, 0xce, 0x78, 0x52, 0x65, 0xdc, 0x94, 0x92, 0x68, 0x99, 0xb5, 0x86,
-	0x52, 0x40, 0xda, 0x4e, 0x27, 0x0f, 0xe8, 0x11, 0x3c, 0x93, 0x57, 0x13, 0x38, 0x18, 0x38, 0x32,
-	0x56, 0x1f, 0x3a, 0xd3, 0xbe, 0xf6, 0xa9, 0xe9, 0x07, 0xc8, 0x87, 0xe8, 0x43, 0x67, 0x3a, 0x9d,
-	0x3e, 0x76, 0xfa, 0xde, 0xc7, 0x76, 0xfa, 0x94, 0x87, 0x7e, 0x8c, 0xce, 0xfd, 0x01, 0x08, 0x91,
-	0xa2, 0x45, 0xe5, 0x89, 0xb8, 0xdd, 0xfd, 0xed, 0xee, 0xed, 0xed, 0xed, 0xee, 0x11, 0xac, 0x30,
-	0x62, 0x9c, 0x95, 0xdb, 0x04, 0x7b, 0x2c, 0x28, 0x47, 0xa1, 0x57, 0x1e, 0xee, 0x96, 0x63, 0x12,
-	0x0d, 0xa9, 0x47, 0x62, 0x5b, 0x32, 0xd1, 0x1a, 0xe1, 0x3d, 0x12, 0x91, 0x81, 0x6f, 0x2b, 0x31,
-	0x3b, 0x0a, 0x3d, 0x7b, 0xb8, 0x5b, 0xba, 0xdb, 0x65, 0xac, 0xdb, 0x27, 0x65, 0x29, 0xd5, 0x1e,
-	0xbc, 0x2e, 0x13, 0x3f, 0xe4, 0x67, 0x0a, 0x54, 0x7a, 0x70, 0x4e, 0x71, 0x58, 0x09, 0x85, 0x62,
-	0x7e, 0x16, 0x26, 0x5a, 0x4b, 0x1f, 0x29, 0x01, 0xc2, 0x7b, 0xe5, 0xe1, 0x2e, 0xee, 0x87, 0x3d,
-	0xbc, 0xab, 0xa5, 0xdd, 0x76, 0x9f, 0x79, 0x6f, 0xb4, 0xd8, 0xc3, 0x0b, 0xc4, 0x30, 0xe7, 0x24,
-	0xe6, 0x98, 0x53, 0x16, 0x68, 0xa9, 0x7b, 0xda, 0x15, 0x1c, 0xd2, 0x32, 0x0e, 0x02, 0xa6, 0x98,
-	0x89, 0xa9, 0x4f, 0xe4, 0x8f, 0xb7, 0xdd, 0x25, 0xc1, 0x76, 0xfc, 0x0d, 0xee, 0x76, 0x49, 0x54,
-	0x66, 0xa1, 0x94, 0x98, 0x94, 0xb6, 0x8e, 0xa0, 0xb0, 0x2f, 0x1c, 0x70, 0xc8, 0xdb, 0x01, 0x89,
-	0x39, 0x42, 0x30, 0x1f, 0xf7, 0x19, 0x5f, 0x37, 0x4c, 0x63, 0x63, 0xde, 0x91, 0xdf, 0xe8, 0xc7,
-	0x70, 0x3d, 0xc2, 0x41, 0x07, 0x33, 0x37, 0x22, 0x43, 0x82, 0xfb, 0xeb, 0x39, 0xd3, 0xd8, 0x28,
-	0x38, 0x05, 0x45, 0x74, 0x24, 0xcd, 0xda, 0x81, 0x95, 0xd3, 0x88, 0x85, 0x2c, 0x26, 0x0e, 0x89,
-	0x43, 0x16, 0xc4, 0x04, 0xdd, 0x07, 0x90, 0x9b, 0x73, 0x23, 0xa6, 0x35, 0x16, 0x9c, 0x6b, 0x92,
-	0xe2, 0x30, 0xc6, 0xad, 0x21, 0xa0, 0xea, 0x68, 0x6f, 0x89, 0x03, 0xf7, 0x01, 0xc2, 0x41, 0xbb,
-	0x4f, 0x3d, 0xf7, 0x0d, 0x39, 0x4b, 0x40, 0x8a, 0xf2, 0x9c, 0x9c, 0xa1, 0xdb, 0xf0, 0x61, 0xc8,
-	0x3c, 0xb7, 0x4d, 0xb9, 0xf6, 0x22, 0x1f, 0x32, 0x6f, 0x9f, 0x8e, 0x1c, 0x9f, 0xcb, 0x38, 0xbe,
-	0x0a, 0x0b, 0x71, 0x0f, 0x47, 0x9d, 0xf5, 0x79, 0x49, 0x54, 0x0b, 0xeb, 0x21, 0x2c, 0x2b, 0xbb,
-	0xa9, 0xa3, 0x08, 0xe6, 0x33, 0x2e, 0xca, 0x6f, 0xeb, 0x14, 0xee, 0xbe, 0xc4, 0x7d, 0xda, 0xc1,
-	0x9c, 0x45, 0xa7, 0x24, 0x7a, 0xcd, 0x22, 0x1f, 0x07, 0x1e, 0x79, 0x5f, 0x9c, 0xce, 0xbb, 0x9e,
-	0x1b, 0x73, 0xdd, 0xfa, 0xde, 0x80, 0x7b, 0x17, 0xab, 0xd4, 0x6e, 0xac, 0xc3, 0x87, 0x6d, 0xdc,
-	0x17, 0x24, 0xad, 0x36, 0x59, 0xa2, 0x4d, 0x28, 0x72, 0xc6, 0x71, 0xdf, 0x1d, 0x26, 0xf8, 0x58,
-	0xea, 0x9f, 0x77, 0x56, 0x24, 0x3d, 0x55, 0x1b, 0xa3, 0xc7, 0x70, 0x5b, 0x89, 0x62, 0x8f, 0xd3,
-	0x21, 0xc9, 0x22, 0x54, 0x68, 0x6e, 0x49, 0x76, 0x55, 0x72, 0x33, 0xb8, 0x23, 0x30, 0xf1, 0x90,
-	0x44, 0xb8, 0x4b, 0x26, 0x90, 0x6e, 0xe2, 0x95, 0x08, 0x63, 0xce, 0xb9, 0xaf, 0xe5, 0xc6, 0x54,
-	0xec, 0x2b, 0x21, 0xeb, 0x09, 0x94, 0x52, 0x9a, 0x14, 0x39, 0x77, 0xbc, 0x0f, 0x60, 0x69, 0x14,
-	0xa3, 0x78, 0xdd, 0x30, 0xe7, 0x36, 0x0a, 0x0e, 0xa4, 0x41, 0x8a, 0xad, 0xef, 0x72, 0x99, 0xc0,
-	0x67, 0xf1, 0x3a, 0x48, 0x8f, 0xe1, 0x16, 0x56, 0x54, 0xd2, 0x71, 0x27, 0x54, 0xed, 0xe7, 0xd6,
-	0x0d, 0xe7, 0x66, 0x2a, 0x70, 0x9a, 0xea, 0x45, 0x2f, 0x61, 0x51, 0x64, 0xda, 0x20, 0x26, 0x22,
-	0x74, 0x73, 0x1b, 0x4b, 0x95, 0x3d, 0xfb, 0xe2, 0xab, 0x6e, 0xbf, 0xc7, 0xbc, 0xdd, 0x94, 0x3a,
-	0x9c, 0x54, 0x57, 0x29, 0x84, 0xbc, 0xa2, 0x5d, 0x96, 0xb9, 0x47, 0x90, 0x57, 0x20, 0x79, 0x72,
-	0x4b, 0x95, 0xf2, 0xa5, 0xe6, 0xb5, 0x2d, 0x6d, 0xda, 0xd1, 0x70, 0x6b, 0x0f, 0x6e, 0xd7, 0xde,
-	0x51, 0x4e, 0x3a, 0xa3, 0xd3, 0x9b, 0x39, 0xba, 0x9f, 0xc3, 0xfa, 0x24, 0x56, 0x47, 0xf6, 0x52,
-	0xf0, 0x97, 0x80, 0x0e, 0x7a, 0x98, 0x06, 0x4d, 0x8e, 0x23, 0x9e, 0xcd, 0xda, 0x58, 0x10, 0x48,
-	0x47, 0xee, 0x79, 0xd1, 0x49, 0x96, 0xe8, 0x47, 0x50, 0xe8, 0x92, 0x80, 0xc4, 0x34, 0x76, 0x39,
-	0xf5, 0x89, 0xce, 0xd8, 0x25, 0x4d, 0x6b, 0x51, 0x9f, 0x58, 0x8f, 0xe1, 0x56, 0xea, 0x49, 0x3d,
-	0xe8, 0x90, 0x77, 0xb3, 0x95, 0x01, 0xcb, 0x86, 0xb5, 0x71, 0x9c, 0x76, 0x67, 0x15, 0x16, 0xa8,
-	0x20, 0xe8, 0x2b, 0xa4, 0x16, 0xd6, 0x0b, 0xb8, 0x51, 0x8d, 0x63, 0xda, 0x0d, 0x7c, 0x12, 0xf0,
-	0x4c, 0xb4, 0x48, 0xc8, 0xbc, 0x9e, 0x2b, 0x1d, 0xd6, 0x00, 0x90, 0x24, 0xb9, 0xc5, 0xf1, 0x88,
-	0xe4, 0x26, 0x22, 0xf2, 0xbf, 0x1c, 0xa0, 0xac, 0x5e, 0xed, 0xc3, 0x5b, 0x58, 0x1d, 0x5d, 0x1e,
-	0x9c, 0xf2, 0x65, 0x48, 0x97, 0x2a, 0x3f, 0x9b, 0x76, 0xf0, 0x93, 0x9a, 0x32, 0xa9, 0x38, 0xe2,
-	0xdd, 0x1c, 0x4e, 0x12, 0x4b, 0xff, 0x35, 0xe0, 0xe6, 0x05, 0xc2, 0xe8, 0x1e, 0x5c, 0xf3, 0x98,
-	0xef, 0x53, 0xce, 0x09, 0x91, 0xf6, 0xe7, 0x9d, 0x11, 0x61, 0x54, 0x20, 0x73, 0x99, 0x02, 0x79,
-	0x61, 0x29, 0x7d, 0x00, 0x4b, 0x34, 0x76, 0x43, 0x55, 0xe1, 0x23, 0x59, 0x09, 0x16, 0x1d, 0xa0,
-	0xb1, 0xae, 0xf9, 0xd1, 0xd8, 0x81, 0x2d, 0x8c, 0x67, 0xff, 0x17, 0x69, 0xf6, 0xe7, 0x4d, 0x63,
-	0x63, 0xb9, 0xf2, 0x93, 0x59, 0xb3, 0x3f, 0xc9, 0xfa, 0xbf, 0xe4, 0xe0, 0xf6, 0x94, 0x9b, 0x91,
-	0x51, 0x6e, 0xfc, 0x20, 0xe5, 0xe8, 0xa7, 0x70, 0x87, 0xf0, 0xde, 0xae, 0xdb, 0x21, 0x21, 0x8b,
-	0x29, 0x57, 0x3d, 0xd9, 0x0d, 0x06, 0x7e, 0x9b, 0x44, 0x3a, 0x36, 0x62, 0x2e, 0xd8, 0x3d, 0x54,
-	0x7c, 0xd9, 0x31, 0x1b, 0x92, 0x8b, 0x3e, 0x85, 0xb5, 0x04, 0x45, 0x03, 0xaf, 0x3f, 0x88, 0x29,
-	0x0b, 0xdc, 0x4c, 0xf8, 0x56, 0x35, 0xb7, 0x9e, 0x30, 0x9b, 0x22, 0x9c, 0x9b, 0x50, 0xc4, 0x69,
-	0x71, 0x71, 0x65, 0xca, 0xe9, 0x26, 0xb5, 0x32, 0xa2, 0xd7, 0x04, 0x19, 0x7d, 0x01, 0xf7, 0xa4,
-	0x02, 0x21, 0x48, 0x03, 0x37, 0x03, 0x7b, 0x3b, 0x20, 0x03, 0x22, 0x43, 0x3d, 0xef, 0xdc, 0x49,
-	0x64, 0xea, 0xc1, 0xa8, 0x6a, 0x7d, 0x29, 0x04, 0xac, 0x27, 0x70, 0xfd, 0x90, 0xf9, 0x98, 0xa6,
-	0x35, 0x78, 0x15, 0x16, 0x94, 0x45, 0x7d, 0x45, 0xe4, 0x02, 0xad, 0x41, 0xbe, 0x23, 0xc5, 0x92,
-	0xc6, 0xaa, 0x56, 0xd6, 0xe7, 0xb0, 0x9c, 0xc0, 0x75, 0xb8, 0x37, 0xa1, 0x28, 0xf2, 0x0b, 0xf3,
-	0x41, 0x44, 0x5c, 0x8d, 0x51, 0xaa, 0x56, 0x52, 0xba, 0x82, 0x58, 0x7f, 0xcc, 0xc1, 0x0d, 0x19,
-	0xad, 0x56, 0x44, 0x46, 0x8d, 0xee, 0x29, 0xcc, 0xf3, 0x48, 0xe7, 0xe3, 0x52, 0xa5, 0x32, 0xed,
-	0xb4, 0x26, 0x80, 0xb6, 0x58, 0x34, 0x58, 0x87, 0x38, 0x12, 0x5f, 0xfa, 0xb3, 0x01, 0x8b, 0x09,
-	0x09, 0x7d, 0x06, 0x0b, 0xf2, 0xd8, 0xa4, 0x2b, 0x4b, 0x15, 0x6b, 0xa4, 0x95, 0xf0, 0x9e, 0x9d,
-	0x8c, 0x53, 0xf6, 0xbe, 0x34, 0xa1, 0x66, 0x1e, 0x05, 0x18, 0x9b, 0x53, 0x72, 0x63, 0x73, 0x0a,
-	0xda, 0x06, 0x14, 0xe2, 0x88, 0x53, 0x8f, 0x86, 0xb2, 0xe9, 0x0c, 0x19, 0x27, 0x49, 0x33, 0xbd,
-	0x91, 0xe5, 0xbc, 0x14, 0x0c, 0x71, 0x53, 0x74, 0xaf, 0x96, 0x72, 0xea, 0x54, 0x41, 0xb5, 0x69,
-	0x41, 0xb1, 0x8e, 0x61, 0x55, 0x38, 0x2d, 0x5d, 0x10, 0xc9, 0x90, 0x1c, 0xcb, 0x5d, 0xb8, 0x26,
-	0xf2, 0xc6, 0x7d, 0x1d, 0x31, 0x5f, 0xc7, 0x73, 0x51, 0x10, 0x9e, 0x46, 0xcc, 0x17, 0x73, 0x8f,
-	0x64, 0x72, 0xa6, 0xf3, 0x31, 0x2f, 0x96, 0x2d, 0xb6, 0xf5, 0x19, 0x5c, 0x4f, 0xb3, 0xda, 0x61,
-	0x7d, 0x82, 0x96, 0xe0, 0xc3, 0x17, 0x8d, 0xe7, 0x8d, 0x93, 0x57, 0x8d, 0xe2, 0x07, 0xa8, 0x00,
-	0x8b, 0xd5, 0x56, 0xab, 0xd6, 0x6c, 0xd5, 0x9c, 0xa2, 0x21, 0x56, 0xa7, 0xce, 0xc9, 0xe9, 0x49,
-	0xb3, 0xe6, 0x14, 0x73, 0x5b, 0x7f, 0x30, 0x60, 0x65, 0xec, 0x42, 0x20, 0x04, 0xcb, 0x1a, 0xec,
-	0x36, 0x5b, 0xd5, 0xd6, 0x8b, 0x66, 0xf1, 0x03, 0x41, 0x3b, 0xad, 0x35, 0x0e, 0xeb, 0x8d, 0x23,
-	0xb7, 0x7a, 0xd0, 0xaa, 0xbf, 0xac, 0x15, 0x0d, 0x04, 0x90, 0xd7, 0xdf, 0x39, 0xc1, 0xaf, 0x37,
-	0xea, 0xad, 0x7a, 0xb5, 0x55, 0x3b, 0x74, 0x6b, 0x5f, 0xd5, 0x5b, 0xc5, 0x39, 0x54, 0x84, 0xc2,
-	0xab, 0x7a, 0xeb, 0xd9, 0xa1, 0x53, 0x7d, 0x55, 0xdd, 0x3f, 0xae, 0x15, 0xe7, 0x05, 0x42, 0xf0,
-	0x6a, 0x87, 0xc5, 0x05, 0x81, 0x50, 0xdf, 0x6e, 0xf3, 0xb8, 0xda, 0x7c, 0x56, 0x3b, 0x2c, 0xe6,
-	0x2b, 0xff, 0x98, 0x83, 0xeb, 0xea, 0x6c, 0x9a, 0x6a, 0x20, 0x47, 0xbf, 0x84, 0x1b, 0xaf, 0x30,
-	0xe5, 0x4f, 0x59, 0x34, 0xea, 0x3a, 0x68, 0xcd, 0x56, 0xc3, 0xaf, 0x9d, 0xcc, 0xe1, 0x76, 0x4d,
-	0xcc, 0xe1, 0xa5, 0xad, 0x69, 0x49, 0x34, 0xd9, 0xb1, 0x76, 0x0c, 0xf4, 0x1c, 0xae, 0x1f, 0xe0,
-	0x80, 0x05, 0xd4, 0xc3, 0xfd, 0x67, 0x04, 0x77, 0xa6, 0xaa, 0x9d, 0x21, 0x8b, 0xd0, 0x77, 0x06,
-	0x5c, 0x4b, 0x53, 0x75, 0xaa, 0xa6, 0xcd, 0x99, 0xb3, 0xdc, 0x3a, 0xf9, 0xb6, 0xba, 0x83, 0xec,
-	0xa7, 0x84, 0x7b, 0x3d, 0x12, 0x9b, 0x32, 0x11, 0x4d, 0x91, 0xef, 0x66, 0x4c, 0x03, 0x8f, 0x98,
-	0x7d, 0x1c, 0x73, 0xf3, 0x35, 0x0d, 0x70, 0x9f, 0xfe, 0x86, 0x74, 0x14, 0xdf, 0xfe, 0xfd, 0xbf,
-	0xbe, 0xff, 0x53, 0x6e, 0x0d, 0xad, 0x8a, 0x87, 0x87, 0x7e, 0x86, 0x48, 0x86, 0xc0, 0xa1, 0x37,
-	0x50, 0x4c, 0xad, 0xec, 0x9f, 0x89, 0x9c, 0x8b, 0xd1, 0x27, 0xd3, 0xfc, 0xb9, 0x28, 0x37, 0xaf,
-	0xe0, 0x7d, 0xe5, 0x3f, 0x06, 0xac, 0xa8, 0xf9, 0x9a, 0x44, 0xc9, 0x51, 0xf6, 0x00, 0x69, 0x4d,
-	0x99, 0x89, 0x1f, 0x4d, 0x3d, 0xb3, 0xc9, 0x67, 0x41, 0xe9, 0xe3, 0x29, 0x07, 0x91, 0x11, 0x3d,
-	0xc4, 0x1c, 0x23, 0x17, 0x6e, 0x34, 0x07, 0x6d, 0x9f, 0x9e, 0x33, 0x64, 0x5d, 0x0e, 0xce, 0x1a,
-	0xb8, 0xc8, 0x99, 0x74, 0x7b, 0xff, 0x34, 0xd2, 0x87, 0x4e, 0xba, 0xbd, 0xaf, 0xa0, 0xa0, 0xfd,
-	0x54, 0x19, 0xf1, 0xf0, 0xbd, 0xd1, 0x4a, 0xb6, 0x34, 0x4b, 0x6e, 0x7d, 0x0d, 0x05, 0x6d, 0x4c,
-	0xad, 0x67, 0xc0, 0x94, 0xa6, 0x76, 0xbf, 0xb1, 0xf7, 0x59, 0xe5, 0x6f, 0x79, 0x28, 0x8e, 0x0a,
-	0x80, 0xde, 0xcb, 0xd7, 0x00, 0xaa, 0x76, 0xcb, 0x70, 0x7e, 0x34, 0x4d, 0xd7, 0xb9, 0x8e, 0x32,
-	0x3d, 0x78, 0x63, 0x9d, 0xe3, 0xb7, 0xe9, 0x95, 0x1e, 0x35, 0x29, 0x54, 0xb9, 0xd2, 0x1c, 0xae,
-	0x0c, 0x3e, 0xfa, 0x01, 0xb3, 0xfb, 0x8e, 0x81, 0x18, 0x2c, 0x9f, 0x1f, 0x1b, 0xd1, 0xf6, 0xa5,
-	0x8a, 0xb2, 0x63, 0x69, 0xc9, 0x9e, 0x55, 0x5c, 0x6f, 0xb8, 0x0f, 0x37, 0x0f, 0x92, 0x69, 0x2b,
-	0x33, 0x95, 0x6d, 0xce, 0x32, 0x02, 0x2a, 0x8b, 0x5b, 0xb3, 0x4f, 0x8b, 0xe8, 0xed, 0x64, 0x41,
-	0xbf, 0xe2, 0xfe, 0xae, 0xfa, 0x28, 0x41, 0xbf, 0x33, 0x60, 0xf5, 0xa2, 0x47, 0x2d, 0xba, 0xfc,
-	0x84, 0x26, 0x5f, 0xd5, 0xa5, 0x4f, 0xaf, 0x06, 0xd2, 0x3e, 0x0c, 0xa0, 0x38, 0xfe, 0xa8, 0x41,
-	0x53, 0x37, 0x32, 0xe5, 0xe9, 0x54, 0xda, 0x99, 0x1d, 0xa0, 0xcc, 0xee, 0xff, 0x7d, 0xee, 0xdb,
-	0xea, 0x5f, 0xe7, 0xd0, 0xbf, 0x0d, 0x58, 0x38, 0x8d, 0xce, 0x62, 0x1f, 0x3d, 0xfc, 0x45, 0xf3,
-	0xa4, 0x61, 0x3a, 0xa7, 0x07, 0x66, 0xf2, 0x9f, 0x92, 0x19, 0x46, 0x6c, 0x48, 0x3b, 0xa2, 0x4a,
-	0x9f, 0x99, 0x52, 0xc8, 0xb6, 0x0e, 0x60, 0x59, 0x7e, 0x61, 0x4e, 0x3d, 0xf3, 0x18, 0xb7, 0x63,
-	0x74, 0xa7, 0xc7, 0x79, 0x18, 0xef, 0x95, 0xcb, 0x61, 0x42, 0xef, 0xe3, 0x76, 0x6c, 0x7b, 0xcc,
-	0x2f, 0xad, 0x71, 0x82, 0xfd, 0x9f, 0x4f, 0xd0, 0xb7, 0x7e, 0x05, 0x0f, 0x8e, 0x1a, 0x2f, 0xcc,
-	0x23, 0x12, 0x90, 0x08, 0xf7, 0x4d, 0xf5, 0xce, 0x35, 0x8f, 0xa9, 0x47, 0x82, 0x98, 0x98, 0xc3,
-	0x47, 0xf6, 0x0e, 0x7a, 0x92, 0x68, 0xed, 0x52, 0xde, 0x1b, 0xb4, 0x05, 0xec, 0xbc, 0x01, 0xb5,
-	0x12, 0x6d, 0xa2, 0x5d, 0xf6, 0xb1, 0x28, 0xd7, 0xe5, 0xe3, 0xfa, 0x41, 0xad, 0xd1, 0xac, 0xd9,
-	0x7e, 0xa7, 0xb2, 0xb0, 0x63, 0xef, 0xd8, 0x3b, 0xa5, 0x15, 0x1c, 0x52, 0x3b, 0x8c, 0xce, 0xa4,
-	0xe5, 0x80, 0xf0, 0x2d, 0x23, 0x57, 0x29, 0xe2, 0x30, 0xec, 0x53, 0x4f, 0x5e, 0xae, 0xf2, 0xaf,
-	0x63, 0x16, 0x54, 0xee, 0x64, 0x29, 0xdd, 0x28, 0xf4, 0xb6, 0xbf, 0x21, 0xed, 0x6d, 0x4e, 0xde,
-	0xf1, 0x29, 0xac, 0xf7, 0xa0, 0x04, 0x6b, 0x6f, 0xc2, 0xc4, 0xde, 0x74, 0x13, 0xd1, 0x63, 0x51,
-	0x24, 0xcf, 0x62, 0xdf, 0x3c, 0x92, 0x3b, 0x45, 0x1f, 0xcf, 0xb6, 0xf3, 0x76, 0x5e, 0xb6, 0xe8,
-	0x47, 0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xdb, 0x85, 0xd9, 0x17, 0x14, 0x00, 0x00,
+	// 2436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x59, 0x4f, 0x73, 0xdb, 0xd6,
+	0x11, 0x0f, 0x28, 0x92, 0x96, 0x96, 0x92, 0x08, 0x3d, 0x2b, 0x32, 0x4d, 0xdb, 0x13, 0x06, 0x75,
+	0x1c, 0x5b, 0x8d, 0x41, 0x89, 0x49, 0x3d, 0xa9, 0x33, 0x69, 0x4a, 0x51, 0xb4, 0xc4, 0x58, 0xa5,
+	0x14, 0x90, 0xb6, 0x93, 0xa6, 0x1d, 0xf4, 0x11, 0x7c, 0x16, 0x51, 0x93, 0x78, 0x30, 0xf0, 0x48,
+	0x5b, 0x3d, 0x74, 0xa6, 0x3d, 0xb6, 0xa7, 0xa4, 0xf7, 0x66, 0xfa, 0x19, 0x7a, 0xe8, 0x4c, 0xa7,
+	0xd3, 0x73, 0xef, 0x3d, 0xb6, 0xd3, 0x53, 0x0e, 0xfd, 0x06, 0xbd, 0x76, 0xde, 0x1f, 0x80, 0x10,
+	0x29, 0x4a, 0x54, 0x4e, 0xe2, 0xdb, 0xdd, 0xdf, 0xee, 0xbe, 0x7d, 0xfb, 0x76, 0x1f, 0x56, 0x60,
+	0xf8, 0x01, 0x65, 0xb4, 0xdc, 0x21, 0xd8, 0xa1, 0x5e, 0x39, 0xf0, 0x9d, 0xf2, 0x68, 0xbb, 0x1c,
+	0x92, 0x60, 0xe4, 0x3a, 0x24, 0x34, 0x05, 0x13, 0x6d, 0x10, 0xd6, 0x23, 0x01, 0x19, 0x0e, 0x4c,
+	0x29, 0x66, 0x06, 0xbe, 0x63, 0x8e, 0xb6, 0x8b, 0x37, 0x8e, 0x29, 0x3d, 0xee, 0x93, 0xb2, 0x90,
+	0xea, 0x0c, 0x9f, 0x97, 0xc9, 0xc0, 0x67, 0x27, 0x12, 0x54, 0x7c, 0x47, 0x2a, 0x26, 0xac, 0x57,
+	0x1e, 0x6d, 0xe3, 0xbe, 0xdf, 0xc3, 0xdb, 0xca, 0x8a, 0xdd, 0xe9, 0x53, 0xe7, 0x85, 0x12, 0xbb,
+	0x7d, 0x86, 0x18, 0x66, 0x8c, 0x84, 0x0c, 0x33, 0x97, 0x7a, 0x4a, 0xea, 0xa6, 0xb2, 0x84, 0x7d,
+	0xb7, 0x8c, 0x3d, 0x8f, 0x4a, 0xa6, 0xf2, 0xaf, 0xf8, 0x9e, 0xf8, 0xe3, 0xdc, 0x3f, 0x26, 0xde,
+	0xfd, 0xf0, 0x15, 0x3e, 0x3e, 0x26, 0x41, 0x99, 0xfa, 0x42, 0x62, 0x5a, 0xda, 0xd8, 0x83, 0xe5,
+	0x1d, 0xee, 0x80, 0x45, 0x5e, 0x0e, 0x49, 0xc8, 0x10, 0x82, 0x74, 0xd8, 0xa7, 0xac, 0xa0, 0x95,
+	0xb4, 0xbb, 0x69, 0x4b, 0xfc, 0x46, 0xdf, 0x83, 0x95, 0x00, 0x7b, 0x5d, 0x4c, 0xed, 0x80, 0x8c,
+	0x08, 0xee, 0x17, 0x52, 0x25, 0xed, 0xee, 0xb2, 0xb5, 0x2c, 0x89, 0x96, 0xa0, 0x19, 0x5b, 0x90,
+	0x3f, 0x0a, 0xa8, 0x4f, 0x43, 0x62, 0x91, 0xd0, 0xa7, 0x5e, 0x48, 0xd0, 0x2d, 0x00, 0xb1, 0x39,
+	0x3b, 0xa0, 0x4a, 0xe3, 0xb2, 0xb5, 0x24, 0x28, 0x16, 0xa5, 0xcc, 0x18, 0x01, 0xaa, 0x8e, 0xf7,
+	0x16, 0x39, 0x70, 0x0b, 0xc0, 0x1f, 0x76, 0xfa, 0xae, 0x63, 0xbf, 0x20, 0x27, 0x11, 0x48, 0x52,
+	0x1e, 0x93, 0x13, 0x74, 0x0d, 0xae, 0xf8, 0xd4, 0xb1, 0x3b, 0x2e, 0x53, 0x5e, 0x64, 0x7d, 0xea,
+	0xec, 0xb8, 0x63, 0xc7, 0x17, 0x12, 0x8e, 0xaf, 0x43, 0x26, 0xec, 0xe1, 0xa0, 0x5b, 0x48, 0x0b,
+	0xa2, 0x5c, 0x18, 0xb7, 0x61, 0x55, 0xda, 0x8d, 0x1d, 0x45, 0x90, 0x4e, 0xb8, 0x28, 0x7e, 0x1b,
+	0x47, 0x70, 0xe3, 0x29, 0xee, 0xbb, 0x5d, 0xcc, 0x68, 0x70, 0x44, 0x82, 0xe7, 0x34, 0x18, 0x60,
+	0xcf, 0x21, 0xe7, 0xc5, 0xe9, 0xb4, 0xeb, 0xa9, 0x09, 0xd7, 0x8d, 0x6f, 0x35, 0xb8, 0x79, 0xb6,
+	0x4a, 0xe5, 0x46, 0x01, 0xae, 0x74, 0x70, 0x9f, 0x93, 0x94, 0xda, 0x68, 0x89, 0xee, 0x81, 0xce,
+	0x28, 0xc3, 0x7d, 0x7b, 0x14, 0xe1, 0x43, 0xa1, 0x3f, 0x6d, 0xe5, 0x05, 0x3d, 0x56, 0x1b, 0xa2,
+	0x07, 0x70, 0x4d, 0x8a, 0x62, 0x87, 0xb9, 0x23, 0x92, 0x44, 0xc8, 0xd0, 0xbc, 0x29, 0xd8, 0x55,
+	0xc1, 0x4d, 0xe0, 0xf6, 0xa0, 0x84, 0x47, 0x24, 0xc0, 0xc7, 0x64, 0x0a, 0x69, 0x47, 0x5e, 0xf1,
+	0x30, 0xa6, 0xac, 0x5b, 0x4a, 0x6e, 0x42, 0xc5, 0x8e, 0x14, 0x32, 0x3e, 0x86, 0x62, 0x4c, 0x13,
+	0x22, 0xa7, 0x8e, 0xf7, 0x2d, 0xc8, 0x8d, 0x63, 0x14, 0x16, 0xb4, 0xd2, 0xc2, 0xdd, 0x65, 0x0b,
+	0xe2, 0x20, 0x85, 0xc6, 0x37, 0x29, 0xb8, 0x71, 0x26, 0x5e, 0x05, 0xe9, 0x01, 0xbc, 0x89, 0x25,
+	0x95, 0x74, 0xed, 0x29, 0x55, 0x3b, 0xa9, 0x82, 0x66, 0x5d, 0x8d, 0x05, 0x8e, 0x62, 0xbd, 0xe8,
+	0x29, 0x2c, 0xf2, 0x4c, 0x1b, 0x86, 0x84, 0x87, 0x6e, 0xe1, 0x6e, 0xae, 0xf2, 0xd0, 0x3c, 0xfb,
+	0x26, 0x9b, 0xe7, 0x98, 0x37, 0x5b, 0x42, 0x87, 0x15, 0xeb, 0x2a, 0xfa, 0x90, 0x95, 0xb4, 0x8b,
+	0x32, 0x77, 0x0f, 0xb2, 0x12, 0x24, 0x4e, 0x2e, 0x57, 0x29, 0x5f, 0x68, 0x5e, 0xd9, 0x52, 0xa6,
+	0x2d, 0x05, 0x37, 0x1e, 0xc2, 0xb5, 0xfa, 0x6b, 0x97, 0x91, 0xee, 0xf8, 0xf4, 0xe6, 0x8e, 0xee,
+	0x47, 0x50, 0x98, 0xc6, 0xaa, 0xc8, 0x5e, 0x08, 0xfe, 0x0c, 0x50, 0xad, 0x87, 0x5d, 0xaf, 0xc5,
+	0x70, 0xc0, 0x92, 0x59, 0x1b, 0x72, 0x02, 0xe9, 0x8a, 0x3d, 0x2f, 0x5a, 0xd1, 0x12, 0xbd, 0x0d,
+	0xcb, 0xc7, 0xc4, 0x23, 0xa1, 0x1b, 0xda, 0xcc, 0x1d, 0x10, 0x95, 0xb1, 0x39, 0x45, 0x6b, 0xbb,
+	0x03, 0x62, 0x3c, 0x80, 0x37, 0x63, 0x4f, 0x1a, 0x5e, 0x97, 0xbc, 0x9e, 0xaf, 0x0c, 0x18, 0x26,
+	0x6c, 0x4c, 0xe2, 0x94, 0x3b, 0xeb, 0x90, 0x71, 0x39, 0x41, 0x5d, 0x21, 0xb9, 0x30, 0x9e, 0xc0,
+	0x5a, 0x35, 0x0c, 0xdd, 0x63, 0x6f, 0x40, 0x3c, 0x96, 0x88, 0x16, 0xf1, 0xa9, 0xd3, 0xb3, 0x85,
+	0xc3, 0x0a, 0x00, 0x82, 0x24, 0xb6, 0x38, 0x19, 0x91, 0xd4, 0x54, 0x44, 0xfe, 0x9b, 0x02, 0x94,
+	0xd4, 0xab, 0x7c, 0x78, 0x09, 0xeb, 0xe3, 0xcb, 0x83, 0x63, 0xbe, 0x08, 0x69, 0xae, 0xf2, 0xa3,
+	0x59, 0x07, 0x3f, 0xad, 0x29, 0x91, 0x8a, 0x63, 0xde, 0xd5, 0xd1, 0x34, 0xb1, 0xf8, 0x1f, 0x0d,
+	0xae, 0x9e, 0x21, 0x8c, 0x6e, 0xc2, 0x92, 0x43, 0x07, 0x03, 0x97, 0x31, 0x42, 0x84, 0xfd, 0xb4,
+	0x35, 0x26, 0x8c, 0x0b, 0x64, 0x2a, 0x51, 0x20, 0xcf, 0x2c, 0xa5, 0x6f, 0x41, 0xce, 0x0d, 0x6d,
+	0x5f, 0x56, 0xf8, 0x40, 0x54, 0x82, 0x45, 0x0b, 0xdc, 0x50, 0xd5, 0xfc, 0x60, 0xe2, 0xc0, 0x32,
+	0x93, 0xd9, 0xff, 0x49, 0x9c, 0xfd, 0xd9, 0x92, 0x76, 0x77, 0xb5, 0xf2, 0xee, 0xbc, 0xd9, 0x1f,
+	0x65, 0xfd, 0x5f, 0x52, 0x70, 0x6d, 0xc6, 0xcd, 0x48, 0x28, 0xd7, 0xbe, 0x93, 0x72, 0xf4, 0x43,
+	0xb8, 0x4e, 0x58, 0x6f, 0xdb, 0xee, 0x12, 0x9f, 0x86, 0x2e, 0x93, 0x3d, 0xd9, 0xf6, 0x86, 0x83,
+	0x0e, 0x09, 0x54, 0x6c, 0x78, 0xdb, 0xdf, 0xde, 0x95, 0x7c, 0xd1, 0x31, 0x9b, 0x82, 0x8b, 0x3e,
+	0x80, 0x8d, 0x08, 0xe5, 0x7a, 0x4e, 0x7f, 0x18, 0xba, 0xd4, 0xb3, 0x13, 0xe1, 0x5b, 0x57, 0xdc,
+	0x46, 0xc4, 0x6c, 0xf1, 0x70, 0xde, 0x03, 0x1d, 0xc7, 0xc5, 0xc5, 0x16, 0x29, 0xa7, 0x9a, 0x54,
+	0x7e, 0x4c, 0xaf, 0x73, 0x32, 0xfa, 0x04, 0x6e, 0x0a, 0x05, 0x5c, 0xd0, 0xf5, 0xec, 0x04, 0xec,
+	0xe5, 0x90, 0x0c, 0x89, 0x08, 0x75, 0xda, 0xba, 0x1e, 0xc9, 0x34, 0xbc, 0x71, 0xd5, 0xfa, 0x8c,
+	0x0b, 0x18, 0x1f, 0xc3, 0xca, 0x2e, 0x1d, 0x60, 0x37, 0xae, 0xc1, 0xeb, 0x90, 0x91, 0x16, 0xd5,
+	0x15, 0x11, 0x0b, 0xb4, 0x01, 0xd9, 0xae, 0x10, 0x8b, 0x1a, 0xab, 0x5c, 0x19, 0x1f, 0xc1, 0x6a,
+	0x04, 0x57, 0xe1, 0xbe, 0x07, 0x3a, 0xcf, 0x2f, 0xcc, 0x86, 0x01, 0xb1, 0x15, 0x46, 0xaa, 0xca,
+	0xc7, 0x74, 0x09, 0x31, 0xbe, 0x4a, 0xc1, 0x9a, 0x88, 0x56, 0x3b, 0x20, 0xe3, 0x46, 0xf7, 0x08,
+	0xd2, 0x2c, 0x50, 0xf9, 0x98, 0xab, 0x54, 0x66, 0x9d, 0xd6, 0x14, 0xd0, 0xe4, 0x8b, 0x26, 0xed,
+	0x12, 0x4b, 0xe0, 0x8b, 0x7f, 0xd6, 0x60, 0x31, 0x22, 0xa1, 0x0f, 0x21, 0x23, 0x8e, 0x4d, 0xb8,
+	0x92, 0xab, 0x18, 0x63, 0xad, 0x84, 0xf5, 0xcc, 0xe8, 0x39, 0x65, 0xee, 0x08, 0x13, 0x42, 0xb5,
+	0x25, 0x01, 0x13, 0xef, 0x94, 0xd4, 0xc4, 0x3b, 0x05, 0xdd, 0x07, 0xe4, 0xe3, 0x80, 0xb9, 0x8e,
+	0xeb, 0x8b, 0xa6, 0x33, 0xa2, 0x8c, 0x44, 0xcd, 0x74, 0x2d, 0xc9, 0x79, 0xca, 0x19, 0xfc, 0xa6,
+	0xa8, 0x5e, 0x2d, 0xe4, 0xe4, 0xa9, 0x82, 0x6c, 0xd3, 0x9c, 0x62, 0x1c, 0xc0, 0x3a, 0x77, 0x5a,
+	0xb8, 0xc0, 0x93, 0x21, 0x3a, 0x96, 0x1b, 0xb0, 0xc4, 0xf3, 0xc6, 0x7e, 0x1e, 0xd0, 0x81, 0x8a,
+	0xe7, 0x22, 0x27, 0x3c, 0x0a, 0xe8, 0x80, 0xbf, 0x7b, 0x04, 0x93, 0x51, 0x95, 0x8f, 0x59, 0xbe,
+	0x6c, 0x53, 0xe3, 0x2b, 0x0d, 0x6e, 0xd6, 0x59, 0x6f, 0xbb, 0x46, 0x3d, 0x8f, 0x38, 0xfc, 0xd4,
+	0x27, 0x2e, 0xc7, 0xbb, 0x90, 0x57, 0x0d, 0x9d, 0x78, 0x5d, 0x9f, 0xba, 0x9e, 0xac, 0x74, 0x4b,
+	0xd6, 0xaa, 0x24, 0xd7, 0x15, 0x15, 0xed, 0xc3, 0x52, 0x24, 0x11, 0xb5, 0xc8, 0xcd, 0x59, 0x47,
+	0xc3, 0x2d, 0x46, 0x40, 0x65, 0x6f, 0x0c, 0xe6, 0x3d, 0x1c, 0x4d, 0x4b, 0x20, 0x1d, 0x16, 0x86,
+	0x41, 0x5f, 0x59, 0xe7, 0x3f, 0x79, 0xce, 0x49, 0x27, 0xc4, 0xa6, 0x16, 0x2d, 0xb5, 0xe2, 0x3d,
+	0xa5, 0x47, 0x70, 0x9f, 0xf5, 0x4e, 0x44, 0x9c, 0x17, 0xad, 0x68, 0x29, 0x2a, 0x96, 0x43, 0x03,
+	0xf9, 0x16, 0xd1, 0x2c, 0xb9, 0x40, 0x9b, 0xb0, 0xd6, 0x23, 0xb8, 0x7b, 0xfa, 0xde, 0xca, 0x8b,
+	0x91, 0xe7, 0x8c, 0xe4, 0x85, 0xbd, 0x03, 0xf9, 0x84, 0xac, 0x68, 0x4c, 0x59, 0x21, 0xb9, 0x12,
+	0x4b, 0xf2, 0xd6, 0xc4, 0xb3, 0x82, 0x04, 0x01, 0x0d, 0xec, 0x00, 0x33, 0x52, 0xb8, 0x22, 0xcc,
+	0x2d, 0x09, 0x8a, 0x85, 0x99, 0x60, 0xf7, 0x31, 0x23, 0x9e, 0x73, 0x62, 0x0f, 0xc2, 0xc2, 0xa2,
+	0xd0, 0xb0, 0xa4, 0x28, 0x3f, 0x09, 0x25, 0x3b, 0x64, 0xb6, 0x00, 0x14, 0x96, 0xc4, 0x96, 0x97,
+	0x38, 0xa5, 0xce, 0x09, 0xc6, 0x67, 0x70, 0xb5, 0xc5, 0x02, 0x82, 0x07, 0xf5, 0x11, 0xf1, 0x58,
+	0xdc, 0xbf, 0x1f, 0x42, 0x96, 0x51, 0xdf, 0x75, 0x64, 0xf7, 0x5d, 0xad, 0x18, 0x33, 0xe3, 0xcf,
+	0x61, 0x6d, 0x2e, 0x6a, 0x29, 0x84, 0xf1, 0xbf, 0x05, 0xc8, 0x08, 0x32, 0xbf, 0x09, 0x82, 0xa6,
+	0xaa, 0xe1, 0x3c, 0x4a, 0x24, 0x80, 0xbf, 0xf4, 0x07, 0x6e, 0x18, 0x92, 0xae, 0x4d, 0x84, 0x5f,
+	0x2a, 0xd7, 0x96, 0x25, 0x51, 0xfa, 0x8a, 0x7e, 0x00, 0x69, 0x1e, 0x29, 0x71, 0x32, 0xb9, 0xca,
+	0xdb, 0xb3, 0xb4, 0xef, 0x13, 0x2c, 0x11, 0x96, 0x10, 0x1f, 0xdf, 0xcf, 0xf4, 0x65, 0xef, 0xe7,
+	0x2e, 0xe4, 0x12, 0x1f, 0x41, 0x85, 0xcc, 0xb9, 0xf8, 0xe4, 0x27, 0x45, 0x12, 0x86, 0xaa, 0x00,
+	0x4e, 0x8f, 0x38, 0x2f, 0xe4, 0x15, 0xc8, 0x4e, 0x3a, 0x7f, 0x4a, 0x49, 0x2d, 0x16, 0xb4, 0x12,
+	0x20, 0xb4, 0x0f, 0x39, 0x87, 0x3f, 0x80, 0xec, 0x80, 0xd0, 0xe0, 0x58, 0xe4, 0x44, 0x6e, 0x76,
+	0xb3, 0x11, 0x6f, 0x25, 0x8b, 0x4b, 0xca, 0x30, 0x80, 0x13, 0x13, 0xd0, 0x63, 0x58, 0x1d, 0xd1,
+	0xfe, 0xd0, 0x63, 0x38, 0x38, 0xb1, 0xc9, 0x6b, 0x97, 0x89, 0x0c, 0xca, 0x55, 0x6e, 0xcf, 0x70,
+	0xe8, 0x69, 0x24, 0xcc, 0x5f, 0x6f, 0xd6, 0xca, 0x28, 0xb9, 0x34, 0x7e, 0x0e, 0x4b, 0x71, 0xb0,
+	0x67, 0x7d, 0x98, 0x9c, 0x57, 0xe0, 0x6e, 0x01, 0xf0, 0x20, 0x11, 0xc9, 0x5e, 0x90, 0x6c, 0x41,
+	0xe1, 0x6c, 0xe3, 0x4f, 0x1a, 0xe4, 0x27, 0xf6, 0x82, 0x0c, 0x58, 0xa1, 0xfd, 0xae, 0x2d, 0x2e,
+	0x52, 0xc2, 0x5c, 0x8e, 0xf6, 0xbb, 0xdc, 0x15, 0xd1, 0xe3, 0x92, 0x32, 0x09, 0xc3, 0x91, 0x8c,
+	0x30, 0x6d, 0xc0, 0x8a, 0x47, 0x5e, 0x25, 0xf4, 0xc8, 0xb2, 0x9a, 0xf3, 0xc8, 0xab, 0xa4, 0x9e,
+	0x58, 0x46, 0xe8, 0x49, 0x4b, 0x3d, 0x4a, 0x86, 0xeb, 0xd9, 0xfc, 0x10, 0x56, 0xe2, 0xde, 0x6e,
+	0xd1, 0x3e, 0x41, 0x39, 0xb8, 0xf2, 0xa4, 0xf9, 0xb8, 0x79, 0xf8, 0xac, 0xa9, 0xbf, 0x81, 0x96,
+	0x61, 0xb1, 0xda, 0x6e, 0xd7, 0x5b, 0xed, 0xba, 0xa5, 0x6b, 0x7c, 0x75, 0x64, 0x1d, 0x1e, 0x1d,
+	0xb6, 0xea, 0x96, 0x9e, 0xda, 0xfc, 0xbd, 0x06, 0xf9, 0x89, 0x67, 0x01, 0x42, 0xb0, 0xaa, 0xc0,
+	0x76, 0xab, 0x5d, 0x6d, 0x3f, 0x69, 0xe9, 0x6f, 0x70, 0xda, 0x51, 0xbd, 0xb9, 0xdb, 0x68, 0xee,
+	0xd9, 0xd5, 0x5a, 0xbb, 0xf1, 0xb4, 0xae, 0x6b, 0x08, 0x20, 0xab, 0x7e, 0xa7, 0x38, 0xbf, 0xd1,
+	0x6c, 0xb4, 0x1b, 0xd5, 0x76, 0x7d, 0xd7, 0xae, 0x7f, 0xde, 0x68, 0xeb, 0x0b, 0x48, 0x87, 0xe5,
+	0x67, 0x8d, 0xf6, 0xfe, 0xae, 0x55, 0x7d, 0x56, 0xdd, 0x39, 0xa8, 0xeb, 0x69, 0x8e, 0xe0, 0xbc,
+	0xfa, 0xae, 0x9e, 0xe1, 0x08, 0xf9, 0xdb, 0x6e, 0x1d, 0x54, 0x5b, 0xfb, 0xf5, 0x5d, 0x3d, 0xbb,
+	0xf9, 0x47, 0x0d, 0x60, 0x7c, 0x2d, 0xd1, 0x1a, 0xac, 0x44, 0x8e, 0xb4, 0x0f, 0x8f, 0x1a, 0x35,
+	0xfd, 0x0d, 0xb4, 0x08, 0xe9, 0xfd, 0x7a, 0x75, 0x57, 0xd7, 0xd0, 0x12, 0x64, 0x76, 0x0e, 0x0e,
+	0x6b, 0x8f, 0xf5, 0x14, 0xca, 0x43, 0x4e, 0x6e, 0xb0, 0xda, 0x6e, 0x1c, 0x36, 0xf5, 0x05, 0x54,
+	0x80, 0xf5, 0x47, 0x8d, 0x66, 0xf5, 0xa0, 0xf1, 0xd3, 0xfa, 0xae, 0x5d, 0xdb, 0xaf, 0xd7, 0x1e,
+	0x1f, 0x1d, 0x36, 0x9a, 0x6d, 0x3d, 0xcd, 0x39, 0x9f, 0x3e, 0x69, 0xb5, 0x1b, 0x8f, 0x1a, 0xa7,
+	0x39, 0x19, 0xae, 0xa4, 0xb6, 0x5f, 0x6d, 0x34, 0x6d, 0xab, 0x7e, 0x68, 0xed, 0xe9, 0x59, 0xee,
+	0xe0, 0xd3, 0xc3, 0x83, 0x27, 0xcd, 0x76, 0xd5, 0xfa, 0x42, 0x6e, 0xe9, 0x4a, 0xe5, 0x77, 0x19,
+	0x58, 0x91, 0x57, 0xb4, 0x25, 0xc7, 0x22, 0xe8, 0x0b, 0x58, 0x7b, 0x86, 0x5d, 0xf6, 0x88, 0x06,
+	0xe3, 0x8f, 0x03, 0xb4, 0x61, 0xca, 0x19, 0x85, 0x19, 0x4d, 0x43, 0xcc, 0x3a, 0x9f, 0x86, 0x14,
+	0x37, 0xcf, 0xbd, 0x2c, 0xa7, 0x3e, 0x2c, 0xb6, 0x34, 0xf4, 0x18, 0x56, 0x6a, 0xd8, 0xa3, 0x9e,
+	0xeb, 0xe0, 0x3e, 0x3f, 0xea, 0x99, 0x6a, 0xe7, 0x28, 0x26, 0xe8, 0x1b, 0x0d, 0x96, 0xe2, 0x17,
+	0xc5, 0x4c, 0x4d, 0xf7, 0xe6, 0x7e, 0x8c, 0x18, 0x87, 0x5f, 0x57, 0xb7, 0x90, 0xf9, 0x88, 0x30,
+	0xa7, 0x47, 0xc2, 0x92, 0xb8, 0x4e, 0x25, 0x16, 0x10, 0x52, 0x0a, 0x5d, 0xcf, 0x21, 0x25, 0x5e,
+	0xec, 0x4b, 0xcf, 0x5d, 0x0f, 0xf7, 0xdd, 0x5f, 0x91, 0xae, 0xe4, 0x9b, 0xbf, 0xfd, 0xe7, 0xb7,
+	0x7f, 0x48, 0x6d, 0xa0, 0x75, 0x3e, 0x57, 0x52, 0x53, 0x26, 0xc1, 0xe0, 0x38, 0xf4, 0x02, 0xf4,
+	0xd8, 0xca, 0xce, 0x09, 0xcf, 0xfd, 0x10, 0xbd, 0x37, 0xcb, 0x9f, 0xb3, 0x9e, 0x10, 0x97, 0xf0,
+	0x1e, 0x75, 0x61, 0xfd, 0xac, 0x67, 0xc3, 0xcc, 0xc0, 0x7c, 0x70, 0xde, 0x53, 0x60, 0xe6, 0xe3,
+	0xe3, 0x67, 0xb0, 0x9c, 0xec, 0x73, 0xe8, 0xfb, 0xb3, 0xb4, 0x9c, 0xd1, 0x0d, 0x8b, 0xb7, 0xce,
+	0x6d, 0x5c, 0x5b, 0x5a, 0xe5, 0xdf, 0x1a, 0xe4, 0x65, 0xbd, 0x27, 0x41, 0x94, 0x8e, 0x3d, 0x40,
+	0x0a, 0x9f, 0xe8, 0x04, 0x68, 0x66, 0xde, 0x4d, 0x4f, 0xa0, 0x8a, 0x77, 0x2e, 0xee, 0x2c, 0xbb,
+	0x98, 0x61, 0x64, 0xc3, 0x5a, 0x6b, 0xd8, 0x19, 0xb8, 0xa7, 0x0c, 0xcd, 0xd1, 0x96, 0x8a, 0x77,
+	0xce, 0x77, 0x26, 0x0a, 0x5e, 0xe5, 0x1f, 0x5a, 0x3c, 0x53, 0x8b, 0xb7, 0xf7, 0x39, 0x2c, 0x2b,
+	0x3f, 0x65, 0x56, 0xdf, 0x3e, 0xf7, 0xc4, 0xa3, 0x2d, 0xcd, 0x73, 0x3f, 0xbe, 0x84, 0x65, 0x65,
+	0x4c, 0xae, 0xe7, 0xc0, 0x14, 0x67, 0xf6, 0xbe, 0x89, 0x51, 0x60, 0xe5, 0x6f, 0x59, 0xd0, 0xc7,
+	0x55, 0x56, 0xed, 0xe5, 0x4b, 0x00, 0xf9, 0x99, 0x20, 0xc2, 0xf9, 0xce, 0x2c, 0x5d, 0xa7, 0x3e,
+	0x5e, 0x8a, 0x77, 0x2e, 0x12, 0x53, 0x99, 0xf7, 0xeb, 0xb8, 0x2c, 0x8d, 0xbf, 0x87, 0x50, 0xe5,
+	0x52, 0x23, 0x1f, 0x69, 0xf0, 0xfd, 0xef, 0x30, 0x26, 0xda, 0xd2, 0x10, 0x85, 0xd5, 0xd3, 0x13,
+	0x0a, 0x74, 0xff, 0x42, 0x45, 0xc9, 0x09, 0x48, 0xd1, 0x9c, 0x57, 0x5c, 0x6d, 0xb8, 0x0f, 0x57,
+	0x6b, 0xd1, 0x87, 0x7d, 0x62, 0x00, 0x70, 0x6f, 0x9e, 0x69, 0x83, 0xb4, 0xb8, 0x39, 0xff, 0x60,
+	0x02, 0xbd, 0x9c, 0xee, 0x9a, 0x97, 0xdc, 0xdf, 0x65, 0xe7, 0x5f, 0xe8, 0x37, 0x1a, 0xac, 0x9f,
+	0x35, 0x3f, 0x45, 0x17, 0x9f, 0xd0, 0xf4, 0x00, 0xb7, 0xf8, 0xc1, 0xe5, 0x40, 0xca, 0x87, 0x21,
+	0xe8, 0x93, 0xf3, 0x33, 0x34, 0x73, 0x23, 0x33, 0xa6, 0x74, 0xc5, 0xad, 0xf9, 0x01, 0xd2, 0xec,
+	0xce, 0xdf, 0x17, 0xbe, 0xae, 0xfe, 0x75, 0x01, 0xfd, 0x4b, 0x83, 0xcc, 0x51, 0x70, 0x12, 0x0e,
+	0xd0, 0xed, 0x4f, 0x5b, 0x87, 0xcd, 0x92, 0x75, 0x54, 0x2b, 0x45, 0xff, 0x9d, 0x28, 0xf9, 0x01,
+	0x1d, 0xb9, 0x5d, 0xde, 0x69, 0x4e, 0x4a, 0x42, 0xc8, 0x34, 0x6a, 0xb0, 0x2a, 0x7e, 0x61, 0xe6,
+	0x3a, 0xa5, 0x03, 0xdc, 0x09, 0xd1, 0xf5, 0x1e, 0x63, 0x7e, 0xf8, 0xb0, 0x5c, 0xf6, 0x23, 0x7a,
+	0x1f, 0x77, 0x42, 0xd3, 0xa1, 0x83, 0xe2, 0x06, 0x23, 0x78, 0xf0, 0xe3, 0x29, 0xfa, 0xe6, 0x2f,
+	0xe0, 0xad, 0xbd, 0xe6, 0x93, 0xd2, 0x1e, 0xf1, 0x48, 0x80, 0xfb, 0x25, 0x39, 0x52, 0x2d, 0x1d,
+	0xb8, 0x0e, 0xf1, 0x42, 0x52, 0x1a, 0xbd, 0x6f, 0x6e, 0xa1, 0x8f, 0x23, 0xad, 0xc7, 0x2e, 0xeb,
+	0x0d, 0x3b, 0x1c, 0x76, 0xda, 0x80, 0x5c, 0xf1, 0x56, 0xd7, 0x29, 0x0f, 0x30, 0x2f, 0xd7, 0xe5,
+	0x83, 0x46, 0xad, 0xde, 0x6c, 0xd5, 0xcd, 0x41, 0xb7, 0x92, 0xd9, 0x32, 0xb7, 0xcc, 0xad, 0x62,
+	0x1e, 0xfb, 0xae, 0xe9, 0x07, 0x27, 0xc2, 0xb2, 0x47, 0xd8, 0xa6, 0x96, 0xaa, 0xe8, 0xd8, 0xf7,
+	0xfb, 0xae, 0x23, 0x2e, 0x57, 0xf9, 0x97, 0x21, 0xf5, 0x2a, 0xd7, 0x93, 0x94, 0xe3, 0xc0, 0x77,
+	0xee, 0xbf, 0x22, 0x9d, 0xfb, 0x8c, 0xbc, 0x66, 0x33, 0x58, 0xe7, 0xa0, 0x38, 0xeb, 0xe1, 0x94,
+	0x89, 0x87, 0xb3, 0x4d, 0x04, 0x0f, 0x78, 0x91, 0x3c, 0x09, 0x07, 0xa5, 0x3d, 0xb1, 0x53, 0x74,
+	0x67, 0xbe, 0x9d, 0x77, 0xb2, 0xa2, 0x9b, 0xbe, 0xff, 0xff, 0x01, 0x00, 0xaf, 0x6c, 0x63, 0xe7,
+	0x61, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1370,6 +1858,8 @@ type BeaconServiceClient interface {
 	CanonicalHead(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*v1alpha1.BeaconBlock, error)
 	BlockTree(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*BlockTreeResponse, error)
 	BlockTreeBySlots(ctx context.Context, in *TreeBlockSlotRequest, opts ...grpc.CallOption) (*BlockTreeResponse, error)
+	Eth1ConnectionStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatusResponse, error)
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (BeaconService_StreamEventsClient, error)
 }
 
 type beaconServiceClient struct {
@@ -1439,12 +1929,55 @@ func (c *beaconServiceClient) BlockTreeBySlots(ctx context.Context, in *TreeBloc
 	return out, nil
 }
 
+func (c *beaconServiceClient) Eth1ConnectionStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*Eth1ConnectionStatusResponse, error) {
+	out := new(Eth1ConnectionStatusResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.beacon.rpc.v1.BeaconService/Eth1ConnectionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *beaconServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (BeaconService_StreamEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BeaconService_serviceDesc.Streams[1], "/ethereum.beacon.rpc.v1.BeaconService/StreamEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &beaconServiceStreamEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BeaconService_StreamEventsClient interface {
+	Recv() (*Event, error)
+	grpc.ClientStream
+}
+
+type beaconServiceStreamEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *beaconServiceStreamEventsClient) Recv() (*Event, error) {
+	m := new(Event)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BeaconServiceServer is the server API for BeaconService service.
 type BeaconServiceServer interface {
 	WaitForChainStart(*empty.Empty, BeaconService_WaitForChainStartServer) error
 	CanonicalHead(context.Context, *empty.Empty) (*v1alpha1.BeaconBlock, error)
 	BlockTree(context.Context, *empty.Empty) (*BlockTreeResponse, error)
 	BlockTreeBySlots(context.Context, *TreeBlockSlotRequest) (*BlockTreeResponse, error)
+	Eth1ConnectionStatus(context.Context, *empty.Empty) (*Eth1ConnectionStatusResponse, error)
+	StreamEvents(*StreamEventsRequest, BeaconService_StreamEventsServer) error
 }
 
 func RegisterBeaconServiceServer(s *grpc.Server, srv BeaconServiceServer) {
@@ -1526,6 +2059,45 @@ func _BeaconService_BlockTreeBySlots_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BeaconService_Eth1ConnectionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BeaconServiceServer).Eth1ConnectionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.beacon.rpc.v1.BeaconService/Eth1ConnectionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconServiceServer).Eth1ConnectionStatus(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BeaconService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BeaconServiceServer).StreamEvents(m, &beaconServiceStreamEventsServer{stream})
+}
+
+type BeaconService_StreamEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type beaconServiceStreamEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *beaconServiceStreamEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _BeaconService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethereum.beacon.rpc.v1.BeaconService",
 	HandlerType: (*BeaconServiceServer)(nil),
@@ -1542,6 +2114,10 @@ var _BeaconService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockTreeBySlots",
 			Handler:    _BeaconService_BlockTreeBySlots_Handler,
 		},
+		{
+			MethodName: "Eth1ConnectionStatus",
+			Handler:    _BeaconService_Eth1ConnectionStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1549,6 +2125,11 @@ var _BeaconService_serviceDesc = grpc.ServiceDesc{
 			Handler:       _BeaconService_WaitForChainStart_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _BeaconService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/beacon/rpc/v1/services.proto",
 }
@@ -631,20 +631,286 @@ func (m *ChainHead) GetPreviousJustifiedBlockRoot() []byte {
 	return nil
 }
 
+type StateSelector struct {
+	// Types that are valid to be assigned to Selector:
+	//	*StateSelector_Head
+	//	*StateSelector_Finalized
+	//	*StateSelector_Justified
+	//	*StateSelector_Slot
+	//	*StateSelector_StateRoot
+	//	*StateSelector_BlockRoot
+	Selector             isStateSelector_Selector `protobuf_oneof:"selector"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *StateSelector) Reset()         { *m = StateSelector{} }
+func (m *StateSelector) String() string { return proto.CompactTextString(m) }
+func (*StateSelector) ProtoMessage()    {}
+func (*StateSelector) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{5}
+}
+func (m *StateSelector) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateSelector) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateSelector.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StateSelector) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateSelector.Merge(m, src)
+}
+func (m *StateSelector) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateSelector) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateSelector.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateSelector proto.InternalMessageInfo
+
+type isStateSelector_Selector interface {
+	isStateSelector_Selector()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type StateSelector_Head struct {
+	Head bool `protobuf:"varint,1,opt,name=head,proto3,oneof"`
+}
+type StateSelector_Finalized struct {
+	Finalized bool `protobuf:"varint,2,opt,name=finalized,proto3,oneof"`
+}
+type StateSelector_Justified struct {
+	Justified bool `protobuf:"varint,3,opt,name=justified,proto3,oneof"`
+}
+type StateSelector_Slot struct {
+	Slot uint64 `protobuf:"varint,4,opt,name=slot,proto3,oneof"`
+}
+type StateSelector_StateRoot struct {
+	StateRoot []byte `protobuf:"bytes,5,opt,name=state_root,json=stateRoot,proto3,oneof"`
+}
+type StateSelector_BlockRoot struct {
+	BlockRoot []byte `protobuf:"bytes,6,opt,name=block_root,json=blockRoot,proto3,oneof"`
+}
+
+func (*StateSelector_Head) isStateSelector_Selector()      {}
+func (*StateSelector_Finalized) isStateSelector_Selector() {}
+func (*StateSelector_Justified) isStateSelector_Selector() {}
+func (*StateSelector_Slot) isStateSelector_Selector()      {}
+func (*StateSelector_StateRoot) isStateSelector_Selector() {}
+func (*StateSelector_BlockRoot) isStateSelector_Selector() {}
+
+func (m *StateSelector) GetSelector() isStateSelector_Selector {
+	if m != nil {
+		return m.Selector
+	}
+	return nil
+}
+
+func (m *StateSelector) GetHead() bool {
+	if x, ok := m.GetSelector().(*StateSelector_Head); ok {
+		return x.Head
+	}
+	return false
+}
+
+func (m *StateSelector) GetFinalized() bool {
+	if x, ok := m.GetSelector().(*StateSelector_Finalized); ok {
+		return x.Finalized
+	}
+	return false
+}
+
+func (m *StateSelector) GetJustified() bool {
+	if x, ok := m.GetSelector().(*StateSelector_Justified); ok {
+		return x.Justified
+	}
+	return false
+}
+
+func (m *StateSelector) GetSlot() uint64 {
+	if x, ok := m.GetSelector().(*StateSelector_Slot); ok {
+		return x.Slot
+	}
+	return 0
+}
+
+func (m *StateSelector) GetStateRoot() []byte {
+	if x, ok := m.GetSelector().(*StateSelector_StateRoot); ok {
+		return x.StateRoot
+	}
+	return nil
+}
+
+func (m *StateSelector) GetBlockRoot() []byte {
+	if x, ok := m.GetSelector().(*StateSelector_BlockRoot); ok {
+		return x.BlockRoot
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*StateSelector) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _StateSelector_OneofMarshaler, _StateSelector_OneofUnmarshaler, _StateSelector_OneofSizer, []interface{}{
+		(*StateSelector_Head)(nil),
+		(*StateSelector_Finalized)(nil),
+		(*StateSelector_Justified)(nil),
+		(*StateSelector_Slot)(nil),
+		(*StateSelector_StateRoot)(nil),
+		(*StateSelector_BlockRoot)(nil),
+	}
+}
+
+func _StateSelector_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*StateSelector)
+	// selector
+	switch x := m.Selector.(type) {
+	case *StateSelector_Head:
+		t := uint64(0)
+		if x.Head {
+			t = 1
+		}
+		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case *StateSelector_Finalized:
+		t := uint64(0)
+		if x.Finalized {
+			t = 1
+		}
+		_ = b.EncodeVarint(2<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case *StateSelector_Justified:
+		t := uint64(0)
+		if x.Justified {
+			t = 1
+		}
+		_ = b.EncodeVarint(3<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case *StateSelector_Slot:
+		_ = b.EncodeVarint(4<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.Slot))
+	case *StateSelector_StateRoot:
+		_ = b.EncodeVarint(5<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.StateRoot)
+	case *StateSelector_BlockRoot:
+		_ = b.EncodeVarint(6<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.BlockRoot)
+	case nil:
+	default:
+		return fmt.Errorf("StateSelector.Selector has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _StateSelector_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*StateSelector)
+	switch tag {
+	case 1: // selector.head
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Selector = &StateSelector_Head{x != 0}
+		return true, err
+	case 2: // selector.finalized
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Selector = &StateSelector_Finalized{x != 0}
+		return true, err
+	case 3: // selector.justified
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Selector = &StateSelector_Justified{x != 0}
+		return true, err
+	case 4: // selector.slot
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Selector = &StateSelector_Slot{x}
+		return true, err
+	case 5: // selector.state_root
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Selector = &StateSelector_StateRoot{x}
+		return true, err
+	case 6: // selector.block_root
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Selector = &StateSelector_BlockRoot{x}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _StateSelector_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*StateSelector)
+	// selector
+	switch x := m.Selector.(type) {
+	case *StateSelector_Head:
+		n += 1 // tag and wire
+		n += 1
+	case *StateSelector_Finalized:
+		n += 1 // tag and wire
+		n += 1
+	case *StateSelector_Justified:
+		n += 1 // tag and wire
+		n += 1
+	case *StateSelector_Slot:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Slot))
+	case *StateSelector_StateRoot:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.StateRoot)))
+		n += len(x.StateRoot)
+	case *StateSelector_BlockRoot:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.BlockRoot)))
+		n += len(x.BlockRoot)
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type GetValidatorBalancesRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
-	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Types that are valid to be assigned to QueryFilter:
+	//	*GetValidatorBalancesRequest_Epoch
+	//	*GetValidatorBalancesRequest_Genesis
+	QueryFilter          isGetValidatorBalancesRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PublicKeys           [][]byte                                  `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices              []uint64                                  `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	State                *StateSelector                            `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                  `json:"-"`
+	XXX_unrecognized     []byte                                    `json:"-"`
+	XXX_sizecache        int32                                     `json:"-"`
 }
 
 func (m *GetValidatorBalancesRequest) Reset()         { *m = GetValidatorBalancesRequest{} }
 func (m *GetValidatorBalancesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorBalancesRequest) ProtoMessage()    {}
 func (*GetValidatorBalancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{5}
+	return fileDescriptor_678c88b69c3c78d4, []int{6}
 }
 func (m *GetValidatorBalancesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -673,13 +939,43 @@ func (m *GetValidatorBalancesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetValidatorBalancesRequest proto.InternalMessageInfo
 
-func (m *GetValidatorBalancesRequest) GetEpoch() uint64 {
+type isGetValidatorBalancesRequest_QueryFilter interface {
+	isGetValidatorBalancesRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type GetValidatorBalancesRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+type GetValidatorBalancesRequest_Genesis struct {
+	Genesis bool `protobuf:"varint,5,opt,name=genesis,proto3,oneof"`
+}
+
+func (*GetValidatorBalancesRequest_Epoch) isGetValidatorBalancesRequest_QueryFilter()   {}
+func (*GetValidatorBalancesRequest_Genesis) isGetValidatorBalancesRequest_QueryFilter() {}
+
+func (m *GetValidatorBalancesRequest) GetQueryFilter() isGetValidatorBalancesRequest_QueryFilter {
 	if m != nil {
-		return m.Epoch
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *GetValidatorBalancesRequest) GetEpoch() uint64 {
+	if x, ok := m.GetQueryFilter().(*GetValidatorBalancesRequest_Epoch); ok {
+		return x.Epoch
 	}
 	return 0
 }
 
+func (m *GetValidatorBalancesRequest) GetGenesis() bool {
+	if x, ok := m.GetQueryFilter().(*GetValidatorBalancesRequest_Genesis); ok {
+		return x.Genesis
+	}
+	return false
+}
+
 func (m *GetValidatorBalancesRequest) GetPublicKeys() [][]byte {
 	if m != nil {
 		return m.PublicKeys
@@ -694,6 +990,81 @@ func (m *GetValidatorBalancesRequest) GetIndices() []uint64 {
 	return nil
 }
 
+func (m *GetValidatorBalancesRequest) GetState() *StateSelector {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GetValidatorBalancesRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GetValidatorBalancesRequest_OneofMarshaler, _GetValidatorBalancesRequest_OneofUnmarshaler, _GetValidatorBalancesRequest_OneofSizer, []interface{}{
+		(*GetValidatorBalancesRequest_Epoch)(nil),
+		(*GetValidatorBalancesRequest_Genesis)(nil),
+	}
+}
+
+func _GetValidatorBalancesRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GetValidatorBalancesRequest)
+	// query_filter
+	switch x := m.QueryFilter.(type) {
+	case *GetValidatorBalancesRequest_Epoch:
+		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.Epoch))
+	case *GetValidatorBalancesRequest_Genesis:
+		t := uint64(0)
+		if x.Genesis {
+			t = 1
+		}
+		_ = b.EncodeVarint(5<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("GetValidatorBalancesRequest.QueryFilter has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _GetValidatorBalancesRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GetValidatorBalancesRequest)
+	switch tag {
+	case 1: // query_filter.epoch
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.QueryFilter = &GetValidatorBalancesRequest_Epoch{x}
+		return true, err
+	case 5: // query_filter.genesis
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.QueryFilter = &GetValidatorBalancesRequest_Genesis{x != 0}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GetValidatorBalancesRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GetValidatorBalancesRequest)
+	// query_filter
+	switch x := m.QueryFilter.(type) {
+	case *GetValidatorBalancesRequest_Epoch:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Epoch))
+	case *GetValidatorBalancesRequest_Genesis:
+		n += 1 // tag and wire
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type ValidatorBalances struct {
 	Balances             []*ValidatorBalances_Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
@@ -705,7 +1076,7 @@ func (m *ValidatorBalances) Reset()         { *m = ValidatorBalances{} }
 func (m *ValidatorBalances) String() string { return proto.CompactTextString(m) }
 func (*ValidatorBalances) ProtoMessage()    {}
 func (*ValidatorBalances) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{6}
+	return fileDescriptor_678c88b69c3c78d4, []int{7}
 }
 func (m *ValidatorBalances) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +1125,7 @@ func (m *ValidatorBalances_Balance) Reset()         { *m = ValidatorBalances_Bal
 func (m *ValidatorBalances_Balance) String() string { return proto.CompactTextString(m) }
 func (*ValidatorBalances_Balance) ProtoMessage()    {}
 func (*ValidatorBalances_Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{6, 0}
+	return fileDescriptor_678c88b69c3c78d4, []int{7, 0}
 }
 func (m *ValidatorBalances_Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,6 +1182,7 @@ type GetValidatorsRequest struct {
 	QueryFilter          isGetValidatorsRequest_QueryFilter `protobuf_oneof:"query_filter"`
 	PageSize             int32                              `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	PageToken            string                             `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	State                *StateSelector                     `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
 	XXX_unrecognized     []byte                             `json:"-"`
 	XXX_sizecache        int32                              `json:"-"`
@@ -820,7 +1192,7 @@ func (m *GetValidatorsRequest) Reset()         { *m = GetValidatorsRequest{} }
 func (m *GetValidatorsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorsRequest) ProtoMessage()    {}
 func (*GetValidatorsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{7}
+	return fileDescriptor_678c88b69c3c78d4, []int{8}
 }
 func (m *GetValidatorsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -900,6 +1272,13 @@ func (m *GetValidatorsRequest) GetPageToken() string {
 	return ""
 }
 
+func (m *GetValidatorsRequest) GetState() *StateSelector {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*GetValidatorsRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _GetValidatorsRequest_OneofMarshaler, _GetValidatorsRequest_OneofUnmarshaler, _GetValidatorsRequest_OneofSizer, []interface{}{
@@ -982,7 +1361,7 @@ func (m *Validators) Reset()         { *m = Validators{} }
 func (m *Validators) String() string { return proto.CompactTextString(m) }
 func (*Validators) ProtoMessage()    {}
 func (*Validators) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{8}
+	return fileDescriptor_678c88b69c3c78d4, []int{9}
 }
 func (m *Validators) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1050,7 +1429,7 @@ func (m *GetValidatorActiveSetChangesRequest) Reset()         { *m = GetValidato
 func (m *GetValidatorActiveSetChangesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorActiveSetChangesRequest) ProtoMessage()    {}
 func (*GetValidatorActiveSetChangesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{9}
+	return fileDescriptor_678c88b69c3c78d4, []int{10}
 }
 func (m *GetValidatorActiveSetChangesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1100,7 +1479,7 @@ func (m *ActiveSetChanges) Reset()         { *m = ActiveSetChanges{} }
 func (m *ActiveSetChanges) String() string { return proto.CompactTextString(m) }
 func (*ActiveSetChanges) ProtoMessage()    {}
 func (*ActiveSetChanges) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{10}
+	return fileDescriptor_678c88b69c3c78d4, []int{11}
 }
 func (m *ActiveSetChanges) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1157,20 +1536,67 @@ func (m *ActiveSetChanges) GetEjectedPublicKeys() [][]byte {
 	return nil
 }
 
-type ValidatorQueue struct {
-	ChurnLimit           uint64   `protobuf:"varint,1,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
-	ActivationPublicKeys [][]byte `protobuf:"bytes,2,rep,name=activation_public_keys,json=activationPublicKeys,proto3" json:"activation_public_keys,omitempty" ssz-size:"?,48"`
-	ExitPublicKeys       [][]byte `protobuf:"bytes,3,rep,name=exit_public_keys,json=exitPublicKeys,proto3" json:"exit_public_keys,omitempty" ssz-size:"?,48"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetValidatorQueueRequest struct {
+	State                *StateSelector `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ValidatorQueue) Reset()         { *m = ValidatorQueue{} }
-func (m *ValidatorQueue) String() string { return proto.CompactTextString(m) }
-func (*ValidatorQueue) ProtoMessage()    {}
+func (m *GetValidatorQueueRequest) Reset()         { *m = GetValidatorQueueRequest{} }
+func (m *GetValidatorQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorQueueRequest) ProtoMessage()    {}
+func (*GetValidatorQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{12}
+}
+func (m *GetValidatorQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetValidatorQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValidatorQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetValidatorQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorQueueRequest.Merge(m, src)
+}
+func (m *GetValidatorQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetValidatorQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetValidatorQueueRequest proto.InternalMessageInfo
+
+func (m *GetValidatorQueueRequest) GetState() *StateSelector {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+type ValidatorQueue struct {
+	ChurnLimit           uint64   `protobuf:"varint,1,opt,name=churn_limit,json=churnLimit,proto3" json:"churn_limit,omitempty"`
+	ActivationPublicKeys [][]byte `protobuf:"bytes,2,rep,name=activation_public_keys,json=activationPublicKeys,proto3" json:"activation_public_keys,omitempty" ssz-size:"?,48"`
+	ExitPublicKeys       [][]byte `protobuf:"bytes,3,rep,name=exit_public_keys,json=exitPublicKeys,proto3" json:"exit_public_keys,omitempty" ssz-size:"?,48"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidatorQueue) Reset()         { *m = ValidatorQueue{} }
+func (m *ValidatorQueue) String() string { return proto.CompactTextString(m) }
+func (*ValidatorQueue) ProtoMessage()    {}
 func (*ValidatorQueue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{11}
+	return fileDescriptor_678c88b69c3c78d4, []int{13}
 }
 func (m *ValidatorQueue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1221,21 +1647,25 @@ func (m *ValidatorQueue) GetExitPublicKeys() [][]byte {
 }
 
 type ListValidatorAssignmentsRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
-	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
-	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken            string   `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Types that are valid to be assigned to QueryFilter:
+	//	*ListValidatorAssignmentsRequest_Epoch
+	//	*ListValidatorAssignmentsRequest_Genesis
+	QueryFilter          isListValidatorAssignmentsRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	PublicKeys           [][]byte                                      `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices              []uint64                                      `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	PageSize             int32                                         `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken            string                                        `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	State                *StateSelector                                `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *ListValidatorAssignmentsRequest) Reset()         { *m = ListValidatorAssignmentsRequest{} }
 func (m *ListValidatorAssignmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListValidatorAssignmentsRequest) ProtoMessage()    {}
 func (*ListValidatorAssignmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{12}
+	return fileDescriptor_678c88b69c3c78d4, []int{14}
 }
 func (m *ListValidatorAssignmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1264,13 +1694,43 @@ func (m *ListValidatorAssignmentsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListValidatorAssignmentsRequest proto.InternalMessageInfo
 
-func (m *ListValidatorAssignmentsRequest) GetEpoch() uint64 {
+type isListValidatorAssignmentsRequest_QueryFilter interface {
+	isListValidatorAssignmentsRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ListValidatorAssignmentsRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+type ListValidatorAssignmentsRequest_Genesis struct {
+	Genesis bool `protobuf:"varint,7,opt,name=genesis,proto3,oneof"`
+}
+
+func (*ListValidatorAssignmentsRequest_Epoch) isListValidatorAssignmentsRequest_QueryFilter()   {}
+func (*ListValidatorAssignmentsRequest_Genesis) isListValidatorAssignmentsRequest_QueryFilter() {}
+
+func (m *ListValidatorAssignmentsRequest) GetQueryFilter() isListValidatorAssignmentsRequest_QueryFilter {
 	if m != nil {
-		return m.Epoch
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *ListValidatorAssignmentsRequest) GetEpoch() uint64 {
+	if x, ok := m.GetQueryFilter().(*ListValidatorAssignmentsRequest_Epoch); ok {
+		return x.Epoch
 	}
 	return 0
 }
 
+func (m *ListValidatorAssignmentsRequest) GetGenesis() bool {
+	if x, ok := m.GetQueryFilter().(*ListValidatorAssignmentsRequest_Genesis); ok {
+		return x.Genesis
+	}
+	return false
+}
+
 func (m *ListValidatorAssignmentsRequest) GetPublicKeys() [][]byte {
 	if m != nil {
 		return m.PublicKeys
@@ -1299,6 +1759,81 @@ func (m *ListValidatorAssignmentsRequest) GetPageToken() string {
 	return ""
 }
 
+func (m *ListValidatorAssignmentsRequest) GetState() *StateSelector {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ListValidatorAssignmentsRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ListValidatorAssignmentsRequest_OneofMarshaler, _ListValidatorAssignmentsRequest_OneofUnmarshaler, _ListValidatorAssignmentsRequest_OneofSizer, []interface{}{
+		(*ListValidatorAssignmentsRequest_Epoch)(nil),
+		(*ListValidatorAssignmentsRequest_Genesis)(nil),
+	}
+}
+
+func _ListValidatorAssignmentsRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ListValidatorAssignmentsRequest)
+	// query_filter
+	switch x := m.QueryFilter.(type) {
+	case *ListValidatorAssignmentsRequest_Epoch:
+		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.Epoch))
+	case *ListValidatorAssignmentsRequest_Genesis:
+		t := uint64(0)
+		if x.Genesis {
+			t = 1
+		}
+		_ = b.EncodeVarint(7<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("ListValidatorAssignmentsRequest.QueryFilter has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ListValidatorAssignmentsRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ListValidatorAssignmentsRequest)
+	switch tag {
+	case 1: // query_filter.epoch
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.QueryFilter = &ListValidatorAssignmentsRequest_Epoch{x}
+		return true, err
+	case 7: // query_filter.genesis
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.QueryFilter = &ListValidatorAssignmentsRequest_Genesis{x != 0}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ListValidatorAssignmentsRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ListValidatorAssignmentsRequest)
+	// query_filter
+	switch x := m.QueryFilter.(type) {
+	case *ListValidatorAssignmentsRequest_Epoch:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Epoch))
+	case *ListValidatorAssignmentsRequest_Genesis:
+		n += 1 // tag and wire
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type ValidatorAssignments struct {
 	Epoch                uint64                                      `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Assignments          []*ValidatorAssignments_CommitteeAssignment `protobuf:"bytes,2,rep,name=assignments,proto3" json:"assignments,omitempty"`
@@ -1313,7 +1848,7 @@ func (m *ValidatorAssignments) Reset()         { *m = ValidatorAssignments{} }
 func (m *ValidatorAssignments) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAssignments) ProtoMessage()    {}
 func (*ValidatorAssignments) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{13}
+	return fileDescriptor_678c88b69c3c78d4, []int{15}
 }
 func (m *ValidatorAssignments) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1387,7 +1922,7 @@ func (m *ValidatorAssignments_CommitteeAssignment) Reset() {
 func (m *ValidatorAssignments_CommitteeAssignment) String() string { return proto.CompactTextString(m) }
 func (*ValidatorAssignments_CommitteeAssignment) ProtoMessage()    {}
 func (*ValidatorAssignments_CommitteeAssignment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{13, 0}
+	return fileDescriptor_678c88b69c3c78d4, []int{15, 0}
 }
 func (m *ValidatorAssignments_CommitteeAssignment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1452,17 +1987,21 @@ func (m *ValidatorAssignments_CommitteeAssignment) GetPublicKey() []byte {
 }
 
 type GetValidatorParticipationRequest struct {
-	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// Types that are valid to be assigned to QueryFilter:
+	//	*GetValidatorParticipationRequest_Epoch
+	//	*GetValidatorParticipationRequest_Genesis
+	QueryFilter          isGetValidatorParticipationRequest_QueryFilter `protobuf_oneof:"query_filter"`
+	State                *StateSelector                                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
 }
 
 func (m *GetValidatorParticipationRequest) Reset()         { *m = GetValidatorParticipationRequest{} }
 func (m *GetValidatorParticipationRequest) String() string { return proto.CompactTextString(m) }
 func (*GetValidatorParticipationRequest) ProtoMessage()    {}
 func (*GetValidatorParticipationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{14}
+	return fileDescriptor_678c88b69c3c78d4, []int{16}
 }
 func (m *GetValidatorParticipationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1491,13 +2030,118 @@ func (m *GetValidatorParticipationRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetValidatorParticipationRequest proto.InternalMessageInfo
 
-func (m *GetValidatorParticipationRequest) GetEpoch() uint64 {
+type isGetValidatorParticipationRequest_QueryFilter interface {
+	isGetValidatorParticipationRequest_QueryFilter()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type GetValidatorParticipationRequest_Epoch struct {
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3,oneof"`
+}
+type GetValidatorParticipationRequest_Genesis struct {
+	Genesis bool `protobuf:"varint,3,opt,name=genesis,proto3,oneof"`
+}
+
+func (*GetValidatorParticipationRequest_Epoch) isGetValidatorParticipationRequest_QueryFilter()   {}
+func (*GetValidatorParticipationRequest_Genesis) isGetValidatorParticipationRequest_QueryFilter() {}
+
+func (m *GetValidatorParticipationRequest) GetQueryFilter() isGetValidatorParticipationRequest_QueryFilter {
 	if m != nil {
-		return m.Epoch
+		return m.QueryFilter
+	}
+	return nil
+}
+
+func (m *GetValidatorParticipationRequest) GetEpoch() uint64 {
+	if x, ok := m.GetQueryFilter().(*GetValidatorParticipationRequest_Epoch); ok {
+		return x.Epoch
 	}
 	return 0
 }
 
+func (m *GetValidatorParticipationRequest) GetGenesis() bool {
+	if x, ok := m.GetQueryFilter().(*GetValidatorParticipationRequest_Genesis); ok {
+		return x.Genesis
+	}
+	return false
+}
+
+func (m *GetValidatorParticipationRequest) GetState() *StateSelector {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*GetValidatorParticipationRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _GetValidatorParticipationRequest_OneofMarshaler, _GetValidatorParticipationRequest_OneofUnmarshaler, _GetValidatorParticipationRequest_OneofSizer, []interface{}{
+		(*GetValidatorParticipationRequest_Epoch)(nil),
+		(*GetValidatorParticipationRequest_Genesis)(nil),
+	}
+}
+
+func _GetValidatorParticipationRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*GetValidatorParticipationRequest)
+	// query_filter
+	switch x := m.QueryFilter.(type) {
+	case *GetValidatorParticipationRequest_Epoch:
+		_ = b.EncodeVarint(1<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(uint64(x.Epoch))
+	case *GetValidatorParticipationRequest_Genesis:
+		t := uint64(0)
+		if x.Genesis {
+			t = 1
+		}
+		_ = b.EncodeVarint(3<<3 | proto.WireVarint)
+		_ = b.EncodeVarint(t)
+	case nil:
+	default:
+		return fmt.Errorf("GetValidatorParticipationRequest.QueryFilter has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _GetValidatorParticipationRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*GetValidatorParticipationRequest)
+	switch tag {
+	case 1: // query_filter.epoch
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.QueryFilter = &GetValidatorParticipationRequest_Epoch{x}
+		return true, err
+	case 3: // query_filter.genesis
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.QueryFilter = &GetValidatorParticipationRequest_Genesis{x != 0}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _GetValidatorParticipationRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*GetValidatorParticipationRequest)
+	// query_filter
+	switch x := m.QueryFilter.(type) {
+	case *GetValidatorParticipationRequest_Epoch:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(x.Epoch))
+	case *GetValidatorParticipationRequest_Genesis:
+		n += 1 // tag and wire
+		n += 1
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
 type ValidatorParticipation struct {
 	Epoch                   uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Finalized               bool     `protobuf:"varint,2,opt,name=finalized,proto3" json:"finalized,omitempty"`
@@ -1513,7 +2157,7 @@ func (m *ValidatorParticipation) Reset()         { *m = ValidatorParticipation{}
 func (m *ValidatorParticipation) String() string { return proto.CompactTextString(m) }
 func (*ValidatorParticipation) ProtoMessage()    {}
 func (*ValidatorParticipation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{15}
+	return fileDescriptor_678c88b69c3c78d4, []int{17}
 }
 func (m *ValidatorParticipation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1577,25 +2221,27 @@ func (m *ValidatorParticipation) GetEligibleEther() uint64 {
 	return 0
 }
 
-type AttestationPoolResponse struct {
-	Attestations         []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type GetValidatorRewardsRequest struct {
+	Epoch                uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicKeys           [][]byte `protobuf:"bytes,2,rep,name=public_keys,json=publicKeys,proto3" json:"public_keys,omitempty" ssz-size:"?,48"`
+	Indices              []uint64 `protobuf:"varint,3,rep,packed,name=indices,proto3" json:"indices,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AttestationPoolResponse) Reset()         { *m = AttestationPoolResponse{} }
-func (m *AttestationPoolResponse) String() string { return proto.CompactTextString(m) }
-func (*AttestationPoolResponse) ProtoMessage()    {}
-func (*AttestationPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_678c88b69c3c78d4, []int{16}
+func (m *GetValidatorRewardsRequest) Reset()         { *m = GetValidatorRewardsRequest{} }
+func (m *GetValidatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetValidatorRewardsRequest) ProtoMessage()    {}
+func (*GetValidatorRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{18}
 }
-func (m *AttestationPoolResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetValidatorRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetValidatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AttestationPoolResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetValidatorRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1605,1189 +2251,1039 @@ func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *AttestationPoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AttestationPoolResponse.Merge(m, src)
+func (m *GetValidatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValidatorRewardsRequest.Merge(m, src)
 }
-func (m *AttestationPoolResponse) XXX_Size() int {
+func (m *GetValidatorRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *AttestationPoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AttestationPoolResponse.DiscardUnknown(m)
+func (m *GetValidatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValidatorRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AttestationPoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetValidatorRewardsRequest proto.InternalMessageInfo
 
-func (m *AttestationPoolResponse) GetAttestations() []*Attestation {
+func (m *GetValidatorRewardsRequest) GetEpoch() uint64 {
 	if m != nil {
-		return m.Attestations
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GetValidatorRewardsRequest) GetPublicKeys() [][]byte {
+	if m != nil {
+		return m.PublicKeys
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
-	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
-	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
-	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
-	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
-	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
-	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
-	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
-	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
-	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
-	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
-	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
-	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
-	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
-	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
-	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
-	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
-	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
-	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+func (m *GetValidatorRewardsRequest) GetIndices() []uint64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
+type ValidatorRewards struct {
+	Epoch                uint64                        `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rewards              []*ValidatorRewards_Breakdown `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-var fileDescriptor_678c88b69c3c78d4 = []byte{
-	// 1528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x1b, 0xef, 0xc4, 0x4e, 0x93, 0x3c, 0xf9, 0x68, 0x33, 0xf9, 0x72, 0x9d, 0x26, 0x71, 0xb7, 0x4d,
-	0x5e, 0x57, 0x6d, 0xed, 0x26, 0xed, 0xdb, 0xb7, 0x4a, 0xf5, 0xaa, 0xd4, 0x51, 0x69, 0x80, 0x1e,
-	0xc2, 0xb6, 0xe2, 0xc0, 0xc5, 0x1a, 0x6f, 0x26, 0xf6, 0x34, 0xeb, 0x9d, 0xed, 0xce, 0x38, 0x6a,
-	0x72, 0x03, 0x24, 0x24, 0xce, 0x48, 0x20, 0x2e, 0x08, 0x89, 0x13, 0x42, 0x9c, 0x40, 0x5c, 0xb8,
-	0x20, 0xb8, 0x70, 0x42, 0x95, 0xb8, 0x57, 0xa8, 0xe2, 0x2f, 0xe8, 0x05, 0x71, 0x43, 0x3b, 0x3b,
-	0xfb, 0x61, 0xc7, 0x6b, 0x1b, 0x51, 0xc1, 0xcd, 0xf3, 0xcc, 0xf3, 0xf1, 0x7b, 0x7e, 0xfb, 0xcc,
-	0x33, 0xcf, 0x18, 0x56, 0x5d, 0x8f, 0x4b, 0x5e, 0xa6, 0xb2, 0x51, 0x3e, 0x58, 0x27, 0xb6, 0xdb,
-	0x20, 0xeb, 0xe5, 0x1a, 0x25, 0x16, 0x77, 0xaa, 0x56, 0x83, 0x30, 0xa7, 0xa4, 0xf6, 0xf1, 0x1c,
-	0x95, 0x0d, 0xea, 0xd1, 0x56, 0xb3, 0x44, 0x65, 0xa3, 0x14, 0x6a, 0xe6, 0xaf, 0xd4, 0x99, 0x6c,
-	0xb4, 0x6a, 0x25, 0x8b, 0x37, 0xcb, 0x75, 0x5e, 0xe7, 0x65, 0xa5, 0x5d, 0x6b, 0xed, 0xa9, 0x55,
-	0xe0, 0xda, 0xff, 0x15, 0x78, 0xc9, 0x9f, 0xad, 0x73, 0x5e, 0xb7, 0x69, 0x99, 0xb8, 0xac, 0x4c,
-	0x1c, 0x87, 0x4b, 0x22, 0x19, 0x77, 0x84, 0xde, 0x5d, 0xd4, 0xbb, 0x91, 0x0f, 0xda, 0x74, 0xe5,
-	0xa1, 0xde, 0xbc, 0xd0, 0x05, 0x27, 0x91, 0x92, 0x8a, 0xc0, 0x87, 0xd6, 0xea, 0x91, 0x4d, 0xcd,
-	0xe6, 0xd6, 0xbe, 0x56, 0x33, 0xba, 0xa8, 0x1d, 0x10, 0x9b, 0xed, 0x12, 0xc9, 0xbd, 0x40, 0xc7,
-	0xf8, 0x06, 0xc1, 0xc2, 0x7d, 0x26, 0xe4, 0x9d, 0x38, 0x88, 0x30, 0xe9, 0xe3, 0x16, 0x15, 0x12,
-	0xaf, 0x00, 0x28, 0x77, 0x55, 0x8f, 0x73, 0x99, 0x43, 0x05, 0x54, 0x9c, 0xd8, 0x3e, 0x61, 0x8e,
-	0x29, 0x99, 0xc9, 0xb9, 0xc4, 0xb3, 0x90, 0x15, 0x36, 0x97, 0xb9, 0xa1, 0x02, 0x2a, 0x66, 0xb7,
-	0x4f, 0x98, 0x6a, 0x85, 0xe7, 0x61, 0x98, 0xba, 0xdc, 0x6a, 0xe4, 0x32, 0x5a, 0x1c, 0x2c, 0xf1,
-	0x22, 0x8c, 0xb9, 0xa4, 0x4e, 0xab, 0x82, 0x1d, 0xd1, 0x5c, 0xb6, 0x80, 0x8a, 0xc3, 0xe6, 0xa8,
-	0x2f, 0x78, 0xc0, 0x8e, 0x28, 0x5e, 0x02, 0x50, 0x9b, 0x92, 0xef, 0x53, 0x27, 0x37, 0x5c, 0x40,
-	0xc5, 0x31, 0x53, 0xa9, 0x3f, 0xf4, 0x05, 0x95, 0x29, 0x98, 0x78, 0xdc, 0xa2, 0xde, 0x61, 0x75,
-	0x8f, 0xd9, 0x92, 0x7a, 0xc6, 0x17, 0x08, 0x72, 0xc7, 0x61, 0x0b, 0x97, 0x3b, 0x82, 0xe2, 0x57,
-	0x61, 0x22, 0xc1, 0x99, 0xc8, 0xa1, 0x42, 0xa6, 0x38, 0xbe, 0x61, 0x94, 0xba, 0x7e, 0xdc, 0x52,
-	0xc2, 0x85, 0xd9, 0x66, 0x87, 0xd7, 0xe0, 0x94, 0x43, 0x9f, 0xc8, 0x6a, 0x02, 0xd8, 0x90, 0x02,
-	0x36, 0xe9, 0x8b, 0x77, 0x42, 0x70, 0x3e, 0x76, 0xc9, 0x25, 0xb1, 0x83, 0xcc, 0x32, 0x2a, 0xb3,
-	0x31, 0x25, 0xf1, 0x53, 0x33, 0x3e, 0x47, 0x30, 0xed, 0x63, 0xad, 0xf8, 0xbc, 0x45, 0xe4, 0xce,
-	0x42, 0xb6, 0x8d, 0x56, 0xb5, 0xfa, 0x17, 0x19, 0xfd, 0x18, 0x01, 0x4e, 0xa2, 0xd4, 0x5c, 0x6e,
-	0xc2, 0x49, 0xf5, 0xbd, 0xfb, 0xb1, 0x58, 0x51, 0xe5, 0xa7, 0x8c, 0x4d, 0x6d, 0xf1, 0xb2, 0xf8,
-	0xfb, 0x21, 0x03, 0x63, 0x5b, 0xfe, 0x21, 0xdd, 0xa6, 0x64, 0x17, 0x5f, 0x3d, 0x5e, 0x94, 0x95,
-	0xe9, 0x17, 0xcf, 0x56, 0x26, 0x85, 0x38, 0xba, 0xe2, 0x3b, 0xd8, 0x34, 0xae, 0x6d, 0x18, 0xc9,
-	0x2a, 0x5d, 0x0a, 0x2d, 0x62, 0x66, 0xf5, 0xf6, 0x03, 0x9f, 0xdc, 0x55, 0x98, 0xda, 0x63, 0x0e,
-	0xb1, 0xd9, 0x11, 0xdd, 0x0d, 0x54, 0x14, 0xcb, 0xe6, 0x64, 0x24, 0x55, 0x6a, 0x5b, 0x30, 0x1b,
-	0xab, 0x25, 0x10, 0x64, 0xd3, 0x10, 0xe0, 0x48, 0xbd, 0x12, 0x41, 0x59, 0x85, 0xa9, 0x47, 0x2d,
-	0x21, 0xd9, 0x1e, 0x0b, 0x63, 0x0d, 0x07, 0xb1, 0x22, 0x69, 0x18, 0x2b, 0x56, 0x4b, 0xc4, 0x3a,
-	0x99, 0x1a, 0x2b, 0x52, 0x8f, 0x63, 0xdd, 0x80, 0x05, 0xd7, 0xa3, 0x07, 0x8c, 0xb7, 0x44, 0xb5,
-	0x23, 0xe8, 0x88, 0x0a, 0x3a, 0x17, 0x6e, 0xbf, 0xde, 0x16, 0xfc, 0x21, 0x2c, 0x75, 0xb1, 0x4b,
-	0xa0, 0x18, 0x4d, 0x43, 0x91, 0x3f, 0xe6, 0x30, 0x42, 0x63, 0xbc, 0x87, 0x60, 0xf1, 0x1e, 0x95,
-	0x6f, 0x85, 0xed, 0xa7, 0x42, 0x6c, 0xe2, 0x58, 0x34, 0x71, 0x1c, 0x74, 0x89, 0x23, 0x85, 0x4d,
-	0x17, 0xf8, 0x75, 0x18, 0x77, 0x5b, 0x35, 0x9b, 0x59, 0xd5, 0x7d, 0x7a, 0x28, 0x72, 0x43, 0x85,
-	0x4c, 0x71, 0xa2, 0x32, 0xf3, 0xe2, 0xd9, 0xca, 0xa9, 0x38, 0xf2, 0xed, 0xcb, 0xd7, 0x6f, 0x1a,
-	0x26, 0x04, 0x7a, 0x6f, 0xd0, 0x43, 0x81, 0x73, 0x30, 0xc2, 0x9c, 0x5d, 0x66, 0x51, 0x91, 0xcb,
-	0x14, 0x32, 0xc5, 0xac, 0x19, 0x2e, 0x8d, 0x9f, 0x11, 0x4c, 0x1f, 0x83, 0x80, 0xef, 0xc3, 0x68,
-	0x4d, 0xff, 0xd6, 0x55, 0x7e, 0x35, 0xa5, 0xca, 0x8f, 0xd9, 0x96, 0xf4, 0x0f, 0x33, 0xf2, 0x90,
-	0xdf, 0x87, 0x11, 0x2d, 0xf4, 0x6b, 0x35, 0x86, 0xdf, 0xbd, 0x56, 0x7d, 0xec, 0x63, 0x11, 0x76,
-	0x9f, 0x06, 0xe6, 0xec, 0xd2, 0x27, 0xba, 0x4c, 0x83, 0x85, 0x9f, 0x90, 0x76, 0xaf, 0x6b, 0x33,
-	0x5c, 0x1a, 0x1f, 0x21, 0x98, 0x4d, 0xd2, 0x1a, 0xf1, 0x39, 0xdf, 0xc6, 0x67, 0xdc, 0x32, 0xf2,
-	0x30, 0x52, 0xa7, 0x0e, 0x15, 0x4c, 0xa8, 0x10, 0xa3, 0xdb, 0x27, 0xcc, 0x50, 0xd0, 0xde, 0x4e,
-	0x32, 0x3d, 0xdb, 0x49, 0xb6, 0x5f, 0x3b, 0xf9, 0x12, 0x01, 0xc4, 0xa8, 0x52, 0x3e, 0xef, 0x2b,
-	0x00, 0xd1, 0x7d, 0x14, 0x7c, 0xdd, 0xf1, 0x8d, 0x42, 0x3f, 0xea, 0xcd, 0x84, 0x4d, 0xb7, 0x16,
-	0x93, 0xe9, 0xdf, 0x62, 0xb2, 0x9d, 0x2d, 0xe6, 0x16, 0x9c, 0x4f, 0xb2, 0x78, 0xc7, 0x92, 0xec,
-	0x80, 0x3e, 0xa0, 0x72, 0xab, 0x41, 0x9c, 0x7a, 0x9f, 0x22, 0x35, 0xfe, 0x40, 0x70, 0xba, 0xd3,
-	0x22, 0x25, 0xe1, 0x7b, 0x30, 0x47, 0x7c, 0x4d, 0x22, 0xe9, 0x6e, 0x75, 0xc0, 0xca, 0x9e, 0x89,
-	0x2c, 0x76, 0xe2, 0x12, 0xbf, 0x03, 0x98, 0x3e, 0x61, 0x9d, 0x5e, 0x32, 0xe9, 0x5e, 0x4e, 0x07,
-	0xea, 0x09, 0x17, 0x5b, 0x30, 0x43, 0x1f, 0x51, 0xab, 0xd3, 0x47, 0x36, 0xdd, 0xc7, 0xb4, 0xd6,
-	0x8f, 0x9d, 0x18, 0xdf, 0x21, 0x98, 0x8a, 0x68, 0x7b, 0xb3, 0x45, 0x5b, 0x14, 0xaf, 0xc0, 0xb8,
-	0xd5, 0x68, 0x79, 0x4e, 0xd5, 0x66, 0x4d, 0x26, 0x75, 0xfe, 0xa0, 0x44, 0xf7, 0x7d, 0x09, 0x7e,
-	0x0d, 0xe6, 0x75, 0x4a, 0x8c, 0x3b, 0x83, 0xb2, 0x30, 0x1b, 0x9b, 0x24, 0x72, 0xf8, 0x3f, 0xa8,
-	0xbc, 0x06, 0x25, 0x61, 0xca, 0x57, 0x4e, 0xa0, 0xff, 0x11, 0xc1, 0x8a, 0x7f, 0xe7, 0xc5, 0x1f,
-	0x5e, 0x08, 0x56, 0x77, 0x9a, 0xd4, 0x91, 0xff, 0x6c, 0x63, 0xfa, 0x3b, 0x37, 0xb9, 0xf1, 0x49,
-	0x06, 0x66, 0xbb, 0x65, 0x90, 0x02, 0x9d, 0xc0, 0x38, 0x89, 0x95, 0xf4, 0xa9, 0xbb, 0xdd, 0xef,
-	0xd4, 0x25, 0xfc, 0x96, 0xb6, 0x78, 0xb3, 0xc9, 0xa4, 0xa4, 0x34, 0x16, 0x9a, 0x49, 0x9f, 0x2f,
-	0xe9, 0x54, 0xe6, 0xbf, 0x47, 0x30, 0xd3, 0x25, 0x16, 0x5e, 0x87, 0x59, 0xcb, 0xe3, 0x42, 0xd8,
-	0xcc, 0xd9, 0xaf, 0x5a, 0xa1, 0x42, 0xd0, 0xbb, 0xb3, 0xe6, 0x4c, 0xb4, 0x17, 0xd9, 0x2a, 0x2a,
-	0x44, 0x83, 0x78, 0xbb, 0x61, 0x5f, 0x55, 0x0b, 0x8c, 0xf5, 0xb4, 0x15, 0x34, 0xd5, 0x60, 0xd6,
-	0xca, 0xc3, 0xa8, 0xeb, 0x71, 0x97, 0x0b, 0xea, 0x29, 0x44, 0xa3, 0x66, 0xb4, 0xee, 0xe8, 0xe7,
-	0xc3, 0xfd, 0xfb, 0xb9, 0x71, 0x13, 0x0a, 0xc9, 0xc6, 0xb2, 0x43, 0x3c, 0xc9, 0x2c, 0xe6, 0x06,
-	0xd3, 0x66, 0xcf, 0xae, 0xf2, 0x14, 0xc1, 0x7c, 0x77, 0xbb, 0x94, 0xef, 0x7a, 0x16, 0xc6, 0xa2,
-	0x89, 0x23, 0xe8, 0xed, 0x66, 0x2c, 0xc0, 0x9b, 0x70, 0xa6, 0x6e, 0xf3, 0x1a, 0xb1, 0xab, 0x6e,
-	0xd2, 0x57, 0xd5, 0x23, 0x32, 0xe8, 0xf5, 0x43, 0xe6, 0x42, 0xa0, 0xd0, 0x8e, 0x91, 0x48, 0x75,
-	0xa2, 0x0f, 0xb8, 0xdf, 0x27, 0x54, 0x8d, 0x28, 0x56, 0xb2, 0x26, 0x28, 0xd1, 0x5d, 0x5f, 0xe2,
-	0x8f, 0x35, 0xd4, 0x66, 0x75, 0x56, 0xb3, 0xa9, 0xd6, 0xd1, 0x63, 0x4d, 0x28, 0x55, 0x6a, 0x06,
-	0x81, 0x85, 0xc4, 0xb0, 0xbd, 0xc3, 0xb9, 0xfd, 0xb2, 0x47, 0xf6, 0x8d, 0xdf, 0xc7, 0x61, 0x3c,
-	0x18, 0x45, 0xd5, 0xc4, 0x88, 0x3f, 0x45, 0x70, 0xba, 0xf3, 0x9d, 0x80, 0x4b, 0x29, 0x6e, 0x53,
-	0xde, 0x41, 0xf9, 0xf2, 0xc0, 0xfa, 0x41, 0x36, 0xc6, 0xc5, 0x77, 0x7f, 0xf9, 0xed, 0xc3, 0xa1,
-	0xf3, 0xf8, 0x5c, 0xb7, 0x27, 0x5a, 0xb9, 0xed, 0x8d, 0xf1, 0x01, 0x82, 0x53, 0x1d, 0xa4, 0xe0,
-	0xf9, 0x52, 0xf0, 0x44, 0x2c, 0x85, 0x4f, 0xc4, 0xd2, 0x5d, 0xff, 0x89, 0x98, 0x2f, 0xf5, 0xa7,
-	0x23, 0x49, 0xaa, 0x51, 0x52, 0x30, 0x8a, 0x78, 0xad, 0x2f, 0x8c, 0xb2, 0xeb, 0xc7, 0x7d, 0x1f,
-	0x01, 0xc4, 0x4f, 0x00, 0x5c, 0xec, 0x91, 0x76, 0xdb, 0x5b, 0x26, 0x7f, 0x71, 0x00, 0x4d, 0x8d,
-	0xe9, 0xbc, 0xc2, 0xb4, 0x84, 0x17, 0xbb, 0x62, 0xd2, 0x0f, 0x07, 0x17, 0x26, 0xee, 0xa9, 0xab,
-	0x54, 0xcf, 0xfc, 0x69, 0x84, 0xa4, 0xcd, 0x0a, 0x91, 0xa5, 0xb1, 0xa6, 0xc2, 0x15, 0xf0, 0x72,
-	0xd7, 0x70, 0xea, 0xe9, 0xdf, 0xf0, 0x23, 0x7c, 0x86, 0x60, 0xae, 0xed, 0x26, 0x88, 0x86, 0xc3,
-	0x8d, 0x94, 0x18, 0x3d, 0x86, 0xd9, 0x7c, 0x71, 0xd0, 0xf1, 0x31, 0xad, 0x52, 0xe2, 0x09, 0xa7,
-	0x1c, 0xce, 0x95, 0xf8, 0x1d, 0x04, 0x93, 0x6d, 0xa3, 0x1e, 0xbe, 0x34, 0x00, 0xb4, 0x08, 0xd3,
-	0xb9, 0x7e, 0x98, 0x84, 0x51, 0x50, 0x60, 0xf2, 0x38, 0x97, 0x06, 0x06, 0x7f, 0x8b, 0xe0, 0x6c,
-	0xaf, 0x41, 0x09, 0x6f, 0x0e, 0x00, 0x29, 0x65, 0xba, 0xca, 0xff, 0x27, 0xad, 0xbc, 0x3b, 0xf4,
-	0x8d, 0x75, 0x85, 0xf3, 0x12, 0xbe, 0x98, 0x4a, 0x9a, 0x1a, 0x16, 0xa8, 0xa0, 0xd2, 0xd2, 0xb8,
-	0x8e, 0x60, 0x3a, 0x09, 0x21, 0x98, 0x54, 0xd2, 0xca, 0x6a, 0xb5, 0x1f, 0x55, 0xca, 0x3c, 0xad,
-	0xb6, 0x12, 0x30, 0x1e, 0xab, 0x30, 0x5f, 0xe9, 0xff, 0x2a, 0xba, 0xde, 0xd1, 0x37, 0x7a, 0x1c,
-	0x9d, 0x1e, 0x63, 0x49, 0xfe, 0xd2, 0x5f, 0xb8, 0xb0, 0x8d, 0xcb, 0x0a, 0xe9, 0x1a, 0xbe, 0x90,
-	0x4e, 0x58, 0x02, 0xd2, 0xd7, 0x08, 0xce, 0xa4, 0x5e, 0x5a, 0xf8, 0x7f, 0x03, 0x7c, 0xe1, 0x6e,
-	0xd7, 0x5c, 0xfe, 0x4a, 0x3f, 0xc4, 0x6d, 0x56, 0x69, 0xcd, 0x2b, 0x81, 0xb9, 0xed, 0x22, 0xab,
-	0x6c, 0xfd, 0xf4, 0x7c, 0x19, 0x3d, 0x7d, 0xbe, 0x8c, 0x7e, 0x7d, 0xbe, 0x8c, 0xde, 0xfe, 0x6f,
-	0xe2, 0x1f, 0x3b, 0xd7, 0x3b, 0x14, 0x4d, 0x22, 0x99, 0x65, 0x93, 0x9a, 0x08, 0x56, 0xe5, 0xe3,
-	0xff, 0x8c, 0xdd, 0xa2, 0xb2, 0x51, 0x3b, 0xa9, 0xe4, 0xd7, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff,
-	0x48, 0x72, 0x96, 0xde, 0x2f, 0x14, 0x00, 0x00,
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{19}
+}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
+}
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
 
-// BeaconChainClient is the client API for BeaconChain service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type BeaconChainClient interface {
-	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
-	AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
-	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
-	GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error)
-	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
-	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
-	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
-	GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error)
-	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
-	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+func (m *ValidatorRewards) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
 }
 
-type beaconChainClient struct {
-	cc *grpc.ClientConn
+func (m *ValidatorRewards) GetRewards() []*ValidatorRewards_Breakdown {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
 }
 
-func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
-	return &beaconChainClient{cc}
+type ValidatorRewards_Breakdown struct {
+	PublicKey            []byte   `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty" ssz-size:"48"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	SourceReward         uint64   `protobuf:"varint,3,opt,name=source_reward,json=sourceReward,proto3" json:"source_reward,omitempty"`
+	SourcePenalty        uint64   `protobuf:"varint,4,opt,name=source_penalty,json=sourcePenalty,proto3" json:"source_penalty,omitempty"`
+	TargetReward         uint64   `protobuf:"varint,5,opt,name=target_reward,json=targetReward,proto3" json:"target_reward,omitempty"`
+	TargetPenalty        uint64   `protobuf:"varint,6,opt,name=target_penalty,json=targetPenalty,proto3" json:"target_penalty,omitempty"`
+	HeadReward           uint64   `protobuf:"varint,7,opt,name=head_reward,json=headReward,proto3" json:"head_reward,omitempty"`
+	HeadPenalty          uint64   `protobuf:"varint,8,opt,name=head_penalty,json=headPenalty,proto3" json:"head_penalty,omitempty"`
+	InclusionDelayReward uint64   `protobuf:"varint,9,opt,name=inclusion_delay_reward,json=inclusionDelayReward,proto3" json:"inclusion_delay_reward,omitempty"`
+	CrosslinkReward      uint64   `protobuf:"varint,10,opt,name=crosslink_reward,json=crosslinkReward,proto3" json:"crosslink_reward,omitempty"`
+	CrosslinkPenalty     uint64   `protobuf:"varint,11,opt,name=crosslink_penalty,json=crosslinkPenalty,proto3" json:"crosslink_penalty,omitempty"`
+	InactivityPenalty    uint64   `protobuf:"varint,12,opt,name=inactivity_penalty,json=inactivityPenalty,proto3" json:"inactivity_penalty,omitempty"`
+	ProposerReward       uint64   `protobuf:"varint,13,opt,name=proposer_reward,json=proposerReward,proto3" json:"proposer_reward,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *beaconChainClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
-	out := new(ListAttestationsResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidatorRewards_Breakdown) Reset()         { *m = ValidatorRewards_Breakdown{} }
+func (m *ValidatorRewards_Breakdown) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards_Breakdown) ProtoMessage()    {}
+func (*ValidatorRewards_Breakdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{19, 0}
 }
-
-func (c *beaconChainClient) AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error) {
-	out := new(AttestationPoolResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorRewards_Breakdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards_Breakdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ValidatorRewards_Breakdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards_Breakdown.Merge(m, src)
+}
+func (m *ValidatorRewards_Breakdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorRewards_Breakdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards_Breakdown.DiscardUnknown(m)
 }
 
-func (c *beaconChainClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
-	out := new(ListBlocksResponse)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidatorRewards_Breakdown proto.InternalMessageInfo
+
+func (m *ValidatorRewards_Breakdown) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
 	}
-	return out, nil
+	return nil
 }
 
-func (c *beaconChainClient) GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error) {
-	out := new(ChainHead)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
-	out := new(ValidatorBalances)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetSourceReward() uint64 {
+	if m != nil {
+		return m.SourceReward
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
-	out := new(Validators)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetSourcePenalty() uint64 {
+	if m != nil {
+		return m.SourcePenalty
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
-	out := new(ActiveSetChanges)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetTargetReward() uint64 {
+	if m != nil {
+		return m.TargetReward
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ValidatorQueue, error) {
-	out := new(ValidatorQueue)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetTargetPenalty() uint64 {
+	if m != nil {
+		return m.TargetPenalty
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
-	out := new(ValidatorAssignments)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetHeadReward() uint64 {
+	if m != nil {
+		return m.HeadReward
 	}
-	return out, nil
+	return 0
 }
 
-func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
-	out := new(ValidatorParticipation)
-	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards_Breakdown) GetHeadPenalty() uint64 {
+	if m != nil {
+		return m.HeadPenalty
 	}
-	return out, nil
+	return 0
 }
 
-// BeaconChainServer is the server API for BeaconChain service.
-type BeaconChainServer interface {
-	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
-	AttestationPool(context.Context, *types.Empty) (*AttestationPoolResponse, error)
-	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
-	GetChainHead(context.Context, *types.Empty) (*ChainHead, error)
-	ListValidatorBalances(context.Context, *GetValidatorBalancesRequest) (*ValidatorBalances, error)
-	GetValidators(context.Context, *GetValidatorsRequest) (*Validators, error)
-	GetValidatorActiveSetChanges(context.Context, *GetValidatorActiveSetChangesRequest) (*ActiveSetChanges, error)
-	GetValidatorQueue(context.Context, *types.Empty) (*ValidatorQueue, error)
-	ListValidatorAssignments(context.Context, *ListValidatorAssignmentsRequest) (*ValidatorAssignments, error)
-	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*ValidatorParticipation, error)
+func (m *ValidatorRewards_Breakdown) GetInclusionDelayReward() uint64 {
+	if m != nil {
+		return m.InclusionDelayReward
+	}
+	return 0
 }
 
-func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
-	s.RegisterService(&_BeaconChain_serviceDesc, srv)
+func (m *ValidatorRewards_Breakdown) GetCrosslinkReward() uint64 {
+	if m != nil {
+		return m.CrosslinkReward
+	}
+	return 0
 }
 
-func _BeaconChain_ListAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListAttestationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListAttestations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListAttestations(ctx, req.(*ListAttestationsRequest))
+func (m *ValidatorRewards_Breakdown) GetCrosslinkPenalty() uint64 {
+	if m != nil {
+		return m.CrosslinkPenalty
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_AttestationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).AttestationPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).AttestationPool(ctx, req.(*types.Empty))
+func (m *ValidatorRewards_Breakdown) GetInactivityPenalty() uint64 {
+	if m != nil {
+		return m.InactivityPenalty
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBlocksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListBlocks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListBlocks(ctx, req.(*ListBlocksRequest))
+func (m *ValidatorRewards_Breakdown) GetProposerReward() uint64 {
+	if m != nil {
+		return m.ProposerReward
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _BeaconChain_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetChainHead(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetChainHead(ctx, req.(*types.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+type AttestationPoolResponse struct {
+	Attestations         []*Attestation `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func _BeaconChain_ListValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListValidatorBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListValidatorBalances(ctx, req.(*GetValidatorBalancesRequest))
+func (m *AttestationPoolResponse) Reset()         { *m = AttestationPoolResponse{} }
+func (m *AttestationPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*AttestationPoolResponse) ProtoMessage()    {}
+func (*AttestationPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{20}
+}
+func (m *AttestationPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *AttestationPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPoolResponse.Merge(m, src)
+}
+func (m *AttestationPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPoolResponse.DiscardUnknown(m)
 }
 
-func _BeaconChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidators(ctx, req.(*GetValidatorsRequest))
+var xxx_messageInfo_AttestationPoolResponse proto.InternalMessageInfo
+
+func (m *AttestationPoolResponse) GetAttestations() []*Attestation {
+	if m != nil {
+		return m.Attestations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BeaconChain_GetValidatorActiveSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorActiveSetChangesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, req.(*GetValidatorActiveSetChangesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type StateProofRequest struct {
+	State                *StateSelector `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Paths                []string       `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func _BeaconChain_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidatorQueue(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidatorQueue(ctx, req.(*types.Empty))
+func (m *StateProofRequest) Reset()         { *m = StateProofRequest{} }
+func (m *StateProofRequest) String() string { return proto.CompactTextString(m) }
+func (*StateProofRequest) ProtoMessage()    {}
+func (*StateProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{21}
+}
+func (m *StateProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *StateProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProofRequest.Merge(m, src)
+}
+func (m *StateProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProofRequest.DiscardUnknown(m)
 }
 
-func _BeaconChain_ListValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListValidatorAssignmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, req.(*ListValidatorAssignmentsRequest))
+var xxx_messageInfo_StateProofRequest proto.InternalMessageInfo
+
+func (m *StateProofRequest) GetState() *StateSelector {
+	if m != nil {
+		return m.State
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _BeaconChain_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValidatorParticipationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, req.(*GetValidatorParticipationRequest))
+func (m *StateProofRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _BeaconChain_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ethereum.eth.v1alpha1.BeaconChain",
-	HandlerType: (*BeaconChainServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListAttestations",
-			Handler:    _BeaconChain_ListAttestations_Handler,
-		},
-		{
-			MethodName: "AttestationPool",
-			Handler:    _BeaconChain_AttestationPool_Handler,
-		},
-		{
-			MethodName: "ListBlocks",
-			Handler:    _BeaconChain_ListBlocks_Handler,
-		},
-		{
-			MethodName: "GetChainHead",
-			Handler:    _BeaconChain_GetChainHead_Handler,
-		},
-		{
-			MethodName: "ListValidatorBalances",
-			Handler:    _BeaconChain_ListValidatorBalances_Handler,
-		},
-		{
-			MethodName: "GetValidators",
-			Handler:    _BeaconChain_GetValidators_Handler,
-		},
-		{
-			MethodName: "GetValidatorActiveSetChanges",
-			Handler:    _BeaconChain_GetValidatorActiveSetChanges_Handler,
-		},
-		{
-			MethodName: "GetValidatorQueue",
-			Handler:    _BeaconChain_GetValidatorQueue_Handler,
-		},
-		{
-			MethodName: "ListValidatorAssignments",
-			Handler:    _BeaconChain_ListValidatorAssignments_Handler,
-		},
-		{
-			MethodName: "GetValidatorParticipation",
-			Handler:    _BeaconChain_GetValidatorParticipation_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "proto/eth/v1alpha1/beacon_chain.proto",
+type StateProof struct {
+	StateRoot            []byte   `protobuf:"bytes,1,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty" ssz-size:"32"`
+	Slot                 uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	GeneralizedIndices   []uint64 `protobuf:"varint,3,rep,packed,name=generalized_indices,json=generalizedIndices,proto3" json:"generalized_indices,omitempty"`
+	Leaves               [][]byte `protobuf:"bytes,4,rep,name=leaves,proto3" json:"leaves,omitempty" ssz-size:"?,32"`
+	Hashes               [][]byte `protobuf:"bytes,5,rep,name=hashes,proto3" json:"hashes,omitempty" ssz-size:"?,32"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListAttestationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *StateProof) Reset()         { *m = StateProof{} }
+func (m *StateProof) String() string { return proto.CompactTextString(m) }
+func (*StateProof) ProtoMessage()    {}
+func (*StateProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_678c88b69c3c78d4, []int{22}
 }
-
-func (m *ListAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		nn1, err := m.QueryFilter.MarshalTo(dAtA[i:])
+func (m *StateProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StateProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += nn1
-	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
-	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+}
+func (m *StateProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateProof.Merge(m, src)
+}
+func (m *StateProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateProof proto.InternalMessageInfo
+
+func (m *StateProof) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ListAttestationsRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.BlockRoot != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
+func (m *StateProof) GetSlot() uint64 {
+	if m != nil {
+		return m.Slot
 	}
-	return i, nil
+	return 0
 }
-func (m *ListAttestationsRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
-	return i, nil
+
+func (m *StateProof) GetGeneralizedIndices() []uint64 {
+	if m != nil {
+		return m.GeneralizedIndices
+	}
+	return nil
 }
-func (m *ListAttestationsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	return i, nil
+
+func (m *StateProof) GetLeaves() [][]byte {
+	if m != nil {
+		return m.Leaves
+	}
+	return nil
 }
-func (m *ListAttestationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+
+func (m *StateProof) GetHashes() [][]byte {
+	if m != nil {
+		return m.Hashes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ListAttestationsRequest)(nil), "ethereum.eth.v1alpha1.ListAttestationsRequest")
+	proto.RegisterType((*ListAttestationsResponse)(nil), "ethereum.eth.v1alpha1.ListAttestationsResponse")
+	proto.RegisterType((*ListBlocksRequest)(nil), "ethereum.eth.v1alpha1.ListBlocksRequest")
+	proto.RegisterType((*ListBlocksResponse)(nil), "ethereum.eth.v1alpha1.ListBlocksResponse")
+	proto.RegisterType((*ChainHead)(nil), "ethereum.eth.v1alpha1.ChainHead")
+	proto.RegisterType((*StateSelector)(nil), "ethereum.eth.v1alpha1.StateSelector")
+	proto.RegisterType((*GetValidatorBalancesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorBalancesRequest")
+	proto.RegisterType((*ValidatorBalances)(nil), "ethereum.eth.v1alpha1.ValidatorBalances")
+	proto.RegisterType((*ValidatorBalances_Balance)(nil), "ethereum.eth.v1alpha1.ValidatorBalances.Balance")
+	proto.RegisterType((*GetValidatorsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorsRequest")
+	proto.RegisterType((*Validators)(nil), "ethereum.eth.v1alpha1.Validators")
+	proto.RegisterType((*GetValidatorActiveSetChangesRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorActiveSetChangesRequest")
+	proto.RegisterType((*ActiveSetChanges)(nil), "ethereum.eth.v1alpha1.ActiveSetChanges")
+	proto.RegisterType((*GetValidatorQueueRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorQueueRequest")
+	proto.RegisterType((*ValidatorQueue)(nil), "ethereum.eth.v1alpha1.ValidatorQueue")
+	proto.RegisterType((*ListValidatorAssignmentsRequest)(nil), "ethereum.eth.v1alpha1.ListValidatorAssignmentsRequest")
+	proto.RegisterType((*ValidatorAssignments)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments")
+	proto.RegisterType((*ValidatorAssignments_CommitteeAssignment)(nil), "ethereum.eth.v1alpha1.ValidatorAssignments.CommitteeAssignment")
+	proto.RegisterType((*GetValidatorParticipationRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorParticipationRequest")
+	proto.RegisterType((*ValidatorParticipation)(nil), "ethereum.eth.v1alpha1.ValidatorParticipation")
+	proto.RegisterType((*GetValidatorRewardsRequest)(nil), "ethereum.eth.v1alpha1.GetValidatorRewardsRequest")
+	proto.RegisterType((*ValidatorRewards)(nil), "ethereum.eth.v1alpha1.ValidatorRewards")
+	proto.RegisterType((*ValidatorRewards_Breakdown)(nil), "ethereum.eth.v1alpha1.ValidatorRewards.Breakdown")
+	proto.RegisterType((*AttestationPoolResponse)(nil), "ethereum.eth.v1alpha1.AttestationPoolResponse")
+	proto.RegisterType((*StateProofRequest)(nil), "ethereum.eth.v1alpha1.StateProofRequest")
+	proto.RegisterType((*StateProof)(nil), "ethereum.eth.v1alpha1.StateProof")
+}
+
+func init() {
+	proto.RegisterFile("proto/eth/v1alpha1/beacon_chain.proto", fileDescriptor_678c88b69c3c78d4)
+}
+
+var fileDescriptor_678c88b69c3c78d4 = []byte{
+	// 2047 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6c, 0x1b, 0xc7,
+	0x15, 0xce, 0x88, 0xa4, 0x24, 0x3e, 0xfd, 0x8f, 0x64, 0x99, 0x59, 0xdb, 0x12, 0xbd, 0xfe, 0x09,
+	0x0d, 0xc5, 0x64, 0xa4, 0xb8, 0x69, 0xa1, 0xa0, 0x48, 0x4d, 0x35, 0xb5, 0xd3, 0xf8, 0xa0, 0xae,
+	0x82, 0x1c, 0x7a, 0x21, 0x86, 0xcb, 0x11, 0xb9, 0xd1, 0x6a, 0x67, 0xbd, 0x3b, 0x54, 0x2c, 0xdf,
+	0x9a, 0x43, 0x80, 0x1e, 0x7a, 0x0a, 0x8a, 0xa2, 0x28, 0x50, 0x14, 0xed, 0xa9, 0x28, 0x72, 0x6a,
+	0x51, 0x14, 0xe8, 0xa5, 0xe8, 0xa9, 0xa7, 0x22, 0x68, 0xcf, 0x0d, 0x0a, 0xa3, 0xd7, 0xa2, 0x80,
+	0x6f, 0xbd, 0x15, 0xf3, 0xb3, 0xbb, 0x43, 0x8a, 0x2b, 0xd2, 0xb0, 0x8a, 0xdc, 0xb8, 0x6f, 0xbe,
+	0x79, 0xef, 0x9b, 0x37, 0x6f, 0xde, 0xbc, 0x79, 0x84, 0x5b, 0x61, 0xc4, 0x38, 0x6b, 0x50, 0xde,
+	0x6b, 0x9c, 0x6c, 0x13, 0x3f, 0xec, 0x91, 0xed, 0x46, 0x9b, 0x12, 0x97, 0x05, 0x2d, 0xb7, 0x47,
+	0xbc, 0xa0, 0x2e, 0xc7, 0xf1, 0x25, 0xca, 0x7b, 0x34, 0xa2, 0xfd, 0xe3, 0x3a, 0xe5, 0xbd, 0x7a,
+	0x82, 0xb4, 0xee, 0x76, 0x3d, 0xde, 0xeb, 0xb7, 0xeb, 0x2e, 0x3b, 0x6e, 0x74, 0x59, 0x97, 0x35,
+	0x24, 0xba, 0xdd, 0x3f, 0x94, 0x5f, 0x4a, 0xb5, 0xf8, 0xa5, 0xb4, 0x58, 0x57, 0xbb, 0x8c, 0x75,
+	0x7d, 0xda, 0x20, 0xa1, 0xd7, 0x20, 0x41, 0xc0, 0x38, 0xe1, 0x1e, 0x0b, 0x62, 0x3d, 0x7a, 0x45,
+	0x8f, 0xa6, 0x3a, 0xe8, 0x71, 0xc8, 0x4f, 0xf5, 0xe0, 0xcd, 0x11, 0x3c, 0x09, 0xe7, 0x34, 0x56,
+	0x3a, 0x34, 0xea, 0x9c, 0xd5, 0xb4, 0x7d, 0xe6, 0x1e, 0x69, 0x98, 0x3d, 0x02, 0x76, 0x42, 0x7c,
+	0xaf, 0x43, 0x38, 0x8b, 0x14, 0xc6, 0xfe, 0x1d, 0x82, 0xcb, 0x8f, 0xbc, 0x98, 0xdf, 0xcf, 0x8c,
+	0xc4, 0x0e, 0x7d, 0xdc, 0xa7, 0x31, 0xc7, 0x9b, 0x00, 0x52, 0x5d, 0x2b, 0x62, 0x8c, 0x57, 0x50,
+	0x15, 0xd5, 0xe6, 0x1f, 0xbe, 0xe2, 0x94, 0xa5, 0xcc, 0x61, 0x8c, 0xe3, 0x35, 0x28, 0xc6, 0x3e,
+	0xe3, 0x95, 0xa9, 0x2a, 0xaa, 0x15, 0x1f, 0xbe, 0xe2, 0xc8, 0x2f, 0xbc, 0x0e, 0x25, 0x1a, 0x32,
+	0xb7, 0x57, 0x29, 0x68, 0xb1, 0xfa, 0xc4, 0x57, 0xa0, 0x1c, 0x92, 0x2e, 0x6d, 0xc5, 0xde, 0x53,
+	0x5a, 0x29, 0x56, 0x51, 0xad, 0xe4, 0xcc, 0x0a, 0xc1, 0x81, 0xf7, 0x94, 0xe2, 0x6b, 0x00, 0x72,
+	0x90, 0xb3, 0x23, 0x1a, 0x54, 0x4a, 0x55, 0x54, 0x2b, 0x3b, 0x12, 0xfe, 0x81, 0x10, 0x34, 0x17,
+	0x61, 0xfe, 0x71, 0x9f, 0x46, 0xa7, 0xad, 0x43, 0xcf, 0xe7, 0x34, 0xb2, 0x7f, 0x8d, 0xa0, 0x72,
+	0x96, 0x76, 0x1c, 0xb2, 0x20, 0xa6, 0xf8, 0x3b, 0x30, 0x6f, 0xf8, 0x2c, 0xae, 0xa0, 0x6a, 0xa1,
+	0x36, 0xb7, 0x63, 0xd7, 0x47, 0x6e, 0x6e, 0xdd, 0x50, 0xe1, 0x0c, 0xcc, 0xc3, 0xb7, 0x61, 0x29,
+	0xa0, 0x4f, 0x78, 0xcb, 0x20, 0x36, 0x25, 0x89, 0x2d, 0x08, 0xf1, 0x7e, 0x42, 0x4e, 0x70, 0xe7,
+	0x8c, 0x13, 0x5f, 0xad, 0xac, 0x20, 0x57, 0x56, 0x96, 0x12, 0xb1, 0x34, 0xfb, 0x57, 0x08, 0x56,
+	0x04, 0xd7, 0xa6, 0xf0, 0x5b, 0xea, 0xdc, 0x35, 0x28, 0x0e, 0xb8, 0x55, 0x7e, 0x7d, 0x85, 0x1e,
+	0xfd, 0x09, 0x02, 0x6c, 0xb2, 0xd4, 0xbe, 0xdc, 0x85, 0x69, 0xb9, 0xdf, 0xe3, 0xbc, 0xd8, 0x94,
+	0xe1, 0x27, 0x27, 0x3b, 0x7a, 0xc6, 0x45, 0xf9, 0xef, 0xcf, 0x05, 0x28, 0xef, 0x89, 0x43, 0xfa,
+	0x90, 0x92, 0x0e, 0x7e, 0xe3, 0x6c, 0x50, 0x36, 0x57, 0x9e, 0x7f, 0xb9, 0xb9, 0x10, 0xc7, 0x4f,
+	0xef, 0x0a, 0x05, 0xbb, 0xf6, 0x9b, 0x3b, 0xb6, 0x19, 0xa5, 0xd7, 0x92, 0x19, 0x99, 0x67, 0xf5,
+	0xf0, 0x81, 0x70, 0xee, 0x2d, 0x58, 0x3c, 0xf4, 0x02, 0xe2, 0x7b, 0x4f, 0x69, 0x47, 0x41, 0xa4,
+	0x97, 0x9d, 0x85, 0x54, 0x2a, 0x61, 0x7b, 0xb0, 0x96, 0xc1, 0x0c, 0x06, 0xc5, 0x3c, 0x06, 0x38,
+	0x85, 0x37, 0x53, 0x2a, 0xb7, 0x60, 0xf1, 0xa3, 0x7e, 0xcc, 0xbd, 0x43, 0x2f, 0xb1, 0x55, 0x52,
+	0xb6, 0x52, 0x69, 0x62, 0x2b, 0x83, 0x19, 0xb6, 0xa6, 0x73, 0x6d, 0xa5, 0xf0, 0xcc, 0xd6, 0x5b,
+	0x70, 0x39, 0x8c, 0xe8, 0x89, 0xc7, 0xfa, 0x71, 0x6b, 0xc8, 0xe8, 0x8c, 0x34, 0x7a, 0x29, 0x19,
+	0xfe, 0xee, 0x80, 0xf1, 0x0f, 0xe0, 0xda, 0x88, 0x79, 0x06, 0x8b, 0xd9, 0x3c, 0x16, 0xd6, 0x19,
+	0x85, 0x29, 0x1b, 0xfb, 0x3f, 0x08, 0x16, 0x0e, 0x38, 0xe1, 0xf4, 0x80, 0xfa, 0xd4, 0xe5, 0x2c,
+	0x12, 0xa1, 0xde, 0xa3, 0xa4, 0x23, 0xb7, 0x70, 0x56, 0x84, 0xba, 0xf8, 0xc2, 0x1b, 0x50, 0x4e,
+	0xfd, 0x56, 0x99, 0xd2, 0x43, 0x99, 0x48, 0x8c, 0xa7, 0xa4, 0x2a, 0x85, 0x64, 0x3c, 0x15, 0xa5,
+	0x07, 0xa8, 0x38, 0x70, 0x80, 0x76, 0x00, 0xc4, 0xa9, 0xa6, 0x6a, 0x01, 0xa5, 0x9c, 0x05, 0x08,
+	0x4d, 0x12, 0x26, 0xfd, 0xb7, 0x03, 0x30, 0x81, 0xeb, 0x07, 0x12, 0x62, 0x13, 0x60, 0x36, 0xd6,
+	0xeb, 0xb3, 0xff, 0x8d, 0xe0, 0xca, 0x03, 0xca, 0x3f, 0x4c, 0x12, 0x6e, 0x93, 0xf8, 0x24, 0x70,
+	0x69, 0x9a, 0x00, 0xd2, 0x43, 0x8d, 0x06, 0x0f, 0xb5, 0x05, 0x33, 0x5d, 0x1a, 0xd0, 0xd8, 0x8b,
+	0x2b, 0x25, 0xbd, 0xbe, 0x44, 0x80, 0xef, 0xc1, 0x5c, 0xd8, 0x6f, 0xfb, 0x9e, 0xdb, 0x3a, 0xa2,
+	0xa7, 0x71, 0x65, 0xaa, 0x5a, 0xa8, 0xcd, 0x37, 0x57, 0x9f, 0x7f, 0xb9, 0xb9, 0x94, 0x91, 0x7a,
+	0xe7, 0xf5, 0x7b, 0xdf, 0xb0, 0x1d, 0x50, 0xb8, 0xf7, 0xe9, 0x69, 0x8c, 0x2b, 0x30, 0xe3, 0x05,
+	0x1d, 0xcf, 0xa5, 0x71, 0xa5, 0x50, 0x2d, 0xd4, 0x8a, 0x4e, 0xf2, 0x89, 0x77, 0xa1, 0x24, 0x17,
+	0x2c, 0xdd, 0x35, 0xb7, 0x73, 0x33, 0xe7, 0x70, 0x0f, 0x6c, 0x9c, 0xa3, 0xa6, 0x9c, 0x49, 0x20,
+	0x7f, 0x45, 0xb0, 0x72, 0x66, 0xb1, 0xf8, 0x11, 0xcc, 0xb6, 0xf5, 0x6f, 0x9d, 0x41, 0xde, 0xc8,
+	0x31, 0x72, 0x66, 0x6e, 0x5d, 0xff, 0x70, 0x52, 0x0d, 0xd6, 0x11, 0xcc, 0x68, 0xa1, 0xc8, 0x03,
+	0x99, 0x2b, 0x46, 0xe7, 0x01, 0xe1, 0x87, 0x72, 0xea, 0x07, 0xbc, 0x06, 0x25, 0x2f, 0xe8, 0xd0,
+	0x27, 0x3a, 0x05, 0xa8, 0x0f, 0xe1, 0x1c, 0xad, 0x5e, 0x9f, 0xfb, 0xe4, 0xd3, 0xfe, 0x1b, 0x82,
+	0x35, 0x73, 0x03, 0x5f, 0x64, 0xe7, 0xa6, 0x86, 0x77, 0x6e, 0x20, 0x55, 0x17, 0xce, 0x4d, 0xd5,
+	0xc5, 0xa1, 0x54, 0x9d, 0xed, 0x52, 0xe9, 0xe5, 0x77, 0xe9, 0x37, 0x08, 0x20, 0x5b, 0x91, 0xf0,
+	0x89, 0xb1, 0x94, 0x64, 0x21, 0xdf, 0x02, 0x48, 0xeb, 0x04, 0x15, 0x65, 0x73, 0x3b, 0xd5, 0x71,
+	0xdb, 0xe6, 0x18, 0x73, 0x46, 0xa5, 0xfe, 0xc2, 0xf8, 0xd4, 0x5f, 0x1c, 0x4e, 0xfd, 0x6f, 0xc3,
+	0x0d, 0x73, 0x07, 0xee, 0xbb, 0xdc, 0x3b, 0xa1, 0x07, 0x94, 0xef, 0xf5, 0x48, 0xd0, 0xa5, 0xc6,
+	0x5d, 0x3a, 0x62, 0x15, 0xf6, 0x7f, 0x11, 0x2c, 0x0f, 0xcf, 0xc8, 0x59, 0xf0, 0x03, 0xb8, 0x44,
+	0x04, 0x92, 0x70, 0xda, 0x69, 0x4d, 0x78, 0xc2, 0x56, 0xd3, 0x19, 0xfb, 0xd9, 0x51, 0xbb, 0x0f,
+	0x98, 0x3e, 0xf1, 0x86, 0xb5, 0x14, 0xf2, 0xb5, 0x2c, 0x2b, 0xb8, 0xa1, 0x62, 0x0f, 0x56, 0xe9,
+	0x47, 0xd4, 0x1d, 0xd6, 0x51, 0xcc, 0xd7, 0xb1, 0xa2, 0xf1, 0x99, 0x12, 0xfb, 0x43, 0xa8, 0x98,
+	0x8e, 0xfb, 0x5e, 0x9f, 0xf6, 0x69, 0xe2, 0xad, 0x34, 0x9c, 0xd0, 0x0b, 0x87, 0x93, 0xfd, 0x47,
+	0x04, 0x8b, 0x83, 0x5a, 0xf1, 0x26, 0xcc, 0xb9, 0xbd, 0x7e, 0x14, 0xb4, 0x7c, 0xef, 0xd8, 0xe3,
+	0xda, 0xaf, 0x20, 0x45, 0x8f, 0x84, 0x04, 0xbf, 0x07, 0xeb, 0xda, 0x55, 0x1e, 0x0b, 0x26, 0xf5,
+	0xee, 0x5a, 0x36, 0xc5, 0xf0, 0xcd, 0x37, 0x41, 0xfa, 0x6b, 0x52, 0xe7, 0x2e, 0x0a, 0xb0, 0xe1,
+	0x95, 0xcf, 0xa7, 0x60, 0x53, 0xd4, 0x38, 0x59, 0x40, 0xc5, 0xb1, 0xd7, 0x0d, 0x8e, 0x69, 0xc0,
+	0x5f, 0xe4, 0x70, 0xcf, 0xfc, 0xbf, 0xd3, 0xf2, 0x4b, 0xd4, 0x75, 0xd9, 0xee, 0x4e, 0xbf, 0x7c,
+	0xb2, 0xf8, 0x69, 0x01, 0xd6, 0x46, 0xf9, 0x2a, 0xe7, 0x14, 0x11, 0x98, 0x23, 0x19, 0x48, 0xe7,
+	0x8d, 0x77, 0xc6, 0xe5, 0x0d, 0x43, 0x6f, 0x7d, 0x8f, 0x1d, 0x1f, 0x7b, 0x9c, 0x53, 0x9a, 0x09,
+	0x1d, 0x53, 0xe7, 0x05, 0xe5, 0x15, 0xeb, 0x4f, 0x08, 0x56, 0x47, 0xd8, 0xc2, 0xdb, 0xb0, 0xe6,
+	0x46, 0x2c, 0x8e, 0x7d, 0x2f, 0x38, 0x6a, 0xb9, 0x09, 0x40, 0xdd, 0x5c, 0x45, 0x67, 0x35, 0x1d,
+	0x4b, 0xe7, 0x4a, 0x57, 0xc4, 0x3d, 0x12, 0x75, 0x92, 0x5b, 0x45, 0x7e, 0x60, 0xac, 0xcb, 0x10,
+	0x75, 0xa5, 0xc8, 0xdf, 0xd8, 0x82, 0xd9, 0x30, 0x62, 0x21, 0x8b, 0x69, 0x24, 0x19, 0xcd, 0x3a,
+	0xe9, 0xf7, 0xd0, 0x6d, 0x56, 0x1a, 0x7f, 0x9b, 0xd9, 0xbf, 0x44, 0x50, 0x35, 0x8f, 0xf8, 0x3e,
+	0x89, 0xb8, 0xe7, 0x7a, 0xa1, 0x7a, 0xc8, 0x4c, 0x1e, 0xcc, 0x85, 0xe1, 0x60, 0x4e, 0x03, 0x68,
+	0xea, 0xe5, 0x03, 0xe8, 0x0b, 0x04, 0xeb, 0xa3, 0x19, 0xe6, 0x84, 0xd0, 0xd5, 0x33, 0xe5, 0x9f,
+	0x59, 0xfc, 0xed, 0xc2, 0xab, 0x5d, 0x9f, 0xb5, 0x89, 0xdf, 0x0a, 0x4d, 0x5d, 0xad, 0x48, 0xd0,
+	0x15, 0x0b, 0x99, 0x72, 0x2e, 0x2b, 0xc0, 0xa0, 0x37, 0x08, 0x97, 0x69, 0xea, 0x84, 0x89, 0xa4,
+	0x2a, 0x97, 0xa3, 0xea, 0x43, 0x07, 0xa4, 0xe8, 0x5d, 0x21, 0x11, 0xb5, 0x39, 0xf5, 0xbd, 0xae,
+	0xd7, 0xf6, 0xa9, 0xc6, 0xe8, 0xda, 0x3c, 0x91, 0x4a, 0x98, 0xfd, 0x09, 0x02, 0xcb, 0xf4, 0xbb,
+	0x43, 0x3f, 0x26, 0x51, 0xe7, 0xfc, 0xab, 0xe8, 0xa2, 0x13, 0x84, 0xfd, 0xa3, 0x12, 0x2c, 0x0f,
+	0x33, 0xc8, 0x31, 0xfd, 0x3e, 0xcc, 0x44, 0x0a, 0xa0, 0x0f, 0xe4, 0xf6, 0xd8, 0x8b, 0x5c, 0xc1,
+	0xeb, 0xcd, 0x88, 0x92, 0xa3, 0x0e, 0xfb, 0x38, 0x70, 0x12, 0x0d, 0xd6, 0x8f, 0x8b, 0x50, 0x4e,
+	0xc5, 0x17, 0x56, 0x82, 0xdd, 0x80, 0x85, 0x98, 0xf5, 0x23, 0x97, 0xb6, 0x94, 0x1d, 0x7d, 0x6a,
+	0xe6, 0x95, 0x50, 0xd1, 0x11, 0xdb, 0xa3, 0x41, 0x21, 0x0d, 0x88, 0xcf, 0x4f, 0xf5, 0x16, 0xea,
+	0xa9, 0xfb, 0x4a, 0x28, 0x74, 0x71, 0x12, 0x75, 0x29, 0x4f, 0x74, 0xa9, 0x4d, 0x9c, 0x57, 0xc2,
+	0x4c, 0x97, 0x06, 0x25, 0xba, 0xa6, 0x95, 0x2e, 0x25, 0x4d, 0x74, 0x6d, 0xc2, 0x9c, 0x78, 0x93,
+	0x24, 0x9a, 0xd4, 0xab, 0x09, 0x84, 0x48, 0xeb, 0xb9, 0x0e, 0xf3, 0x12, 0x90, 0x68, 0x99, 0x95,
+	0x08, 0x39, 0x29, 0xd1, 0x71, 0x0f, 0xd6, 0xbd, 0xc0, 0xf5, 0xfb, 0xb1, 0x88, 0xd3, 0x0e, 0xf5,
+	0xc9, 0x69, 0xa2, 0xae, 0x2c, 0xc1, 0x6b, 0xe9, 0xe8, 0xb7, 0xc5, 0xa0, 0x56, 0x7c, 0x07, 0x96,
+	0xb3, 0x3c, 0xa4, 0xf1, 0x20, 0xf1, 0x4b, 0xa9, 0x5c, 0x43, 0xb7, 0x60, 0x25, 0x83, 0x26, 0x44,
+	0xe6, 0x24, 0x36, 0xd3, 0x91, 0xb0, 0xb9, 0x0b, 0xd8, 0x0b, 0xe4, 0xcd, 0xea, 0xf1, 0xd3, 0x14,
+	0x3d, 0x2f, 0xd1, 0x2b, 0xd9, 0x48, 0x02, 0x7f, 0x0d, 0x96, 0x92, 0x0c, 0x95, 0xb0, 0x58, 0x90,
+	0xd8, 0xc5, 0x44, 0xac, 0x48, 0xd8, 0x04, 0x2e, 0x1b, 0x6d, 0x94, 0x7d, 0xc6, 0xfc, 0x8b, 0x6e,
+	0xc6, 0xd8, 0x14, 0x56, 0x64, 0xca, 0xd9, 0x8f, 0x18, 0x3b, 0xbc, 0x80, 0x52, 0x46, 0xc4, 0x62,
+	0x48, 0x78, 0x4f, 0x1d, 0x8b, 0xb2, 0xa3, 0x3e, 0xec, 0x7f, 0x20, 0x80, 0xcc, 0x8e, 0x08, 0x71,
+	0xe3, 0xe1, 0x98, 0xdf, 0x6d, 0xc8, 0x9e, 0x8d, 0xd8, 0xec, 0xe0, 0xe8, 0xcc, 0xdf, 0x80, 0x55,
+	0x91, 0x5d, 0x23, 0xdd, 0x3d, 0x18, 0x3c, 0xd4, 0xd8, 0x18, 0x7a, 0x4f, 0x8d, 0xe0, 0x2d, 0x98,
+	0xf6, 0x29, 0x39, 0xa1, 0xb9, 0x65, 0x9f, 0x30, 0xaa, 0x21, 0x02, 0xdc, 0x23, 0x71, 0x8f, 0x8a,
+	0xf7, 0x62, 0x3e, 0x58, 0x41, 0x76, 0xfe, 0xb0, 0x08, 0x73, 0xaa, 0x57, 0x23, 0x5b, 0x2a, 0xf8,
+	0xe7, 0x08, 0x96, 0x87, 0x1b, 0x69, 0xb8, 0x9e, 0xe3, 0xc7, 0x9c, 0x46, 0xa1, 0xd5, 0x98, 0x18,
+	0xaf, 0x82, 0xc2, 0xbe, 0xf3, 0xc9, 0xdf, 0xff, 0xf5, 0xd9, 0xd4, 0x0d, 0x7c, 0x7d, 0x54, 0x0f,
+	0xb3, 0x31, 0xd0, 0x84, 0xfb, 0x21, 0x82, 0xa5, 0xa1, 0xd8, 0xc2, 0xeb, 0x75, 0xd5, 0x43, 0xad,
+	0x27, 0x3d, 0xd4, 0xfa, 0xbb, 0xa2, 0x87, 0x6a, 0xd5, 0xc7, 0x47, 0x95, 0x19, 0x9b, 0x76, 0x5d,
+	0xd2, 0xa8, 0xe1, 0xdb, 0x63, 0x69, 0x34, 0x42, 0x61, 0xf7, 0x53, 0x04, 0x90, 0xf5, 0xc8, 0x70,
+	0xed, 0x9c, 0x65, 0x0f, 0x34, 0xfb, 0xac, 0x3b, 0x13, 0x20, 0x35, 0xa7, 0x1b, 0x92, 0xd3, 0x35,
+	0x7c, 0x65, 0x24, 0x27, 0xdd, 0x59, 0x0b, 0x61, 0xfe, 0x01, 0xe5, 0x59, 0x53, 0x2c, 0xcf, 0x21,
+	0x79, 0x8f, 0xb6, 0x74, 0xa6, 0x7d, 0x5b, 0x9a, 0xab, 0xe2, 0x8d, 0x91, 0xe6, 0x64, 0x6f, 0x5c,
+	0xf6, 0x65, 0x7e, 0x81, 0xe0, 0xd2, 0x40, 0xe9, 0x9c, 0xbe, 0xf0, 0x77, 0x72, 0x6c, 0x9c, 0xd3,
+	0xfb, 0xb0, 0x6a, 0x93, 0xf6, 0x00, 0xf2, 0x22, 0x25, 0x7b, 0x6a, 0x36, 0x92, 0xe6, 0x00, 0xfe,
+	0x01, 0x82, 0x05, 0xd3, 0x68, 0x8c, 0xb7, 0x26, 0xa0, 0x96, 0x72, 0xba, 0x3e, 0x8e, 0x53, 0x6c,
+	0x57, 0x25, 0x19, 0x0b, 0x57, 0xf2, 0xc8, 0xe0, 0xdf, 0x23, 0xb8, 0x7a, 0xde, 0x8b, 0x15, 0xef,
+	0x4e, 0x40, 0x29, 0xe7, 0x99, 0x6b, 0xbd, 0x96, 0x17, 0xde, 0x43, 0x78, 0x7b, 0x5b, 0xf2, 0xdc,
+	0xc2, 0x77, 0x72, 0x9d, 0x26, 0x33, 0x3d, 0x8d, 0x29, 0x77, 0x35, 0xaf, 0xcf, 0x10, 0xac, 0x9c,
+	0x79, 0x31, 0xe2, 0xc6, 0x04, 0x6c, 0xcd, 0xb7, 0xa5, 0x75, 0x6b, 0x9c, 0x13, 0x25, 0x3a, 0x2f,
+	0xea, 0x0c, 0x82, 0x8f, 0xa5, 0xfd, 0xcf, 0x75, 0x9b, 0x7f, 0xe4, 0x23, 0xe4, 0xad, 0x73, 0x0e,
+	0xd5, 0x39, 0x2f, 0x3c, 0x6b, 0xeb, 0x05, 0x5e, 0x24, 0xf6, 0xeb, 0x92, 0xe9, 0x6d, 0x7c, 0x33,
+	0xdf, 0x95, 0x06, 0xa5, 0xdf, 0x22, 0x78, 0x35, 0xb7, 0x28, 0xc7, 0x5f, 0x9f, 0xc0, 0x9b, 0xa3,
+	0xca, 0x78, 0xeb, 0xee, 0x38, 0xc6, 0x03, 0xb3, 0xf2, 0xd2, 0x9a, 0xc1, 0x79, 0xa0, 0x7c, 0xc6,
+	0x3f, 0x43, 0xb0, 0x3a, 0xa2, 0xa4, 0xc5, 0xdb, 0x13, 0xf0, 0x1d, 0x2c, 0x7f, 0x73, 0x43, 0x74,
+	0x18, 0x6f, 0xd7, 0x24, 0x47, 0x1b, 0x57, 0x73, 0x39, 0xea, 0x9a, 0x13, 0x7f, 0xaa, 0x8e, 0xb5,
+	0x71, 0x29, 0xd7, 0xce, 0xbb, 0xe6, 0xcd, 0xfa, 0xc0, 0xba, 0x3e, 0x16, 0x99, 0x47, 0x44, 0x27,
+	0x40, 0x79, 0xaf, 0x8b, 0xbf, 0xea, 0xd8, 0x61, 0x73, 0xef, 0x2f, 0xcf, 0x36, 0xd0, 0x17, 0xcf,
+	0x36, 0xd0, 0x3f, 0x9f, 0x6d, 0xa0, 0xef, 0x7f, 0xcd, 0xf8, 0x4f, 0x30, 0x8c, 0x4e, 0xe3, 0x63,
+	0xc2, 0x3d, 0xd7, 0x27, 0xed, 0x58, 0x7d, 0x35, 0xce, 0xfe, 0xf7, 0xf6, 0x36, 0xe5, 0xbd, 0xf6,
+	0xb4, 0x94, 0xbf, 0xf9, 0xbf, 0x01, 0x00, 0x87, 0x7d, 0xd5, 0x06, 0x91, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BeaconChainClient is the client API for BeaconChain service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BeaconChainClient interface {
+	ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error)
+	AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error)
+	ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error)
+	GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error)
+	ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error)
+	GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error)
+	GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error)
+	GetValidatorQueue(ctx context.Context, in *GetValidatorQueueRequest, opts ...grpc.CallOption) (*ValidatorQueue, error)
+	ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error)
+	GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error)
+	GetValidatorRewards(ctx context.Context, in *GetValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewards, error)
+	GetStateProof(ctx context.Context, in *StateProofRequest, opts ...grpc.CallOption) (*StateProof, error)
+}
+
+type beaconChainClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBeaconChainClient(cc *grpc.ClientConn) BeaconChainClient {
+	return &beaconChainClient{cc}
+}
+
+func (c *beaconChainClient) ListAttestations(ctx context.Context, in *ListAttestationsRequest, opts ...grpc.CallOption) (*ListAttestationsResponse, error) {
+	out := new(ListAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, msg := range m.Attestations {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) AttestationPool(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*AttestationPoolResponse, error) {
+	out := new(AttestationPoolResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ListBlocksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) ListBlocks(ctx context.Context, in *ListBlocksRequest, opts ...grpc.CallOption) (*ListBlocksResponse, error) {
+	out := new(ListBlocksResponse)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		nn2, err := m.QueryFilter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn2
-	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
-	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) GetChainHead(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*ChainHead, error) {
+	out := new(ChainHead)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ListBlocksRequest_Root) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.Root != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Root)))
-		i += copy(dAtA[i:], m.Root)
+func (c *beaconChainClient) ListValidatorBalances(ctx context.Context, in *GetValidatorBalancesRequest, opts ...grpc.CallOption) (*ValidatorBalances, error) {
+	out := new(ValidatorBalances)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
-}
-func (m *ListBlocksRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
-	return i, nil
-}
-func (m *ListBlocksRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x18
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	return i, nil
+	return out, nil
 }
-func (m *ListBlocksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+
+func (c *beaconChainClient) GetValidators(ctx context.Context, in *GetValidatorsRequest, opts ...grpc.CallOption) (*Validators, error) {
+	out := new(Validators)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Blocks) > 0 {
-		for _, msg := range m.Blocks {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
-	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) GetValidatorActiveSetChanges(ctx context.Context, in *GetValidatorActiveSetChangesRequest, opts ...grpc.CallOption) (*ActiveSetChanges, error) {
+	out := new(ActiveSetChanges)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ChainHead) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) GetValidatorQueue(ctx context.Context, in *GetValidatorQueueRequest, opts ...grpc.CallOption) (*ValidatorQueue, error) {
+	out := new(ValidatorQueue)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ChainHead) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.BlockRoot) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
-		i += copy(dAtA[i:], m.BlockRoot)
-	}
-	if m.BlockSlot != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.BlockSlot))
-	}
-	if m.FinalizedSlot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedSlot))
-	}
-	if len(m.FinalizedBlockRoot) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.FinalizedBlockRoot)))
-		i += copy(dAtA[i:], m.FinalizedBlockRoot)
-	}
-	if m.JustifiedSlot != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.JustifiedSlot))
-	}
-	if len(m.JustifiedBlockRoot) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.JustifiedBlockRoot)))
-		i += copy(dAtA[i:], m.JustifiedBlockRoot)
-	}
-	if m.PreviousJustifiedSlot != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousJustifiedSlot))
-	}
-	if len(m.PreviousJustifiedBlockRoot) > 0 {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PreviousJustifiedBlockRoot)))
-		i += copy(dAtA[i:], m.PreviousJustifiedBlockRoot)
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) ListValidatorAssignments(ctx context.Context, in *ListValidatorAssignmentsRequest, opts ...grpc.CallOption) (*ValidatorAssignments, error) {
+	out := new(ValidatorAssignments)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) GetValidatorParticipation(ctx context.Context, in *GetValidatorParticipationRequest, opts ...grpc.CallOption) (*ValidatorParticipation, error) {
+	out := new(ValidatorParticipation)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if len(m.Indices) > 0 {
-		dAtA4 := make([]byte, len(m.Indices)*10)
-		var j3 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+func (c *beaconChainClient) GetValidatorRewards(ctx context.Context, in *GetValidatorRewardsRequest, opts ...grpc.CallOption) (*ValidatorRewards, error) {
+	out := new(ValidatorRewards)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
+	return out, nil
 }
 
-func (m *ValidatorBalances) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
+func (c *beaconChainClient) GetStateProof(ctx context.Context, in *StateProofRequest, opts ...grpc.CallOption) (*StateProof, error) {
+	out := new(StateProof)
+	err := c.cc.Invoke(ctx, "/ethereum.eth.v1alpha1.BeaconChain/GetStateProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *ValidatorBalances) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for _, msg := range m.Balances {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	return i, nil
+// BeaconChainServer is the server API for BeaconChain service.
+type BeaconChainServer interface {
+	ListAttestations(context.Context, *ListAttestationsRequest) (*ListAttestationsResponse, error)
+	AttestationPool(context.Context, *types.Empty) (*AttestationPoolResponse, error)
+	ListBlocks(context.Context, *ListBlocksRequest) (*ListBlocksResponse, error)
+	GetChainHead(context.Context, *types.Empty) (*ChainHead, error)
+	ListValidatorBalances(context.Context, *GetValidatorBalancesRequest) (*ValidatorBalances, error)
+	GetValidators(context.Context, *GetValidatorsRequest) (*Validators, error)
+	GetValidatorActiveSetChanges(context.Context, *GetValidatorActiveSetChangesRequest) (*ActiveSetChanges, error)
+	GetValidatorQueue(context.Context, *GetValidatorQueueRequest) (*ValidatorQueue, error)
+	ListValidatorAssignments(context.Context, *ListValidatorAssignmentsRequest) (*ValidatorAssignments, error)
+	GetValidatorParticipation(context.Context, *GetValidatorParticipationRequest) (*ValidatorParticipation, error)
+	GetValidatorRewards(context.Context, *GetValidatorRewardsRequest) (*ValidatorRewards, error)
+	GetStateProof(context.Context, *StateProofRequest) (*StateProof, error)
 }
 
-func (m *ValidatorBalances_Balance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func RegisterBeaconChainServer(s *grpc.Server, srv BeaconChainServer) {
+	s.RegisterService(&_BeaconChain_serviceDesc, srv)
+}
+
+func _BeaconChain_ListAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttestationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListAttestations(ctx, req.(*ListAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorBalances_Balance) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.PublicKey) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+func _BeaconChain_AttestationPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Index != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).AttestationPool(ctx, in)
 	}
-	if m.Balance != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Balance))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/AttestationPool",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).AttestationPool(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlocksRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListBlocks(ctx, req.(*ListBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.QueryFilter != nil {
-		nn5, err := m.QueryFilter.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += nn5
+func _BeaconChain_GetChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.PageSize != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetChainHead(ctx, in)
 	}
-	if len(m.PageToken) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
-		i += copy(dAtA[i:], m.PageToken)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetChainHead",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetChainHead(ctx, req.(*types.Empty))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x8
-	i++
-	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	return i, nil
-}
-func (m *GetValidatorsRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	dAtA[i] = 0x10
-	i++
-	if m.Genesis {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
-	}
-	i++
-	return i, nil
-}
-func (m *Validators) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_ListValidatorBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorBalancesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorBalances(ctx, req.(*GetValidatorBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Validators) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.Validators) > 0 {
-		for _, msg := range m.Validators {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+func _BeaconChain_GetValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
-		i += copy(dAtA[i:], m.NextPageToken)
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidators(ctx, in)
 	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidators",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidators(ctx, req.(*GetValidatorsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_GetValidatorActiveSetChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorActiveSetChangesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorActiveSetChanges",
 	}
-	return i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorActiveSetChanges(ctx, req.(*GetValidatorActiveSetChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_GetValidatorQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorQueueRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorQueue(ctx, req.(*GetValidatorQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+func _BeaconChain_ListValidatorAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListValidatorAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ExitedPublicKeys) > 0 {
-		for _, b := range m.ExitedPublicKeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, in)
 	}
-	if len(m.EjectedPublicKeys) > 0 {
-		for _, b := range m.EjectedPublicKeys {
-			dAtA[i] = 0x22
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/ListValidatorAssignments",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).ListValidatorAssignments(ctx, req.(*ListValidatorAssignmentsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_GetValidatorParticipation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorParticipationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorParticipation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorParticipation(ctx, req.(*GetValidatorParticipationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.ChurnLimit != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
+func _BeaconChain_GetValidatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ActivationPublicKeys) > 0 {
-		for _, b := range m.ActivationPublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetValidatorRewards(ctx, in)
 	}
-	if len(m.ExitPublicKeys) > 0 {
-		for _, b := range m.ExitPublicKeys {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetValidatorRewards",
 	}
-	if m.XXX_unrecognized != nil {
-		i += copy(dAtA[i:], m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetValidatorRewards(ctx, req.(*GetValidatorRewardsRequest))
 	}
-	return i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+func _BeaconChain_GetStateProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateProofRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	if interceptor == nil {
+		return srv.(BeaconChainServer).GetStateProof(ctx, in)
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethereum.eth.v1alpha1.BeaconChain/GetStateProof",
 	}
-	if len(m.Indices) > 0 {
-		dAtA7 := make([]byte, len(m.Indices)*10)
-		var j6 int
-		for _, num := range m.Indices {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BeaconChainServer).GetStateProof(ctx, req.(*StateProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BeaconChain_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ethereum.eth.v1alpha1.BeaconChain",
+	HandlerType: (*BeaconChainServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListAttestations",
+			Handler:    _BeaconChain_ListAttestations_Handler,
+		},
+		{
+			MethodName: "AttestationPool",
+			Handler:    _BeaconChain_AttestationPool_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _BeaconChain_ListBlocks_Handler,
+		},
+		{
+			MethodName: "GetChainHead",
+			Handler:    _BeaconChain_GetChainHead_Handler,
+		},
+		{
+			MethodName: "ListValidatorBalances",
+			Handler:    _BeaconChain_ListValidatorBalances_Handler,
+		},
+		{
+			MethodName: "GetValidators",
+			Handler:    _BeaconChain_GetValidators_Handler,
+		},
+		{
+			MethodName: "GetValidatorActiveSetChanges",
+			Handler:    _BeaconChain_GetValidatorActiveSetChanges_Handler,
+		},
+		{
+			MethodName: "GetValidatorQueue",
+			Handler:    _BeaconChain_GetValidatorQueue_Handler,
+		},
+		{
+			MethodName: "ListValidatorAssignments",
+			Handler:    _BeaconChain_ListValidatorAssignments_Handler,
+		},
+		{
+			MethodName: "GetValidatorParticipation",
+			Handler:    _BeaconChain_GetValidatorParticipation_Handler,
+		},
+		{
+			MethodName: "GetValidatorRewards",
+			Handler:    _BeaconChain_GetValidatorRewards_Handler,
+		},
+		{
+			MethodName: "GetStateProof",
+			Handler:    _BeaconChain_GetStateProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/eth/v1alpha1/beacon_chain.proto",
+}
+
+func (m *ListAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		nn1, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j6))
-		i += copy(dAtA[i:], dAtA7[:j6])
+		i += nn1
 	}
 	if m.PageSize != 0 {
 		dAtA[i] = 0x20
@@ -2806,7 +3302,31 @@ func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorAssignments) Marshal() (dAtA []byte, err error) {
+func (m *ListAttestationsRequest_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlockRoot != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	return i, nil
+}
+func (m *ListAttestationsRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	return i, nil
+}
+func (m *ListAttestationsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *ListAttestationsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2816,19 +3336,14 @@ func (m *ValidatorAssignments) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
-	}
-	if len(m.Assignments) > 0 {
-		for _, msg := range m.Assignments {
-			dAtA[i] = 0x12
+	if len(m.Attestations) > 0 {
+		for _, msg := range m.Attestations {
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -2839,13 +3354,13 @@ func (m *ValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.NextPageToken) > 0 {
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
 		i += copy(dAtA[i:], m.NextPageToken)
 	}
 	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
+		dAtA[i] = 0x18
 		i++
 		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
@@ -2855,7 +3370,7 @@ func (m *ValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorAssignments_CommitteeAssignment) Marshal() (dAtA []byte, err error) {
+func (m *ListBlocksRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2865,53 +3380,28 @@ func (m *ValidatorAssignments_CommitteeAssignment) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorAssignments_CommitteeAssignment) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListBlocksRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.CrosslinkCommittees) > 0 {
-		dAtA9 := make([]byte, len(m.CrosslinkCommittees)*10)
-		var j8 int
-		for _, num := range m.CrosslinkCommittees {
-			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j8++
-			}
-			dAtA9[j8] = uint8(num)
-			j8++
+	if m.QueryFilter != nil {
+		nn2, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(j8))
-		i += copy(dAtA[i:], dAtA9[:j8])
-	}
-	if m.Shard != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Shard))
-	}
-	if m.Slot != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+		i += nn2
 	}
-	if m.Proposer {
+	if m.PageSize != 0 {
 		dAtA[i] = 0x20
 		i++
-		if m.Proposer {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
 	}
-	if len(m.PublicKey) > 0 {
+	if len(m.PageToken) > 0 {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
-		i += copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2919,7 +3409,31 @@ func (m *ValidatorAssignments_CommitteeAssignment) MarshalTo(dAtA []byte) (int,
 	return i, nil
 }
 
-func (m *GetValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListBlocksRequest_Root) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Root != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.Root)))
+		i += copy(dAtA[i:], m.Root)
+	}
+	return i, nil
+}
+func (m *ListBlocksRequest_Slot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	return i, nil
+}
+func (m *ListBlocksRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *ListBlocksResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2929,15 +3443,33 @@ func (m *GetValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListBlocksResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
+	if len(m.Blocks) > 0 {
+		for _, msg := range m.Blocks {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -2945,7 +3477,7 @@ func (m *GetValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+func (m *ChainHead) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2955,49 +3487,62 @@ func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChainHead) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		dAtA[i] = 0x8
+	if len(m.BlockRoot) > 0 {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
 	}
-	if m.Finalized {
+	if m.BlockSlot != 0 {
 		dAtA[i] = 0x10
 		i++
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.BlockSlot))
 	}
-	if m.GlobalParticipationRate != 0 {
-		dAtA[i] = 0x1d
+	if m.FinalizedSlot != 0 {
+		dAtA[i] = 0x18
 		i++
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GlobalParticipationRate))))
-		i += 4
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.FinalizedSlot))
 	}
-	if m.VotedEther != 0 {
-		dAtA[i] = 0x20
+	if len(m.FinalizedBlockRoot) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.VotedEther))
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.FinalizedBlockRoot)))
+		i += copy(dAtA[i:], m.FinalizedBlockRoot)
 	}
-	if m.EligibleEther != 0 {
+	if m.JustifiedSlot != 0 {
 		dAtA[i] = 0x28
 		i++
-		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EligibleEther))
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.JustifiedSlot))
 	}
-	if m.XXX_unrecognized != nil {
+	if len(m.JustifiedBlockRoot) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.JustifiedBlockRoot)))
+		i += copy(dAtA[i:], m.JustifiedBlockRoot)
+	}
+	if m.PreviousJustifiedSlot != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PreviousJustifiedSlot))
+	}
+	if len(m.PreviousJustifiedBlockRoot) > 0 {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PreviousJustifiedBlockRoot)))
+		i += copy(dAtA[i:], m.PreviousJustifiedBlockRoot)
+	}
+	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
+func (m *StateSelector) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -3007,22 +3552,17 @@ func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *StateSelector) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attestations) > 0 {
-		for _, msg := range m.Attestations {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	if m.Selector != nil {
+		nn3, err := m.Selector.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += nn3
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
@@ -3030,558 +3570,1184 @@ func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StateSelector_Head) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	if m.Head {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	i++
+	return i, nil
 }
-func (m *ListAttestationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StateSelector_Finalized) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	if m.Finalized {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *StateSelector_Justified) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	if m.Justified {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *StateSelector_Slot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x20
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	return i, nil
+}
+func (m *StateSelector_StateRoot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.StateRoot != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.StateRoot)))
+		i += copy(dAtA[i:], m.StateRoot)
+	}
+	return i, nil
+}
+func (m *StateSelector_BlockRoot) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.BlockRoot != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.BlockRoot)))
+		i += copy(dAtA[i:], m.BlockRoot)
+	}
+	return i, nil
+}
+func (m *GetValidatorBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+		nn4, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn4
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.Indices) > 0 {
+		dAtA6 := make([]byte, len(m.Indices)*10)
+		var j5 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	if m.State != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.State.Size()))
+		n7, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListAttestationsRequest_BlockRoot) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BlockRoot != nil {
-		l = len(m.BlockRoot)
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	return n
+func (m *GetValidatorBalancesRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
 }
-func (m *ListAttestationsRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorBalancesRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x28
+	i++
+	if m.Genesis {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Slot))
-	return n
+	i++
+	return i, nil
 }
-func (m *ListAttestationsRequest_Epoch) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorBalances) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Epoch))
-	return n
+	return dAtA[:n], nil
 }
-func (m *ListAttestationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ValidatorBalances) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, msg := range m.Balances {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListBlocksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorBalances_Balance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorBalances_Balance) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if m.Balance != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Balance))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListBlocksRequest_Root) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Root != nil {
-		l = len(m.Root)
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if m.QueryFilter != nil {
+		nn8, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn8
 	}
-	return n
-}
-func (m *ListBlocksRequest_Slot) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PageSize != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Slot))
-	return n
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.State != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.State.Size()))
+		n9, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
 }
-func (m *ListBlocksRequest_Epoch) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *GetValidatorsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *GetValidatorsRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x10
+	i++
+	if m.Genesis {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Epoch))
-	return n
+	i++
+	return i, nil
 }
-func (m *ListBlocksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Validators) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Validators) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Blocks) > 0 {
-		for _, e := range m.Blocks {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, msg := range m.Validators {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
 	}
 	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ChainHead) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorActiveSetChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorActiveSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.BlockSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.BlockSlot))
-	}
-	if m.FinalizedSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.FinalizedSlot))
-	}
-	l = len(m.FinalizedBlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.JustifiedSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.JustifiedSlot))
-	}
-	l = len(m.JustifiedBlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.PreviousJustifiedSlot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PreviousJustifiedSlot))
-	}
-	l = len(m.PreviousJustifiedBlockRoot)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if m.Epoch != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetValidatorBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ActiveSetChanges) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ActiveSetChanges) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
 		}
 	}
-	if len(m.Indices) > 0 {
-		l = 0
-		for _, e := range m.Indices {
-			l += sovBeaconChain(uint64(e))
+	if len(m.ExitedPublicKeys) > 0 {
+		for _, b := range m.ExitedPublicKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.EjectedPublicKeys) > 0 {
+		for _, b := range m.EjectedPublicKeys {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
 		}
-		n += 1 + sovBeaconChain(uint64(l)) + l
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorBalances) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if m.State != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.State.Size()))
+		n10, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n10
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorBalances_Balance) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *ValidatorQueue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorQueue) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if m.ChurnLimit != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ChurnLimit))
 	}
-	if m.Index != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Index))
+	if len(m.ActivationPublicKeys) > 0 {
+		for _, b := range m.ActivationPublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	if m.Balance != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Balance))
+	if len(m.ExitPublicKeys) > 0 {
+		for _, b := range m.ExitPublicKeys {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListValidatorAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListValidatorAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.QueryFilter != nil {
-		n += m.QueryFilter.Size()
+		nn11, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn11
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Indices) > 0 {
+		dAtA13 := make([]byte, len(m.Indices)*10)
+		var j12 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j12))
+		i += copy(dAtA[i:], dAtA13[:j12])
 	}
 	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.PageSize))
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.PageToken) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PageToken)))
+		i += copy(dAtA[i:], m.PageToken)
+	}
+	if m.State != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.State.Size()))
+		n14, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetValidatorsRequest_Epoch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += 1 + sovBeaconChain(uint64(m.Epoch))
-	return n
+func (m *ListValidatorAssignmentsRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
 }
-func (m *GetValidatorsRequest_Genesis) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListValidatorAssignmentsRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x38
+	i++
+	if m.Genesis {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	var l int
-	_ = l
-	n += 2
-	return n
+	i++
+	return i, nil
 }
-func (m *Validators) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorAssignments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorAssignments) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.Assignments) > 0 {
+		for _, msg := range m.Assignments {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.NextPageToken) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.NextPageToken)))
+		i += copy(dAtA[i:], m.NextPageToken)
 	}
 	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TotalSize))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ValidatorAssignments_CommitteeAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ActiveSetChanges) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorAssignments_CommitteeAssignment) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
-	}
-	if len(m.ActivatedPublicKeys) > 0 {
-		for _, b := range m.ActivatedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.CrosslinkCommittees) > 0 {
+		dAtA16 := make([]byte, len(m.CrosslinkCommittees)*10)
+		var j15 int
+		for _, num := range m.CrosslinkCommittees {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
 		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j15))
+		i += copy(dAtA[i:], dAtA16[:j15])
 	}
-	if len(m.ExitedPublicKeys) > 0 {
-		for _, b := range m.ExitedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
-		}
+	if m.Shard != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Shard))
 	}
-	if len(m.EjectedPublicKeys) > 0 {
-		for _, b := range m.EjectedPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if m.Slot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	}
+	if m.Proposer {
+		dAtA[i] = 0x20
+		i++
+		if m.Proposer {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
+	}
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorQueue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorParticipationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorParticipationRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ChurnLimit != 0 {
-		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
-	}
-	if len(m.ActivationPublicKeys) > 0 {
-		for _, b := range m.ActivationPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if m.QueryFilter != nil {
+		nn17, err := m.QueryFilter.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += nn17
 	}
-	if len(m.ExitPublicKeys) > 0 {
-		for _, b := range m.ExitPublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if m.State != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.State.Size()))
+		n18, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ListValidatorAssignmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorParticipationRequest_Epoch) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x8
+	i++
+	i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	return i, nil
+}
+func (m *GetValidatorParticipationRequest_Genesis) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	dAtA[i] = 0x18
+	i++
+	if m.Genesis {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i++
+	return i, nil
+}
+func (m *ValidatorParticipation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorParticipation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.PublicKeys) > 0 {
-		for _, b := range m.PublicKeys {
-			l = len(b)
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if m.Finalized {
+		dAtA[i] = 0x10
+		i++
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i++
 	}
-	if len(m.Indices) > 0 {
-		l = 0
-		for _, e := range m.Indices {
-			l += sovBeaconChain(uint64(e))
-		}
-		n += 1 + sovBeaconChain(uint64(l)) + l
+	if m.GlobalParticipationRate != 0 {
+		dAtA[i] = 0x1d
+		i++
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GlobalParticipationRate))))
+		i += 4
 	}
-	if m.PageSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	if m.VotedEther != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.VotedEther))
 	}
-	l = len(m.PageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
+	if m.EligibleEther != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.EligibleEther))
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorAssignments) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetValidatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValidatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
 	}
-	if len(m.Assignments) > 0 {
-		for _, e := range m.Assignments {
-			l = e.Size()
-			n += 1 + l + sovBeaconChain(uint64(l))
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
 		}
 	}
-	l = len(m.NextPageToken)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.TotalSize != 0 {
-		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	if len(m.Indices) > 0 {
+		dAtA20 := make([]byte, len(m.Indices)*10)
+		var j19 int
+		for _, num := range m.Indices {
+			for num >= 1<<7 {
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j19++
+			}
+			dAtA20[j19] = uint8(num)
+			j19++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j19))
+		i += copy(dAtA[i:], dAtA20[:j19])
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorAssignments_CommitteeAssignment) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.CrosslinkCommittees) > 0 {
-		l = 0
-		for _, e := range m.CrosslinkCommittees {
-			l += sovBeaconChain(uint64(e))
-		}
-		n += 1 + sovBeaconChain(uint64(l)) + l
-	}
-	if m.Shard != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Shard))
-	}
-	if m.Slot != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Slot))
-	}
-	if m.Proposer {
-		n += 2
-	}
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovBeaconChain(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetValidatorParticipationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, msg := range m.Rewards {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *ValidatorParticipation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorRewards_Breakdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards_Breakdown) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	if len(m.PublicKey) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.PublicKey)))
+		i += copy(dAtA[i:], m.PublicKey)
 	}
-	if m.Finalized {
-		n += 2
+	if m.Index != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Index))
 	}
-	if m.GlobalParticipationRate != 0 {
-		n += 5
+	if m.SourceReward != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.SourceReward))
 	}
-	if m.VotedEther != 0 {
-		n += 1 + sovBeaconChain(uint64(m.VotedEther))
+	if m.SourcePenalty != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.SourcePenalty))
 	}
-	if m.EligibleEther != 0 {
-		n += 1 + sovBeaconChain(uint64(m.EligibleEther))
+	if m.TargetReward != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TargetReward))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.TargetPenalty != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.TargetPenalty))
+	}
+	if m.HeadReward != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.HeadReward))
+	}
+	if m.HeadPenalty != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.HeadPenalty))
+	}
+	if m.InclusionDelayReward != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.InclusionDelayReward))
+	}
+	if m.CrosslinkReward != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.CrosslinkReward))
+	}
+	if m.CrosslinkPenalty != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.CrosslinkPenalty))
+	}
+	if m.InactivityPenalty != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.InactivityPenalty))
+	}
+	if m.ProposerReward != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.ProposerReward))
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *AttestationPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, msg := range m.Attestations {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StateProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.State.Size()))
+		n21, err := m.State.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			dAtA[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *StateProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateProof) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.StateRoot) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(len(m.StateRoot)))
+		i += copy(dAtA[i:], m.StateRoot)
+	}
+	if m.Slot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(m.Slot))
+	}
+	if len(m.GeneralizedIndices) > 0 {
+		dAtA23 := make([]byte, len(m.GeneralizedIndices)*10)
+		var j22 int
+		for _, num := range m.GeneralizedIndices {
+			for num >= 1<<7 {
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j22++
+			}
+			dAtA23[j22] = uint8(num)
+			j22++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintBeaconChain(dAtA, i, uint64(j22))
+		i += copy(dAtA[i:], dAtA23[:j22])
+	}
+	if len(m.Leaves) > 0 {
+		for _, b := range m.Leaves {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintBeaconChain(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func encodeVarintBeaconChain(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *ListAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AttestationPoolResponse) Size() (n int) {
+func (m *ListAttestationsRequest_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *ListAttestationsRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Slot))
+	return n
+}
+func (m *ListAttestationsRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *ListAttestationsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3593,26 +4759,1738 @@ func (m *AttestationPoolResponse) Size() (n int) {
 			n += 1 + l + sovBeaconChain(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBlocksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListBlocksRequest_Root) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Root != nil {
+		l = len(m.Root)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *ListBlocksRequest_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Slot))
+	return n
+}
+func (m *ListBlocksRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *ListBlocksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blocks) > 0 {
+		for _, e := range m.Blocks {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChainHead) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.BlockSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.BlockSlot))
+	}
+	if m.FinalizedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.FinalizedSlot))
+	}
+	l = len(m.FinalizedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.JustifiedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.JustifiedSlot))
+	}
+	l = len(m.JustifiedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.PreviousJustifiedSlot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PreviousJustifiedSlot))
+	}
+	l = len(m.PreviousJustifiedBlockRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateSelector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Selector != nil {
+		n += m.Selector.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateSelector_Head) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *StateSelector_Finalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *StateSelector_Justified) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *StateSelector_Slot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Slot))
+	return n
+}
+func (m *StateSelector_StateRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateRoot != nil {
+		l = len(m.StateRoot)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *StateSelector_BlockRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockRoot != nil {
+		l = len(m.BlockRoot)
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	return n
+}
+func (m *GetValidatorBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorBalancesRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *GetValidatorBalancesRequest_Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *ValidatorBalances) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorBalances_Balance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Balance))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorsRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *GetValidatorsRequest_Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *Validators) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorActiveSetChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ActiveSetChanges) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.ActivatedPublicKeys) > 0 {
+		for _, b := range m.ActivatedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitedPublicKeys) > 0 {
+		for _, b := range m.ExitedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.EjectedPublicKeys) > 0 {
+		for _, b := range m.EjectedPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorQueue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChurnLimit != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ChurnLimit))
+	}
+	if len(m.ActivationPublicKeys) > 0 {
+		for _, b := range m.ActivationPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.ExitPublicKeys) > 0 {
+		for _, b := range m.ExitPublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListValidatorAssignmentsRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *ListValidatorAssignmentsRequest_Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *ValidatorAssignments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Assignments) > 0 {
+		for _, e := range m.Assignments {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TotalSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorAssignments_CommitteeAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CrosslinkCommittees) > 0 {
+		l = 0
+		for _, e := range m.CrosslinkCommittees {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.Shard != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Shard))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if m.Proposer {
+		n += 2
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorParticipationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.QueryFilter != nil {
+		n += m.QueryFilter.Size()
+	}
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorParticipationRequest_Epoch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovBeaconChain(uint64(m.Epoch))
+	return n
+}
+func (m *GetValidatorParticipationRequest_Genesis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *ValidatorParticipation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	if m.GlobalParticipationRate != 0 {
+		n += 5
+	}
+	if m.VotedEther != 0 {
+		n += 1 + sovBeaconChain(uint64(m.VotedEther))
+	}
+	if m.EligibleEther != 0 {
+		n += 1 + sovBeaconChain(uint64(m.EligibleEther))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetValidatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.PublicKeys) > 0 {
+		for _, b := range m.PublicKeys {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Epoch))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ValidatorRewards_Breakdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Index))
+	}
+	if m.SourceReward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.SourceReward))
+	}
+	if m.SourcePenalty != 0 {
+		n += 1 + sovBeaconChain(uint64(m.SourcePenalty))
+	}
+	if m.TargetReward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TargetReward))
+	}
+	if m.TargetPenalty != 0 {
+		n += 1 + sovBeaconChain(uint64(m.TargetPenalty))
+	}
+	if m.HeadReward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.HeadReward))
+	}
+	if m.HeadPenalty != 0 {
+		n += 1 + sovBeaconChain(uint64(m.HeadPenalty))
+	}
+	if m.InclusionDelayReward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.InclusionDelayReward))
+	}
+	if m.CrosslinkReward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.CrosslinkReward))
+	}
+	if m.CrosslinkPenalty != 0 {
+		n += 1 + sovBeaconChain(uint64(m.CrosslinkPenalty))
+	}
+	if m.InactivityPenalty != 0 {
+		n += 1 + sovBeaconChain(uint64(m.InactivityPenalty))
+	}
+	if m.ProposerReward != 0 {
+		n += 1 + sovBeaconChain(uint64(m.ProposerReward))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *AttestationPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StateProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovBeaconChain(uint64(l))
+	}
+	if m.Slot != 0 {
+		n += 1 + sovBeaconChain(uint64(m.Slot))
+	}
+	if len(m.GeneralizedIndices) > 0 {
+		l = 0
+		for _, e := range m.GeneralizedIndices {
+			l += sovBeaconChain(uint64(e))
+		}
+		n += 1 + sovBeaconChain(uint64(l)) + l
+	}
+	if len(m.Leaves) > 0 {
+		for _, b := range m.Leaves {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if len(m.Hashes) > 0 {
+		for _, b := range m.Hashes {
+			l = len(b)
+			n += 1 + l + sovBeaconChain(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBeaconChain(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozBeaconChain(x uint64) (n int) {
+	return sovBeaconChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListAttestationsRequest_BlockRoot{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListAttestationsRequest_Slot{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListAttestationsRequest_Epoch{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAttestationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlocksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.QueryFilter = &ListBlocksRequest_Root{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListBlocksRequest_Slot{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &ListBlocksRequest_Epoch{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBlocksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocks = append(m.Blocks, &BeaconBlock{})
+			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			}
+			m.TotalSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainHead) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainHead: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockRoot == nil {
+				m.BlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
+			}
+			m.BlockSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+			}
+			m.FinalizedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.FinalizedBlockRoot == nil {
+				m.FinalizedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
+			}
+			m.JustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JustifiedBlockRoot = append(m.JustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.JustifiedBlockRoot == nil {
+				m.JustifiedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedSlot", wireType)
+			}
+			m.PreviousJustifiedSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousJustifiedSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedBlockRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousJustifiedBlockRoot = append(m.PreviousJustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.PreviousJustifiedBlockRoot == nil {
+				m.PreviousJustifiedBlockRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func sovBeaconChain(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozBeaconChain(x uint64) (n int) {
-	return sovBeaconChain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
+func (m *StateSelector) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3635,17 +6513,17 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttestationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateSelector: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateSelector: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3655,30 +6533,39 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			b := bool(v != 0)
+			m.Selector = &StateSelector_Head{b}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &ListAttestationsRequest_BlockRoot{v}
-			iNdEx = postIndex
-		case 2:
+			b := bool(v != 0)
+			m.Selector = &StateSelector_Finalized{b}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Justified", wireType)
 			}
-			var v uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3688,15 +6575,16 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListAttestationsRequest_Slot{v}
-		case 3:
+			b := bool(v != 0)
+			m.Selector = &StateSelector_Justified{b}
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
 			var v uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3713,12 +6601,12 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.QueryFilter = &ListAttestationsRequest_Epoch{v}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			m.Selector = &StateSelector_Slot{v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			m.PageSize = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3728,16 +6616,30 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Selector = &StateSelector_StateRoot{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3747,23 +6649,24 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Selector = &StateSelector_BlockRoot{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3790,7 +6693,7 @@ func (m *ListAttestationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3813,15 +6716,143 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAttestationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorBalancesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.QueryFilter = &GetValidatorBalancesRequest_Epoch{v}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3848,16 +6879,18 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &StateSelector{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3867,29 +6900,72 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			b := bool(v != 0)
+			m.QueryFilter = &GetValidatorBalancesRequest_Genesis{b}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
 			}
-			m.TotalSize = 0
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorBalances: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -3899,11 +6975,26 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &ValidatorBalances_Balance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -3929,7 +7020,7 @@ func (m *ListAttestationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3952,15 +7043,15 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBlocksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBlocksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3987,15 +7078,16 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.QueryFilter = &ListBlocksRequest_Root{v}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var v uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4005,56 +7097,16 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= uint64(b&0x7F) << shift
+				m.Index |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.QueryFilter = &ListBlocksRequest_Slot{v}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
-			}
-			var v uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.QueryFilter = &ListBlocksRequest_Epoch{v}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
-			}
-			m.PageSize = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
-			var stringLen uint64
+			m.Balance = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4064,24 +7116,11 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Balance |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4107,7 +7146,7 @@ func (m *ListBlocksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,17 +7169,17 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListBlocksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBlocksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4150,29 +7189,55 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBeaconChain
+			m.QueryFilter = &GetValidatorsRequest_Epoch{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			b := bool(v != 0)
+			m.QueryFilter = &GetValidatorsRequest_Genesis{b}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
-			m.Blocks = append(m.Blocks, &BeaconBlock{})
-			if err := m.Blocks[len(m.Blocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4200,13 +7265,13 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.TotalSize = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4216,11 +7281,28 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &StateSelector{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4246,7 +7328,7 @@ func (m *ListBlocksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChainHead) Unmarshal(dAtA []byte) error {
+func (m *Validators) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4269,70 +7351,17 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChainHead: wiretype end group for non-group")
+			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainHead: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRoot", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BlockRoot = append(m.BlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockRoot == nil {
-				m.BlockRoot = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockSlot", wireType)
-			}
-			m.BlockSlot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockSlot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.FinalizedSlot = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4342,16 +7371,16 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FinalizedSlot |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedBlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4361,50 +7390,31 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalizedBlockRoot = append(m.FinalizedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.FinalizedBlockRoot == nil {
-				m.FinalizedBlockRoot = []byte{}
+			m.Validators = append(m.Validators, &Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedSlot", wireType)
-			}
-			m.JustifiedSlot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JustifiedSlot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JustifiedBlockRoot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4414,31 +7424,29 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JustifiedBlockRoot = append(m.JustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.JustifiedBlockRoot == nil {
-				m.JustifiedBlockRoot = []byte{}
-			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedSlot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
 			}
-			m.PreviousJustifiedSlot = 0
+			m.TotalSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4448,16 +7456,70 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PreviousJustifiedSlot |= uint64(b&0x7F) << shift
+				m.TotalSize |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousJustifiedBlockRoot", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4467,26 +7529,11 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousJustifiedBlockRoot = append(m.PreviousJustifiedBlockRoot[:0], dAtA[iNdEx:postIndex]...)
-			if m.PreviousJustifiedBlockRoot == nil {
-				m.PreviousJustifiedBlockRoot = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4512,7 +7559,7 @@ func (m *ChainHead) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
+func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4535,10 +7582,10 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorBalancesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActiveSetChanges: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorBalancesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActiveSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4562,7 +7609,7 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4589,85 +7636,73 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitedPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
 				}
-				m.Indices = append(m.Indices, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBeaconChain
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBeaconChain
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitedPublicKeys = append(m.ExitedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExitedPublicKeys[len(m.ExitedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EjectedPublicKeys", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Indices) == 0 {
-					m.Indices = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBeaconChain
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Indices = append(m.Indices, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EjectedPublicKeys = append(m.EjectedPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.EjectedPublicKeys[len(m.EjectedPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4693,7 +7728,7 @@ func (m *GetValidatorBalancesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorQueueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4716,15 +7751,15 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorBalances: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorQueueRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorBalances: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4751,8 +7786,10 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, &ValidatorBalances_Balance{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.State == nil {
+				m.State = &StateSelector{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4781,7 +7818,7 @@ func (m *ValidatorBalances) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
+func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4804,17 +7841,17 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorQueue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorQueue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
 			}
-			var byteLen int
+			m.ChurnLimit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4824,31 +7861,16 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ChurnLimit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationPublicKeys", wireType)
 			}
-			m.Index = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4858,16 +7880,29 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationPublicKeys = append(m.ActivationPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ActivationPublicKeys[len(m.ActivationPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitPublicKeys", wireType)
 			}
-			m.Balance = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4877,11 +7912,24 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Balance |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitPublicKeys = append(m.ExitPublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.ExitPublicKeys[len(m.ExitPublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -4907,7 +7955,7 @@ func (m *ValidatorBalances_Balance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4930,10 +7978,10 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4955,12 +8003,12 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.QueryFilter = &GetValidatorsRequest_Epoch{v}
+			m.QueryFilter = &ListValidatorAssignmentsRequest_Epoch{v}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKeys", wireType)
 			}
-			var v int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -4970,14 +8018,101 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			b := bool(v != 0)
-			m.QueryFilter = &GetValidatorsRequest_Genesis{b}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKeys = append(m.PublicKeys, make([]byte, postIndex-iNdEx))
+			copy(m.PublicKeys[len(m.PublicKeys)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Indices) == 0 {
+					m.Indices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
 			}
@@ -4996,7 +8131,7 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
 			}
@@ -5028,6 +8163,63 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PageToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &StateSelector{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			b := bool(v != 0)
+			m.QueryFilter = &ListValidatorAssignmentsRequest_Genesis{b}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5053,7 +8245,7 @@ func (m *GetValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Validators) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5076,10 +8268,10 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Validators: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorAssignments: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Validators: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5103,7 +8295,7 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5130,8 +8322,8 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &Validator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Assignments = append(m.Assignments, &ValidatorAssignments_CommitteeAssignment{})
+			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5211,7 +8403,7 @@ func (m *Validators) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
+func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5234,17 +8426,93 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitteeAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorActiveSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitteeAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CrosslinkCommittees) == 0 {
+					m.CrosslinkCommittees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkCommittees", wireType)
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			m.Epoch = 0
+			m.Shard = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5254,11 +8522,84 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.Shard |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
+			}
+			m.Slot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Slot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Proposer = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5284,7 +8625,7 @@ func (m *GetValidatorActiveSetChangesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5307,17 +8648,17 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActiveSetChanges: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorParticipationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActiveSetChanges: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.Epoch = 0
+			var v uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5327,16 +8668,17 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				v |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.QueryFilter = &GetValidatorParticipationRequest_Epoch{v}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivatedPublicKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5346,61 +8688,33 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivatedPublicKeys = append(m.ActivatedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivatedPublicKeys[len(m.ActivatedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitedPublicKeys", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			if m.State == nil {
+				m.State = &StateSelector{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ExitedPublicKeys = append(m.ExitedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ExitedPublicKeys[len(m.ExitedPublicKeys)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EjectedPublicKeys", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5410,24 +8724,13 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EjectedPublicKeys = append(m.EjectedPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.EjectedPublicKeys[len(m.EjectedPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.QueryFilter = &GetValidatorParticipationRequest_Genesis{b}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5453,7 +8756,7 @@ func (m *ActiveSetChanges) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
+func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5476,17 +8779,17 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorQueue: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorQueue: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChurnLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.ChurnLimit = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5496,16 +8799,16 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ChurnLimit |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationPublicKeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5515,29 +8818,28 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			m.Finalized = bool(v != 0)
+		case 3:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalParticipationRate", wireType)
 			}
-			if postIndex > l {
+			var v uint32
+			if (iNdEx + 4) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ActivationPublicKeys = append(m.ActivationPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ActivationPublicKeys[len(m.ActivationPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitPublicKeys", wireType)
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.GlobalParticipationRate = float32(math.Float32frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedEther", wireType)
 			}
-			var byteLen int
+			m.VotedEther = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5547,24 +8849,30 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VotedEther |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBeaconChain
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleEther", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.EligibleEther = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleEther |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ExitPublicKeys = append(m.ExitPublicKeys, make([]byte, postIndex-iNdEx))
-			copy(m.ExitPublicKeys[len(m.ExitPublicKeys)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -5590,7 +8898,7 @@ func (m *ValidatorQueue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *GetValidatorRewardsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5613,10 +8921,10 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetValidatorRewardsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListValidatorAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetValidatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5746,11 +9054,65 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBeaconChain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBeaconChain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.PageSize = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5760,16 +9122,16 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PageSize |= int32(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5779,23 +9141,25 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PageToken = string(dAtA[iNdEx:postIndex])
+			m.Rewards = append(m.Rewards, &ValidatorRewards_Breakdown{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5822,7 +9186,7 @@ func (m *ListValidatorAssignmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
+func (m *ValidatorRewards_Breakdown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5841,21 +9205,131 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorAssignments: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorAssignments: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Breakdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Breakdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceReward", wireType)
+			}
+			m.SourceReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourcePenalty", wireType)
+			}
+			m.SourcePenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourcePenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReward", wireType)
+			}
+			m.TargetReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPenalty", wireType)
 			}
-			m.Epoch = 0
+			m.TargetPenalty = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5865,16 +9339,16 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.TargetPenalty |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Assignments", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadReward", wireType)
 			}
-			var msglen int
+			m.HeadReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5884,31 +9358,54 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HeadReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBeaconChain
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadPenalty", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			m.HeadPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InclusionDelayReward", wireType)
 			}
-			m.Assignments = append(m.Assignments, &ValidatorAssignments_CommitteeAssignment{})
-			if err := m.Assignments[len(m.Assignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InclusionDelayReward = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InclusionDelayReward |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkReward", wireType)
 			}
-			var stringLen uint64
+			m.CrosslinkReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5918,29 +9415,54 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CrosslinkReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBeaconChain
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkPenalty", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBeaconChain
+			m.CrosslinkPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrosslinkPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactivityPenalty", wireType)
 			}
-			m.NextPageToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			m.InactivityPenalty = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InactivityPenalty |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerReward", wireType)
 			}
-			m.TotalSize = 0
+			m.ProposerReward = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -5950,7 +9472,7 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalSize |= int32(b&0x7F) << shift
+				m.ProposerReward |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5980,7 +9502,7 @@ func (m *ValidatorAssignments) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error {
+func (m *AttestationPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6003,151 +9525,17 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitteeAssignment: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttestationPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitteeAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttestationPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBeaconChain
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBeaconChain
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.CrosslinkCommittees) == 0 {
-					m.CrosslinkCommittees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBeaconChain
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.CrosslinkCommittees = append(m.CrosslinkCommittees, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrosslinkCommittees", wireType)
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
-			}
-			m.Shard = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Shard |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
-			}
-			m.Slot = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Slot |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Proposer = bool(v != 0)
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6157,24 +9545,24 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
+			m.Attestations = append(m.Attestations, &Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6202,7 +9590,7 @@ func (m *ValidatorAssignments_CommitteeAssignment) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
+func (m *StateProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6225,17 +9613,17 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetValidatorParticipationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetValidatorParticipationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6245,11 +9633,60 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &StateSelector{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBeaconChain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBeaconChain(dAtA[iNdEx:])
@@ -6275,7 +9712,7 @@ func (m *GetValidatorParticipationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
+func (m *StateProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6298,17 +9735,17 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorParticipation: wiretype end group for non-group")
+			return fmt.Errorf("proto: StateProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorParticipation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StateProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
 			}
-			m.Epoch = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6318,16 +9755,31 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBeaconChain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slot", wireType)
 			}
-			var v int
+			m.Slot = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6337,47 +9789,92 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Slot |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
 		case 3:
-			if wireType != 5 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalParticipationRate", wireType)
-			}
-			var v uint32
-			if (iNdEx + 4) > l {
-				return io.ErrUnexpectedEOF
-			}
-			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
-			iNdEx += 4
-			m.GlobalParticipationRate = float32(math.Float32frombits(v))
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotedEther", wireType)
-			}
-			m.VotedEther = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBeaconChain
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.GeneralizedIndices = append(m.GeneralizedIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBeaconChain
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBeaconChain
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotedEther |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.GeneralizedIndices) == 0 {
+					m.GeneralizedIndices = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBeaconChain
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.GeneralizedIndices = append(m.GeneralizedIndices, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralizedIndices", wireType)
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EligibleEther", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leaves", wireType)
 			}
-			m.EligibleEther = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6387,70 +9884,29 @@ func (m *ValidatorParticipation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EligibleEther |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBeaconChain(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttestationPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBeaconChain
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttestationPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttestationPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Leaves = append(m.Leaves, make([]byte, postIndex-iNdEx))
+			copy(m.Leaves[len(m.Leaves)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hashes", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBeaconChain
@@ -6460,25 +9916,23 @@ func (m *AttestationPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBeaconChain
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, &Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hashes = append(m.Hashes, make([]byte, postIndex-iNdEx))
+			copy(m.Hashes[len(m.Hashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5,6 +5,7 @@ package eth
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -26,11 +27,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+type SyncStatus_Phase int32
+
+const (
+	SyncStatus_QUERYING       SyncStatus_Phase = 0
+	SyncStatus_STATE_DOWNLOAD SyncStatus_Phase = 1
+	SyncStatus_BLOCK_DOWNLOAD SyncStatus_Phase = 2
+	SyncStatus_REGULAR        SyncStatus_Phase = 3
+)
+
+var SyncStatus_Phase_name = map[int32]string{
+	0: "QUERYING",
+	1: "STATE_DOWNLOAD",
+	2: "BLOCK_DOWNLOAD",
+	3: "REGULAR",
+}
+
+var SyncStatus_Phase_value = map[string]int32{
+	"QUERYING":       0,
+	"STATE_DOWNLOAD": 1,
+	"BLOCK_DOWNLOAD": 2,
+	"REGULAR":        3,
+}
+
+func (x SyncStatus_Phase) String() string {
+	return proto.EnumName(SyncStatus_Phase_name, int32(x))
+}
+
+func (SyncStatus_Phase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{0, 0}
+}
+
 type SyncStatus struct {
-	Syncing              bool     `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Syncing                bool             `protobuf:"varint,1,opt,name=syncing,proto3" json:"syncing,omitempty"`
+	Phase                  SyncStatus_Phase `protobuf:"varint,2,opt,name=phase,proto3,enum=ethereum.eth.v1alpha1.SyncStatus_Phase" json:"phase,omitempty"`
+	HeadSlot               uint64           `protobuf:"varint,3,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	HighestPeerSlot        uint64           `protobuf:"varint,4,opt,name=highest_peer_slot,json=highestPeerSlot,proto3" json:"highest_peer_slot,omitempty"`
+	StartSlot              uint64           `protobuf:"varint,5,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	BlocksPerSecond        float64          `protobuf:"fixed64,6,opt,name=blocks_per_second,json=blocksPerSecond,proto3" json:"blocks_per_second,omitempty"`
+	EstimatedTimeRemaining *types.Duration  `protobuf:"bytes,7,opt,name=estimated_time_remaining,json=estimatedTimeRemaining,proto3" json:"estimated_time_remaining,omitempty"`
+	Targets                []*SyncTarget    `protobuf:"bytes,8,rep,name=targets,proto3" json:"targets,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}         `json:"-"`
+	XXX_unrecognized       []byte           `json:"-"`
+	XXX_sizecache          int32            `json:"-"`
 }
 
 func (m *SyncStatus) Reset()         { *m = SyncStatus{} }
@@ -73,6 +112,118 @@ func (m *SyncStatus) GetSyncing() bool {
 	return false
 }
 
+func (m *SyncStatus) GetPhase() SyncStatus_Phase {
+	if m != nil {
+		return m.Phase
+	}
+	return SyncStatus_QUERYING
+}
+
+func (m *SyncStatus) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetHighestPeerSlot() uint64 {
+	if m != nil {
+		return m.HighestPeerSlot
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetStartSlot() uint64 {
+	if m != nil {
+		return m.StartSlot
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetBlocksPerSecond() float64 {
+	if m != nil {
+		return m.BlocksPerSecond
+	}
+	return 0
+}
+
+func (m *SyncStatus) GetEstimatedTimeRemaining() *types.Duration {
+	if m != nil {
+		return m.EstimatedTimeRemaining
+	}
+	return nil
+}
+
+func (m *SyncStatus) GetTargets() []*SyncTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type SyncTarget struct {
+	PeerId               string   `protobuf:"bytes,1,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	HeadSlot             uint64   `protobuf:"varint,2,opt,name=head_slot,json=headSlot,proto3" json:"head_slot,omitempty"`
+	FinalizedEpoch       uint64   `protobuf:"varint,3,opt,name=finalized_epoch,json=finalizedEpoch,proto3" json:"finalized_epoch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncTarget) Reset()         { *m = SyncTarget{} }
+func (m *SyncTarget) String() string { return proto.CompactTextString(m) }
+func (*SyncTarget) ProtoMessage()    {}
+func (*SyncTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98054421e2cad574, []int{1}
+}
+func (m *SyncTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SyncTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SyncTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SyncTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncTarget.Merge(m, src)
+}
+func (m *SyncTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *SyncTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncTarget proto.InternalMessageInfo
+
+func (m *SyncTarget) GetPeerId() string {
+	if m != nil {
+		return m.PeerId
+	}
+	return ""
+}
+
+func (m *SyncTarget) GetHeadSlot() uint64 {
+	if m != nil {
+		return m.HeadSlot
+	}
+	return 0
+}
+
+func (m *SyncTarget) GetFinalizedEpoch() uint64 {
+	if m != nil {
+		return m.FinalizedEpoch
+	}
+	return 0
+}
+
 type Genesis struct {
 	GenesisTime            *types.Timestamp `protobuf:"bytes,1,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
 	DepositContractAddress []byte           `protobuf:"bytes,2,opt,name=deposit_contract_address,json=depositContractAddress,proto3" json:"deposit_contract_address,omitempty"`
@@ -85,7 +236,7 @@ func (m *Genesis) Reset()         { *m = Genesis{} }
 func (m *Genesis) String() string { return proto.CompactTextString(m) }
 func (*Genesis) ProtoMessage()    {}
 func (*Genesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98054421e2cad574, []int{1}
+	return fileDescriptor_98054421e2cad574, []int{2}
 }
 func (m *Genesis) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -140,7 +291,7 @@ func (m *Version) Reset()         { *m = Version{} }
 func (m *Version) String() string { return proto.CompactTextString(m) }
 func (*Version) ProtoMessage()    {}
 func (*Version) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98054421e2cad574, []int{2}
+	return fileDescriptor_98054421e2cad574, []int{3}
 }
 func (m *Version) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -194,7 +345,7 @@ func (m *ImplementedServices) Reset()         { *m = ImplementedServices{} }
 func (m *ImplementedServices) String() string { return proto.CompactTextString(m) }
 func (*ImplementedServices) ProtoMessage()    {}
 func (*ImplementedServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98054421e2cad574, []int{3}
+	return fileDescriptor_98054421e2cad574, []int{4}
 }
 func (m *ImplementedServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,7 +382,9 @@ func (m *ImplementedServices) GetServices() []string {
 }
 
 func init() {
+	proto.RegisterEnum("ethereum.eth.v1alpha1.SyncStatus_Phase", SyncStatus_Phase_name, SyncStatus_Phase_value)
 	proto.RegisterType((*SyncStatus)(nil), "ethereum.eth.v1alpha1.SyncStatus")
+	proto.RegisterType((*SyncTarget)(nil), "ethereum.eth.v1alpha1.SyncTarget")
 	proto.RegisterType((*Genesis)(nil), "ethereum.eth.v1alpha1.Genesis")
 	proto.RegisterType((*Version)(nil), "ethereum.eth.v1alpha1.Version")
 	proto.RegisterType((*ImplementedServices)(nil), "ethereum.eth.v1alpha1.ImplementedServices")
@@ -240,36 +393,54 @@ func init() {
 func init() { proto.RegisterFile("proto/eth/v1alpha1/node.proto", fileDescriptor_98054421e2cad574) }
 
 var fileDescriptor_98054421e2cad574 = []byte{
-	// 462 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0xc9, 0xae, 0xd8, 0xdd, 0xec, 0x7a, 0x89, 0xb8, 0x0e, 0xb3, 0xbb, 0xb5, 0x8e, 0x20,
-	0x8b, 0x87, 0x84, 0xae, 0x08, 0x82, 0x88, 0xe8, 0x22, 0x45, 0x10, 0x0f, 0x53, 0xf1, 0xe0, 0xa5,
-	0xa4, 0x33, 0xcf, 0x99, 0x40, 0x93, 0x0c, 0x93, 0xd7, 0x42, 0xaf, 0xfd, 0x0a, 0x7e, 0x29, 0x6f,
-	0x0a, 0x7e, 0x01, 0x29, 0x7e, 0x10, 0x99, 0x99, 0x44, 0xc5, 0xb6, 0x0b, 0xde, 0xf2, 0xf2, 0x7f,
-	0xff, 0xf7, 0x4b, 0xff, 0xaf, 0x43, 0xcf, 0xab, 0xda, 0xa2, 0x15, 0x80, 0xa5, 0x58, 0x0c, 0xe5,
-	0xac, 0x2a, 0xe5, 0x50, 0x18, 0x9b, 0x03, 0x6f, 0xef, 0xd9, 0x1d, 0xc0, 0x12, 0x6a, 0x98, 0x6b,
-	0x0e, 0x58, 0xf2, 0xd0, 0x11, 0x9f, 0x15, 0xd6, 0x16, 0x33, 0x10, 0xb2, 0x52, 0x42, 0x1a, 0x63,
-	0x51, 0xa2, 0xb2, 0xc6, 0x75, 0xa6, 0xf8, 0xd4, 0xab, 0x6d, 0x35, 0x9d, 0x7f, 0x12, 0xa0, 0x2b,
-	0x5c, 0x7a, 0xf1, 0xde, 0xbf, 0x22, 0x2a, 0x0d, 0x0e, 0xa5, 0xae, 0xba, 0x86, 0xe4, 0x21, 0xa5,
-	0xe3, 0xa5, 0xc9, 0xc6, 0x28, 0x71, 0xee, 0x58, 0x44, 0x7b, 0x6e, 0x69, 0x32, 0x65, 0x8a, 0x88,
-	0x0c, 0xc8, 0xc5, 0x41, 0x1a, 0xca, 0x64, 0x45, 0x68, 0x6f, 0x04, 0x06, 0x9c, 0x72, 0xec, 0x39,
-	0x3d, 0x2e, 0xba, 0xe3, 0xa4, 0x19, 0xd7, 0xb6, 0x1e, 0x5d, 0xc6, 0xbc, 0x63, 0xf1, 0xc0, 0xe2,
-	0xef, 0x03, 0x2b, 0x3d, 0xf2, 0xfd, 0xcd, 0x0d, 0x7b, 0x4a, 0xa3, 0x1c, 0x2a, 0xeb, 0x14, 0x4e,
-	0x32, 0x6b, 0xb0, 0x96, 0x19, 0x4e, 0x64, 0x9e, 0xd7, 0xe0, 0x5c, 0xb4, 0x37, 0x20, 0x17, 0xc7,
-	0xe9, 0x89, 0xd7, 0xaf, 0xbc, 0xfc, 0xb2, 0x53, 0x93, 0x17, 0xb4, 0xf7, 0x01, 0x6a, 0xa7, 0xac,
-	0x69, 0x5e, 0xba, 0xe8, 0x8e, 0x2d, 0xfe, 0x30, 0x0d, 0x25, 0x8b, 0xe9, 0x81, 0x06, 0x94, 0xb9,
-	0x44, 0xd9, 0x8e, 0x3b, 0x4c, 0x7f, 0xd7, 0xc9, 0x90, 0xde, 0x7e, 0xa3, 0xab, 0x19, 0x68, 0x30,
-	0x08, 0xf9, 0x18, 0xea, 0x85, 0xca, 0xc0, 0x35, 0x16, 0xe7, 0xcf, 0x11, 0x19, 0xec, 0x37, 0x96,
-	0x50, 0x5f, 0x7e, 0xdd, 0xa7, 0x37, 0xde, 0xd9, 0x1c, 0x98, 0xa1, 0xb7, 0x46, 0x80, 0x7f, 0x85,
-	0x75, 0xb2, 0xf1, 0x83, 0x5f, 0x37, 0xc9, 0xc7, 0xf7, 0xf9, 0xd6, 0x35, 0xf2, 0x3f, 0xd6, 0x24,
-	0x59, 0x7d, 0xff, 0xf9, 0x79, 0xef, 0x8c, 0xc5, 0x9b, 0x7f, 0x05, 0xe1, 0x13, 0x67, 0x25, 0xa5,
-	0x23, 0xc0, 0x90, 0xf9, 0x2e, 0x58, 0x7f, 0x07, 0xcc, 0xfb, 0xae, 0x25, 0xf9, 0xa5, 0x78, 0x52,
-	0x48, 0xf6, 0x7f, 0x49, 0xde, 0x77, 0x2d, 0x29, 0xec, 0x66, 0x45, 0xe8, 0xdd, 0xb7, 0xca, 0xe1,
-	0xb6, 0x25, 0xec, 0xe2, 0x3e, 0xda, 0xc1, 0xdd, 0x32, 0x23, 0x79, 0xd0, 0xbe, 0xe1, 0x9c, 0x9d,
-	0x6e, 0xcb, 0xd5, 0x37, 0xbd, 0xba, 0xfa, 0xb2, 0xee, 0x93, 0x6f, 0xeb, 0x3e, 0xf9, 0xb1, 0xee,
-	0x93, 0x8f, 0x4f, 0x0a, 0x85, 0xe5, 0x7c, 0xca, 0x33, 0xab, 0x45, 0x55, 0x2f, 0x9d, 0x96, 0xa8,
-	0xb2, 0x99, 0x9c, 0xba, 0xae, 0x12, 0x9b, 0x5f, 0xec, 0x33, 0xc0, 0x72, 0x7a, 0xb3, 0xbd, 0x7f,
-	0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x53, 0xd1, 0xfd, 0x57, 0xd2, 0x03, 0x00, 0x00,
+	// 747 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xc6, 0x49, 0xdb, 0x24, 0x93, 0xd2, 0x96, 0x41, 0x74, 0x4d, 0xba, 0x0d, 0x59, 0x73, 0xd8,
+	0x68, 0x0f, 0xb6, 0x1a, 0x84, 0x84, 0xb4, 0x5a, 0xa1, 0xb4, 0x8d, 0xa2, 0x42, 0xd4, 0x16, 0x27,
+	0x05, 0xc1, 0xc5, 0x9a, 0xd8, 0xaf, 0xf1, 0xa8, 0xb6, 0xc7, 0xf2, 0xbc, 0x54, 0x0a, 0xc7, 0xfe,
+	0x05, 0xfe, 0x14, 0x37, 0x90, 0x38, 0x70, 0x45, 0x15, 0x3f, 0x04, 0x79, 0x3c, 0x6e, 0xa1, 0x49,
+	0x8a, 0xf6, 0xe6, 0xf7, 0x7d, 0xdf, 0x7b, 0x9f, 0xe7, 0x7b, 0x1e, 0x93, 0xc3, 0x34, 0x13, 0x28,
+	0x1c, 0xc0, 0xd0, 0xb9, 0x3d, 0x62, 0x51, 0x1a, 0xb2, 0x23, 0x27, 0x11, 0x01, 0xd8, 0x0a, 0xa7,
+	0x9f, 0x00, 0x86, 0x90, 0xc1, 0x3c, 0xb6, 0x01, 0x43, 0xbb, 0x54, 0xb4, 0x5e, 0xce, 0x84, 0x98,
+	0x45, 0xe0, 0xb0, 0x94, 0x3b, 0x2c, 0x49, 0x04, 0x32, 0xe4, 0x22, 0x91, 0x45, 0x53, 0xab, 0xad,
+	0x59, 0x55, 0x4d, 0xe7, 0xd7, 0x4e, 0x30, 0xcf, 0x94, 0x40, 0xf3, 0x07, 0x4f, 0x79, 0x88, 0x53,
+	0x5c, 0x68, 0xf2, 0xb3, 0xa7, 0x24, 0xf2, 0x18, 0x24, 0xb2, 0x38, 0x2d, 0x04, 0xd6, 0x9f, 0x55,
+	0x42, 0xc6, 0x8b, 0xc4, 0x1f, 0x23, 0xc3, 0xb9, 0xa4, 0x26, 0xa9, 0xc9, 0x45, 0xe2, 0xf3, 0x64,
+	0x66, 0x1a, 0x1d, 0xa3, 0x5b, 0x77, 0xcb, 0x92, 0xbe, 0x23, 0x9b, 0x69, 0xc8, 0x24, 0x98, 0x95,
+	0x8e, 0xd1, 0xdd, 0xe9, 0xbd, 0xb6, 0x57, 0x9e, 0xc5, 0x7e, 0x9c, 0x65, 0x5f, 0xe6, 0x72, 0xb7,
+	0xe8, 0xa2, 0x07, 0xa4, 0x11, 0x02, 0x0b, 0x3c, 0x19, 0x09, 0x34, 0xab, 0x1d, 0xa3, 0xbb, 0xe1,
+	0xd6, 0x73, 0x60, 0x1c, 0x09, 0xa4, 0x6f, 0xc8, 0x47, 0x21, 0x9f, 0x85, 0x20, 0xd1, 0x4b, 0x01,
+	0xb2, 0x42, 0xb4, 0xa1, 0x44, 0xbb, 0x9a, 0xb8, 0x04, 0xc8, 0x94, 0xf6, 0x90, 0x10, 0x89, 0x2c,
+	0xc3, 0x42, 0xb4, 0xa9, 0x44, 0x0d, 0x85, 0x94, 0xa3, 0xa6, 0x91, 0xf0, 0x6f, 0xa4, 0x97, 0xe6,
+	0x83, 0xc0, 0x17, 0x49, 0x60, 0x6e, 0x75, 0x8c, 0xae, 0xe1, 0xee, 0x16, 0xc4, 0x25, 0x64, 0x63,
+	0x05, 0xd3, 0x31, 0x31, 0x41, 0x22, 0x8f, 0x19, 0x42, 0xe0, 0xe5, 0xc1, 0x78, 0x19, 0xc4, 0x8c,
+	0x27, 0xf9, 0xe9, 0x6b, 0x1d, 0xa3, 0xdb, 0xec, 0x7d, 0x6a, 0x17, 0xf9, 0xd9, 0x65, 0x7e, 0xf6,
+	0xa9, 0x0e, 0xdf, 0xdd, 0x7f, 0x68, 0x9d, 0xf0, 0x18, 0xdc, 0xb2, 0x91, 0xbe, 0x25, 0x35, 0x64,
+	0xd9, 0x0c, 0x50, 0x9a, 0xf5, 0x4e, 0xb5, 0xdb, 0xec, 0xbd, 0x7a, 0x26, 0xa9, 0x89, 0x52, 0xba,
+	0x65, 0x87, 0xf5, 0x0d, 0xd9, 0x54, 0xa9, 0xd1, 0x6d, 0x52, 0xff, 0xee, 0x6a, 0xe0, 0xfe, 0x78,
+	0x76, 0x3e, 0xdc, 0xfb, 0x80, 0x52, 0xb2, 0x33, 0x9e, 0xf4, 0x27, 0x03, 0xef, 0xf4, 0xe2, 0x87,
+	0xf3, 0xd1, 0x45, 0xff, 0x74, 0xcf, 0xc8, 0xb1, 0xe3, 0xd1, 0xc5, 0xc9, 0xb7, 0x8f, 0x58, 0x85,
+	0x36, 0x49, 0xcd, 0x1d, 0x0c, 0xaf, 0x46, 0x7d, 0x77, 0xaf, 0x6a, 0xdd, 0x10, 0xf2, 0x68, 0x41,
+	0x5f, 0x90, 0x9a, 0x8a, 0x96, 0x07, 0x6a, 0xb1, 0x0d, 0x77, 0x2b, 0x2f, 0xcf, 0x82, 0xff, 0x2e,
+	0xa6, 0xf2, 0x64, 0x31, 0xaf, 0xc9, 0xee, 0x35, 0x4f, 0x58, 0xc4, 0x7f, 0x86, 0xc0, 0x83, 0x54,
+	0xf8, 0xa1, 0xde, 0xdd, 0xce, 0x03, 0x3c, 0xc8, 0x51, 0xeb, 0xce, 0x20, 0xb5, 0x21, 0x24, 0x20,
+	0xb9, 0xa4, 0xef, 0xc8, 0xf6, 0xac, 0x78, 0x54, 0xa1, 0x2a, 0xbf, 0x66, 0xaf, 0xb5, 0x14, 0xe5,
+	0xa4, 0xfc, 0x14, 0xdd, 0xa6, 0xd6, 0xe7, 0x08, 0xfd, 0x8a, 0x98, 0x01, 0xa4, 0x42, 0x72, 0xf4,
+	0x7c, 0x91, 0x60, 0xc6, 0x7c, 0xf4, 0x58, 0x10, 0x64, 0x20, 0xa5, 0x7a, 0xbf, 0x6d, 0x77, 0x5f,
+	0xf3, 0x27, 0x9a, 0xee, 0x17, 0xac, 0xf5, 0x35, 0xa9, 0x7d, 0x0f, 0x99, 0xe4, 0x22, 0xc9, 0xbf,
+	0xe3, 0xdb, 0xe2, 0x51, 0x1f, 0xb7, 0x2c, 0x69, 0x8b, 0xd4, 0x63, 0x40, 0x16, 0x30, 0x64, 0x6a,
+	0x5c, 0xc3, 0x7d, 0xa8, 0xad, 0x23, 0xf2, 0xf1, 0x59, 0x9c, 0x46, 0x10, 0x43, 0x82, 0x10, 0x8c,
+	0x21, 0xbb, 0xe5, 0x3e, 0xc8, 0xbc, 0x45, 0xea, 0x67, 0xd3, 0xe8, 0x54, 0xf3, 0x96, 0xb2, 0xee,
+	0xfd, 0x56, 0x25, 0x1b, 0xe7, 0x22, 0x00, 0x9a, 0x90, 0x0f, 0x87, 0x80, 0xff, 0xba, 0x4a, 0xfb,
+	0x4b, 0x07, 0x1e, 0xe4, 0x17, 0xb3, 0xf5, 0xea, 0x7f, 0x6f, 0x8e, 0x65, 0xdd, 0xfd, 0xf1, 0xf7,
+	0x2f, 0x95, 0x97, 0xb4, 0xb5, 0xfc, 0x27, 0x71, 0xca, 0xfb, 0x18, 0x12, 0x32, 0x04, 0x2c, 0x33,
+	0x5f, 0x67, 0xd6, 0x5e, 0x63, 0xa6, 0xfb, 0x9e, 0x75, 0xd2, 0x4b, 0xd1, 0x4e, 0x65, 0xb2, 0xef,
+	0xeb, 0xa4, 0xfb, 0x9e, 0x75, 0x2a, 0x77, 0x73, 0x67, 0x90, 0x17, 0x23, 0x2e, 0x71, 0xd5, 0x12,
+	0xd6, 0xf9, 0xbe, 0x59, 0xe3, 0xbb, 0x62, 0x86, 0xf5, 0xb9, 0x7a, 0x87, 0x43, 0x7a, 0xb0, 0x2a,
+	0x57, 0x2d, 0x3a, 0x3e, 0xf9, 0xf5, 0xbe, 0x6d, 0xfc, 0x7e, 0xdf, 0x36, 0xfe, 0xba, 0x6f, 0x1b,
+	0x3f, 0x7d, 0x39, 0xe3, 0x18, 0xce, 0xa7, 0xb6, 0x2f, 0x62, 0x27, 0xcd, 0x16, 0x32, 0x66, 0xc8,
+	0xfd, 0x88, 0x4d, 0x65, 0x51, 0x39, 0xcb, 0x3f, 0xfc, 0xb7, 0x80, 0xe1, 0x74, 0x4b, 0xe1, 0x5f,
+	0xfc, 0x33, 0x00, 0xfc, 0x8c, 0x06, 0xde, 0x11, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -468,6 +639,91 @@ func (m *SyncStatus) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Phase != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.Phase))
+	}
+	if m.HeadSlot != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.HeadSlot))
+	}
+	if m.HighestPeerSlot != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.HighestPeerSlot))
+	}
+	if m.StartSlot != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.StartSlot))
+	}
+	if m.BlocksPerSecond != 0 {
+		dAtA[i] = 0x31
+		i++
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.BlocksPerSecond))))
+		i += 8
+	}
+	if m.EstimatedTimeRemaining != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.EstimatedTimeRemaining.Size()))
+		n1, err := m.EstimatedTimeRemaining.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
+	}
+	if len(m.Targets) > 0 {
+		for _, msg := range m.Targets {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintNode(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SyncTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SyncTarget) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.PeerId) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(len(m.PeerId)))
+		i += copy(dAtA[i:], m.PeerId)
+	}
+	if m.HeadSlot != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.HeadSlot))
+	}
+	if m.FinalizedEpoch != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintNode(dAtA, i, uint64(m.FinalizedEpoch))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(dAtA[i:], m.XXX_unrecognized)
 	}
@@ -493,11 +749,11 @@ func (m *Genesis) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintNode(dAtA, i, uint64(m.GenesisTime.Size()))
-		n1, err := m.GenesisTime.MarshalTo(dAtA[i:])
+		n2, err := m.GenesisTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n1
+		i += n2
 	}
 	if len(m.DepositContractAddress) > 0 {
 		dAtA[i] = 0x12
@@ -598,6 +854,53 @@ func (m *SyncStatus) Size() (n int) {
 	if m.Syncing {
 		n += 2
 	}
+	if m.Phase != 0 {
+		n += 1 + sovNode(uint64(m.Phase))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovNode(uint64(m.HeadSlot))
+	}
+	if m.HighestPeerSlot != 0 {
+		n += 1 + sovNode(uint64(m.HighestPeerSlot))
+	}
+	if m.StartSlot != 0 {
+		n += 1 + sovNode(uint64(m.StartSlot))
+	}
+	if m.BlocksPerSecond != 0 {
+		n += 9
+	}
+	if m.EstimatedTimeRemaining != nil {
+		l = m.EstimatedTimeRemaining.Size()
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovNode(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SyncTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PeerId)
+	if l > 0 {
+		n += 1 + l + sovNode(uint64(l))
+	}
+	if m.HeadSlot != 0 {
+		n += 1 + sovNode(uint64(m.HeadSlot))
+	}
+	if m.FinalizedEpoch != 0 {
+		n += 1 + sovNode(uint64(m.FinalizedEpoch))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -724,6 +1027,287 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Syncing = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= SyncStatus_Phase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestPeerSlot", wireType)
+			}
+			m.HighestPeerSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestPeerSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSlot", wireType)
+			}
+			m.StartSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerSecond", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.BlocksPerSecond = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedTimeRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedTimeRemaining == nil {
+				m.EstimatedTimeRemaining = &types.Duration{}
+			}
+			if err := m.EstimatedTimeRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, &SyncTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNode(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthNode
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SyncTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNode
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SyncTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SyncTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNode
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNode
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeadSlot", wireType)
+			}
+			m.HeadSlot = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeadSlot |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedEpoch", wireType)
+			}
+			m.FinalizedEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNode
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNode(dAtA[iNdEx:])
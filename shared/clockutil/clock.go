@@ -0,0 +1,189 @@
+// Package clockutil defines the wall clock read by the slot driven components
+// of the beacon chain and validator client, so tests can replace the system
+// clock with a manual clock which they advance slot by slot.
+package clockutil
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Clock interface makes it possible to replace the system clock with a
+// manual clock.
+type Clock interface {
+	Now() time.Time
+	After(time.Duration) <-chan time.Time
+	NewTimer(time.Duration) Timer
+}
+
+// Timer fires once on the channel returned by C, unless it is stopped first.
+type Timer interface {
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, it returns false if the timer
+	// already fired or was stopped.
+	Stop() bool
+}
+
+// System implements Clock using the system clock.
+type System struct{}
+
+// Now implements Clock.
+func (System) Now() time.Time {
+	return time.Now()
+}
+
+// After implements Clock.
+func (System) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTimer implements Clock.
+func (System) NewTimer(d time.Duration) Timer {
+	return systemTimer{time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	*time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
+
+// WithDeadline returns a copy of the parent context which is canceled once the
+// clock reaches the deadline. Unlike the contexts of the context package, it
+// reports context.Canceled rather than context.DeadlineExceeded for clocks
+// other than the system clock.
+func WithDeadline(parent context.Context, clock Clock, deadline time.Time) (context.Context, context.CancelFunc) {
+	if _, ok := clock.(System); ok {
+		return context.WithDeadline(parent, deadline)
+	}
+	ctx, cancel := context.WithCancel(parent)
+	timer := clock.NewTimer(deadline.Sub(clock.Now()))
+	go func() {
+		select {
+		case <-timer.C():
+			cancel()
+		case <-ctx.Done():
+			timer.Stop()
+		}
+	}()
+	// The timer is stopped before returning, so that a manual clock never
+	// waits on the timers of canceled contexts.
+	return ctx, func() {
+		timer.Stop()
+		cancel()
+	}
+}
+
+// Manual implements Clock with a time which only changes when it is advanced.
+// Channels returned by After receive the time once the clock is advanced past
+// their deadline.
+type Manual struct {
+	lock    sync.Mutex
+	changed *sync.Cond
+	now     time.Time
+	timers  []*manualTimer
+}
+
+type manualTimer struct {
+	clock    *Manual
+	deadline time.Time
+	c        chan time.Time
+}
+
+func (t *manualTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *manualTimer) Stop() bool {
+	m := t.clock
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for i, timer := range m.timers {
+		if timer == t {
+			m.timers = append(m.timers[:i], m.timers[i+1:]...)
+			m.changed.Broadcast()
+			return true
+		}
+	}
+	return false
+}
+
+// NewManual returns a manual clock set to the given time.
+func NewManual(now time.Time) *Manual {
+	m := &Manual{now: now}
+	m.changed = sync.NewCond(&m.lock)
+	return m
+}
+
+// Now implements Clock.
+func (m *Manual) Now() time.Time {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.now
+}
+
+// After implements Clock.
+func (m *Manual) After(d time.Duration) <-chan time.Time {
+	return m.NewTimer(d).C()
+}
+
+// NewTimer implements Clock.
+func (m *Manual) NewTimer(d time.Duration) Timer {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	t := &manualTimer{clock: m, deadline: m.now.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		t.c <- m.now
+		return t
+	}
+	m.timers = append(m.timers, t)
+	sort.SliceStable(m.timers, func(i, j int) bool {
+		return m.timers[i].deadline.Before(m.timers[j].deadline)
+	})
+	m.changed.Broadcast()
+	return t
+}
+
+// Advance moves the clock forward by the given duration, firing the timers
+// which are due in the order of their deadlines.
+func (m *Manual) Advance(d time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.set(m.now.Add(d))
+}
+
+// Set moves the clock forward to the given time, a time before the time of
+// the clock is ignored.
+func (m *Manual) Set(now time.Time) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if now.Before(m.now) {
+		return
+	}
+	m.set(now)
+}
+
+func (m *Manual) set(now time.Time) {
+	m.now = now
+	for len(m.timers) > 0 && !m.timers[0].deadline.After(now) {
+		m.timers[0].c <- m.timers[0].deadline
+		m.timers = m.timers[1:]
+	}
+	m.changed.Broadcast()
+}
+
+// WaitForTimers blocks until at least n timers are waiting for the clock to
+// advance. Stopped timers are not counted, but the timers of channels returned
+// by After nobody receives from anymore are. Tests use it to know that a
+// component waits on the clock before advancing it.
+func (m *Manual) WaitForTimers(n int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for len(m.timers) < n {
+		m.changed.Wait()
+	}
+}
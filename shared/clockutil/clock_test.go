@@ -0,0 +1,115 @@
+package clockutil
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestManual_AfterFiresWhenAdvancedPastDeadline(t *testing.T) {
+	start := time.Unix(1000, 0)
+	m := NewManual(start)
+
+	first := m.After(2 * time.Second)
+	second := m.After(time.Second)
+	select {
+	case <-first:
+		t.Fatal("Expected the timer not to fire before the clock is advanced")
+	case <-second:
+		t.Fatal("Expected the timer not to fire before the clock is advanced")
+	default:
+	}
+
+	m.Advance(time.Second)
+	if fired := <-second; !fired.Equal(start.Add(time.Second)) {
+		t.Errorf("Expected the timer to receive its deadline %v, received %v", start.Add(time.Second), fired)
+	}
+	select {
+	case <-first:
+		t.Fatal("Expected the later timer not to fire")
+	default:
+	}
+
+	m.Set(start.Add(5 * time.Second))
+	if fired := <-first; !fired.Equal(start.Add(2 * time.Second)) {
+		t.Errorf("Expected the timer to receive its deadline %v, received %v", start.Add(2*time.Second), fired)
+	}
+	if !m.Now().Equal(start.Add(5 * time.Second)) {
+		t.Errorf("Expected the clock at %v, received %v", start.Add(5*time.Second), m.Now())
+	}
+}
+
+func TestManual_SetIgnoresEarlierTime(t *testing.T) {
+	start := time.Unix(1000, 0)
+	m := NewManual(start)
+	m.Set(start.Add(-time.Second))
+	if !m.Now().Equal(start) {
+		t.Errorf("Expected the clock to stay at %v, received %v", start, m.Now())
+	}
+}
+
+func TestManual_AfterNonPositiveDurationFiresImmediately(t *testing.T) {
+	m := NewManual(time.Unix(1000, 0))
+	select {
+	case <-m.After(0):
+	default:
+		t.Fatal("Expected the timer to fire immediately")
+	}
+}
+
+func TestManual_WaitForTimers(t *testing.T) {
+	m := NewManual(time.Unix(1000, 0))
+	done := make(chan struct{})
+	go func() {
+		<-m.After(time.Second)
+		close(done)
+	}()
+	m.WaitForTimers(1)
+	m.Advance(time.Second)
+	<-done
+}
+
+func TestWithDeadline_ManualClock(t *testing.T) {
+	m := NewManual(time.Unix(1000, 0))
+	ctx, cancel := WithDeadline(context.Background(), m, time.Unix(1002, 0))
+	defer cancel()
+
+	m.Advance(time.Second)
+	select {
+	case <-ctx.Done():
+		t.Fatal("Expected the context not to be done before the deadline")
+	default:
+	}
+	m.Advance(time.Second)
+	<-ctx.Done()
+}
+
+func TestManual_StoppedTimerDoesNotFire(t *testing.T) {
+	m := NewManual(time.Unix(1000, 0))
+	timer := m.NewTimer(time.Second)
+	if !timer.Stop() {
+		t.Fatal("Expected to stop a pending timer")
+	}
+	if timer.Stop() {
+		t.Error("Expected a stopped timer not to be stopped again")
+	}
+	m.Advance(time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("Expected a stopped timer not to fire")
+	default:
+	}
+}
+
+func TestWithDeadline_CancelRemovesManualTimer(t *testing.T) {
+	m := NewManual(time.Unix(1000, 0))
+	_, cancel := WithDeadline(context.Background(), m, time.Unix(1002, 0))
+	m.WaitForTimers(1)
+	cancel()
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if len(m.timers) != 0 {
+		t.Errorf("Expected the timer of the canceled context to be removed, %d timers left", len(m.timers))
+	}
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli"
+)
+
+// ConfigureChainConfig applies the chain config file given by the
+// --chain-config-file flag over the chain parameters in use, and logs the
+// parameters it changes.
+func ConfigureChainConfig(ctx *cli.Context) error {
+	path := ctx.GlobalString(ChainConfigFileFlag.Name)
+	if path == "" {
+		return nil
+	}
+	log := logrus.WithField("prefix", "config")
+	previous := params.BeaconConfig()
+	cfg, unknown, err := params.LoadChainConfigFile(path, previous)
+	if err != nil {
+		return errors.Wrapf(err, "could not load chain config file %s", path)
+	}
+	for _, key := range unknown {
+		log.WithField("key", key).Warn("Ignoring unknown chain config parameter")
+	}
+	for _, change := range params.ConfigDiff(previous, cfg) {
+		log.WithFields(logrus.Fields{
+			"previous": change.Previous,
+			"value":    change.Value,
+		}).Infof("Setting chain parameter %s", change.Name)
+	}
+	params.OverrideBeaconConfig(cfg)
+	log.WithField("path", path).Info("Using chain config file")
+	return nil
+}
@@ -69,6 +69,16 @@ var (
 		Usage: "The address of bootstrap node. Beacon node will connect for peer discovery via DHT",
 		Value: "/ip4/35.224.249.2/tcp/30001/p2p/QmQEe7o6hKJdGdSkJRh7WJzS6xrex5f4w2SPR6oWbJNriw",
 	}
+	// EnableDiscv5 enables peer discovery via discv5 with Ethereum node records.
+	EnableDiscv5 = cli.BoolFlag{
+		Name:  "enable-discv5",
+		Usage: "Discover peers via discv5 node records advertising the fork version and attestation subnets.",
+	}
+	// Discv5BootstrapNode tells the beacon node which discv5 bootstrap nodes to query.
+	Discv5BootstrapNode = cli.StringSliceFlag{
+		Name:  "discv5-bootstrap-node",
+		Usage: "The ENR of a discv5 bootstrap node. This flag may be used multiple times.",
+	}
 	// RelayNode tells the beacon node which relay node to connect to.
 	RelayNode = cli.StringFlag{
 		Name: "relay-node",
@@ -82,12 +92,24 @@ var (
 		Usage: "The port used by libp2p.",
 		Value: 12000,
 	}
+	// P2PUDPPort defines the port to be used by discv5.
+	P2PUDPPort = cli.IntFlag{
+		Name:  "p2p-udp-port",
+		Usage: "The UDP port used by discv5.",
+		Value: 12000,
+	}
 	// P2PHost defines the host IP to be used by libp2p.
 	P2PHost = cli.StringFlag{
 		Name:  "p2p-host-ip",
 		Usage: "The IP address advertised by libp2p. This may be used to advertise an external IP.",
 		Value: "",
 	}
+	// P2PLocalIP defines the local IP address libp2p listens on.
+	P2PLocalIP = cli.StringFlag{
+		Name:  "p2p-local-ip",
+		Usage: "The local IP address libp2p listens on, the external IPv4 address of the host if it is not set.",
+		Value: "",
+	}
 	// P2PPrivKey defines a flag to specify the location of the private key file for libp2p.
 	P2PPrivKey = cli.StringFlag{
 		Name:  "p2p-priv-key",
@@ -107,6 +129,18 @@ var (
 			"would whitelist connections to peers on your local network only. The default " +
 			"is to accept all connections.",
 	}
+	// AttestationSubnetCount defines the number of subnet topics attestations are gossiped on.
+	AttestationSubnetCount = cli.Uint64Flag{
+		Name:  "attestation-subnet-count",
+		Usage: "The number of subnet topics unaggregated attestations are gossiped on.",
+		Value: 8,
+	}
+	// PersistentSubnets defines the number of attestation subnets the node joins for its whole lifetime.
+	PersistentSubnets = cli.Uint64Flag{
+		Name:  "persistent-subnets",
+		Usage: "The number of attestation subnets, picked at random, which the node always participates in.",
+		Value: 1,
+	}
 	// ClearDB tells the beacon node to remove any previously stored data at the data directory.
 	ClearDB = cli.BoolFlag{
 		Name:  "clear-db",
@@ -134,4 +168,9 @@ var (
 		Name:  "enable-upnp",
 		Usage: "Enable the service (Beacon chain or Validator) to use UPnP when possible.",
 	}
+	// ChainConfigFileFlag specifies a YAML file of chain parameters applied over the preset in use.
+	ChainConfigFileFlag = cli.StringFlag{
+		Name:  "chain-config-file",
+		Usage: "The path to a YAML file of chain parameters, such as SECONDS_PER_SLOT, applied over the parameters in use or over the preset named by its PRESET_BASE key (mainnet, minimal or demo)",
+	}
 )
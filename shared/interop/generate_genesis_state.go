@@ -0,0 +1,83 @@
+package interop
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+// Eth1BlockHash is the ETH1.0 block hash of the eth1 data of interop genesis
+// states, which do not refer to an actual ETH1.0 chain.
+var Eth1BlockHash = bytes.Repeat([]byte{0x42}, 32)
+
+// GenerateGenesisState returns the genesis state of an interop chain starting
+// at genesisTime with numValidators validators holding the deterministic keys,
+// along with the deposits of these validators.
+func GenerateGenesisState(genesisTime uint64, numValidators uint64) (*pb.BeaconState, []*ethpb.Deposit, error) {
+	privKeys, pubKeys, err := DeterministicallyGenerateKeys(0 /*startIndex*/, numValidators)
+	if err != nil {
+		return nil, nil, err
+	}
+	deposits, depositRoot, err := GenerateDeposits(privKeys, pubKeys)
+	if err != nil {
+		return nil, nil, err
+	}
+	genesisState, err := state.GenesisBeaconState(deposits, genesisTime, &ethpb.Eth1Data{
+		DepositRoot: depositRoot[:],
+		BlockHash:   Eth1BlockHash,
+	})
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not generate genesis state")
+	}
+	return genesisState, deposits, nil
+}
+
+// GenerateDeposits returns the signed deposits of the maximum effective balance
+// of the given keys, with their proofs against the returned deposit root.
+func GenerateDeposits(privKeys []*bls.SecretKey, pubKeys []*bls.PublicKey) ([]*ethpb.Deposit, [32]byte, error) {
+	domain := bls.Domain(params.BeaconConfig().DomainDeposit, params.BeaconConfig().GenesisForkVersion)
+	deposits := make([]*ethpb.Deposit, len(privKeys))
+	leaves := make([][]byte, len(privKeys))
+	for i := range privKeys {
+		pubKey := pubKeys[i].Marshal()
+		withdrawalCreds := hashutil.Hash(pubKey)
+		withdrawalCreds[0] = params.BeaconConfig().BLSWithdrawalPrefixByte
+		data := &ethpb.Deposit_Data{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: withdrawalCreds[:],
+			Amount:                params.BeaconConfig().MaxEffectiveBalance,
+		}
+		root, err := ssz.SigningRoot(data)
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrap(err, "could not get signing root of deposit data")
+		}
+		data.Signature = privKeys[i].Sign(root[:], domain).Marshal()
+		leaf, err := ssz.HashTreeRoot(data)
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrap(err, "could not hash deposit data")
+		}
+		leaves[i] = leaf[:]
+		deposits[i] = &ethpb.Deposit{Data: data}
+	}
+
+	depositTrie, err := trieutil.GenerateTrieFromItems(leaves, int(params.BeaconConfig().DepositContractTreeDepth))
+	if err != nil {
+		return nil, [32]byte{}, errors.Wrap(err, "could not generate deposit trie")
+	}
+	for i := range deposits {
+		proof, err := depositTrie.MerkleProof(i)
+		if err != nil {
+			return nil, [32]byte{}, errors.Wrapf(err, "could not generate proof of deposit %d", i)
+		}
+		deposits[i].Proof = proof
+	}
+	return deposits, depositTrie.Root(), nil
+}
@@ -0,0 +1,53 @@
+// Package interop derives the deterministic validator keys and genesis state
+// which clients agree on to start a local test network without an ETH1.0
+// chain, following the eth2.0 interop mocked start specification.
+package interop
+
+import (
+	"encoding/binary"
+	"math/big"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// curveOrder is the order of the BLS12-381 curve, which the derived secret
+// keys are reduced by.
+var curveOrder, _ = new(big.Int).SetString("52435875175126190479447740508185965837690552500527637822603658699938581184513", 10)
+
+// DeterministicallyGenerateKeys returns the secret keys of the validators with
+// indices startIndex up to startIndex+numKeys, along with their public keys.
+func DeterministicallyGenerateKeys(startIndex uint64, numKeys uint64) ([]*bls.SecretKey, []*bls.PublicKey, error) {
+	privKeys := make([]*bls.SecretKey, numKeys)
+	pubKeys := make([]*bls.PublicKey, numKeys)
+	for i := uint64(0); i < numKeys; i++ {
+		priv, err := bls.SecretKeyFromBytes(secretKeyBytes(startIndex + i))
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "could not derive secret key of validator %d", startIndex+i)
+		}
+		privKeys[i] = priv
+		pubKeys[i] = priv.PublicKey()
+	}
+	return privKeys, pubKeys, nil
+}
+
+// secretKeyBytes returns the big endian encoding of the secret key of the
+// validator with the given index, which is the sha256 hash of the index as 32
+// little endian bytes, read as a little endian integer modulo the curve order.
+func secretKeyBytes(index uint64) []byte {
+	enc := make([]byte, 32)
+	binary.LittleEndian.PutUint64(enc, index)
+	hash := hashutil.Hash(enc)
+	// The hash is read as a little endian integer, big.Int expects big endian.
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+	num := new(big.Int).SetBytes(hash[:])
+	num.Mod(num, curveOrder)
+
+	key := make([]byte, 32)
+	b := num.Bytes()
+	copy(key[len(key)-len(b):], b)
+	return key
+}
@@ -0,0 +1,80 @@
+package interop
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/prysmaticlabs/go-ssz"
+)
+
+func TestSecretKeyBytes_InteropVectors(t *testing.T) {
+	// Keys of the mocked start keygen test vectors.
+	want := []string{
+		"25295f0d1d592a90b333e26e85149708208e9f8e8bc18f6c77bd62f8ad7a6866",
+		"51d0b65185db6989ab0b560d6deed19c7ead0e24b9b6372cbecb1f26bdfad000",
+		"315ed405fafe339603932eebe8dbfd650ce5dafa561f6928664c75db85f97857",
+	}
+	for i, w := range want {
+		if got := hex.EncodeToString(secretKeyBytes(uint64(i))); got != w {
+			t.Errorf("Wrong secret key of validator %d, expected %s, received %s", i, w, got)
+		}
+	}
+}
+
+func TestDeterministicallyGenerateKeys_StartIndex(t *testing.T) {
+	_, allKeys, err := DeterministicallyGenerateKeys(0, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, rangeKeys, err := DeterministicallyGenerateKeys(2, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, key := range rangeKeys {
+		if !bytes.Equal(key.Marshal(), allKeys[2+i].Marshal()) {
+			t.Errorf("Key %d of the range does not match the key of validator %d", i, 2+i)
+		}
+	}
+}
+
+func TestGenerateGenesisState_Deterministic(t *testing.T) {
+	genesisState, deposits, err := GenerateGenesisState(1567000000, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(genesisState.Validators) != 8 || len(deposits) != 8 {
+		t.Fatalf("Expected 8 validators and deposits, received %d and %d", len(genesisState.Validators), len(deposits))
+	}
+	if genesisState.GenesisTime != 1567000000 {
+		t.Errorf("Expected genesis time 1567000000, received %d", genesisState.GenesisTime)
+	}
+	_, pubKeys, err := DeterministicallyGenerateKeys(0, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range genesisState.Validators {
+		if !bytes.Equal(v.PublicKey, pubKeys[i].Marshal()) {
+			t.Errorf("Validator %d does not hold the deterministic key", i)
+		}
+		if v.ActivationEpoch != 0 {
+			t.Errorf("Expected validator %d to be active at genesis", i)
+		}
+	}
+
+	again, _, err := GenerateGenesisState(1567000000, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := ssz.HashTreeRoot(genesisState)
+	if err != nil {
+		t.Fatal(err)
+	}
+	againRoot, err := ssz.HashTreeRoot(again)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != againRoot {
+		t.Errorf("Expected the same genesis state root, received %#x and %#x", root, againRoot)
+	}
+}
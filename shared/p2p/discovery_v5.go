@@ -0,0 +1,315 @@
+package p2p
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	host "github.com/libp2p/go-libp2p-host"
+	peer "github.com/libp2p/go-libp2p-peer"
+	ps "github.com/libp2p/go-libp2p-peerstore"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// forkVersionENRKey is the ENR key under which a node advertises the fork
+	// version of the chain it follows.
+	forkVersionENRKey = "forkversion"
+	// attSubnetsENRKey is the ENR key under which a node advertises, as a
+	// bitmask, the attestation subnets it participates in.
+	attSubnetsENRKey = "attnets"
+	// MaxAttestationSubnets is the number of subnets which fit in the
+	// attestation subnet bitmask of a node record.
+	MaxAttestationSubnets = 64
+)
+
+// Interval at which the discv5 dialer checks whether more peers are needed.
+var discv5DialInterval = 1 * time.Second
+
+// subnetPeerReserve is the inverse share of the peer slots which the discv5
+// dialer keeps for nodes sharing one of our attestation subnets.
+const subnetPeerReserve = 4
+
+// discv5Config describes the local node record and the bootstrap records of
+// a discv5 listener.
+type discv5Config struct {
+	privKey     *ecdsa.PrivateKey
+	ip          net.IP
+	udpPort     int
+	tcpPort     int
+	forkVersion []byte
+	subnets     uint64
+	bootnodes   []*enode.Node
+}
+
+// configureDiscv5 derives the discv5 configuration from the server config and
+// the persistent attestation subnets of the node. The libp2p host must share
+// its secp256k1 identity with the node record, so a generated identity is
+// appended to the libp2p options when no key file is configured.
+func configureDiscv5(cfg *ServerConfig, persistentSubnets []uint64, opts []libp2p.Option) (*discv5Config, []libp2p.Option, error) {
+	key, err := discv5Identity(cfg.PrvKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	privKey, err := ecdsaKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg.PrvKey == "" {
+		opts = append(opts, libp2p.Identity(key))
+	}
+	bootnodes, err := parseBootnodeENRs(cfg.Discv5BootstrapAddrs)
+	if err != nil {
+		return nil, nil, err
+	}
+	subnets, err := subnetBitmask(persistentSubnets)
+	if err != nil {
+		return nil, nil, err
+	}
+	ip, err := localIPv4(cfg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get IPv4 address: %v", err)
+	}
+	return &discv5Config{
+		privKey:     privKey,
+		ip:          net.ParseIP(ip),
+		udpPort:     cfg.UDPPort,
+		tcpPort:     cfg.Port,
+		forkVersion: cfg.ForkVersion,
+		subnets:     subnets,
+		bootnodes:   bootnodes,
+	}, opts, nil
+}
+
+// startDiscoveryV5 opens a UDP socket on the configured address and starts a
+// discv5 listener advertising the local node record. When the UDP port is 0,
+// the record advertises the port picked by the system.
+func startDiscoveryV5(cfg *discv5Config) (*discover.UDPv5, error) {
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: cfg.ip, Port: cfg.udpPort})
+	if err != nil {
+		return nil, fmt.Errorf("could not listen on udp: %v", err)
+	}
+	if cfg.udpPort == 0 {
+		bound := *cfg
+		bound.udpPort = conn.LocalAddr().(*net.UDPAddr).Port
+		cfg = &bound
+	}
+	localNode, err := createLocalNode(cfg)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	listener, err := discover.ListenV5(conn, localNode, discover.Config{
+		PrivateKey: cfg.privKey,
+		Bootnodes:  cfg.bootnodes,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not start discv5 listener: %v", err)
+	}
+	return listener, nil
+}
+
+// createLocalNode builds the ENR of this node, including the eth2 specific
+// fork version and attestation subnet entries.
+func createLocalNode(cfg *discv5Config) (*enode.LocalNode, error) {
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, fmt.Errorf("could not open node db: %v", err)
+	}
+	localNode := enode.NewLocalNode(db, cfg.privKey)
+	ip := cfg.ip
+	if ip == nil || ip.IsUnspecified() {
+		ip = net.IPv4(127, 0, 0, 1)
+	}
+	localNode.SetStaticIP(ip)
+	localNode.Set(enr.UDP(cfg.udpPort))
+	if cfg.tcpPort != 0 {
+		localNode.Set(enr.TCP(cfg.tcpPort))
+	}
+	localNode.Set(enr.WithEntry(forkVersionENRKey, cfg.forkVersion))
+	localNode.Set(enr.WithEntry(attSubnetsENRKey, cfg.subnets))
+	return localNode, nil
+}
+
+// parseBootnodeENRs decodes the textual ENRs of the discv5 bootstrap nodes.
+func parseBootnodeENRs(records []string) ([]*enode.Node, error) {
+	nodes := make([]*enode.Node, 0, len(records))
+	for _, r := range records {
+		if r == "" {
+			continue
+		}
+		node, err := enode.Parse(enode.ValidSchemes, r)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse bootnode record %q: %v", r, err)
+		}
+		nodes = append(nodes, node)
+	}
+	return nodes, nil
+}
+
+// subnetBitmask converts a list of attestation subnet indices into the
+// bitmask stored in the node record.
+func subnetBitmask(subnets []uint64) (uint64, error) {
+	var mask uint64
+	for _, s := range subnets {
+		if s >= MaxAttestationSubnets {
+			return 0, fmt.Errorf("attestation subnet %d exceeds the maximum of %d", s, MaxAttestationSubnets-1)
+		}
+		mask |= 1 << s
+	}
+	return mask, nil
+}
+
+// retrieveForkVersion reads the advertised fork version from a node record.
+func retrieveForkVersion(node *enode.Node) ([]byte, error) {
+	var version []byte
+	if err := node.Load(enr.WithEntry(forkVersionENRKey, &version)); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// retrieveAttSubnets reads the advertised attestation subnet bitmask from a
+// node record.
+func retrieveAttSubnets(node *enode.Node) (uint64, error) {
+	var mask uint64
+	if err := node.Load(enr.WithEntry(attSubnetsENRKey, &mask)); err != nil {
+		return 0, err
+	}
+	return mask, nil
+}
+
+// filterPeer returns true if the node is on the given fork. Nodes without a
+// TCP port cannot be dialed by libp2p and are rejected.
+func filterPeer(node *enode.Node, forkVersion []byte) bool {
+	if node.IP() == nil || node.TCP() == 0 {
+		return false
+	}
+	version, err := retrieveForkVersion(node)
+	return err == nil && bytes.Equal(version, forkVersion)
+}
+
+// sharesSubnet returns true if the node advertises at least one of the
+// subnets of the given bitmask.
+func sharesSubnet(node *enode.Node, subnets uint64) bool {
+	mask, err := retrieveAttSubnets(node)
+	return err == nil && mask&subnets != 0
+}
+
+// shouldDial decides whether a node on our fork is dialed given the current
+// peer count. Nodes sharing one of our subnets are preferred: once all but
+// 1/subnetPeerReserve of the peer slots are taken, only those are dialed.
+func shouldDial(node *enode.Node, subnets uint64, peers int, maxPeers int) bool {
+	if maxPeers <= 0 {
+		return true
+	}
+	if peers >= maxPeers {
+		return false
+	}
+	if subnets == 0 || sharesSubnet(node, subnets) {
+		return true
+	}
+	return peers < maxPeers-maxPeers/subnetPeerReserve
+}
+
+// peerInfoFromNode converts a discv5 node record into the libp2p peer info
+// needed to dial it. The libp2p peer ID is derived from the secp256k1 public
+// key of the record.
+func peerInfoFromNode(node *enode.Node) (*ps.PeerInfo, error) {
+	pubKey := (*crypto.Secp256k1PublicKey)((*btcec.PublicKey)(node.Pubkey()))
+	id, err := peer.IDFromPublicKey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", node.IP().String(), node.TCP()))
+	if err != nil {
+		return nil, err
+	}
+	return &ps.PeerInfo{ID: id, Addrs: []ma.Multiaddr{addr}}, nil
+}
+
+// discv5Identity returns the libp2p private key used when discv5 is enabled.
+// The configured key file is used when present, otherwise a fresh secp256k1
+// key is generated, as discv5 only supports secp256k1 identities.
+func discv5Identity(prvKey string) (crypto.PrivKey, error) {
+	if prvKey != "" {
+		return readPrivateKey(prvKey)
+	}
+	key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	return key, err
+}
+
+// ecdsaKey converts a libp2p secp256k1 private key into the key format used
+// by discv5, so that both protocols share the same node identity.
+func ecdsaKey(key crypto.PrivKey) (*ecdsa.PrivateKey, error) {
+	secpKey, ok := key.(*crypto.Secp256k1PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("discv5 requires a secp256k1 private key, got %T", key)
+	}
+	return (*ecdsa.PrivateKey)((*btcec.PrivateKey)(secpKey)), nil
+}
+
+// listenForNewNodes walks the discv5 table with random lookups and dials the
+// discovered nodes on our fork, as long as the host has fewer than maxPeers
+// connections. Nodes sharing one of our subnets are preferred, see shouldDial.
+func listenForNewNodes(
+	ctx context.Context,
+	h host.Host,
+	listener *discover.UDPv5,
+	forkVersion []byte,
+	subnets uint64,
+	maxPeers int,
+) {
+	iterator := enode.Filter(listener.RandomNodes(), func(node *enode.Node) bool {
+		return filterPeer(node, forkVersion)
+	})
+	defer iterator.Close()
+	go func() {
+		<-ctx.Done()
+		iterator.Close()
+	}()
+
+	for iterator.Next() {
+		if ctx.Err() != nil {
+			return
+		}
+		if maxPeers > 0 && peerCount(h) >= maxPeers {
+			time.Sleep(discv5DialInterval)
+			continue
+		}
+		node := iterator.Node()
+		if !shouldDial(node, subnets, peerCount(h), maxPeers) {
+			continue
+		}
+		info, err := peerInfoFromNode(node)
+		if err != nil {
+			log.WithError(err).Debug("Could not convert node record to peer info")
+			continue
+		}
+		if info.ID == h.ID() || len(h.Network().ConnsToPeer(info.ID)) > 0 {
+			continue
+		}
+		log.WithFields(logrus.Fields{
+			"peer id":    info.ID,
+			"peer addrs": info.Addrs,
+		}).Debug("Dialing peer found via discv5")
+		h.Peerstore().AddAddrs(info.ID, info.Addrs, ps.TempAddrTTL)
+		dialCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		if err := h.Connect(dialCtx, *info); err != nil {
+			log.WithError(err).WithField("peer id", info.ID).Debug("Failed to connect to discv5 peer")
+		}
+		cancel()
+	}
+}
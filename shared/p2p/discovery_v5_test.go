@@ -0,0 +1,180 @@
+package p2p
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	peer "github.com/libp2p/go-libp2p-peer"
+)
+
+func generateDiscv5Key(t *testing.T) (crypto.PrivKey, *ecdsa.PrivateKey) {
+	key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privKey, err := ecdsaKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, privKey
+}
+
+// createTestListener starts a loopback listener on a port picked by the
+// system. The TCP port is only advertised in the record, nothing listens on it.
+func createTestListener(t *testing.T, forkVersion []byte, subnets uint64, bootnodes []*enode.Node) *discover.UDPv5 {
+	_, privKey := generateDiscv5Key(t)
+	listener, err := startDiscoveryV5(&discv5Config{
+		privKey:     privKey,
+		ip:          net.IPv4(127, 0, 0, 1),
+		udpPort:     0,
+		tcpPort:     1,
+		forkVersion: forkVersion,
+		subnets:     subnets,
+		bootnodes:   bootnodes,
+	})
+	if err != nil {
+		t.Fatalf("Could not start discv5 listener: %v", err)
+	}
+	return listener
+}
+
+func TestStartDiscoveryV5_DiscoversLoopbackNodes(t *testing.T) {
+	forkVersion := []byte{0, 0, 0, 0}
+	bootListener := createTestListener(t, forkVersion, 0, nil)
+	defer bootListener.Close()
+
+	bootnode, err := enode.Parse(enode.ValidSchemes, bootListener.Self().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var listeners []*discover.UDPv5
+	for i := 1; i <= 5; i++ {
+		listener := createTestListener(t, forkVersion, 1<<uint64(i), []*enode.Node{bootnode})
+		defer listener.Close()
+		listeners = append(listeners, listener)
+	}
+
+	// Poll until the routing tables are populated.
+	lastListener := listeners[len(listeners)-1]
+	var nodes []*enode.Node
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(50 * time.Millisecond) {
+		nodes = lastListener.Lookup(bootnode.ID())
+		if len(nodes) >= 4 {
+			return
+		}
+	}
+	t.Errorf("Expected at least 4 nodes to be discovered, received %d", len(nodes))
+}
+
+func TestStartDiscoveryV5_AdvertisesBoundPort(t *testing.T) {
+	listener := createTestListener(t, []byte{0, 0, 0, 0}, 0, nil)
+	defer listener.Close()
+	if listener.Self().UDP() == 0 {
+		t.Error("Expected the record to advertise the port picked by the system")
+	}
+}
+
+func testNode(t *testing.T, forkVersion []byte, subnets uint64) *enode.Node {
+	// The ports are only written to the record, nothing is bound to them.
+	_, privKey := generateDiscv5Key(t)
+	localNode, err := createLocalNode(&discv5Config{
+		privKey:     privKey,
+		ip:          net.IPv4(127, 0, 0, 1),
+		udpPort:     3000,
+		tcpPort:     3000,
+		forkVersion: forkVersion,
+		subnets:     subnets,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return localNode.Node()
+}
+
+func TestFilterPeer_ForkVersion(t *testing.T) {
+	node := testNode(t, []byte{0, 0, 0, 1}, 1<<3|1<<7)
+	if !filterPeer(node, []byte{0, 0, 0, 1}) {
+		t.Error("Expected node on the same fork to pass the filter")
+	}
+	if filterPeer(node, []byte{0, 0, 0, 0}) {
+		t.Error("Expected node on another fork to be filtered out")
+	}
+	if !filterPeer(testNode(t, []byte{0, 0, 0, 1}, 0), []byte{0, 0, 0, 1}) {
+		t.Error("Expected node without subnets to pass the filter")
+	}
+}
+
+func TestShouldDial_PrefersSubnetPeers(t *testing.T) {
+	subnetNode := testNode(t, []byte{0, 0, 0, 1}, 1<<3|1<<7)
+	otherNode := testNode(t, []byte{0, 0, 0, 1}, 1<<4)
+	noSubnetNode := testNode(t, []byte{0, 0, 0, 1}, 0)
+
+	tests := []struct {
+		node     *enode.Node
+		subnets  uint64
+		peers    int
+		maxPeers int
+		want     bool
+	}{
+		{node: otherNode, subnets: 1 << 3, peers: 100, maxPeers: 0, want: true},
+		{node: subnetNode, subnets: 1 << 3, peers: 8, maxPeers: 8, want: false},
+		{node: subnetNode, subnets: 1<<2 | 1<<7, peers: 7, maxPeers: 8, want: true},
+		{node: otherNode, subnets: 0, peers: 7, maxPeers: 8, want: true},
+		{node: otherNode, subnets: 1 << 3, peers: 5, maxPeers: 8, want: true},
+		{node: otherNode, subnets: 1 << 3, peers: 6, maxPeers: 8, want: false},
+		{node: noSubnetNode, subnets: 1 << 3, peers: 5, maxPeers: 8, want: true},
+		{node: noSubnetNode, subnets: 1 << 3, peers: 6, maxPeers: 8, want: false},
+	}
+	for i, tt := range tests {
+		if got := shouldDial(tt.node, tt.subnets, tt.peers, tt.maxPeers); got != tt.want {
+			t.Errorf("%d: shouldDial(%b, %d, %d) = %v, want %v", i, tt.subnets, tt.peers, tt.maxPeers, got, tt.want)
+		}
+	}
+}
+
+func TestSubnetBitmask(t *testing.T) {
+	mask, err := subnetBitmask([]uint64{0, 5, 63})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mask != 1|1<<5|1<<63 {
+		t.Errorf("Unexpected subnet bitmask %b", mask)
+	}
+	if _, err := subnetBitmask([]uint64{MaxAttestationSubnets}); err == nil {
+		t.Error("Expected error for subnet beyond the bitmask size")
+	}
+}
+
+func TestPeerInfoFromNode_MatchesLibp2pIdentity(t *testing.T) {
+	key, privKey := generateDiscv5Key(t)
+	localNode, err := createLocalNode(&discv5Config{
+		privKey: privKey,
+		ip:      net.IPv4(127, 0, 0, 1),
+		udpPort: 3001,
+		tcpPort: 3001,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := peerInfoFromNode(localNode.Node())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.ID != want {
+		t.Errorf("Expected peer ID %s, received %s", want.Pretty(), info.ID.Pretty())
+	}
+	if len(info.Addrs) != 1 || info.Addrs[0].String() != "/ip4/127.0.0.1/tcp/3001" {
+		t.Errorf("Unexpected peer addresses %v", info.Addrs)
+	}
+}
@@ -8,15 +8,31 @@ import (
 )
 
 // setHandshakeHandler to respond to requests for p2p handshake messages.
-func setHandshakeHandler(host host.Host, contractAddress string) {
+// The peer's handshake is read and validated before responding with our
+// current status. Peers on a different chain are disconnected without a
+// response.
+func setHandshakeHandler(host host.Host, status *peerStatus) {
 	host.SetStreamHandler(handshakeProtocol, func(stream inet.Stream) {
 		defer stream.Close()
 		log.Debug("Handling handshake stream")
+
+		r := ggio.NewDelimitedReader(stream, maxMessageSize)
+		defer r.Close()
+		req := &pb.Handshake{}
+		if err := r.ReadMsg(req); err != nil {
+			log.WithError(err).Error("Failed to read handshake request")
+			return
+		}
+
+		local := status.localHandshake()
+		if err := checkPeerHandshake(host, status, local, stream.Conn().RemotePeer(), req); err != nil {
+			return
+		}
+
 		w := ggio.NewDelimitedWriter(stream)
 		defer w.Close()
 
-		hs := &pb.Handshake{DepositContractAddress: contractAddress}
-		if err := w.WriteMsg(hs); err != nil {
+		if err := w.WriteMsg(local); err != nil {
 			log.WithError(err).Error("Failed to write handshake response")
 		}
 	})
@@ -2,9 +2,11 @@ package p2p
 
 import (
 	"context"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 )
 
@@ -27,3 +29,18 @@ type Subscriber interface {
 type ReputationManager interface {
 	Reputation(peer peer.ID, val int)
 }
+
+// PeerStatusProvider represents a subset of the p2p.Server which exposes the
+// chain status that peers reported in their handshake.
+type PeerStatusProvider interface {
+	PeerStatuses() map[peer.ID]*pb.Handshake
+	RefreshPeerStatuses(ctx context.Context) error
+}
+
+// SubnetManager represents a subset of the p2p.Server which manages the
+// attestation subnets of the node.
+type SubnetManager interface {
+	AttestationSubnetForShard(shard uint64) uint64
+	JoinAttestationSubnet(subnet uint64, expiry time.Time) error
+	BroadcastToSubnet(ctx context.Context, subnet uint64, msg proto.Message)
+}
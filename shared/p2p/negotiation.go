@@ -1,10 +1,11 @@
 package p2p
 
 import (
-	"bytes"
 	"context"
+	"fmt"
+	"sync"
+	"time"
 
-	"github.com/ethereum/go-ethereum/common"
 	ggio "github.com/gogo/protobuf/io"
 	host "github.com/libp2p/go-libp2p-host"
 	inet "github.com/libp2p/go-libp2p-net"
@@ -15,12 +16,19 @@ import (
 
 const handshakeProtocol = prysmProtocolPrefix + "/handshake"
 
+// Maximum time allowed to exchange handshakes with a peer.
+var handshakeTimeout = 10 * time.Second
+
+// Age after which the chain status of a peer is refreshed.
+var peerStatusMaxAge = 1 * time.Minute
+
 // setupPeerNegotiation adds a "Connected" event handler which checks a peer's
-// handshake to ensure the peer is on the same blockchain. This currently
-// checks only the deposit contract address. Some peer IDs may be excluded.
+// handshake to ensure the peer is on the same blockchain. This checks the
+// deposit contract address, the fork version and that the peer's finalized
+// checkpoint does not conflict with ours. Some peer IDs may be excluded.
 // For example, a relay or bootnode will not support the handshake protocol,
 // but we would not want to disconnect from those well known peer IDs.
-func setupPeerNegotiation(h host.Host, contractAddress string, exclusions []peer.ID) {
+func setupPeerNegotiation(h host.Host, status *peerStatus, exclusions []peer.ID) {
 	h.Network().Notify(&inet.NotifyBundle{
 		ConnectedF: func(net inet.Network, conn inet.Conn) {
 			// Must be handled in a goroutine as this callback cannot be blocking.
@@ -36,62 +44,138 @@ func setupPeerNegotiation(h host.Host, contractAddress string, exclusions []peer
 					"Checking connection to peer",
 				)
 
-				s, err := h.NewStream(
-					context.Background(),
-					conn.RemotePeer(),
-					handshakeProtocol,
-				)
-				if err != nil {
+				local := status.localHandshake()
+				if err := exchangeHandshake(context.Background(), h, status, local, conn.RemotePeer(), true); err != nil {
 					log.WithError(err).WithFields(logrus.Fields{
 						"peer":    conn.RemotePeer(),
 						"address": conn.RemoteMultiaddr(),
-					}).Debug("Failed to open stream with newly connected peer")
-
-					h.ConnManager().TagPeer(conn.RemotePeer(), "handshake", -10000)
-					return
+					}).Debug("Failed handshake with newly connected peer")
 				}
-				defer s.Close()
+			}()
+		},
+		DisconnectedF: func(net inet.Network, conn inet.Conn) {
+			if len(net.ConnsToPeer(conn.RemotePeer())) == 0 {
+				status.remove(conn.RemotePeer())
+			}
+		},
+	})
+}
 
-				w := ggio.NewDelimitedWriter(s)
-				defer w.Close()
+// exchangeHandshake sends our handshake to the peer and validates the
+// response. Peers on a different chain are disconnected, otherwise the peer's
+// handshake is recorded. Peers which fail to respond are only disconnected if
+// disconnectOnError is set, as a refresh may fail for transient reasons.
+func exchangeHandshake(
+	ctx context.Context,
+	h host.Host,
+	status *peerStatus,
+	local *pb.Handshake,
+	pid peer.ID,
+	disconnectOnError bool,
+) error {
+	ctx, cancel := context.WithTimeout(ctx, handshakeTimeout)
+	defer cancel()
 
-				hs := &pb.Handshake{DepositContractAddress: contractAddress}
-				if err := w.WriteMsg(hs); err != nil {
-					log.WithError(err).Error("Failed to write handshake to peer")
+	s, err := h.NewStream(ctx, pid, handshakeProtocol)
+	if err != nil {
+		h.ConnManager().TagPeer(pid, "handshake", -10000)
+		return err
+	}
+	defer s.Close()
 
-					if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
-						log.WithError(err).Error("failed to disconnect peer")
-					}
-					return
-				}
+	w := ggio.NewDelimitedWriter(s)
+	defer w.Close()
 
-				r := ggio.NewDelimitedReader(s, maxMessageSize)
-				resp := &pb.Handshake{}
-				if err := r.ReadMsg(resp); err != nil {
-					log.WithError(err).Error("Failed to read message")
+	if err := w.WriteMsg(local); err != nil {
+		if disconnectOnError {
+			disconnectPeer(h, pid)
+		}
+		return err
+	}
 
-					if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
-						log.WithError(err).Error("failed to disconnect peer")
-					}
-					return
-				}
+	r := ggio.NewDelimitedReader(s, maxMessageSize)
+	resp := &pb.Handshake{}
+	if err := r.ReadMsg(resp); err != nil {
+		if disconnectOnError {
+			disconnectPeer(h, pid)
+		}
+		return err
+	}
 
-				log.WithField("msg", resp).Debug("Handshake received")
+	log.WithField("msg", resp).Debug("Handshake received")
+	return checkPeerHandshake(h, status, local, pid, resp)
+}
 
-				if !bytes.Equal(common.HexToHash(resp.DepositContractAddress).Bytes(), common.HexToHash(contractAddress).Bytes()) {
-					log.WithFields(logrus.Fields{
-						"peerContract":     resp.DepositContractAddress,
-						"expectedContract": contractAddress,
-					}).Warn("Disconnecting from peer on different contract")
+// checkPeerHandshake records the handshake of a peer on the same chain as
+// ours and disconnects peers on a different chain.
+func checkPeerHandshake(h host.Host, status *peerStatus, local *pb.Handshake, pid peer.ID, remote *pb.Handshake) error {
+	if err := status.validate(local, remote); err != nil {
+		log.WithError(err).WithFields(logrus.Fields{
+			"peer":                pid,
+			"peerContract":        remote.DepositContractAddress,
+			"expectedContract":    local.DepositContractAddress,
+			"peerForkVersion":     remote.ForkVersion,
+			"peerFinalizedEpoch":  remote.FinalizedEpoch,
+			"peerFinalizedRoot":   remote.FinalizedRoot,
+			"localFinalizedEpoch": local.FinalizedEpoch,
+		}).Warn("Disconnecting from peer on a different chain")
 
-					if err := h.Network().ClosePeer(conn.RemotePeer()); err != nil {
-						log.WithError(err).Error("failed to disconnect peer")
-					}
-					h.ConnManager().TagPeer(conn.RemotePeer(), "ContractAddress", -5000)
-				} else {
-					h.ConnManager().TagPeer(conn.RemotePeer(), "ContractAddress", 10000)
-				}
-			}()
-		},
-	})
+		status.remove(pid)
+		disconnectPeer(h, pid)
+		h.ConnManager().TagPeer(pid, "ChainStatus", -5000)
+		return err
+	}
+
+	status.set(pid, remote)
+	h.ConnManager().TagPeer(pid, "ChainStatus", 10000)
+	return nil
+}
+
+// refreshPeerStatuses exchanges handshakes, in parallel, with the connected
+// peers whose chain status is older than peerStatusMaxAge, except for the
+// excluded peers. Peers which fail to respond are kept.
+func refreshPeerStatuses(ctx context.Context, h host.Host, status *peerStatus, exclusions []peer.ID) error {
+	var stale []peer.ID
+	for _, pid := range h.Network().Peers() {
+		excluded := false
+		for _, exclusion := range exclusions {
+			if pid == exclusion {
+				excluded = true
+				break
+			}
+		}
+		if !excluded && status.isStale(pid, peerStatusMaxAge) {
+			stale = append(stale, pid)
+		}
+	}
+	if len(stale) == 0 {
+		return nil
+	}
+
+	local := status.localHandshake()
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	var lastErr error
+	for _, pid := range stale {
+		wg.Add(1)
+		go func(pid peer.ID) {
+			defer wg.Done()
+			if err := exchangeHandshake(ctx, h, status, local, pid, false); err != nil {
+				errLock.Lock()
+				lastErr = err
+				errLock.Unlock()
+			}
+		}(pid)
+	}
+	wg.Wait()
+	if lastErr != nil {
+		return fmt.Errorf("could not refresh the status of every peer: %v", lastErr)
+	}
+	return nil
+}
+
+func disconnectPeer(h host.Host, pid peer.ID) {
+	if err := h.Network().ClosePeer(pid); err != nil {
+		log.WithError(err).Error("failed to disconnect peer")
+	}
 }
@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -18,11 +19,13 @@ func TestNegotiation_AcceptsValidPeer(t *testing.T) {
 	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 
 	address := "0x83250193c56fab7a25b40fe98c9b4f7fc238a568"
-	setHandshakeHandler(hostA, address)
-	setHandshakeHandler(hostB, address)
+	statusA := newPeerStatus(address, []byte{0, 0, 0, 0})
+	statusB := newPeerStatus(address, []byte{0, 0, 0, 0})
+	setHandshakeHandler(hostA, statusA)
+	setHandshakeHandler(hostB, statusB)
 
-	setupPeerNegotiation(hostA, address, []peer.ID{})
-	setupPeerNegotiation(hostB, address, []peer.ID{})
+	setupPeerNegotiation(hostA, statusA, []peer.ID{})
+	setupPeerNegotiation(hostB, statusB, []peer.ID{})
 
 	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
 		t.Fatal(err)
@@ -40,11 +43,13 @@ func TestNegotiation_DisconnectsDifferentDepositContract(t *testing.T) {
 	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 
-	setHandshakeHandler(hostA, "0x83250193c56fab7a25b40fe98c9b4f7fc238a568")
-	setHandshakeHandler(hostB, "0x9d525e28fe5830ee92d7aa799c4d21590567b595")
+	statusA := newPeerStatus("0x83250193c56fab7a25b40fe98c9b4f7fc238a568", []byte{0, 0, 0, 0})
+	statusB := newPeerStatus("0x9d525e28fe5830ee92d7aa799c4d21590567b595", []byte{0, 0, 0, 0})
+	setHandshakeHandler(hostA, statusA)
+	setHandshakeHandler(hostB, statusB)
 
-	setupPeerNegotiation(hostA, "0x83250193c56fab7a25b40fe98c9b4f7fc238a568", []peer.ID{})
-	setupPeerNegotiation(hostB, "0x9d525e28fe5830ee92d7aa799c4d21590567b595", []peer.ID{})
+	setupPeerNegotiation(hostA, statusA, []peer.ID{})
+	setupPeerNegotiation(hostB, statusB, []peer.ID{})
 
 	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
 		t.Fatal(err)
@@ -56,3 +61,103 @@ func TestNegotiation_DisconnectsDifferentDepositContract(t *testing.T) {
 		t.Error("hosts are connected, but should not be connected")
 	}
 }
+
+func TestNegotiation_DisconnectsDifferentFork(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	address := "0x83250193c56fab7a25b40fe98c9b4f7fc238a568"
+	statusA := newPeerStatus(address, []byte{0, 0, 0, 0})
+	statusB := newPeerStatus(address, []byte{0, 0, 0, 1})
+	setHandshakeHandler(hostA, statusA)
+	setHandshakeHandler(hostB, statusB)
+
+	setupPeerNegotiation(hostA, statusA, []peer.ID{})
+	setupPeerNegotiation(hostB, statusB, []peer.ID{})
+
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Allow short delay for async negotiation.
+	time.Sleep(200 * time.Millisecond)
+	if hostA.Network().Connectedness(hostB.ID()) == libp2pnet.Connected {
+		t.Error("hosts are connected, but should not be connected")
+	}
+	if len(statusA.all()) != 0 {
+		t.Error("expected no peer status to be recorded for a peer on another fork")
+	}
+}
+
+func TestRefreshPeerStatuses_KeepsPeerOnTransientError(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	// Peer B accepts the handshake stream but never responds.
+	hostB.SetStreamHandler(handshakeProtocol, func(stream libp2pnet.Stream) {
+		stream.Close()
+	})
+	statusA := newPeerStatus("0x83250193c56fab7a25b40fe98c9b4f7fc238a568", []byte{0, 0, 0, 0})
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := refreshPeerStatuses(ctx, hostA, statusA, []peer.ID{}); err == nil {
+		t.Error("Expected an error refreshing a peer which does not respond")
+	}
+	if hostA.Network().Connectedness(hostB.ID()) != libp2pnet.Connected {
+		t.Error("Expected the peer to stay connected after a failed refresh")
+	}
+}
+
+func TestRefreshPeerStatuses_SkipsFreshStatuses(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	address := "0x83250193c56fab7a25b40fe98c9b4f7fc238a568"
+	statusA := newPeerStatus(address, []byte{0, 0, 0, 0})
+	statusB := newPeerStatus(address, []byte{0, 0, 0, 0})
+	var handshakes int32
+	hostB.SetStreamHandler(handshakeProtocol, func(stream libp2pnet.Stream) {
+		atomic.AddInt32(&handshakes, 1)
+		stream.Close()
+	})
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+	statusA.set(hostB.ID(), statusB.localHandshake())
+
+	if err := refreshPeerStatuses(ctx, hostA, statusA, []peer.ID{}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&handshakes); n != 0 {
+		t.Errorf("Expected no handshake with a peer whose status is fresh, received %d", n)
+	}
+}
+
+func TestHandshakeHandler_RejectsPeerOnDifferentFork(t *testing.T) {
+	ctx := context.Background()
+	hostA := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	hostB := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	address := "0x83250193c56fab7a25b40fe98c9b4f7fc238a568"
+	statusA := newPeerStatus(address, []byte{0, 0, 0, 1})
+	statusB := newPeerStatus(address, []byte{0, 0, 0, 0})
+	setHandshakeHandler(hostB, statusB)
+	if err := hostA.Connect(ctx, pstore.PeerInfo{ID: hostB.ID(), Addrs: hostB.Addrs()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := exchangeHandshake(ctx, hostA, statusA, statusA.localHandshake(), hostB.ID(), false); err == nil {
+		t.Error("Expected the peer on another fork to receive no handshake response")
+	}
+	if len(statusB.all()) != 0 {
+		t.Error("Expected no peer status to be recorded by the responder for a peer on another fork")
+	}
+	if hostB.Network().Connectedness(hostA.ID()) == libp2pnet.Connected {
+		t.Error("Expected the responder to disconnect the peer on another fork")
+	}
+}
@@ -18,7 +18,7 @@ import (
 // TODO(287): Expand on these options and provide the option configuration via flags.
 func buildOptions(cfg *ServerConfig) []libp2p.Option {
 
-	ip, err := iputils.ExternalIPv4()
+	ip, err := localIPv4(cfg)
 	if err != nil {
 		log.Errorf("Could not get IPv4 address: %v", err)
 	}
@@ -43,6 +43,15 @@ func buildOptions(cfg *ServerConfig) []libp2p.Option {
 	return options
 }
 
+// localIPv4 returns the local IP address libp2p listens on, which is the
+// external IPv4 address of the host unless the config sets another one.
+func localIPv4(cfg *ServerConfig) (string, error) {
+	if cfg.LocalIP != "" {
+		return cfg.LocalIP, nil
+	}
+	return iputils.ExternalIPv4()
+}
+
 // whitelistSubnet adds a whitelist multiaddress filter for a given CIDR subnet.
 // Example: 192.168.0.0/16 may be used to accept only connections on your local
 // network.
@@ -79,23 +88,8 @@ func privKey(prvKey string) libp2p.Option {
 	}
 
 	return func(cfg *libp2p.Config) error {
-		if _, err := os.Stat(prvKey); os.IsNotExist(err) {
-			log.WithField("private key file", prvKey).Warn("Could not read private key, file is missing or unreadable")
-			return err
-		}
-		bytes, err := ioutil.ReadFile(prvKey)
-		if err != nil {
-			log.WithError(err).Error("Error reading private key from file")
-			return err
-		}
-		keyBytes, err := crypto.ConfigDecodeKey(string(bytes))
-		if err != nil {
-			log.WithError(err).Error("Error decoding private key")
-			return err
-		}
-		key, err := crypto.UnmarshalPrivateKey(keyBytes)
+		key, err := readPrivateKey(prvKey)
 		if err != nil {
-			log.WithError(err).Error("Error unmarshalling private key")
 			return err
 		}
 		pubKey, err := peer.IDFromPrivateKey(key)
@@ -109,3 +103,27 @@ func privKey(prvKey string) libp2p.Option {
 		return cfg.Apply(libp2p.Identity(key))
 	}
 }
+
+// readPrivateKey loads and decodes a libp2p private key from the given file.
+func readPrivateKey(prvKey string) (crypto.PrivKey, error) {
+	if _, err := os.Stat(prvKey); os.IsNotExist(err) {
+		log.WithField("private key file", prvKey).Warn("Could not read private key, file is missing or unreadable")
+		return nil, err
+	}
+	bytes, err := ioutil.ReadFile(prvKey)
+	if err != nil {
+		log.WithError(err).Error("Error reading private key from file")
+		return nil, err
+	}
+	keyBytes, err := crypto.ConfigDecodeKey(string(bytes))
+	if err != nil {
+		log.WithError(err).Error("Error decoding private key")
+		return nil, err
+	}
+	key, err := crypto.UnmarshalPrivateKey(keyBytes)
+	if err != nil {
+		log.WithError(err).Error("Error unmarshalling private key")
+		return nil, err
+	}
+	return key, nil
+}
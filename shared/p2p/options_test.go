@@ -16,6 +16,18 @@ func TestBuildOptions(t *testing.T) {
 	_ = opts
 }
 
+func TestBuildOptions_LocalIP(t *testing.T) {
+	opts := buildOptions(&ServerConfig{LocalIP: "127.0.0.1", Port: 13000})
+
+	var cfg config.Config
+	if err := cfg.Apply(opts...); err != nil {
+		t.Fatalf("Could not apply options: %v", err)
+	}
+	if len(cfg.ListenAddrs) != 1 || cfg.ListenAddrs[0].String() != "/ip4/127.0.0.1/tcp/13000" {
+		t.Errorf("Expected to listen on /ip4/127.0.0.1/tcp/13000, received %v", cfg.ListenAddrs)
+	}
+}
+
 func TestPrivateKeyLoading(t *testing.T) {
 	file, err := ioutil.TempFile(testutil.TempDir(), "key")
 	if err != nil {
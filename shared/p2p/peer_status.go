@@ -0,0 +1,143 @@
+package p2p
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	peer "github.com/libp2p/go-libp2p-peer"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+var (
+	errDifferentContract         = errors.New("peer is using a different deposit contract")
+	errDifferentFork             = errors.New("peer is on a different fork")
+	errConflictingFinalizedBlock = errors.New("peer has a conflicting finalized checkpoint")
+)
+
+// ChainStatusProvider supplies the local chain status which is exchanged with
+// peers during the handshake. Until a provider is set, the handshake only
+// carries the deposit contract address and the fork version.
+type ChainStatusProvider interface {
+	// ChainStatus returns the finalized checkpoint and the head of the
+	// local chain.
+	ChainStatus() (*pb.Handshake, error)
+	// HasBlock returns true if the block with the given root is known locally.
+	HasBlock(root [32]byte) bool
+}
+
+// peerStatus tracks the local handshake and the latest handshake received
+// from every connected peer.
+type peerStatus struct {
+	lock            sync.RWMutex
+	contractAddress string
+	forkVersion     []byte
+	provider        ChainStatusProvider
+	peers           map[peer.ID]*pb.Handshake
+	updated         map[peer.ID]time.Time
+}
+
+func newPeerStatus(contractAddress string, forkVersion []byte) *peerStatus {
+	return &peerStatus{
+		contractAddress: contractAddress,
+		forkVersion:     forkVersion,
+		peers:           make(map[peer.ID]*pb.Handshake),
+		updated:         make(map[peer.ID]time.Time),
+	}
+}
+
+func (ps *peerStatus) setProvider(provider ChainStatusProvider) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	ps.provider = provider
+}
+
+// localHandshake builds the handshake sent to peers from the current chain
+// status of the provider.
+func (ps *peerStatus) localHandshake() *pb.Handshake {
+	ps.lock.RLock()
+	provider := ps.provider
+	ps.lock.RUnlock()
+
+	hs := &pb.Handshake{}
+	if provider != nil {
+		status, err := provider.ChainStatus()
+		if err != nil {
+			log.WithError(err).Error("Could not retrieve chain status for handshake")
+		} else if status != nil {
+			hs = status
+		}
+	}
+	hs.DepositContractAddress = ps.contractAddress
+	hs.ForkVersion = ps.forkVersion
+	return hs
+}
+
+// validate checks that a peer's handshake is for the same chain as ours. A
+// peer is rejected if it uses another deposit contract or fork version, or if
+// its finalized checkpoint is not part of our chain.
+func (ps *peerStatus) validate(local *pb.Handshake, remote *pb.Handshake) error {
+	if !bytes.Equal(common.HexToHash(remote.DepositContractAddress).Bytes(), common.HexToHash(local.DepositContractAddress).Bytes()) {
+		return errDifferentContract
+	}
+	if !bytes.Equal(remote.ForkVersion, local.ForkVersion) {
+		return errDifferentFork
+	}
+	// Without a local chain status, there is no finalized checkpoint to
+	// compare against.
+	if len(local.FinalizedRoot) == 0 || len(remote.FinalizedRoot) == 0 {
+		return nil
+	}
+	switch {
+	case remote.FinalizedEpoch == local.FinalizedEpoch:
+		if !bytes.Equal(remote.FinalizedRoot, local.FinalizedRoot) {
+			return errConflictingFinalizedBlock
+		}
+	case remote.FinalizedEpoch < local.FinalizedEpoch && remote.FinalizedEpoch > 0:
+		// The peer is behind us, so its finalized block must be one we know.
+		// A peer still at the genesis checkpoint cannot conflict with ours.
+		ps.lock.RLock()
+		provider := ps.provider
+		ps.lock.RUnlock()
+		if provider != nil && !provider.HasBlock(bytesutil.ToBytes32(remote.FinalizedRoot)) {
+			return errConflictingFinalizedBlock
+		}
+	}
+	return nil
+}
+
+func (ps *peerStatus) set(pid peer.ID, hs *pb.Handshake) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	ps.peers[pid] = hs
+	ps.updated[pid] = time.Now()
+}
+
+func (ps *peerStatus) remove(pid peer.ID) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+	delete(ps.peers, pid)
+	delete(ps.updated, pid)
+}
+
+// isStale returns true if no status was received from the peer within the
+// given duration.
+func (ps *peerStatus) isStale(pid peer.ID, maxAge time.Duration) bool {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	updated, ok := ps.updated[pid]
+	return !ok || time.Since(updated) > maxAge
+}
+
+func (ps *peerStatus) all() map[peer.ID]*pb.Handshake {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+	statuses := make(map[peer.ID]*pb.Handshake, len(ps.peers))
+	for pid, hs := range ps.peers {
+		statuses[pid] = hs
+	}
+	return statuses
+}
@@ -0,0 +1,121 @@
+package p2p
+
+import (
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+)
+
+type mockChainStatus struct {
+	status *pb.Handshake
+	known  map[[32]byte]bool
+}
+
+func (m *mockChainStatus) ChainStatus() (*pb.Handshake, error) {
+	return m.status, nil
+}
+
+func (m *mockChainStatus) HasBlock(root [32]byte) bool {
+	return m.known[root]
+}
+
+func TestPeerStatus_Validate(t *testing.T) {
+	address := "0x83250193c56fab7a25b40fe98c9b4f7fc238a568"
+	forkVersion := []byte{0, 0, 0, 0}
+	knownRoot := [32]byte{'a'}
+	provider := &mockChainStatus{
+		status: &pb.Handshake{
+			FinalizedRoot:  []byte{'b'},
+			FinalizedEpoch: 5,
+		},
+		known: map[[32]byte]bool{knownRoot: true},
+	}
+	status := newPeerStatus(address, forkVersion)
+	status.setProvider(provider)
+	local := status.localHandshake()
+
+	tests := []struct {
+		name   string
+		remote *pb.Handshake
+		err    error
+	}{
+		{
+			name:   "no chain status",
+			remote: &pb.Handshake{DepositContractAddress: address, ForkVersion: forkVersion},
+		},
+		{
+			name:   "different contract",
+			remote: &pb.Handshake{DepositContractAddress: "0x9d525e28fe5830ee92d7aa799c4d21590567b595", ForkVersion: forkVersion},
+			err:    errDifferentContract,
+		},
+		{
+			name:   "different fork",
+			remote: &pb.Handshake{DepositContractAddress: address, ForkVersion: []byte{0, 0, 0, 1}},
+			err:    errDifferentFork,
+		},
+		{
+			name: "same finalized checkpoint",
+			remote: &pb.Handshake{
+				DepositContractAddress: address,
+				ForkVersion:            forkVersion,
+				FinalizedRoot:          []byte{'b'},
+				FinalizedEpoch:         5,
+			},
+		},
+		{
+			name: "conflicting finalized root at same epoch",
+			remote: &pb.Handshake{
+				DepositContractAddress: address,
+				ForkVersion:            forkVersion,
+				FinalizedRoot:          []byte{'c'},
+				FinalizedEpoch:         5,
+			},
+			err: errConflictingFinalizedBlock,
+		},
+		{
+			name: "known earlier finalized root",
+			remote: &pb.Handshake{
+				DepositContractAddress: address,
+				ForkVersion:            forkVersion,
+				FinalizedRoot:          knownRoot[:],
+				FinalizedEpoch:         3,
+			},
+		},
+		{
+			name: "unknown earlier finalized root",
+			remote: &pb.Handshake{
+				DepositContractAddress: address,
+				ForkVersion:            forkVersion,
+				FinalizedRoot:          []byte{'d'},
+				FinalizedEpoch:         3,
+			},
+			err: errConflictingFinalizedBlock,
+		},
+		{
+			name: "peer ahead of us",
+			remote: &pb.Handshake{
+				DepositContractAddress: address,
+				ForkVersion:            forkVersion,
+				FinalizedRoot:          []byte{'e'},
+				FinalizedEpoch:         8,
+			},
+		},
+	}
+	for _, tt := range tests {
+		if err := status.validate(local, tt.remote); err != tt.err {
+			t.Errorf("%s: expected error %v, received %v", tt.name, tt.err, err)
+		}
+	}
+}
+
+func TestPeerStatus_LocalHandshakeWithoutProvider(t *testing.T) {
+	address := "0x83250193c56fab7a25b40fe98c9b4f7fc238a568"
+	status := newPeerStatus(address, []byte{0, 0, 0, 1})
+	hs := status.localHandshake()
+	if hs.DepositContractAddress != address {
+		t.Errorf("Expected deposit contract %s, received %s", address, hs.DepositContractAddress)
+	}
+	if len(hs.FinalizedRoot) != 0 || hs.HeadSlot != 0 {
+		t.Errorf("Expected no chain status without a provider, received %v", hs)
+	}
+}
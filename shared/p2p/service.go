@@ -10,6 +10,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ethereum/go-ethereum/p2p/discover"
 	ggio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
 	"github.com/gogo/protobuf/types"
@@ -29,7 +30,6 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
-	"github.com/prysmaticlabs/prysm/shared/iputils"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 	"go.opencensus.io/trace/propagation"
@@ -61,6 +61,12 @@ type Server struct {
 	relayNodeAddr string
 	noDiscovery   bool
 	staticPeers   []string
+	maxPeers      int
+	status        *peerStatus
+	exclusions    []peer.ID
+	dv5Cfg        *discv5Config
+	dv5Listener   *discover.UDPv5
+	subnets       *attestationSubnets
 }
 
 // ServerConfig for peer to peer networking.
@@ -70,12 +76,20 @@ type ServerConfig struct {
 	BootstrapNodeAddr      string
 	RelayNodeAddr          string
 	HostAddress            string
+	LocalIP                string
 	PrvKey                 string
 	Port                   int
 	MaxPeers               int
 	DepositContractAddress string
 	WhitelistCIDR          string
 	EnableUPnP             bool
+	EnableDiscv5           bool
+	UDPPort                int
+	Discv5BootstrapAddrs   []string
+	ForkVersion            []byte
+	AttestationSubnets     []uint64
+	AttestationSubnetCount uint64
+	PersistentSubnetCount  uint64
 }
 
 // NewServer creates a new p2p server instance.
@@ -95,7 +109,20 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 			return addrs
 		}))
 	}
-	if !checkAvailablePort(cfg.Port) {
+	subnets, err := newAttestationSubnets(cfg.AttestationSubnetCount, cfg.AttestationSubnets, cfg.PersistentSubnetCount)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	var dv5Cfg *discv5Config
+	if cfg.EnableDiscv5 {
+		dv5Cfg, opts, err = configureDiscv5(cfg, subnets.persistent, opts)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+	}
+	if !checkAvailablePort(cfg) {
 		cancel()
 		return nil, fmt.Errorf("error listening on p2p, port %d already taken", cfg.Port)
 	}
@@ -151,8 +178,9 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		exclusions = append(exclusions, info.ID)
 		h.ConnManager().Protect(info.ID, TagReputation)
 	}
-	setupPeerNegotiation(h, cfg.DepositContractAddress, exclusions)
-	setHandshakeHandler(h, cfg.DepositContractAddress)
+	status := newPeerStatus(cfg.DepositContractAddress, cfg.ForkVersion)
+	setupPeerNegotiation(h, status, exclusions)
+	setHandshakeHandler(h, status)
 
 	return &Server{
 		ctx:           ctx,
@@ -167,16 +195,21 @@ func NewServer(cfg *ServerConfig) (*Server, error) {
 		relayNodeAddr: cfg.RelayNodeAddr,
 		noDiscovery:   cfg.NoDiscovery,
 		staticPeers:   cfg.StaticPeers,
+		maxPeers:      cfg.MaxPeers,
+		status:        status,
+		exclusions:    exclusions,
+		dv5Cfg:        dv5Cfg,
+		subnets:       subnets,
 	}, nil
 }
 
-func checkAvailablePort(port int) bool {
-	ip, err := iputils.ExternalIPv4()
+func checkAvailablePort(cfg *ServerConfig) bool {
+	ip, err := localIPv4(cfg)
 	if err != nil {
 		log.Errorf("Could not get IPv4 address: %v", err)
 	}
 
-	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", ip, port))
+	ln, err := net.Listen("tcp", fmt.Sprintf("%s:%d", ip, cfg.Port))
 	if err != nil {
 		return false
 	}
@@ -212,6 +245,17 @@ func (s *Server) Start() {
 			}
 		}
 
+		if s.dv5Cfg != nil {
+			listener, err := startDiscoveryV5(s.dv5Cfg)
+			if err != nil {
+				log.Errorf("Could not start peer discovery via discv5: %v", err)
+			} else {
+				s.dv5Listener = listener
+				log.WithField("enr", listener.Self().String()).Info("Started discv5 listener")
+				go listenForNewNodes(s.ctx, s.host, listener, s.dv5Cfg.forkVersion, s.dv5Cfg.subnets, s.maxPeers)
+			}
+		}
+
 		if err := startmDNSDiscovery(ctx, s.host); err != nil {
 			log.Errorf("Could not start peer discovery via mDNS: %v", err)
 		}
@@ -233,6 +277,8 @@ func (s *Server) Start() {
 	if len(peersToWatch) > 0 {
 		startPeerWatcher(ctx, s.host, peersToWatch...)
 	}
+
+	go s.pruneAttestationSubnets()
 }
 
 // Stop the main p2p loop.
@@ -240,6 +286,9 @@ func (s *Server) Stop() error {
 	log.Info("Stopping service")
 
 	s.cancel()
+	if s.dv5Listener != nil {
+		s.dv5Listener.Close()
+	}
 	return nil
 }
 
@@ -251,6 +300,24 @@ func (s *Server) Status() error {
 	return nil
 }
 
+// SetChainStatusProvider sets the source of the local chain status which is
+// exchanged with peers during the handshake.
+func (s *Server) SetChainStatusProvider(provider ChainStatusProvider) {
+	s.status.setProvider(provider)
+}
+
+// PeerStatuses returns the latest handshake received from each connected peer.
+func (s *Server) PeerStatuses() map[peer.ID]*pb.Handshake {
+	return s.status.all()
+}
+
+// RefreshPeerStatuses exchanges handshakes with all connected peers in order
+// to update their chain status. Peers found to be on a different chain are
+// disconnected.
+func (s *Server) RefreshPeerStatuses(ctx context.Context) error {
+	return refreshPeerStatuses(ctx, s.host, s.status, s.exclusions)
+}
+
 // RegisterTopic with a message and the adapter stack for the given topic. The
 // message type provided will be feed selector for emitting messages received
 // on a given topic.
@@ -270,15 +337,45 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 		log.Errorf("Failed to subscribe to topic: %v", err)
 		return
 	}
+	handler := s.messageHandler(topic, message, adapters)
+
+	s.host.SetStreamHandler(protocol.ID(prysmProtocolPrefix+"/"+topic), func(stream libp2pnet.Stream) {
+		log.WithField("topic", topic).Debug("Received new stream")
+		defer stream.Close()
+		r := ggio.NewDelimitedReader(stream, maxMessageSize)
+		defer r.Close()
+
+		msg := &pb.Envelope{}
+		for {
+			err := r.ReadMsg(msg)
+			if err == io.EOF {
+				return // end of stream
+			}
+			if err != nil {
+				log.WithError(err).Error("Could not read message from stream")
+				return
+			}
+
+			handler(msg, stream.Conn().RemotePeer())
+		}
+	})
+
+	go s.readGossip(s.ctx, sub, message, handler)
+}
+
+// messageHandler returns the function which decodes an envelope received on
+// the topic, passes it through the adapter stack and emits the message to the
+// feed of its type.
+func (s *Server) messageHandler(topic string, message proto.Message, adapters []Adapter) func(*pb.Envelope, peer.ID) {
 	feed := s.Feed(message)
 
 	// Reverse adapter order
-	for i := len(adapters)/2 - 1; i >= 0; i-- {
-		opp := len(adapters) - 1 - i
-		adapters[i], adapters[opp] = adapters[opp], adapters[i]
+	reversed := make([]Adapter, len(adapters))
+	for i, adapter := range adapters {
+		reversed[len(adapters)-1-i] = adapter
 	}
 
-	handler := func(msg *pb.Envelope, peerID peer.ID) {
+	return func(msg *pb.Envelope, peerID peer.ID) {
 		log.WithField("topic", topic).Debug("Processing incoming message")
 		var h Handler = func(pMsg Message) {
 			s.emit(pMsg, feed)
@@ -316,75 +413,56 @@ func (s *Server) RegisterTopic(topic string, message proto.Message, adapters ...
 			s.Reputation(peerID, RepPenalityInvalidProtobuf)
 		}
 		pMsg := Message{Ctx: ctx, Data: data, Peer: peerID}
-		for _, adapter := range adapters {
+		for _, adapter := range reversed {
 			h = adapter(h)
 		}
 
 		h(pMsg)
 	}
+}
 
-	s.host.SetStreamHandler(protocol.ID(prysmProtocolPrefix+"/"+topic), func(stream libp2pnet.Stream) {
-		log.WithField("topic", topic).Debug("Received new stream")
-		defer stream.Close()
-		r := ggio.NewDelimitedReader(stream, maxMessageSize)
-		defer r.Close()
+// readGossip passes the messages of a gossipsub subscription to the handler
+// until the context is cancelled.
+func (s *Server) readGossip(ctx context.Context, sub *pubsub.Subscription, message proto.Message, handler func(*pb.Envelope, peer.ID)) {
+	defer sub.Cancel()
 
-		msg := &pb.Envelope{}
-		for {
-			err := r.ReadMsg(msg)
-			if err == io.EOF {
-				return // end of stream
-			}
-			if err != nil {
-				log.WithError(err).Error("Could not read message from stream")
-				return
-			}
+	var msg *pubsub.Message
+	var err error
 
-			handler(msg, stream.Conn().RemotePeer())
+	// Recover from any panic as part of the receive p2p msg process.
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithFields(logrus.Fields{
+				"r":        r,
+				"msg.Data": attemptToConvertPbToString(msg.Data, message),
+			}).Error("P2P message caused a panic! Recovering...")
 		}
-	})
-
-	go func() {
-		defer sub.Cancel()
-
-		var msg *pubsub.Message
-		var err error
-
-		// Recover from any panic as part of the receive p2p msg process.
-		defer func() {
-			if r := recover(); r != nil {
-				log.WithFields(logrus.Fields{
-					"r":        r,
-					"msg.Data": attemptToConvertPbToString(msg.Data, message),
-				}).Error("P2P message caused a panic! Recovering...")
-			}
-		}()
-
-		for {
-			msg, err = sub.Next(s.ctx)
+	}()
 
-			if s.ctx.Err() != nil {
-				log.WithError(s.ctx.Err()).Debug("Context error")
-				return
-			}
-			if err != nil {
-				log.Errorf("Failed to get next message: %v", err)
-				continue
-			}
+	for {
+		msg, err = sub.Next(ctx)
 
-			if msg == nil || msg.GetFrom() == s.host.ID() {
-				continue
-			}
+		if ctx.Err() != nil {
+			log.WithError(ctx.Err()).Debug("Context error")
+			return
+		}
+		if err != nil {
+			log.Errorf("Failed to get next message: %v", err)
+			continue
+		}
 
-			d := &pb.Envelope{}
-			if err := proto.Unmarshal(msg.Data, d); err != nil {
-				log.WithError(err).Error("Failed to decode data")
-				continue
-			}
+		if msg == nil || msg.GetFrom() == s.host.ID() {
+			continue
+		}
 
-			handler(d, msg.GetFrom())
+		d := &pb.Envelope{}
+		if err := proto.Unmarshal(msg.Data, d); err != nil {
+			log.WithError(err).Error("Failed to decode data")
+			continue
 		}
-	}()
+
+		handler(d, msg.GetFrom())
+	}
 }
 
 // Attempts to convert some proto.Message to a string in a panic safe method.
@@ -507,6 +585,12 @@ func (s *Server) Broadcast(ctx context.Context, msg proto.Message) {
 		log.Warnf("Topic is unknown for message type %T. %v", msg, msg)
 	}
 
+	s.publish(span, topic, msg)
+}
+
+// publish wraps the message in an envelope carrying the span context and
+// publishes it on the gossipsub topic.
+func (s *Server) publish(span *trace.Span, topic string, msg proto.Message) {
 	m, ok := msg.(proto.Message)
 	if !ok {
 		log.Errorf("Message to broadcast (type: %T) is not a protobuf message: %v", msg, msg)
@@ -31,6 +31,7 @@ import (
 var _ = shared.Service(&Server{})
 var _ = Broadcaster(&Server{})
 var _ = Sender(&Server{})
+var _ = SubnetManager(&Server{})
 
 const bar = "bar"
 const testTopic = "test_topic"
@@ -443,7 +444,7 @@ func simulateIncomingMessage(t *testing.T, s *Server, topic string, msg proto.Me
 	ctx := context.Background()
 	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
 
-	setHandshakeHandler(h, "")
+	setHandshakeHandler(h, newPeerStatus("", nil))
 
 	gsub, err := pubsub.NewFloodSub(ctx, h)
 	if err != nil {
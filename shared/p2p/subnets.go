@@ -0,0 +1,211 @@
+package p2p
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// DefaultAttestationSubnetCount is the number of attestation subnets used
+// when none is configured.
+const DefaultAttestationSubnetCount = 8
+
+// Interval at which subnets joined for validator duties are checked for expiry.
+var subnetPruneInterval = 12 * time.Second
+
+// attestationSubnets tracks the attestation subnet topics the node is
+// subscribed to. Persistent subnets are joined for the lifetime of the node
+// and advertised in its node record, while the other subnets are joined for
+// the duties of validators and left once those have passed.
+type attestationSubnets struct {
+	lock       sync.Mutex
+	count      uint64
+	persistent []uint64
+	message    proto.Message
+	adapters   []Adapter
+	active     map[uint64]*subnetSubscription
+}
+
+// subnetSubscription is an active subscription to an attestation subnet
+// topic. A zero expiry marks a persistent subnet.
+type subnetSubscription struct {
+	cancel context.CancelFunc
+	expiry time.Time
+}
+
+// newAttestationSubnets validates the subnet configuration. When no
+// persistent subnets are given, randomCount subnets are picked at random.
+func newAttestationSubnets(count uint64, persistent []uint64, randomCount uint64) (*attestationSubnets, error) {
+	if count == 0 {
+		count = DefaultAttestationSubnetCount
+	}
+	if count > MaxAttestationSubnets {
+		return nil, fmt.Errorf("attestation subnet count %d exceeds the maximum of %d", count, MaxAttestationSubnets)
+	}
+	for _, subnet := range persistent {
+		if subnet >= count {
+			return nil, fmt.Errorf("persistent attestation subnet %d is out of range, the node uses %d subnets", subnet, count)
+		}
+	}
+	if len(persistent) == 0 {
+		persistent = randomSubnets(count, randomCount)
+	}
+	return &attestationSubnets{
+		count:      count,
+		persistent: persistent,
+		active:     make(map[uint64]*subnetSubscription),
+	}, nil
+}
+
+// randomSubnets picks n distinct subnets out of count.
+func randomSubnets(count uint64, n uint64) []uint64 {
+	if n > count {
+		n = count
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	subnets := make([]uint64, 0, n)
+	for _, i := range r.Perm(int(count))[:n] {
+		subnets = append(subnets, uint64(i))
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+	return subnets
+}
+
+// AttestationSubnetTopic returns the gossip topic of an attestation subnet.
+func AttestationSubnetTopic(subnet uint64) string {
+	return fmt.Sprintf("%s_%d", pb.Topic_ATTESTATION_SUBNET, subnet)
+}
+
+// RegisterAttestationSubnets sets the message type and adapter stack used on
+// the attestation subnet topics, and joins the persistent subnets of the node.
+// Subnets joined later on with JoinAttestationSubnet use the same message type.
+func (s *Server) RegisterAttestationSubnets(message proto.Message, adapters ...Adapter) {
+	s.subnets.lock.Lock()
+	s.subnets.message = message
+	s.subnets.adapters = adapters
+	s.subnets.lock.Unlock()
+
+	for _, subnet := range s.subnets.persistent {
+		if err := s.JoinAttestationSubnet(subnet, time.Time{}); err != nil {
+			log.WithError(err).WithField("subnet", subnet).Error("Could not join persistent attestation subnet")
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"subnetCount":       s.subnets.count,
+		"persistentSubnets": s.subnets.persistent,
+	}).Info("Registered attestation subnets")
+}
+
+// AttestationSubnetForShard returns the subnet on which attestations of the
+// committee for the given shard are gossiped.
+func (s *Server) AttestationSubnetForShard(shard uint64) uint64 {
+	return shard % s.subnets.count
+}
+
+// JoinAttestationSubnet subscribes to the subnet topic until the expiry time,
+// or for the lifetime of the node if the expiry is zero. Joining a subnet the
+// node is already subscribed to extends its expiry.
+func (s *Server) JoinAttestationSubnet(subnet uint64, expiry time.Time) error {
+	if subnet >= s.subnets.count {
+		return fmt.Errorf("attestation subnet %d is out of range, the node uses %d subnets", subnet, s.subnets.count)
+	}
+
+	s.subnets.lock.Lock()
+	defer s.subnets.lock.Unlock()
+	if s.subnets.message == nil {
+		return errors.New("attestation subnets are not registered")
+	}
+	if sub, ok := s.subnets.active[subnet]; ok {
+		if !sub.expiry.IsZero() && (expiry.IsZero() || expiry.After(sub.expiry)) {
+			sub.expiry = expiry
+		}
+		return nil
+	}
+
+	topic := AttestationSubnetTopic(subnet)
+	gsubSub, err := s.gsub.Subscribe(topic)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.subnets.active[subnet] = &subnetSubscription{cancel: cancel, expiry: expiry}
+	handler := s.messageHandler(topic, s.subnets.message, s.subnets.adapters)
+	go s.readGossip(ctx, gsubSub, s.subnets.message, handler)
+
+	log.WithFields(logrus.Fields{
+		"subnet": subnet,
+		"expiry": expiry,
+	}).Debug("Joined attestation subnet")
+	return nil
+}
+
+// AttestationSubnets returns the subnets the node is currently subscribed to.
+func (s *Server) AttestationSubnets() []uint64 {
+	s.subnets.lock.Lock()
+	defer s.subnets.lock.Unlock()
+	subnets := make([]uint64, 0, len(s.subnets.active))
+	for subnet := range s.subnets.active {
+		subnets = append(subnets, subnet)
+	}
+	sort.Slice(subnets, func(i, j int) bool { return subnets[i] < subnets[j] })
+	return subnets
+}
+
+// BroadcastToSubnet publishes a message on the topic of an attestation
+// subnet. The node does not need to be subscribed to the subnet.
+func (s *Server) BroadcastToSubnet(ctx context.Context, subnet uint64, msg proto.Message) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.WithField("r", r).Error("Panicked when broadcasting to subnet!")
+		}
+	}()
+
+	_, span := trace.StartSpan(ctx, "beacon-chain.p2p.BroadcastToSubnet")
+	defer span.End()
+
+	topic := AttestationSubnetTopic(subnet)
+	span.AddAttributes(trace.StringAttribute("topic", topic))
+	log.WithField("topic", topic).Debug("Broadcasting msg to subnet")
+
+	s.publish(span, topic, msg)
+}
+
+// pruneAttestationSubnets periodically leaves the subnets whose expiry has
+// passed.
+func (s *Server) pruneAttestationSubnets() {
+	if s.subnets == nil {
+		return
+	}
+	ticker := time.NewTicker(subnetPruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.leaveExpiredSubnets(now)
+		}
+	}
+}
+
+func (s *Server) leaveExpiredSubnets(now time.Time) {
+	s.subnets.lock.Lock()
+	defer s.subnets.lock.Unlock()
+	for subnet, sub := range s.subnets.active {
+		if sub.expiry.IsZero() || sub.expiry.After(now) {
+			continue
+		}
+		sub.cancel()
+		delete(s.subnets.active, subnet)
+		log.WithField("subnet", subnet).Debug("Left attestation subnet")
+	}
+}
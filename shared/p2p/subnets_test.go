@@ -0,0 +1,119 @@
+package p2p
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	bhost "github.com/libp2p/go-libp2p-blankhost"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	swarmt "github.com/libp2p/go-libp2p-swarm/testing"
+	shardpb "github.com/prysmaticlabs/prysm/proto/sharding/p2p/v1"
+)
+
+func TestNewAttestationSubnets_Validation(t *testing.T) {
+	if _, err := newAttestationSubnets(MaxAttestationSubnets+1, nil, 0); err == nil {
+		t.Error("Expected error for a subnet count beyond the bitmask size")
+	}
+	if _, err := newAttestationSubnets(4, []uint64{4}, 0); err == nil {
+		t.Error("Expected error for a persistent subnet out of range")
+	}
+	subnets, err := newAttestationSubnets(0, []uint64{1, 3}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subnets.count != DefaultAttestationSubnetCount {
+		t.Errorf("Expected default subnet count %d, received %d", DefaultAttestationSubnetCount, subnets.count)
+	}
+	if !reflect.DeepEqual(subnets.persistent, []uint64{1, 3}) {
+		t.Errorf("Expected configured persistent subnets to be kept, received %v", subnets.persistent)
+	}
+}
+
+func TestRandomSubnets_Distinct(t *testing.T) {
+	subnets := randomSubnets(16, 5)
+	if len(subnets) != 5 {
+		t.Fatalf("Expected 5 subnets, received %d", len(subnets))
+	}
+	seen := make(map[uint64]bool)
+	for _, subnet := range subnets {
+		if subnet >= 16 {
+			t.Errorf("Subnet %d is out of range", subnet)
+		}
+		if seen[subnet] {
+			t.Errorf("Subnet %d was picked twice", subnet)
+		}
+		seen[subnet] = true
+	}
+	if len(randomSubnets(4, 10)) != 4 {
+		t.Error("Expected the number of random subnets to be capped by the subnet count")
+	}
+}
+
+func TestJoinAttestationSubnet_ReceivesAndExpires(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 1*time.Second)
+	defer cancel()
+	h := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+	h2 := bhost.NewBlankHost(swarmt.GenSwarm(t, ctx))
+
+	gsub, err := pubsub.NewFloodSub(ctx, h2)
+	if err != nil {
+		t.Fatalf("Failed to create pubsub: %v", err)
+	}
+	subnets, err := newAttestationSubnets(4, []uint64{0}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Server{
+		ctx:          ctx,
+		gsub:         gsub,
+		host:         h,
+		feeds:        make(map[reflect.Type]Feed),
+		mutex:        &sync.Mutex{},
+		topicMapping: make(map[reflect.Type]string),
+		subnets:      subnets,
+	}
+
+	ch := make(chan Message)
+	sub := s.Subscribe(&shardpb.CollationBodyRequest{}, ch)
+	defer sub.Unsubscribe()
+
+	s.RegisterAttestationSubnets(&shardpb.CollationBodyRequest{})
+	if s.AttestationSubnetForShard(6) != 2 {
+		t.Errorf("Expected shard 6 to map to subnet 2, received %d", s.AttestationSubnetForShard(6))
+	}
+	expiry := time.Now().Add(time.Minute)
+	if err := s.JoinAttestationSubnet(2, expiry); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.JoinAttestationSubnet(4, expiry); err == nil {
+		t.Error("Expected error when joining a subnet out of range")
+	}
+	if !reflect.DeepEqual(s.AttestationSubnets(), []uint64{0, 2}) {
+		t.Errorf("Unexpected subscribed subnets %v", s.AttestationSubnets())
+	}
+
+	// Short delay to let goroutine add subscription.
+	time.Sleep(time.Millisecond * 10)
+
+	pbMsg := &shardpb.CollationBodyRequest{ShardId: 6}
+	if err := gsub.Publish(AttestationSubnetTopic(2), createEnvelopeBytes(t, pbMsg)); err != nil {
+		t.Fatalf("Failed to publish message: %v", err)
+	}
+	select {
+	case msg := <-ch:
+		if !proto.Equal(msg.Data.(proto.Message), pbMsg) {
+			t.Errorf("Unexpected msg: %+v. Wanted %+v.", msg.Data, pbMsg)
+		}
+	case <-ctx.Done():
+		t.Fatal("Context timed out before a message was received!")
+	}
+
+	s.leaveExpiredSubnets(expiry.Add(time.Second))
+	if !reflect.DeepEqual(s.AttestationSubnets(), []uint64{0}) {
+		t.Errorf("Expected only the persistent subnet to remain, received %v", s.AttestationSubnets())
+	}
+}
@@ -0,0 +1,249 @@
+package params
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// presetBaseKey names, in a chain config file, the preset which the values of
+// the file are applied over.
+const presetBaseKey = "PRESET_BASE"
+
+// Preset returns a copy of the named chain config preset, one of mainnet,
+// minimal or demo.
+func Preset(name string) (*BeaconChainConfig, error) {
+	switch name {
+	case "mainnet":
+		return MainnetConfig().Copy(), nil
+	case "minimal":
+		return MinimalSpecConfig(), nil
+	case "demo":
+		return DemoBeaconConfig(), nil
+	default:
+		return nil, fmt.Errorf("unknown chain config preset %q", name)
+	}
+}
+
+// Copy returns a deep copy of the config.
+func (c *BeaconChainConfig) Copy() *BeaconChainConfig {
+	cfg := *c
+	v := reflect.ValueOf(&cfg).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Slice && !f.IsNil() {
+			f.Set(reflect.ValueOf(append([]byte{}, f.Bytes()...)))
+		}
+	}
+	return &cfg
+}
+
+// LoadChainConfigFile reads a YAML chain config file and applies it over the
+// base config, see UnmarshalChainConfig.
+func LoadChainConfigFile(path string, base *BeaconChainConfig) (*BeaconChainConfig, []string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return UnmarshalChainConfig(data, base)
+}
+
+// UnmarshalChainConfig applies the parameters of a full or partial YAML chain
+// config, keyed by their names in the spec such as SECONDS_PER_SLOT, over a
+// copy of the base config. A PRESET_BASE key replaces the base with the named
+// preset. Integers may be given in decimal or hexadecimal, byte strings such as
+// domains are hexadecimal with a 0x prefix. The returned config is validated,
+// and the keys of the file which are not chain parameters are returned so that
+// they can be reported.
+func UnmarshalChainConfig(data []byte, base *BeaconChainConfig) (*BeaconChainConfig, []string, error) {
+	// Scalars are decoded as their raw text, so that hexadecimal byte strings
+	// keep their length.
+	values := make(map[string]string)
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, nil, fmt.Errorf("could not parse chain config: %v", err)
+	}
+	cfg := base.Copy()
+	if name, ok := values[presetBaseKey]; ok {
+		preset, err := Preset(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		cfg = preset
+		delete(values, presetBaseKey)
+	}
+
+	fields := yamlFields()
+	var unknown []string
+	v := reflect.ValueOf(cfg).Elem()
+	for key, raw := range values {
+		i, ok := fields[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		if err := setField(v.Field(i), raw); err != nil {
+			return nil, nil, fmt.Errorf("invalid value %q of %s: %v", raw, key, err)
+		}
+	}
+	sort.Strings(unknown)
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, err
+	}
+	return cfg, unknown, nil
+}
+
+// yamlFields maps the YAML names of the chain parameters to their field
+// indices.
+func yamlFields() map[string]int {
+	t := reflect.TypeOf(BeaconChainConfig{})
+	fields := make(map[string]int)
+	for i := 0; i < t.NumField(); i++ {
+		if name := t.Field(i).Tag.Get("yaml"); name != "" {
+			fields[name] = i
+		}
+	}
+	return fields
+}
+
+func setField(f reflect.Value, raw string) error {
+	switch f.Kind() {
+	case reflect.Uint8, reflect.Uint64:
+		n, err := strconv.ParseUint(raw, 0, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetUint(n)
+	case reflect.Slice:
+		if !strings.HasPrefix(raw, "0x") {
+			return errors.New("expected a hexadecimal byte string with a 0x prefix")
+		}
+		b, err := hex.DecodeString(raw[2:])
+		if err != nil {
+			return err
+		}
+		f.SetBytes(b)
+	default:
+		return fmt.Errorf("unsupported field type %v", f.Type())
+	}
+	return nil
+}
+
+// Validate checks the internal consistency of the chain parameters.
+func (c *BeaconChainConfig) Validate() error {
+	positive := map[string]uint64{
+		"SHARD_COUNT":                  c.ShardCount,
+		"TARGET_COMMITTEE_SIZE":        c.TargetCommitteeSize,
+		"SECONDS_PER_SLOT":             c.SecondsPerSlot,
+		"SLOTS_PER_EPOCH":              c.SlotsPerEpoch,
+		"SLOTS_PER_HISTORICAL_ROOT":    c.SlotsPerHistoricalRoot,
+		"SLOTS_PER_ETH1_VOTING_PERIOD": c.SlotsPerEth1VotingPeriod,
+		"EPOCHS_PER_HISTORICAL_VECTOR": c.EpochsPerHistoricalVector,
+		"EPOCHS_PER_SLASHINGS_VECTOR":  c.EpochsPerSlashingsVector,
+		"EFFECTIVE_BALANCE_INCREMENT":  c.EffectiveBalanceIncrement,
+		"MAX_EFFECTIVE_BALANCE":        c.MaxEffectiveBalance,
+		"BASE_REWARD_FACTOR":           c.BaseRewardFactor,
+		"PROPOSER_REWARD_QUOTIENT":     c.ProposerRewardQuotient,
+		"CHURN_LIMIT_QUOTIENT":         c.ChurnLimitQuotient,
+	}
+	var zero []string
+	for name, value := range positive {
+		if value == 0 {
+			zero = append(zero, name)
+		}
+	}
+	if len(zero) > 0 {
+		sort.Strings(zero)
+		return fmt.Errorf("%s must be greater than zero", strings.Join(zero, ", "))
+	}
+
+	if c.SlotsPerHistoricalRoot%c.SlotsPerEpoch != 0 {
+		return fmt.Errorf("SLOTS_PER_HISTORICAL_ROOT %d is not a multiple of SLOTS_PER_EPOCH %d",
+			c.SlotsPerHistoricalRoot, c.SlotsPerEpoch)
+	}
+	if c.SlotsPerEth1VotingPeriod%c.SlotsPerEpoch != 0 {
+		return fmt.Errorf("SLOTS_PER_ETH1_VOTING_PERIOD %d is not a multiple of SLOTS_PER_EPOCH %d",
+			c.SlotsPerEth1VotingPeriod, c.SlotsPerEpoch)
+	}
+	if c.EpochsPerHistoricalVector <= c.MinSeedLookahead+c.ActivationExitDelay {
+		return fmt.Errorf("EPOCHS_PER_HISTORICAL_VECTOR %d must exceed MIN_SEED_LOOKAHEAD + ACTIVATION_EXIT_DELAY %d",
+			c.EpochsPerHistoricalVector, c.MinSeedLookahead+c.ActivationExitDelay)
+	}
+	if c.MaxEffectiveBalance%c.EffectiveBalanceIncrement != 0 {
+		return fmt.Errorf("MAX_EFFECTIVE_BALANCE %d is not a multiple of EFFECTIVE_BALANCE_INCREMENT %d",
+			c.MaxEffectiveBalance, c.EffectiveBalanceIncrement)
+	}
+	if c.EjectionBalance >= c.MaxEffectiveBalance {
+		return fmt.Errorf("EJECTION_BALANCE %d must be lower than MAX_EFFECTIVE_BALANCE %d",
+			c.EjectionBalance, c.MaxEffectiveBalance)
+	}
+	if c.MinDepositAmount > c.MaxEffectiveBalance {
+		return fmt.Errorf("MIN_DEPOSIT_AMOUNT %d exceeds MAX_EFFECTIVE_BALANCE %d",
+			c.MinDepositAmount, c.MaxEffectiveBalance)
+	}
+	if c.TargetCommitteeSize > c.MaxValidatorsPerCommittee {
+		return fmt.Errorf("TARGET_COMMITTEE_SIZE %d exceeds MAX_VALIDATORS_PER_COMMITTEE %d",
+			c.TargetCommitteeSize, c.MaxValidatorsPerCommittee)
+	}
+	fourBytes := map[string][]byte{
+		"DOMAIN_BEACON_PROPOSER": c.DomainBeaconProposer,
+		"DOMAIN_RANDAO":          c.DomainRandao,
+		"DOMAIN_ATTESTATION":     c.DomainAttestation,
+		"DOMAIN_DEPOSIT":         c.DomainDeposit,
+		"DOMAIN_VOLUNTARY_EXIT":  c.DomainVoluntaryExit,
+		"DOMAIN_TRANSFER":        c.DomainTransfer,
+		"GENESIS_FORK_VERSION":   c.GenesisForkVersion,
+	}
+	for name, value := range fourBytes {
+		if len(value) != 4 {
+			return fmt.Errorf("%s must be 4 bytes, received %d", name, len(value))
+		}
+	}
+	return nil
+}
+
+// ConfigChange is a chain parameter which differs between two configs.
+type ConfigChange struct {
+	Name     string
+	Previous string
+	Value    string
+}
+
+// ConfigDiff returns the chain parameters, by YAML name, whose values differ
+// between the two configs.
+func ConfigDiff(previous *BeaconChainConfig, cfg *BeaconChainConfig) []ConfigChange {
+	fields := yamlFields()
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	p := reflect.ValueOf(previous).Elem()
+	v := reflect.ValueOf(cfg).Elem()
+	var changes []ConfigChange
+	for _, name := range names {
+		i := fields[name]
+		if reflect.DeepEqual(p.Field(i).Interface(), v.Field(i).Interface()) {
+			continue
+		}
+		changes = append(changes, ConfigChange{
+			Name:     name,
+			Previous: formatField(p.Field(i)),
+			Value:    formatField(v.Field(i)),
+		})
+	}
+	return changes
+}
+
+func formatField(f reflect.Value) string {
+	if f.Kind() == reflect.Slice {
+		return fmt.Sprintf("%#x", f.Bytes())
+	}
+	return strconv.FormatUint(f.Uint(), 10)
+}
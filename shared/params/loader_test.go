@@ -0,0 +1,104 @@
+package params
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPresets_AreValid(t *testing.T) {
+	for _, name := range []string{"mainnet", "minimal", "demo"} {
+		cfg, err := Preset(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := cfg.Validate(); err != nil {
+			t.Errorf("Preset %s is not valid: %v", name, err)
+		}
+	}
+	if _, err := Preset("unknown"); err == nil {
+		t.Error("Expected an unknown preset to be rejected")
+	}
+}
+
+func TestUnmarshalChainConfig_OverridesBase(t *testing.T) {
+	base := DemoBeaconConfig()
+	data := []byte(`
+SECONDS_PER_SLOT: 12
+SLOTS_PER_EPOCH: 16
+ETH1_FOLLOW_DISTANCE: 0x20
+DOMAIN_RANDAO: 0x01000000
+DEPOSIT_CONTRACT_ADDRESS: 0x1234567890123456789012345678901234567890
+`)
+	cfg, unknown, err := UnmarshalChainConfig(data, base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SecondsPerSlot != 12 || cfg.SlotsPerEpoch != 16 || cfg.Eth1FollowDistance != 32 {
+		t.Errorf("Expected overridden timings, received %d seconds per slot, %d slots per epoch, follow distance %d",
+			cfg.SecondsPerSlot, cfg.SlotsPerEpoch, cfg.Eth1FollowDistance)
+	}
+	if !bytes.Equal(cfg.DomainRandao, []byte{1, 0, 0, 0}) {
+		t.Errorf("Expected randao domain 0x01000000, received %#x", cfg.DomainRandao)
+	}
+	if cfg.MaxEffectiveBalance != base.MaxEffectiveBalance {
+		t.Errorf("Expected the base max effective balance %d, received %d", base.MaxEffectiveBalance, cfg.MaxEffectiveBalance)
+	}
+	if !reflect.DeepEqual(unknown, []string{"DEPOSIT_CONTRACT_ADDRESS"}) {
+		t.Errorf("Expected the deposit contract address to be reported as unknown, received %v", unknown)
+	}
+	if base.SecondsPerSlot != 6 || !bytes.Equal(base.DomainRandao, []byte{1, 0, 0, 0}) {
+		t.Error("Expected the base config to be left untouched")
+	}
+
+	changes := ConfigDiff(base, cfg)
+	var names []string
+	for _, c := range changes {
+		names = append(names, c.Name)
+	}
+	want := []string{"ETH1_FOLLOW_DISTANCE", "SECONDS_PER_SLOT", "SLOTS_PER_EPOCH"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Expected changes of %v, received %v", want, names)
+	}
+	if changes[1].Previous != "6" || changes[1].Value != "12" {
+		t.Errorf("Expected SECONDS_PER_SLOT to change from 6 to 12, received %+v", changes[1])
+	}
+}
+
+func TestUnmarshalChainConfig_PresetBase(t *testing.T) {
+	cfg, _, err := UnmarshalChainConfig([]byte("PRESET_BASE: minimal\nSECONDS_PER_SLOT: 2\n"), MainnetConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.SlotsPerEpoch != MinimalSpecConfig().SlotsPerEpoch || cfg.SecondsPerSlot != 2 {
+		t.Errorf("Expected minimal preset with 2 seconds per slot, received %d slots per epoch, %d seconds per slot",
+			cfg.SlotsPerEpoch, cfg.SecondsPerSlot)
+	}
+	if MainnetConfig().SecondsPerSlot != 6 {
+		t.Error("Expected the mainnet config to be left untouched")
+	}
+}
+
+func TestUnmarshalChainConfig_Invalid(t *testing.T) {
+	tests := []struct {
+		yaml string
+		err  string
+	}{
+		{yaml: "SLOTS_PER_HISTORICAL_ROOT: 100", err: "not a multiple of SLOTS_PER_EPOCH"},
+		{yaml: "SECONDS_PER_SLOT: 0", err: "SECONDS_PER_SLOT must be greater than zero"},
+		{yaml: "EJECTION_BALANCE: 64000000000", err: "must be lower than MAX_EFFECTIVE_BALANCE"},
+		{yaml: "EPOCHS_PER_HISTORICAL_VECTOR: 4", err: "must exceed MIN_SEED_LOOKAHEAD"},
+		{yaml: "DOMAIN_RANDAO: 0x01", err: "must be 4 bytes"},
+		{yaml: "DOMAIN_RANDAO: 1", err: "0x prefix"},
+		{yaml: "SLOTS_PER_EPOCH: many", err: "invalid value"},
+		{yaml: "BLS_WITHDRAWAL_PREFIX_BYTE: 256", err: "invalid value"},
+		{yaml: "PRESET_BASE: testnet", err: "unknown chain config preset"},
+		{yaml: "- SLOTS_PER_EPOCH", err: "could not parse"},
+	}
+	for _, tt := range tests {
+		if _, _, err := UnmarshalChainConfig([]byte(tt.yaml), MainnetConfig()); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected error %q for %q, received %v", tt.err, tt.yaml, err)
+		}
+	}
+}
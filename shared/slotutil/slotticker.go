@@ -2,6 +2,8 @@ package slotutil
 
 import (
 	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 )
 
 // SlotTicker is a special ticker for the beacon chain block.
@@ -30,11 +32,23 @@ func (s *SlotTicker) Done() {
 
 // GetSlotTicker is the constructor for SlotTicker.
 func GetSlotTicker(genesisTime time.Time, secondsPerSlot uint64) *SlotTicker {
+	return GetSlotTickerWithClock(clockutil.System{}, genesisTime, secondsPerSlot)
+}
+
+// GetSlotTickerWithClock is the constructor for a SlotTicker which ticks
+// following the given clock.
+func GetSlotTickerWithClock(clock clockutil.Clock, genesisTime time.Time, secondsPerSlot uint64) *SlotTicker {
 	ticker := &SlotTicker{
 		c:    make(chan uint64),
 		done: make(chan struct{}),
 	}
-	ticker.start(genesisTime, secondsPerSlot, time.Since, time.Until, time.After)
+	since := func(t time.Time) time.Duration {
+		return clock.Now().Sub(t)
+	}
+	until := func(t time.Time) time.Duration {
+		return t.Sub(clock.Now())
+	}
+	ticker.start(genesisTime, secondsPerSlot, since, until, clock.After)
 	return ticker
 }
 
@@ -3,6 +3,8 @@ package slotutil
 import (
 	"testing"
 	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 )
 
 func TestSlotTicker(t *testing.T) {
@@ -100,3 +102,24 @@ func TestSlotTickerGenesis(t *testing.T) {
 		t.Fatalf("Expected %d, got %d", 1, slot)
 	}
 }
+
+func TestSlotTicker_ManualClock(t *testing.T) {
+	genesisTime := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	secondsPerSlot := uint64(8)
+	clock := clockutil.NewManual(genesisTime.Add(-1 * time.Second))
+	ticker := GetSlotTickerWithClock(clock, genesisTime, secondsPerSlot)
+	defer ticker.Done()
+
+	for slot := uint64(0); slot < 3; slot++ {
+		clock.WaitForTimers(1)
+		select {
+		case <-ticker.C():
+			t.Fatalf("Expected no tick before slot %d starts", slot)
+		default:
+		}
+		clock.Set(genesisTime.Add(time.Duration(slot*secondsPerSlot) * time.Second))
+		if received := <-ticker.C(); received != slot {
+			t.Fatalf("Expected slot %d, got %d", slot, received)
+		}
+	}
+}
@@ -0,0 +1,273 @@
+package sszproof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Location is the position of the value at a field path in the SSZ tree of a
+// struct. Basic values are packed with their neighbours in a 32 byte leaf,
+// from which Value extracts them.
+type Location struct {
+	GeneralizedIndex uint64
+	Offset           int
+	Size             int
+}
+
+// Value returns the serialized value at the location from its leaf.
+func (l *Location) Value(leaf []byte) []byte {
+	return leaf[l.Offset : l.Offset+l.Size]
+}
+
+// Locate returns the location of a field path in the SSZ tree of a pointer to
+// a struct. Paths are made of field names and list or vector indices separated
+// by slashes, such as "finalized_checkpoint/epoch" or "validators/3/slashed".
+// The location of a composite value is the root of its subtree.
+func Locate(v interface{}, path string) (*Location, error) {
+	return locate(v, path, false)
+}
+
+// locate resolves a field path, checking that its list indices are within the
+// lengths of the lists of v if checkLengths is set.
+func locate(v interface{}, path string, checkLengths bool) (*Location, error) {
+	t, err := typeOf(reflect.TypeOf(v))
+	if err != nil {
+		return nil, err
+	}
+	val := reflect.ValueOf(v)
+	loc := &Location{GeneralizedIndex: 1, Size: 32}
+	if path == "" {
+		return loc, nil
+	}
+	for _, step := range strings.Split(path, "/") {
+		if loc.Size != 32 {
+			return nil, fmt.Errorf("path %q continues below a basic value", path)
+		}
+		switch t.kind {
+		case containerKind:
+			i := -1
+			for j, f := range t.fields {
+				if f.name == step {
+					i = j
+					break
+				}
+			}
+			if i < 0 {
+				return nil, fmt.Errorf("unknown field %q in path %q", step, path)
+			}
+			loc.GeneralizedIndex = loc.GeneralizedIndex<<t.depth() | uint64(i)
+			if val.Kind() == reflect.Ptr {
+				if val.IsNil() {
+					val = reflect.Zero(val.Type().Elem())
+				} else {
+					val = val.Elem()
+				}
+			}
+			val = val.Field(t.fields[i].index)
+			t = t.fields[i].typ
+			if t.kind == basicKind {
+				loc.Size = t.size
+			}
+		case vectorKind, listKind:
+			i, err := strconv.ParseUint(step, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q in path %q", step, path)
+			}
+			if i >= t.length {
+				return nil, fmt.Errorf("index %d in path %q out of range of length %d", i, path, t.length)
+			}
+			if t.kind == listKind {
+				if checkLengths && i >= uint64(val.Len()) {
+					return nil, fmt.Errorf("index %d in path %q out of range of list of length %d", i, path, val.Len())
+				}
+				loc.GeneralizedIndex <<= 1
+			}
+			if i < uint64(val.Len()) {
+				val = val.Index(int(i))
+			} else {
+				val = reflect.Zero(val.Type().Elem())
+			}
+			depth := t.depth()
+			t = t.elem
+			if t.kind == basicKind {
+				loc.GeneralizedIndex = loc.GeneralizedIndex<<depth | i*uint64(t.size)/32
+				loc.Offset = int(i * uint64(t.size) % 32)
+				loc.Size = t.size
+			} else {
+				loc.GeneralizedIndex = loc.GeneralizedIndex<<depth | i
+			}
+		default:
+			return nil, fmt.Errorf("path %q continues below a value without fields or indices", path)
+		}
+		if depthOf(loc.GeneralizedIndex) > maxDepth {
+			return nil, fmt.Errorf("path %q is too deep", path)
+		}
+	}
+	return loc, nil
+}
+
+// Multiproof proves leaves of an SSZ tree against its root. The hashes are
+// the nodes at the helper indices of the leaves, in decreasing generalized
+// index order.
+type Multiproof struct {
+	Indices []uint64
+	Leaves  [][]byte
+	Hashes  [][]byte
+}
+
+// Prove creates a multiproof of the values at the field paths of a pointer to
+// a struct.
+func Prove(v interface{}, paths []string) (*Multiproof, error) {
+	proof := &Multiproof{}
+	for _, path := range paths {
+		loc, err := locate(v, path, true)
+		if err != nil {
+			return nil, err
+		}
+		proof.Indices = append(proof.Indices, loc.GeneralizedIndex)
+	}
+	if err := checkIndices(proof.Indices); err != nil {
+		return nil, err
+	}
+	for _, index := range proof.Indices {
+		leaf, err := Node(v, index)
+		if err != nil {
+			return nil, err
+		}
+		proof.Leaves = append(proof.Leaves, leaf[:])
+	}
+	for _, index := range HelperIndices(proof.Indices) {
+		h, err := Node(v, index)
+		if err != nil {
+			return nil, err
+		}
+		proof.Hashes = append(proof.Hashes, h[:])
+	}
+	return proof, nil
+}
+
+// HelperIndices returns the generalized indices of the nodes needed to prove
+// the nodes at the given indices, in decreasing order.
+func HelperIndices(indices []uint64) []uint64 {
+	branch := make(map[uint64]bool)
+	path := make(map[uint64]bool)
+	for _, index := range indices {
+		for i := index; i > 1; i /= 2 {
+			branch[i^1] = true
+			path[i] = true
+		}
+	}
+	helpers := make([]uint64, 0, len(branch))
+	for i := range branch {
+		if !path[i] {
+			helpers = append(helpers, i)
+		}
+	}
+	sort.Slice(helpers, func(i, j int) bool { return helpers[i] > helpers[j] })
+	return helpers
+}
+
+// checkIndices rejects duplicate indices and indices below others, whose
+// leaves would not be checked against each other.
+func checkIndices(indices []uint64) error {
+	seen := make(map[uint64]bool, len(indices))
+	for _, index := range indices {
+		if index == 0 {
+			return errors.New("invalid generalized index 0")
+		}
+		if seen[index] {
+			return fmt.Errorf("duplicate generalized index %d", index)
+		}
+		seen[index] = true
+	}
+	for _, index := range indices {
+		for i := index / 2; i > 0; i /= 2 {
+			if seen[i] {
+				return fmt.Errorf("generalized index %d is below generalized index %d", index, i)
+			}
+		}
+	}
+	return nil
+}
+
+// Verify checks a multiproof against the root of its tree.
+func Verify(root [32]byte, proof *Multiproof) error {
+	if len(proof.Leaves) != len(proof.Indices) {
+		return fmt.Errorf("received %d leaves for %d indices", len(proof.Leaves), len(proof.Indices))
+	}
+	if err := checkIndices(proof.Indices); err != nil {
+		return err
+	}
+	helpers := HelperIndices(proof.Indices)
+	if len(proof.Hashes) != len(helpers) {
+		return fmt.Errorf("received %d hashes for %d helper indices", len(proof.Hashes), len(helpers))
+	}
+
+	nodes := make(map[uint64][32]byte, len(proof.Indices)+len(helpers))
+	keys := make([]uint64, 0, len(proof.Indices)+len(helpers))
+	add := func(index uint64, node []byte) error {
+		if len(node) != 32 {
+			return fmt.Errorf("node at generalized index %d is %d bytes", index, len(node))
+		}
+		var n [32]byte
+		copy(n[:], node)
+		nodes[index] = n
+		keys = append(keys, index)
+		return nil
+	}
+	for i, index := range proof.Indices {
+		if err := add(index, proof.Leaves[i]); err != nil {
+			return err
+		}
+	}
+	for i, index := range helpers {
+		if err := add(index, proof.Hashes[i]); err != nil {
+			return err
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] > keys[j] })
+
+	// Hash the siblings up to the root, parents are appended to the keys as
+	// they are computed.
+	for pos := 0; pos < len(keys); pos++ {
+		k := keys[pos]
+		_, ok := nodes[k^1]
+		_, parent := nodes[k/2]
+		if k > 1 && ok && !parent {
+			nodes[k/2] = hashPair(nodes[k&^1], nodes[k|1])
+			keys = append(keys, k/2)
+		}
+	}
+	computed, ok := nodes[1]
+	if !ok {
+		return errors.New("proof does not reach the root")
+	}
+	if !bytes.Equal(computed[:], root[:]) {
+		return fmt.Errorf("computed root %#x does not match root %#x", computed, root)
+	}
+	return nil
+}
+
+// VerifyPaths checks that a multiproof proves the values at the field paths of
+// the type of v, in the same order, against the root of the tree.
+func VerifyPaths(v interface{}, root [32]byte, paths []string, proof *Multiproof) error {
+	if len(paths) != len(proof.Indices) {
+		return fmt.Errorf("received %d indices for %d paths", len(proof.Indices), len(paths))
+	}
+	for i, path := range paths {
+		loc, err := Locate(v, path)
+		if err != nil {
+			return err
+		}
+		if loc.GeneralizedIndex != proof.Indices[i] {
+			return fmt.Errorf("generalized index %d of path %q does not match index %d of the proof",
+				loc.GeneralizedIndex, path, proof.Indices[i])
+		}
+	}
+	return Verify(root, proof)
+}
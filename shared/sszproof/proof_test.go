@@ -0,0 +1,224 @@
+package sszproof
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/go-ssz"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+func testState() *pb.BeaconState {
+	cfg := params.BeaconConfig()
+	roots := func(n uint64) [][]byte {
+		r := make([][]byte, n)
+		for i := range r {
+			r[i] = make([]byte, 32)
+			binary.LittleEndian.PutUint64(r[i], uint64(i))
+		}
+		return r
+	}
+	s := &pb.BeaconState{
+		GenesisTime: 1000,
+		Slot:        70,
+		Fork: &pb.Fork{
+			PreviousVersion: []byte{0, 0, 0, 0},
+			CurrentVersion:  []byte{0, 0, 0, 1},
+		},
+		LatestBlockHeader: &ethpb.BeaconBlockHeader{
+			Slot:       69,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+			Signature:  make([]byte, 96),
+		},
+		BlockRoots:                  roots(cfg.SlotsPerHistoricalRoot),
+		StateRoots:                  roots(cfg.SlotsPerHistoricalRoot),
+		HistoricalRoots:             roots(2),
+		Eth1Data:                    &ethpb.Eth1Data{DepositCount: 8, DepositRoot: make([]byte, 32), BlockHash: make([]byte, 32)},
+		RandaoMixes:                 roots(cfg.EpochsPerHistoricalVector),
+		ActiveIndexRoots:            roots(cfg.EpochsPerHistoricalVector),
+		CompactCommitteesRoots:      roots(cfg.EpochsPerHistoricalVector),
+		Slashings:                   make([]uint64, cfg.EpochsPerSlashingsVector),
+		JustificationBits:           bitfield.Bitvector4{0x05},
+		PreviousJustifiedCheckpoint: &ethpb.Checkpoint{Epoch: 0, Root: make([]byte, 32)},
+		CurrentJustifiedCheckpoint:  &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+		FinalizedCheckpoint:         &ethpb.Checkpoint{Epoch: 1, Root: []byte("finalized root 0123456789abcdef!")},
+	}
+	for i := uint64(0); i < 10; i++ {
+		pubKey := make([]byte, 48)
+		pubKey[0] = byte(i)
+		s.Validators = append(s.Validators, &ethpb.Validator{
+			PublicKey:             pubKey,
+			WithdrawalCredentials: make([]byte, 32),
+			EffectiveBalance:      cfg.MaxEffectiveBalance,
+			Slashed:               i == 3,
+			ExitEpoch:             cfg.FarFutureEpoch,
+			WithdrawableEpoch:     cfg.FarFutureEpoch,
+		})
+		s.Balances = append(s.Balances, cfg.MaxEffectiveBalance+i)
+	}
+	for i := uint64(0); i < cfg.ShardCount; i++ {
+		crosslink := &ethpb.Crosslink{Shard: i, ParentRoot: make([]byte, 32), DataRoot: make([]byte, 32)}
+		s.PreviousCrosslinks = append(s.PreviousCrosslinks, crosslink)
+		s.CurrentCrosslinks = append(s.CurrentCrosslinks, crosslink)
+	}
+	s.CurrentEpochAttestations = []*pb.PendingAttestation{{
+		AggregationBits: bitfield.Bitlist{0x0b},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Epoch: 1, Root: make([]byte, 32)},
+			Crosslink:       &ethpb.Crosslink{ParentRoot: make([]byte, 32), DataRoot: make([]byte, 32)},
+		},
+		InclusionDelay: 1,
+	}}
+	return s
+}
+
+func TestHashTreeRoot_MatchesSSZ(t *testing.T) {
+	s := testState()
+	want, err := ssz.HashTreeRoot(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := HashTreeRoot(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root != want {
+		t.Errorf("Expected state root %#x, received %#x", want, root)
+	}
+
+	checkpointRoot, err := HashTreeRoot(s.FinalizedCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err = ssz.HashTreeRoot(s.FinalizedCheckpoint)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if checkpointRoot != want {
+		t.Errorf("Expected checkpoint root %#x, received %#x", want, checkpointRoot)
+	}
+}
+
+func TestProve_VerifiesFields(t *testing.T) {
+	s := testState()
+	root, err := HashTreeRoot(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{
+		"balances/7",
+		"finalized_checkpoint/epoch",
+		"finalized_checkpoint/root",
+		"block_roots/5",
+		"validators/3/slashed",
+		"validators/4",
+		"current_epoch_attestations/0/data",
+	}
+	proof, err := Prove(s, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPaths(&pb.BeaconState{}, root, paths, proof); err != nil {
+		t.Fatalf("Could not verify proof: %v", err)
+	}
+
+	loc, err := Locate(&pb.BeaconState{}, "balances/7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balance := binary.LittleEndian.Uint64(loc.Value(proof.Leaves[0])); balance != s.Balances[7] {
+		t.Errorf("Expected balance %d, received %d", s.Balances[7], balance)
+	}
+	if string(proof.Leaves[2]) != string(s.FinalizedCheckpoint.Root) {
+		t.Errorf("Expected finalized root %#x, received %#x", s.FinalizedCheckpoint.Root, proof.Leaves[2])
+	}
+	loc, err = Locate(&pb.BeaconState{}, "validators/3/slashed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if slashed := loc.Value(proof.Leaves[4]); slashed[0] != 1 {
+		t.Error("Expected validator 3 to be slashed")
+	}
+	validatorRoot, err := HashTreeRoot(s.Validators[4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(proof.Leaves[5]) != string(validatorRoot[:]) {
+		t.Errorf("Expected the root of validator 4 as leaf, received %#x", proof.Leaves[5])
+	}
+}
+
+func TestProve_SingleBranch(t *testing.T) {
+	s := testState()
+	proof, err := Prove(s, []string{"validators/9/exit_epoch"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(proof.Hashes) != int(depthOf(proof.Indices[0])) {
+		t.Errorf("Expected a branch of %d hashes, received %d", depthOf(proof.Indices[0]), len(proof.Hashes))
+	}
+}
+
+func TestVerify_RejectsTamperedProofs(t *testing.T) {
+	s := testState()
+	root, err := HashTreeRoot(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	paths := []string{"balances/2", "slot"}
+	proof, err := Prove(s, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proof.Leaves[0][0] ^= 1
+	if err := Verify(root, proof); err == nil {
+		t.Error("Expected a tampered leaf to be rejected")
+	}
+	proof.Leaves[0][0] ^= 1
+	proof.Hashes[1][0] ^= 1
+	if err := Verify(root, proof); err == nil {
+		t.Error("Expected a tampered hash to be rejected")
+	}
+	proof.Hashes[1][0] ^= 1
+	proof.Hashes = proof.Hashes[1:]
+	if err := Verify(root, proof); err == nil {
+		t.Error("Expected a proof with a missing hash to be rejected")
+	}
+
+	proof, err = Prove(s, paths)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyPaths(&pb.BeaconState{}, root, []string{"balances/6", "slot"}, proof); err == nil {
+		t.Error("Expected a proof of another path to be rejected")
+	}
+}
+
+func TestProve_InvalidPaths(t *testing.T) {
+	s := testState()
+	tests := []struct {
+		paths []string
+		err   string
+	}{
+		{paths: []string{"unknown"}, err: "unknown field"},
+		{paths: []string{"slot/0"}, err: "below a basic value"},
+		{paths: []string{"balances/10"}, err: "out of range of list"},
+		{paths: []string{"block_roots/x"}, err: "invalid index"},
+		{paths: []string{"finalized_checkpoint", "finalized_checkpoint/epoch"}, err: "is below generalized index"},
+		{paths: []string{"slot", "slot"}, err: "duplicate"},
+	}
+	for _, tt := range tests {
+		if _, err := Prove(s, tt.paths); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("Expected error %q for paths %v, received %v", tt.err, tt.paths, err)
+		}
+	}
+}
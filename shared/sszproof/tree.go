@@ -0,0 +1,243 @@
+package sszproof
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"reflect"
+
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+)
+
+// maxDepth bounds the depth of the SSZ trees which can be merkleized.
+const maxDepth = 64
+
+var zeroHashes [maxDepth + 1][32]byte
+
+func init() {
+	for i := 1; i <= maxDepth; i++ {
+		zeroHashes[i] = hashPair(zeroHashes[i-1], zeroHashes[i-1])
+	}
+}
+
+func hashPair(left [32]byte, right [32]byte) [32]byte {
+	return hashutil.Hash(append(left[:], right[:]...))
+}
+
+// HashTreeRoot returns the SSZ hash tree root of a pointer to a struct.
+func HashTreeRoot(v interface{}) ([32]byte, error) {
+	return Node(v, 1)
+}
+
+// Node returns the node of the SSZ tree of a pointer to a struct at the
+// given generalized index.
+func Node(v interface{}, gindex uint64) ([32]byte, error) {
+	if gindex == 0 {
+		return [32]byte{}, fmt.Errorf("invalid generalized index 0")
+	}
+	typ, err := typeOf(reflect.TypeOf(v))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return node(reflect.ValueOf(v), typ, gindex)
+}
+
+// node returns the node at the generalized index relative to the root of the
+// value.
+func node(v reflect.Value, t *sszType, gindex uint64) ([32]byte, error) {
+	if t.kind == basicKind {
+		if gindex != 1 {
+			return [32]byte{}, fmt.Errorf("generalized index %d is below a basic value", gindex)
+		}
+		var chunk [32]byte
+		copy(chunk[:], serializeBasic(v, t.size))
+		return chunk, nil
+	}
+	if t.mixesInLength() {
+		switch {
+		case gindex == 1:
+			data, err := dataNode(v, t, 1)
+			if err != nil {
+				return [32]byte{}, err
+			}
+			return hashPair(data, lengthChunk(v, t)), nil
+		case gindex == 3:
+			return lengthChunk(v, t), nil
+		case gindex&(1<<(depthOf(gindex)-1)) != 0:
+			return [32]byte{}, fmt.Errorf("generalized index %d is below the length of a list", gindex)
+		}
+		// Strip the step into the data subtree.
+		d := depthOf(gindex) - 1
+		gindex = 1<<d | gindex&(1<<d-1)
+	}
+	return dataNode(v, t, gindex)
+}
+
+// dataNode returns the node at the generalized index relative to the root of
+// the data chunks of the value.
+func dataNode(v reflect.Value, t *sszType, gindex uint64) ([32]byte, error) {
+	depth := t.depth()
+	d := depthOf(gindex)
+	if d <= depth {
+		height := depth - d
+		start := (gindex - 1<<d) << height
+		return merkleize(v, t, start, height)
+	}
+	if t.kind != containerKind && t.elem.kind == basicKind {
+		return [32]byte{}, fmt.Errorf("generalized index %d is below a packed chunk", gindex)
+	}
+	below := d - depth
+	i := gindex>>below - 1<<depth
+	elem, elemType, err := element(v, t, i)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return node(elem, elemType, 1<<below|gindex&(1<<below-1))
+}
+
+// merkleize returns the root of the subtree of the given height whose leftmost
+// chunk is at the given index.
+func merkleize(v reflect.Value, t *sszType, start uint64, height uint) ([32]byte, error) {
+	if start >= usedChunks(v, t) {
+		return zeroHashes[height], nil
+	}
+	if height == 0 {
+		return chunk(v, t, start)
+	}
+	left, err := merkleize(v, t, start, height-1)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	right, err := merkleize(v, t, start+1<<(height-1), height-1)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return hashPair(left, right), nil
+}
+
+// usedChunks is the number of data chunks of the value which are not
+// zero padding.
+func usedChunks(v reflect.Value, t *sszType) uint64 {
+	switch t.kind {
+	case listKind:
+		n := uint64(v.Len())
+		if t.elem.kind == basicKind {
+			return (n*uint64(t.elem.size) + 31) / 32
+		}
+		return n
+	case bitlistKind:
+		return (bitlistLen(v.Bytes()) + 255) / 256
+	default:
+		return t.chunkCount()
+	}
+}
+
+// chunk returns the data chunk of the value at the given index.
+func chunk(v reflect.Value, t *sszType, i uint64) ([32]byte, error) {
+	var c [32]byte
+	switch {
+	case t.kind == bitlistKind:
+		copy(c[:], bitlistData(v.Bytes())[i*32:])
+		return c, nil
+	case t.kind != containerKind && t.elem.kind == basicKind:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			if b := v.Bytes(); i*32 < uint64(len(b)) {
+				copy(c[:], b[i*32:])
+			}
+			return c, nil
+		}
+		size := uint64(t.elem.size)
+		perChunk := 32 / size
+		for j := uint64(0); j < perChunk; j++ {
+			k := i*perChunk + j
+			if k >= uint64(v.Len()) {
+				break
+			}
+			copy(c[j*size:], serializeBasic(v.Index(int(k)), t.elem.size))
+		}
+		return c, nil
+	default:
+		elem, elemType, err := element(v, t, i)
+		if err != nil {
+			return c, err
+		}
+		return node(elem, elemType, 1)
+	}
+}
+
+// element returns the field of a container, or the element of a vector or list
+// of composite types, at the given index. The missing elements of vectors are
+// zero values.
+func element(v reflect.Value, t *sszType, i uint64) (reflect.Value, *sszType, error) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v = reflect.Zero(v.Type().Elem())
+		} else {
+			v = v.Elem()
+		}
+	}
+	if t.kind == containerKind {
+		if i >= uint64(len(t.fields)) {
+			return reflect.Value{}, nil, fmt.Errorf("field %d out of range", i)
+		}
+		f := t.fields[i]
+		return v.Field(f.index), f.typ, nil
+	}
+	if i >= t.length {
+		return reflect.Value{}, nil, fmt.Errorf("index %d out of range of length %d", i, t.length)
+	}
+	if i >= uint64(v.Len()) {
+		if t.kind == listKind {
+			return reflect.Value{}, nil, fmt.Errorf("index %d out of range of list of length %d", i, v.Len())
+		}
+		return reflect.Zero(v.Type().Elem()), t.elem, nil
+	}
+	return v.Index(int(i)), t.elem, nil
+}
+
+func lengthChunk(v reflect.Value, t *sszType) [32]byte {
+	var c [32]byte
+	length := uint64(v.Len())
+	if t.kind == bitlistKind {
+		length = bitlistLen(v.Bytes())
+	}
+	binary.LittleEndian.PutUint64(c[:], length)
+	return c
+}
+
+func serializeBasic(v reflect.Value, size int) []byte {
+	buf := make([]byte, 8)
+	if v.Kind() == reflect.Bool {
+		if v.Bool() {
+			buf[0] = 1
+		}
+	} else {
+		binary.LittleEndian.PutUint64(buf, v.Uint())
+	}
+	return buf[:size]
+}
+
+// bitlistLen returns the number of bits of a bitlist, which are followed by a
+// single delimiting bit.
+func bitlistLen(b []byte) uint64 {
+	if len(b) == 0 || b[len(b)-1] == 0 {
+		return 0
+	}
+	return uint64(len(b)-1)*8 + uint64(bits.Len8(b[len(b)-1])) - 1
+}
+
+// bitlistData returns the bits of a bitlist without the delimiting bit.
+func bitlistData(b []byte) []byte {
+	n := bitlistLen(b)
+	data := make([]byte, (n+7)/8)
+	copy(data, b)
+	if n%8 != 0 {
+		data[len(data)-1] &^= 1 << (n % 8)
+	}
+	return data
+}
+
+// depthOf returns the depth of a generalized index in its tree.
+func depthOf(gindex uint64) uint {
+	return uint(bits.Len64(gindex)) - 1
+}
@@ -0,0 +1,183 @@
+package sszproof
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type kind int
+
+const (
+	basicKind kind = iota
+	vectorKind
+	listKind
+	bitlistKind
+	containerKind
+)
+
+// sszType describes the SSZ type of a Go value, as declared by the ssz-size
+// and ssz-max tags of the generated protobuf structs.
+type sszType struct {
+	kind kind
+	// size is the byte size of basic types.
+	size int
+	// elem is the element type of vectors and lists.
+	elem *sszType
+	// length is the length of vectors and the limit of lists and bitlists.
+	length uint64
+	fields []*field
+}
+
+type field struct {
+	name  string
+	index int
+	typ   *sszType
+}
+
+var (
+	typeCache     = make(map[reflect.Type]*sszType)
+	typeCacheLock sync.RWMutex
+)
+
+// typeOf returns the SSZ type of a pointer to a struct.
+func typeOf(t reflect.Type) (*sszType, error) {
+	typeCacheLock.RLock()
+	typ, ok := typeCache[t]
+	typeCacheLock.RUnlock()
+	if ok {
+		return typ, nil
+	}
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expected a pointer to a struct, received %v", t)
+	}
+	typ, err := parseType(t, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	typeCacheLock.Lock()
+	typeCache[t] = typ
+	typeCacheLock.Unlock()
+	return typ, nil
+}
+
+func parseType(t reflect.Type, sizes []string, max string) (*sszType, error) {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Uint8:
+		return &sszType{kind: basicKind, size: 1}, nil
+	case reflect.Uint16:
+		return &sszType{kind: basicKind, size: 2}, nil
+	case reflect.Uint32:
+		return &sszType{kind: basicKind, size: 4}, nil
+	case reflect.Uint64:
+		return &sszType{kind: basicKind, size: 8}, nil
+	case reflect.Ptr:
+		return parseType(t.Elem(), sizes, max)
+	case reflect.Struct:
+		return parseContainer(t)
+	case reflect.Slice:
+		if t.Name() == "Bitlist" {
+			limit, err := parseSize(max)
+			if err != nil {
+				return nil, fmt.Errorf("invalid limit of bitlist: %v", err)
+			}
+			return &sszType{kind: bitlistKind, length: limit}, nil
+		}
+		var inner []string
+		if len(sizes) > 1 {
+			inner = sizes[1:]
+		}
+		elem, err := parseType(t.Elem(), inner, "")
+		if err != nil {
+			return nil, err
+		}
+		if len(sizes) > 0 && sizes[0] != "?" {
+			length, err := parseSize(sizes[0])
+			if err != nil {
+				return nil, fmt.Errorf("invalid length of vector: %v", err)
+			}
+			return &sszType{kind: vectorKind, elem: elem, length: length}, nil
+		}
+		if max == "" {
+			return nil, fmt.Errorf("no ssz-size or ssz-max declared for %v", t)
+		}
+		limit, err := parseSize(max)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit of list: %v", err)
+		}
+		return &sszType{kind: listKind, elem: elem, length: limit}, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", t)
+	}
+}
+
+func parseContainer(t reflect.Type) (*sszType, error) {
+	typ := &sszType{kind: containerKind}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" || strings.HasPrefix(f.Name, "XXX_") {
+			continue
+		}
+		var sizes []string
+		if tag, ok := f.Tag.Lookup("ssz-size"); ok {
+			sizes = strings.Split(tag, ",")
+		}
+		fieldType, err := parseType(f.Type, sizes, f.Tag.Get("ssz-max"))
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %v", f.Name, t.Name(), err)
+		}
+		typ.fields = append(typ.fields, &field{name: fieldName(f), index: i, typ: fieldType})
+	}
+	return typ, nil
+}
+
+// fieldName returns the name of a field in the spec, which is the name of the
+// protobuf field unless it is overridden by a spec-name tag.
+func fieldName(f reflect.StructField) string {
+	if name, ok := f.Tag.Lookup("spec-name"); ok {
+		return name
+	}
+	for _, opt := range strings.Split(f.Tag.Get("protobuf"), ",") {
+		if strings.HasPrefix(opt, "name=") {
+			return strings.TrimPrefix(opt, "name=")
+		}
+	}
+	return f.Name
+}
+
+func parseSize(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
+
+// chunkCount is the number of 32 byte chunks of the merkleized data of the type.
+func (t *sszType) chunkCount() uint64 {
+	switch t.kind {
+	case vectorKind, listKind:
+		if t.elem.kind == basicKind {
+			return (t.length*uint64(t.elem.size) + 31) / 32
+		}
+		return t.length
+	case bitlistKind:
+		return (t.length + 255) / 256
+	case containerKind:
+		return uint64(len(t.fields))
+	default:
+		return 1
+	}
+}
+
+// depth is the depth of the merkle tree of the data chunks of the type.
+func (t *sszType) depth() uint {
+	depth := uint(0)
+	for uint64(1)<<depth < t.chunkCount() {
+		depth++
+	}
+	return depth
+}
+
+// mixesInLength is true for the types whose root mixes in their length.
+func (t *sszType) mixesInLength() bool {
+	return t.kind == listKind || t.kind == bitlistKind
+}
@@ -4,6 +4,8 @@
  * A simple peer Kademlia distributed hash table (DHT) service for peer
  * discovery. The purpose of this service is to provide a starting point for
  * newly connected services to find other peers outside of their network.
+ * With --discv5, the bootnode additionally serves as a discv5 bootstrap node
+ * and prints its ENR for use with --discv5-bootstrap-node.
  *
  * Usage: Run bootnode --help for flag options.
  */
@@ -11,9 +13,16 @@ package main
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
 	"flag"
 	"fmt"
+	"net"
 
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
 	ds "github.com/ipfs/go-datastore"
 	dsync "github.com/ipfs/go-datastore/sync"
 	logging "github.com/ipfs/go-log"
@@ -31,6 +40,9 @@ var (
 	debug      = flag.Bool("debug", false, "Enable debug logging")
 	privateKey = flag.String("private", "", "Private key to use for peer ID")
 	port       = flag.Int("port", 4000, "Port to listen for connections")
+	discv5     = flag.Bool("discv5", false, "Also serve as a discv5 bootstrap node")
+	udpPort    = flag.Int("udp-port", 4000, "Port to listen for discv5 packets")
+	externalIP = flag.String("external-ip", "127.0.0.1", "External IP advertised in the discv5 node record")
 
 	log = logging.Logger("prysm-bootnode")
 )
@@ -54,7 +66,10 @@ func main() {
 	opts := []libp2p.Option{
 		libp2p.ListenAddrs(listen),
 	}
-	opts = addPrivateKeyOpt(opts)
+	key := loadPrivateKey()
+	if key != nil {
+		opts = append(opts, libp2p.Identity(key))
+	}
 
 	ctx := context.Background()
 
@@ -80,10 +95,21 @@ func main() {
 
 	fmt.Printf("Running bootnode: /ip4/0.0.0.0/tcp/%d/p2p/%s\n", *port, host.ID().Pretty())
 
+	if *discv5 {
+		listener, err := startDiscv5(key)
+		if err != nil {
+			log.Fatalf("Failed to start discv5 listener: %v", err)
+		}
+		defer listener.Close()
+		fmt.Printf("Running discv5 bootnode: %s\n", listener.Self().String())
+	}
+
 	select {}
 }
 
-func addPrivateKeyOpt(opts []libp2p.Option) []libp2p.Option {
+// loadPrivateKey decodes the private key flag. When discv5 is enabled and no
+// key was provided, a secp256k1 key is generated as discv5 requires one.
+func loadPrivateKey() crypto.PrivKey {
 	if *privateKey != "" {
 		b, err := crypto.ConfigDecodeKey(*privateKey)
 		if err != nil {
@@ -93,9 +119,42 @@ func addPrivateKeyOpt(opts []libp2p.Option) []libp2p.Option {
 		if err != nil {
 			panic(err)
 		}
-		opts = append(opts, libp2p.Identity(pk))
-	} else {
-		log.Warning("No private key was provided. Using default/random private key")
+		return pk
 	}
-	return opts
+	log.Warning("No private key was provided. Using default/random private key")
+	if *discv5 {
+		pk, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		return pk
+	}
+	return nil
+}
+
+// startDiscv5 starts a discv5 listener sharing the identity of the libp2p host.
+func startDiscv5(key crypto.PrivKey) (*discover.UDPv5, error) {
+	secpKey, ok := key.(*crypto.Secp256k1PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("discv5 requires a secp256k1 private key, got %T", key)
+	}
+	privKey := (*ecdsa.PrivateKey)((*btcec.PrivateKey)(secpKey))
+
+	ip := net.ParseIP(*externalIP)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid external ip %q", *externalIP)
+	}
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero, Port: *udpPort})
+	if err != nil {
+		return nil, err
+	}
+	db, err := enode.OpenDB("")
+	if err != nil {
+		return nil, err
+	}
+	localNode := enode.NewLocalNode(db, privKey)
+	localNode.SetStaticIP(ip)
+	localNode.Set(enr.UDP(*udpPort))
+
+	return discover.ListenV5(conn, localNode, discover.Config{PrivateKey: privKey})
 }
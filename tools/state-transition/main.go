@@ -0,0 +1,165 @@
+// This binary runs the state transition of blocks on a pre state and prints the
+// post state root, to debug consensus mismatches with other clients. States and
+// blocks are read from SSZ files, or YAML files in the format of the spec tests.
+// When an expected post state is given and the roots differ, it prints the
+// differing fields of the states.
+//
+//	state-transition -pre-state pre.ssz -block block_0.yaml -block block_1.yaml -post-state post.ssz
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"gopkg.in/d4l3k/messagediff.v1"
+)
+
+type fileList []string
+
+func (f *fileList) String() string {
+	return strings.Join(*f, ",")
+}
+
+func (f *fileList) Set(path string) error {
+	*f = append(*f, path)
+	return nil
+}
+
+var (
+	preStatePath    = flag.String("pre-state", "", "Path to the pre state, an SSZ or YAML file")
+	postStatePath   = flag.String("post-state", "", "Path to the expected post state, an SSZ or YAML file (optional)")
+	preset          = flag.String("config", "mainnet", "Chain config preset of the states, mainnet, minimal or demo")
+	chainConfigPath = flag.String("chain-config-file", "", "Path to a YAML chain config file applied over the preset (optional)")
+	noVerify        = flag.Bool("no-verify", false, "Skip the signature and state root checks of the blocks")
+	blockPaths      fileList
+)
+
+func main() {
+	flag.Var(&blockPaths, "block", "Path to a block, an SSZ or YAML file. Repeat the flag to apply several blocks in order")
+	flag.Parse()
+	if *preStatePath == "" {
+		log.Fatal("The -pre-state flag is required")
+	}
+	if err := configure(*preset, *chainConfigPath); err != nil {
+		log.Fatal(err)
+	}
+
+	matched, err := run(context.Background(), os.Stdout, *preStatePath, blockPaths, *postStatePath, *noVerify)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !matched {
+		os.Exit(1)
+	}
+}
+
+func configure(preset string, chainConfigPath string) error {
+	cfg, err := params.Preset(preset)
+	if err != nil {
+		return err
+	}
+	if chainConfigPath != "" {
+		var unknown []string
+		cfg, unknown, err = params.LoadChainConfigFile(chainConfigPath, cfg)
+		if err != nil {
+			return errors.Wrapf(err, "could not load chain config file %s", chainConfigPath)
+		}
+		for _, key := range unknown {
+			log.Printf("Ignoring unknown chain config parameter %s", key)
+		}
+	}
+	params.OverrideBeaconConfig(cfg)
+	return nil
+}
+
+// run applies the blocks to the pre state and writes the post state root. It
+// returns false when an expected post state is given and differs from the post
+// state, after writing the differing fields.
+func run(
+	ctx context.Context,
+	w io.Writer,
+	preStatePath string,
+	blockPaths []string,
+	postStatePath string,
+	noVerify bool,
+) (bool, error) {
+	preState := &pb.BeaconState{}
+	if err := decodeFile(preStatePath, preState); err != nil {
+		return false, err
+	}
+	s := statetrie.New(preState)
+	for _, path := range blockPaths {
+		block := &ethpb.BeaconBlock{}
+		if err := decodeFile(path, block); err != nil {
+			return false, err
+		}
+		var err error
+		if noVerify {
+			s, err = state.ExecuteStateTransitionNoVerify(ctx, s, block)
+		} else {
+			s, err = state.ExecuteStateTransition(ctx, s, block)
+		}
+		if err != nil {
+			return false, errors.Wrapf(err, "could not process block %s at slot %d", path, block.Slot)
+		}
+	}
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash post state")
+	}
+	fmt.Fprintf(w, "Post state root %#x at slot %d\n", root, s.Slot())
+	if postStatePath == "" {
+		return true, nil
+	}
+
+	expected := &pb.BeaconState{}
+	if err := decodeFile(postStatePath, expected); err != nil {
+		return false, err
+	}
+	expectedRoot, err := ssz.HashTreeRoot(expected)
+	if err != nil {
+		return false, errors.Wrap(err, "could not hash expected post state")
+	}
+	if expectedRoot == root {
+		fmt.Fprintln(w, "Post state matches the expected post state")
+		return true, nil
+	}
+	fmt.Fprintf(w, "Post state differs from the expected post state with root %#x:\n", expectedRoot)
+	diff, _ := messagediff.PrettyDiff(expected, s.InnerStateUnsafe())
+	fmt.Fprint(w, diff)
+	return false, nil
+}
+
+// decodeFile decodes an SSZ file, or a YAML file in the format of the spec
+// tests, into the given value.
+func decodeFile(path string, dest interface{}) error {
+	var unmarshal func([]byte, interface{}) error
+	switch filepath.Ext(path) {
+	case ".ssz":
+		unmarshal = ssz.Unmarshal
+	case ".yaml", ".yml":
+		unmarshal = testutil.UnmarshalYaml
+	default:
+		return fmt.Errorf("unknown format of %s, expected a .ssz, .yaml or .yml file", path)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "could not read %s", path)
+	}
+	return errors.Wrapf(unmarshal(data, dest), "could not decode %s", path)
+}
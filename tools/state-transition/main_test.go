@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/prysmaticlabs/go-ssz"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/statetrie"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+)
+
+func TestRun_ComparesPostState(t *testing.T) {
+	helpers.ClearAllCaches()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "state-transition")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	deposits, privKeys := testutil.SetupInitialDeposits(t, 64)
+	preState, err := state.GenesisBeaconState(deposits, 0, &ethpb.Eth1Data{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := state.ProcessSlots(ctx, statetrie.New(proto.Clone(preState).(*pb.BeaconState)), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	slotState := s.InnerStateUnsafe()
+	parentRoot, err := ssz.SigningRoot(slotState.LatestBlockHeader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	randaoReveal, err := testutil.CreateRandaoReveal(slotState, helpers.CurrentEpoch(slotState), privKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &ethpb.BeaconBlock{
+		Slot:       1,
+		ParentRoot: parentRoot[:],
+		Body: &ethpb.BeaconBlockBody{
+			Eth1Data:     preState.Eth1Data,
+			RandaoReveal: randaoReveal,
+		},
+	}
+	s, err = state.ExecuteStateTransitionNoVerify(ctx, statetrie.New(proto.Clone(preState).(*pb.BeaconState)), block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	postState := s.InnerStateUnsafe()
+
+	prePath := writeSSZ(t, dir, "pre.ssz", preState)
+	blockPath := writeSSZ(t, dir, "block.ssz", block)
+	postPath := writeSSZ(t, dir, "post.ssz", postState)
+	var out bytes.Buffer
+	matched, err := run(ctx, &out, prePath, []string{blockPath}, postPath, true /*noVerify*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !matched || !strings.Contains(out.String(), "matches the expected post state") {
+		t.Errorf("Expected the post state to match, received output:\n%s", out.String())
+	}
+
+	postState.Balances[3]++
+	postState.Validators[5].Slashed = true
+	postPath = writeSSZ(t, dir, "post.ssz", postState)
+	out.Reset()
+	matched, err = run(ctx, &out, prePath, []string{blockPath}, postPath, true /*noVerify*/)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if matched {
+		t.Error("Expected the post state to differ")
+	}
+	for _, field := range []string{".Balances[3]", ".Validators[5].Slashed"} {
+		if !strings.Contains(out.String(), field) {
+			t.Errorf("Expected the diff to list %s, received output:\n%s", field, out.String())
+		}
+	}
+}
+
+func TestDecodeFile_UnknownFormat(t *testing.T) {
+	want := "unknown format"
+	if err := decodeFile("state.json", &pb.BeaconState{}); err == nil || !strings.Contains(err.Error(), want) {
+		t.Errorf("Expected %s, received %v", want, err)
+	}
+}
+
+func writeSSZ(t *testing.T, dir string, name string, obj interface{}) string {
+	enc, err := ssz.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := ioutil.WriteFile(path, enc, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -28,7 +29,8 @@ type Validator interface {
 }
 
 // Run the main validator routine. This routine exits if the context is
-// canceled.
+// canceled. The context of each slot is canceled once the given clock reaches
+// the slot deadline.
 //
 // Order of operations:
 // 1 - Initialize validator data
@@ -37,7 +39,7 @@ type Validator interface {
 // 4 - Update assignments
 // 5 - Determine role at current slot
 // 6 - Perform assigned role, if any
-func run(ctx context.Context, v Validator) {
+func run(ctx context.Context, v Validator, clock clockutil.Clock) {
 	defer v.Done()
 	if err := v.WaitForChainStart(ctx); err != nil {
 		log.Fatalf("Could not determine if beacon chain started: %v", err)
@@ -62,7 +64,7 @@ func run(ctx context.Context, v Validator) {
 			return // Exit if context is canceled.
 		case slot := <-v.NextSlot():
 			span.AddAttributes(trace.Int64Attribute("slot", int64(slot)))
-			slotCtx, cancel := context.WithDeadline(ctx, v.SlotDeadline(slot))
+			slotCtx, cancel := clockutil.WithDeadline(ctx, clock, v.SlotDeadline(slot))
 			// Report this validator client's rewards and penalties throughout its lifecycle.
 			if err := v.LogValidatorGainsAndLosses(slotCtx, slot); err != nil {
 				log.Errorf("Could not report validator's rewards/penalties for slot %d: %v",
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -19,7 +20,7 @@ func cancelledContext() context.Context {
 
 func TestCancelledContext_CleansUpValidator(t *testing.T) {
 	v := &fakeValidator{}
-	run(cancelledContext(), v)
+	run(cancelledContext(), v, clockutil.System{})
 	if !v.DoneCalled {
 		t.Error("Expected Done() to be called")
 	}
@@ -27,7 +28,7 @@ func TestCancelledContext_CleansUpValidator(t *testing.T) {
 
 func TestCancelledContext_WaitsForChainStart(t *testing.T) {
 	v := &fakeValidator{}
-	run(cancelledContext(), v)
+	run(cancelledContext(), v, clockutil.System{})
 	if !v.WaitForChainStartCalled {
 		t.Error("Expected WaitForChainStart() to be called")
 	}
@@ -35,7 +36,7 @@ func TestCancelledContext_WaitsForChainStart(t *testing.T) {
 
 func TestCancelledContext_WaitsForActivation(t *testing.T) {
 	v := &fakeValidator{}
-	run(cancelledContext(), v)
+	run(cancelledContext(), v, clockutil.System{})
 	if !v.WaitForActivationCalled {
 		t.Error("Expected WaitForActivation() to be called")
 	}
@@ -54,7 +55,7 @@ func TestUpdateAssignments_NextSlot(t *testing.T) {
 		cancel()
 	}()
 
-	run(ctx, v)
+	run(ctx, v, clockutil.System{})
 
 	if !v.UpdateAssignmentsCalled {
 		t.Fatalf("Expected UpdateAssignments(%d) to be called", slot)
@@ -79,7 +80,7 @@ func TestUpdateAssignments_HandlesError(t *testing.T) {
 	}()
 	v.UpdateAssignmentsRet = errors.New("bad")
 
-	run(ctx, v)
+	run(ctx, v, clockutil.System{})
 
 	testutil.AssertLogsContain(t, hook, "Failed to update assignments")
 }
@@ -97,7 +98,7 @@ func TestRoleAt_NextSlot(t *testing.T) {
 		cancel()
 	}()
 
-	run(ctx, v)
+	run(ctx, v, clockutil.System{})
 
 	if !v.RoleAtCalled {
 		t.Fatalf("Expected RoleAt(%d) to be called", slot)
@@ -121,7 +122,7 @@ func TestAttests_NextSlot(t *testing.T) {
 		cancel()
 	}()
 	timer := time.NewTimer(time.Duration(200 * time.Millisecond))
-	run(ctx, v)
+	run(ctx, v, clockutil.System{})
 	<-timer.C
 	if !v.AttestToBlockHeadCalled {
 		t.Fatalf("AttestToBlockHead(%d) was not called", slot)
@@ -145,7 +146,7 @@ func TestProposes_NextSlot(t *testing.T) {
 		cancel()
 	}()
 	timer := time.NewTimer(time.Duration(200 * time.Millisecond))
-	run(ctx, v)
+	run(ctx, v, clockutil.System{})
 	<-timer.C
 	if !v.ProposeBlockCalled {
 		t.Fatalf("ProposeBlock(%d) was not called", slot)
@@ -169,7 +170,7 @@ func TestBothProposesAndAttests_NextSlot(t *testing.T) {
 		cancel()
 	}()
 	timer := time.NewTimer(time.Duration(200 * time.Millisecond))
-	run(ctx, v)
+	run(ctx, v, clockutil.System{})
 	<-timer.C
 	if !v.AttestToBlockHeadCalled {
 		t.Fatalf("AttestToBlockHead(%d) was not called", slot)
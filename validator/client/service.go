@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
@@ -28,6 +29,7 @@ type ValidatorService struct {
 	key                  *keystore.Key
 	keys                 map[string]*keystore.Key
 	logValidatorBalances bool
+	clock                clockutil.Clock
 }
 
 // Config for the validator service.
@@ -37,25 +39,38 @@ type Config struct {
 	KeystorePath         string
 	Password             string
 	LogValidatorBalances bool
+	// Keys are validated with instead of the keys of the keystore, if set.
+	Keys map[string]*keystore.Key
+	// Clock is the clock slots are timed by, the system clock if it is not
+	// set.
+	Clock clockutil.Clock
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
 	ctx, cancel := context.WithCancel(ctx)
-	validatorFolder := cfg.KeystorePath
-	validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
-	ks := keystore.NewKeystore(cfg.KeystorePath)
-	keys, err := ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
-	if err != nil {
-		cancel()
-		return nil, errors.Wrap(err, "could not get private key")
+	keys := cfg.Keys
+	if keys == nil {
+		validatorFolder := cfg.KeystorePath
+		validatorPrefix := params.BeaconConfig().ValidatorPrivkeyFileName
+		ks := keystore.NewKeystore(cfg.KeystorePath)
+		var err error
+		keys, err = ks.GetKeys(validatorFolder, validatorPrefix, cfg.Password)
+		if err != nil {
+			cancel()
+			return nil, errors.Wrap(err, "could not get private key")
+		}
 	}
 	var key *keystore.Key
 	for _, v := range keys {
 		key = v
 		break
 	}
+	clock := cfg.Clock
+	if clock == nil {
+		clock = clockutil.System{}
+	}
 	return &ValidatorService{
 		ctx:                  ctx,
 		cancel:               cancel,
@@ -64,6 +79,7 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		keys:                 keys,
 		key:                  key,
 		logValidatorBalances: cfg.LogValidatorBalances,
+		clock:                clock,
 	}, nil
 }
 
@@ -105,8 +121,9 @@ func (v *ValidatorService) Start() {
 		pubkeys:              pubkeys,
 		logValidatorBalances: v.logValidatorBalances,
 		prevBalance:          make(map[[48]byte]uint64),
+		clock:                v.clock,
 	}
-	go run(v.ctx, v.validator)
+	go run(v.ctx, v.validator, v.clock)
 }
 
 // Stop the validator service.
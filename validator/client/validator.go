@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -31,6 +32,16 @@ type validator struct {
 	pubkeys              [][]byte
 	prevBalance          map[[48]byte]uint64
 	logValidatorBalances bool
+	clock                clockutil.Clock
+}
+
+// wallClock returns the clock the validator follows, which is the system
+// clock unless the validator was given another clock.
+func (v *validator) wallClock() clockutil.Clock {
+	if v.clock == nil {
+		return clockutil.System{}
+	}
+	return v.clock
 }
 
 // Done cleans up the validator.
@@ -69,7 +80,7 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 	}
 	// Once the ChainStart log is received, we update the genesis time of the validator client
 	// and begin a slot ticker used to track the current slot the beacon node is in.
-	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	v.ticker = slotutil.GetSlotTickerWithClock(v.wallClock(), time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
 	log.WithField("genesisTime", time.Unix(int64(v.genesisTime), 0)).Info("Beacon chain initialized")
 	return nil
 }
@@ -114,7 +125,7 @@ func (v *validator) WaitForActivation(ctx context.Context) error {
 			"publicKey": fmt.Sprintf("%#x", pk),
 		}).Info("Validator activated")
 	}
-	v.ticker = slotutil.GetSlotTicker(time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
+	v.ticker = slotutil.GetSlotTickerWithClock(v.wallClock(), time.Unix(int64(v.genesisTime), 0), params.BeaconConfig().SecondsPerSlot)
 
 	return nil
 }
@@ -35,7 +35,10 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 		trace.StringAttribute("validator", tpk),
 	)
 
-	v.waitToSlotMidpoint(ctx, slot)
+	if err := v.waitToSlotMidpoint(ctx, slot); err != nil {
+		log.Errorf("Could not wait for the slot midpoint: %v", err)
+		return
+	}
 
 	// We fetch the validator index as it is necessary to generate the aggregation
 	// bitfield of the attestation itself.
@@ -142,13 +145,22 @@ func (v *validator) AttestToBlockHead(ctx context.Context, slot uint64, pk strin
 
 // waitToSlotMidpoint waits until halfway through the current slot period
 // such that any blocks from this slot have time to reach the beacon node
-// before creating the attestation.
-func (v *validator) waitToSlotMidpoint(ctx context.Context, slot uint64) {
+// before creating the attestation. It returns the error of the context if
+// it is done first.
+func (v *validator) waitToSlotMidpoint(ctx context.Context, slot uint64) error {
 	_, span := trace.StartSpan(ctx, "validator.waitToSlotMidpoint")
 	defer span.End()
 
 	duration := time.Duration(slot*params.BeaconConfig().SecondsPerSlot+delay) * time.Second
 	timeToBroadcast := time.Unix(int64(v.genesisTime), 0).Add(duration)
 
-	time.Sleep(time.Until(timeToBroadcast))
+	clock := v.wallClock()
+	timer := clock.NewTimer(timeToBroadcast.Sub(clock.Now()))
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
@@ -15,6 +15,8 @@ import (
 	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -303,3 +305,52 @@ func TestAttestToBlockHead_CorrectBitfieldLength(t *testing.T) {
 		t.Errorf("Wanted length %d, received %d", 2, len(generatedAttestation.AggregationBits))
 	}
 }
+
+func TestWaitToSlotMidpoint_FollowsClock(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+
+	genesis := time.Unix(1000, 0)
+	validator.genesisTime = uint64(genesis.Unix())
+	clock := clockutil.NewManual(genesis)
+	validator.clock = clock
+	delay = 3
+
+	done := make(chan error)
+	go func() {
+		done <- validator.waitToSlotMidpoint(context.Background(), 1)
+	}()
+	clock.WaitForTimers(1)
+	clock.Advance(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	select {
+	case <-done:
+		t.Fatal("Expected to wait for the delay after the slot start")
+	default:
+	}
+	clock.Advance(time.Duration(delay) * time.Second)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWaitToSlotMidpoint_ReturnsWhenContextDone(t *testing.T) {
+	validator, _, finish := setup(t)
+	defer finish()
+
+	genesis := time.Unix(1000, 0)
+	validator.genesisTime = uint64(genesis.Unix())
+	clock := clockutil.NewManual(genesis)
+	validator.clock = clock
+	delay = 3
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- validator.waitToSlotMidpoint(ctx, 1)
+	}()
+	clock.WaitForTimers(1)
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Errorf("Expected %v, received %v", context.Canceled, err)
+	}
+}
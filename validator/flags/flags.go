@@ -43,6 +43,16 @@ var (
 		Name:  "disable-rewards-penalties-logging",
 		Usage: "Disable reward/penalty logging during cluster deployment",
 	}
+	// InteropStartIndexFlag defines the index of the first deterministic interop key to validate with.
+	InteropStartIndexFlag = cli.Uint64Flag{
+		Name:  "interop-start-index",
+		Usage: "Index of the first deterministic interop key to validate with, see interop-num-validators",
+	}
+	// InteropNumValidatorsFlag defines the number of deterministic interop keys to validate with.
+	InteropNumValidatorsFlag = cli.Uint64Flag{
+		Name:  "interop-num-validators",
+		Usage: "Validate with this many deterministic interop keys, from interop-start-index on, instead of the keys of the keystore. Meant for local test networks started from an interop genesis state.",
+	}
 )
 
 func homeDir() string {
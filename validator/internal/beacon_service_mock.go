@@ -99,6 +99,46 @@ func (mr *MockBeaconServiceClientMockRecorder) CanonicalHead(arg0, arg1 interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanonicalHead", reflect.TypeOf((*MockBeaconServiceClient)(nil).CanonicalHead), varargs...)
 }
 
+// Eth1ConnectionStatus mocks base method
+func (m *MockBeaconServiceClient) Eth1ConnectionStatus(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (*v1.Eth1ConnectionStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Eth1ConnectionStatus", varargs...)
+	ret0, _ := ret[0].(*v1.Eth1ConnectionStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Eth1ConnectionStatus indicates an expected call of Eth1ConnectionStatus
+func (mr *MockBeaconServiceClientMockRecorder) Eth1ConnectionStatus(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Eth1ConnectionStatus", reflect.TypeOf((*MockBeaconServiceClient)(nil).Eth1ConnectionStatus), varargs...)
+}
+
+// StreamEvents mocks base method
+func (m *MockBeaconServiceClient) StreamEvents(arg0 context.Context, arg1 *v1.StreamEventsRequest, arg2 ...grpc.CallOption) (v1.BeaconService_StreamEventsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "StreamEvents", varargs...)
+	ret0, _ := ret[0].(v1.BeaconService_StreamEventsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamEvents indicates an expected call of StreamEvents
+func (mr *MockBeaconServiceClientMockRecorder) StreamEvents(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamEvents", reflect.TypeOf((*MockBeaconServiceClient)(nil).StreamEvents), varargs...)
+}
+
 // WaitForChainStart mocks base method
 func (m *MockBeaconServiceClient) WaitForChainStart(arg0 context.Context, arg1 *types.Empty, arg2 ...grpc.CallOption) (v1.BeaconService_WaitForChainStartClient, error) {
 	m.ctrl.T.Helper()
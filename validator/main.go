@@ -33,7 +33,9 @@ func startNode(ctx *cli.Context) error {
 	if err != nil {
 		logrus.Fatal(err)
 	}
-	if !exists {
+	if ctx.GlobalUint64(flags.InteropNumValidatorsFlag.Name) > 0 {
+		logrus.Info("Using deterministic interop keys, the keystore is not opened")
+	} else if !exists {
 		// If an account does not exist, we create a new one and start the node.
 		keystoreDirectory, keystorePassword, err = createValidatorAccount(ctx)
 		if err != nil {
@@ -140,8 +142,11 @@ contract in order to activate the validator client`,
 		flags.KeystorePathFlag,
 		flags.PasswordFlag,
 		flags.DisablePenaltyRewardLogFlag,
+		flags.InteropStartIndexFlag,
+		flags.InteropNumValidatorsFlag,
 		cmd.VerbosityFlag,
 		cmd.DataDirFlag,
+		cmd.ChainConfigFileFlag,
 		cmd.EnableTracingFlag,
 		cmd.TracingProcessNameFlag,
 		cmd.TracingEndpointFlag,
@@ -4,6 +4,7 @@ package node
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,9 +13,12 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/clockutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/interop"
+	"github.com/prysmaticlabs/prysm/shared/keystore"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prometheus"
 	"github.com/prysmaticlabs/prysm/shared/tracing"
@@ -35,10 +39,17 @@ type ValidatorClient struct {
 	services *shared.ServiceRegistry // Lifecycle and service store.
 	lock     sync.RWMutex
 	stop     chan struct{} // Channel to wait for termination notifications.
+	clock    clockutil.Clock
 }
 
 // NewValidatorClient creates a new, Ethereum Serenity validator client.
 func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, error) {
+	return NewValidatorClientWithClock(ctx, password, clockutil.System{})
+}
+
+// NewValidatorClientWithClock creates a new validator client like
+// NewValidatorClient, with its slots timed by the given clock.
+func NewValidatorClientWithClock(ctx *cli.Context, password string, clock clockutil.Clock) (*ValidatorClient, error) {
 	if err := tracing.Setup(
 		"validator", // service name
 		ctx.GlobalString(cmd.TracingProcessNameFlag.Name),
@@ -53,6 +64,7 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 		ctx:      ctx,
 		services: registry,
 		stop:     make(chan struct{}),
+		clock:    clock,
 	}
 
 	// Use custom config values if the --no-custom-config flag is set.
@@ -60,6 +72,9 @@ func NewValidatorClient(ctx *cli.Context, password string) (*ValidatorClient, er
 		log.Info("Using custom parameter configuration")
 		params.UseDemoBeaconConfig()
 	}
+	if err := cmd.ConfigureChainConfig(ctx); err != nil {
+		return nil, err
+	}
 
 	featureconfig.ConfigureBeaconFeatures(ctx)
 
@@ -133,15 +148,47 @@ func (s *ValidatorClient) registerClientService(ctx *cli.Context, password strin
 	keystoreDirectory := ctx.GlobalString(flags.KeystorePathFlag.Name)
 	logValidatorBalances := !ctx.GlobalBool(flags.DisablePenaltyRewardLogFlag.Name)
 	cert := ctx.GlobalString(flags.CertFlag.Name)
+	var keys map[string]*keystore.Key
+	if numKeys := ctx.GlobalUint64(flags.InteropNumValidatorsFlag.Name); numKeys > 0 {
+		var err error
+		keys, err = interopKeys(ctx.GlobalUint64(flags.InteropStartIndexFlag.Name), numKeys)
+		if err != nil {
+			return err
+		}
+	}
 	v, err := client.NewValidatorService(context.Background(), &client.Config{
 		Endpoint:             endpoint,
 		KeystorePath:         keystoreDirectory,
 		Password:             password,
 		LogValidatorBalances: logValidatorBalances,
 		CertFlag:             cert,
+		Keys:                 keys,
+		Clock:                s.clock,
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize client service")
 	}
 	return s.services.RegisterService(v)
 }
+
+// interopKeys returns the deterministic interop keys of the validators with
+// indices startIndex up to startIndex+numKeys, keyed like the keys of a
+// keystore.
+func interopKeys(startIndex uint64, numKeys uint64) (map[string]*keystore.Key, error) {
+	privKeys, pubKeys, err := interop.DeterministicallyGenerateKeys(startIndex, numKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate interop keys")
+	}
+	keys := make(map[string]*keystore.Key, numKeys)
+	for i := range privKeys {
+		keys[hex.EncodeToString(pubKeys[i].Marshal())] = &keystore.Key{
+			PublicKey: pubKeys[i],
+			SecretKey: privKeys[i],
+		}
+	}
+	log.WithFields(logrus.Fields{
+		"startIndex": startIndex,
+		"numKeys":    numKeys,
+	}).Warn("Validating with deterministic interop keys, which are not secret")
+	return keys, nil
+}
@@ -46,6 +46,7 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			cmd.VerbosityFlag,
 			cmd.DataDirFlag,
+			cmd.ChainConfigFileFlag,
 			cmd.EnableTracingFlag,
 			cmd.TracingProcessNameFlag,
 			cmd.TracingEndpointFlag,
@@ -74,6 +75,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.KeystorePathFlag,
 			flags.PasswordFlag,
 			flags.DisablePenaltyRewardLogFlag,
+			flags.InteropStartIndexFlag,
+			flags.InteropNumValidatorsFlag,
 		},
 	},
 	{